@@ -764,6 +764,27 @@ func deepCopy_api_BuildConfigSpec(in buildapi.BuildConfigSpec, out *buildapi.Bui
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = in.RunPolicy
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(buildapi.CommitStatusNotification)
+		if err := deepCopy_api_CommitStatusNotification(*in.CommitStatus, out.CommitStatus, c); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	if err := deepCopy_api_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
@@ -966,13 +987,15 @@ func deepCopy_api_BuildSource(in buildapi.BuildSource, out *buildapi.BuildSource
 	} else {
 		out.Git = nil
 	}
-	if in.Image != nil {
-		out.Image = new(buildapi.ImageSource)
-		if err := deepCopy_api_ImageSource(*in.Image, out.Image, c); err != nil {
-			return err
+	if in.Images != nil {
+		out.Images = make([]buildapi.ImageSource, len(in.Images))
+		for i := range in.Images {
+			if err := deepCopy_api_ImageSource(in.Images[i], &out.Images[i], c); err != nil {
+				return err
+			}
 		}
 	} else {
-		out.Image = nil
+		out.Images = nil
 	}
 	out.ContextDir = in.ContextDir
 	if in.SourceSecret != nil {
@@ -984,6 +1007,16 @@ func deepCopy_api_BuildSource(in buildapi.BuildSource, out *buildapi.BuildSource
 	} else {
 		out.SourceSecret = nil
 	}
+	if in.Secrets != nil {
+		out.Secrets = make([]buildapi.SecretBuildSource, len(in.Secrets))
+		for i := range in.Secrets {
+			if err := deepCopy_api_SecretBuildSource(in.Secrets[i], &out.Secrets[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Secrets = nil
+	}
 	return nil
 }
 
@@ -1082,6 +1115,14 @@ func deepCopy_api_BuildStrategy(in buildapi.BuildStrategy, out *buildapi.BuildSt
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.JenkinsPipelineStrategy != nil {
+		out.JenkinsPipelineStrategy = new(buildapi.JenkinsPipelineBuildStrategy)
+		if err := deepCopy_api_JenkinsPipelineBuildStrategy(*in.JenkinsPipelineStrategy, out.JenkinsPipelineStrategy, c); err != nil {
+			return err
+		}
+	} else {
+		out.JenkinsPipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1103,6 +1144,22 @@ func deepCopy_api_BuildTriggerPolicy(in buildapi.BuildTriggerPolicy, out *builda
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.WebHookTrigger)
+		if err := deepCopy_api_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.WebHookTrigger)
+		if err := deepCopy_api_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapi.ImageChangeTrigger)
 		if err := deepCopy_api_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1114,6 +1171,17 @@ func deepCopy_api_BuildTriggerPolicy(in buildapi.BuildTriggerPolicy, out *builda
 	return nil
 }
 
+func deepCopy_api_CommitStatusNotification(in buildapi.CommitStatusNotification, out *buildapi.CommitStatusNotification, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.APIURL = in.APIURL
+	if newVal, err := c.DeepCopy(in.Secret); err != nil {
+		return err
+	} else {
+		out.Secret = newVal.(pkgapi.LocalObjectReference)
+	}
+	return nil
+}
+
 func deepCopy_api_CustomBuildStrategy(in buildapi.CustomBuildStrategy, out *buildapi.CustomBuildStrategy, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
@@ -1156,6 +1224,23 @@ func deepCopy_api_CustomBuildStrategy(in buildapi.CustomBuildStrategy, out *buil
 	return nil
 }
 
+func deepCopy_api_DockerBuildCache(in buildapi.DockerBuildCache, out *buildapi.DockerBuildCache, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.PersistentVolumeClaim); err != nil {
+		return err
+	} else {
+		out.PersistentVolumeClaim = newVal.(pkgapi.LocalObjectReference)
+	}
+	if in.Paths != nil {
+		out.Paths = make([]string, len(in.Paths))
+		for i := range in.Paths {
+			out.Paths[i] = in.Paths[i]
+		}
+	} else {
+		out.Paths = nil
+	}
+	return nil
+}
+
 func deepCopy_api_DockerBuildStrategy(in buildapi.DockerBuildStrategy, out *buildapi.DockerBuildStrategy, c *conversion.Cloner) error {
 	if in.From != nil {
 		if newVal, err := c.DeepCopy(in.From); err != nil {
@@ -1190,6 +1275,14 @@ func deepCopy_api_DockerBuildStrategy(in buildapi.DockerBuildStrategy, out *buil
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.BuildCache != nil {
+		out.BuildCache = new(buildapi.DockerBuildCache)
+		if err := deepCopy_api_DockerBuildCache(*in.BuildCache, out.BuildCache, c); err != nil {
+			return err
+		}
+	} else {
+		out.BuildCache = nil
+	}
 	return nil
 }
 
@@ -1261,6 +1354,22 @@ func deepCopy_api_ImageSourcePath(in buildapi.ImageSourcePath, out *buildapi.Ima
 	return nil
 }
 
+func deepCopy_api_JenkinsPipelineBuildStrategy(in buildapi.JenkinsPipelineBuildStrategy, out *buildapi.JenkinsPipelineBuildStrategy, c *conversion.Cloner) error {
+	out.JenkinsfilePath = in.JenkinsfilePath
+	out.Jenkinsfile = in.Jenkinsfile
+	return nil
+}
+
+func deepCopy_api_SecretBuildSource(in buildapi.SecretBuildSource, out *buildapi.SecretBuildSource, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Secret); err != nil {
+		return err
+	} else {
+		out.Secret = newVal.(pkgapi.LocalObjectReference)
+	}
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
 func deepCopy_api_SecretSpec(in buildapi.SecretSpec, out *buildapi.SecretSpec, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.SecretSource); err != nil {
 		return err
@@ -1327,6 +1436,88 @@ func deepCopy_api_WebHookTrigger(in buildapi.WebHookTrigger, out *buildapi.WebHo
 	return nil
 }
 
+func deepCopy_api_BlueGreenDeploymentStrategyParams(in deployapi.BlueGreenDeploymentStrategyParams, out *deployapi.BlueGreenDeploymentStrategyParams, c *conversion.Cloner) error {
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.KeepWarmSeconds = in.KeepWarmSeconds
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_api_CanaryDeploymentStrategyParams(in deployapi.CanaryDeploymentStrategyParams, out *deployapi.CanaryDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.Steps != nil {
+		out.Steps = make([]deployapi.CanaryStep, len(in.Steps))
+		for i := range in.Steps {
+			if err := deepCopy_api_CanaryStep(in.Steps[i], &out.Steps[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_api_CanaryStep(in deployapi.CanaryStep, out *deployapi.CanaryStep, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Replicas); err != nil {
+		return err
+	} else {
+		out.Replicas = newVal.(util.IntOrString)
+	}
+	out.PauseSeconds = in.PauseSeconds
+	if in.Verify != nil {
+		out.Verify = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Verify, out.Verify, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	return nil
+}
+
 func deepCopy_api_CustomDeploymentStrategyParams(in deployapi.CustomDeploymentStrategyParams, out *deployapi.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
 	out.Image = in.Image
 	if in.Environment != nil {
@@ -1362,6 +1553,14 @@ func deepCopy_api_DeploymentCause(in deployapi.DeploymentCause, out *deployapi.D
 	} else {
 		out.ImageTrigger = nil
 	}
+	if in.SecretTrigger != nil {
+		out.SecretTrigger = new(deployapi.DeploymentCauseSecretTrigger)
+		if err := deepCopy_api_DeploymentCauseSecretTrigger(*in.SecretTrigger, out.SecretTrigger, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretTrigger = nil
+	}
 	return nil
 }
 
@@ -1374,6 +1573,28 @@ func deepCopy_api_DeploymentCauseImageTrigger(in deployapi.DeploymentCauseImageT
 	return nil
 }
 
+func deepCopy_api_DeploymentCauseSecretTrigger(in deployapi.DeploymentCauseSecretTrigger, out *deployapi.DeploymentCauseSecretTrigger, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapi.ObjectReference)
+	}
+	return nil
+}
+
+func deepCopy_api_DeploymentCondition(in deployapi.DeploymentCondition, out *deployapi.DeploymentCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_api_DeploymentConfig(in deployapi.DeploymentConfig, out *deployapi.DeploymentConfig, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1475,11 +1696,19 @@ func deepCopy_api_DeploymentConfigSpec(in deployapi.DeploymentConfigSpec, out *d
 	} else {
 		out.Template = nil
 	}
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
+	out.Paused = in.Paused
 	return nil
 }
 
 func deepCopy_api_DeploymentConfigStatus(in deployapi.DeploymentConfigStatus, out *deployapi.DeploymentConfigStatus, c *conversion.Cloner) error {
 	out.LatestVersion = in.LatestVersion
+	out.ObservedGeneration = in.ObservedGeneration
 	if in.Details != nil {
 		out.Details = new(deployapi.DeploymentDetails)
 		if err := deepCopy_api_DeploymentDetails(*in.Details, out.Details, c); err != nil {
@@ -1488,6 +1717,21 @@ func deepCopy_api_DeploymentConfigStatus(in deployapi.DeploymentConfigStatus, ou
 	} else {
 		out.Details = nil
 	}
+	out.LiveColor = in.LiveColor
+	out.LiveDeployment = in.LiveDeployment
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapi.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_api_DeploymentCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -1592,6 +1836,22 @@ func deepCopy_api_DeploymentStrategy(in deployapi.DeploymentStrategy, out *deplo
 	} else {
 		out.RollingParams = nil
 	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapi.CanaryDeploymentStrategyParams)
+		if err := deepCopy_api_CanaryDeploymentStrategyParams(*in.CanaryParams, out.CanaryParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapi.BlueGreenDeploymentStrategyParams)
+		if err := deepCopy_api_BlueGreenDeploymentStrategyParams(*in.BlueGreenParams, out.BlueGreenParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -1645,6 +1905,26 @@ func deepCopy_api_DeploymentTriggerPolicy(in deployapi.DeploymentTriggerPolicy,
 	} else {
 		out.ImageChangeParams = nil
 	}
+	if in.SecretChangeParams != nil {
+		out.SecretChangeParams = new(deployapi.DeploymentTriggerSecretChangeParams)
+		if err := deepCopy_api_DeploymentTriggerSecretChangeParams(*in.SecretChangeParams, out.SecretChangeParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretChangeParams = nil
+	}
+	return nil
+}
+
+func deepCopy_api_DeploymentTriggerSecretChangeParams(in deployapi.DeploymentTriggerSecretChangeParams, out *deployapi.DeploymentTriggerSecretChangeParams, c *conversion.Cloner) error {
+	if in.LastTriggeredSecrets != nil {
+		out.LastTriggeredSecrets = make(map[string]string)
+		for key, val := range in.LastTriggeredSecrets {
+			out.LastTriggeredSecrets[key] = val
+		}
+	} else {
+		out.LastTriggeredSecrets = nil
+	}
 	return nil
 }
 
@@ -1691,6 +1971,16 @@ func deepCopy_api_LifecycleHook(in deployapi.LifecycleHook, out *deployapi.Lifec
 	} else {
 		out.ExecNewPod = nil
 	}
+	if in.TagImages != nil {
+		out.TagImages = make([]deployapi.TagImageHook, len(in.TagImages))
+		for i := range in.TagImages {
+			if err := deepCopy_api_TagImageHook(in.TagImages[i], &out.TagImages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TagImages = nil
+	}
 	return nil
 }
 
@@ -1703,6 +1993,14 @@ func deepCopy_api_RecreateDeploymentStrategyParams(in deployapi.RecreateDeployme
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapi.LifecycleHook)
+		if err := deepCopy_api_LifecycleHook(*in.Mid, out.Mid, c); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapi.LifecycleHook)
 		if err := deepCopy_api_LifecycleHook(*in.Post, out.Post, c); err != nil {
@@ -1733,6 +2031,13 @@ func deepCopy_api_RollingDeploymentStrategyParams(in deployapi.RollingDeployment
 	} else {
 		out.TimeoutSeconds = nil
 	}
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
+	out.AutoRollback = in.AutoRollback
 	if newVal, err := c.DeepCopy(in.MaxUnavailable); err != nil {
 		return err
 	} else {
@@ -1768,6 +2073,16 @@ func deepCopy_api_RollingDeploymentStrategyParams(in deployapi.RollingDeployment
 	return nil
 }
 
+func deepCopy_api_TagImageHook(in deployapi.TagImageHook, out *deployapi.TagImageHook, c *conversion.Cloner) error {
+	out.ContainerName = in.ContainerName
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapi.ObjectReference)
+	}
+	return nil
+}
+
 func deepCopy_api_DockerConfig(in imageapi.DockerConfig, out *imageapi.DockerConfig, c *conversion.Cloner) error {
 	out.Hostname = in.Hostname
 	out.Domainname = in.Domainname
@@ -1927,6 +2242,61 @@ func deepCopy_api_Image(in imageapi.Image, out *imageapi.Image, c *conversion.Cl
 	}
 	out.DockerImageMetadataVersion = in.DockerImageMetadataVersion
 	out.DockerImageManifest = in.DockerImageManifest
+	if in.DockerImageLayers != nil {
+		out.DockerImageLayers = make([]imageapi.ImageLayer, len(in.DockerImageLayers))
+		for i := range in.DockerImageLayers {
+			if err := deepCopy_api_ImageLayer(in.DockerImageLayers[i], &out.DockerImageLayers[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DockerImageLayers = nil
+	}
+	return nil
+}
+
+func deepCopy_api_ImageImportSpec(in imageapi.ImageImportSpec, out *imageapi.ImageImportSpec, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapi.ObjectReference)
+	}
+	if in.To != nil {
+		if newVal, err := c.DeepCopy(in.To); err != nil {
+			return err
+		} else {
+			out.To = newVal.(*pkgapi.LocalObjectReference)
+		}
+	} else {
+		out.To = nil
+	}
+	if err := deepCopy_api_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_api_ImageImportStatus(in imageapi.ImageImportStatus, out *imageapi.ImageImportStatus, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Status); err != nil {
+		return err
+	} else {
+		out.Status = newVal.(unversioned.Status)
+	}
+	if in.Image != nil {
+		out.Image = new(imageapi.Image)
+		if err := deepCopy_api_Image(*in.Image, out.Image, c); err != nil {
+			return err
+		}
+	} else {
+		out.Image = nil
+	}
+	out.Tag = in.Tag
+	return nil
+}
+
+func deepCopy_api_ImageLayer(in imageapi.ImageLayer, out *imageapi.ImageLayer, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Size = in.Size
 	return nil
 }
 
@@ -1991,6 +2361,79 @@ func deepCopy_api_ImageStreamImage(in imageapi.ImageStreamImage, out *imageapi.I
 	return nil
 }
 
+func deepCopy_api_ImageStreamImport(in imageapi.ImageStreamImport, out *imageapi.ImageStreamImport, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapi.ObjectMeta)
+	}
+	if err := deepCopy_api_ImageStreamImportSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_api_ImageStreamImportStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_api_ImageStreamImportSpec(in imageapi.ImageStreamImportSpec, out *imageapi.ImageStreamImportSpec, c *conversion.Cloner) error {
+	out.Import = in.Import
+	if in.Repository != nil {
+		out.Repository = new(imageapi.RepositoryImportSpec)
+		if err := deepCopy_api_RepositoryImportSpec(*in.Repository, out.Repository, c); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapi.ImageImportSpec, len(in.Images))
+		for i := range in.Images {
+			if err := deepCopy_api_ImageImportSpec(in.Images[i], &out.Images[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	return nil
+}
+
+func deepCopy_api_ImageStreamImportStatus(in imageapi.ImageStreamImportStatus, out *imageapi.ImageStreamImportStatus, c *conversion.Cloner) error {
+	if in.Import != nil {
+		out.Import = new(imageapi.ImageStream)
+		if err := deepCopy_api_ImageStream(*in.Import, out.Import, c); err != nil {
+			return err
+		}
+	} else {
+		out.Import = nil
+	}
+	if in.Repository != nil {
+		out.Repository = new(imageapi.RepositoryImportStatus)
+		if err := deepCopy_api_RepositoryImportStatus(*in.Repository, out.Repository, c); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapi.ImageImportStatus, len(in.Images))
+		for i := range in.Images {
+			if err := deepCopy_api_ImageImportStatus(in.Images[i], &out.Images[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	return nil
+}
+
 func deepCopy_api_ImageStreamList(in imageapi.ImageStreamList, out *imageapi.ImageStreamList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2109,6 +2552,45 @@ func deepCopy_api_ImageStreamTagList(in imageapi.ImageStreamTagList, out *imagea
 	return nil
 }
 
+func deepCopy_api_RepositoryImportSpec(in imageapi.RepositoryImportSpec, out *imageapi.RepositoryImportSpec, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapi.ObjectReference)
+	}
+	if err := deepCopy_api_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_api_RepositoryImportStatus(in imageapi.RepositoryImportStatus, out *imageapi.RepositoryImportStatus, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Status); err != nil {
+		return err
+	} else {
+		out.Status = newVal.(unversioned.Status)
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapi.ImageImportStatus, len(in.Images))
+		for i := range in.Images {
+			if err := deepCopy_api_ImageImportStatus(in.Images[i], &out.Images[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	if in.AdditionalTags != nil {
+		out.AdditionalTags = make([]string, len(in.AdditionalTags))
+		for i := range in.AdditionalTags {
+			out.AdditionalTags[i] = in.AdditionalTags[i]
+		}
+	} else {
+		out.AdditionalTags = nil
+	}
+	return nil
+}
+
 func deepCopy_api_TagEvent(in imageapi.TagEvent, out *imageapi.TagEvent, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.Created); err != nil {
 		return err
@@ -2120,6 +2602,19 @@ func deepCopy_api_TagEvent(in imageapi.TagEvent, out *imageapi.TagEvent, c *conv
 	return nil
 }
 
+func deepCopy_api_TagEventCondition(in imageapi.TagEventCondition, out *imageapi.TagEventCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_api_TagEventList(in imageapi.TagEventList, out *imageapi.TagEventList, c *conversion.Cloner) error {
 	if in.Items != nil {
 		out.Items = make([]imageapi.TagEvent, len(in.Items))
@@ -2131,6 +2626,22 @@ func deepCopy_api_TagEventList(in imageapi.TagEventList, out *imageapi.TagEventL
 	} else {
 		out.Items = nil
 	}
+	if in.Conditions != nil {
+		out.Conditions = make([]imageapi.TagEventCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_api_TagEventCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+func deepCopy_api_TagImportPolicy(in imageapi.TagImportPolicy, out *imageapi.TagImportPolicy, c *conversion.Cloner) error {
+	out.Insecure = in.Insecure
+	out.Scheduled = in.Scheduled
 	return nil
 }
 
@@ -2153,6 +2664,9 @@ func deepCopy_api_TagReference(in imageapi.TagReference, out *imageapi.TagRefere
 		out.From = nil
 	}
 	out.Reference = in.Reference
+	if err := deepCopy_api_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2455,6 +2969,35 @@ func deepCopy_api_Route(in routeapi.Route, out *routeapi.Route, c *conversion.Cl
 	return nil
 }
 
+func deepCopy_api_RouteIngress(in routeapi.RouteIngress, out *routeapi.RouteIngress, c *conversion.Cloner) error {
+	out.Host = in.Host
+	out.RouterName = in.RouterName
+	if in.Conditions != nil {
+		out.Conditions = make([]routeapi.RouteIngressCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_api_RouteIngressCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+func deepCopy_api_RouteIngressCondition(in routeapi.RouteIngressCondition, out *routeapi.RouteIngressCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	out.Reason = in.Reason
+	out.Message = in.Message
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	return nil
+}
+
 func deepCopy_api_RouteList(in routeapi.RouteList, out *routeapi.RouteList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2516,6 +3059,16 @@ func deepCopy_api_RouteSpec(in routeapi.RouteSpec, out *routeapi.RouteSpec, c *c
 }
 
 func deepCopy_api_RouteStatus(in routeapi.RouteStatus, out *routeapi.RouteStatus, c *conversion.Cloner) error {
+	if in.Ingress != nil {
+		out.Ingress = make([]routeapi.RouteIngress, len(in.Ingress))
+		for i := range in.Ingress {
+			if err := deepCopy_api_RouteIngress(in.Ingress[i], &out.Ingress[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ingress = nil
+	}
 	return nil
 }
 
@@ -2954,21 +3507,30 @@ func init() {
 		deepCopy_api_BuildStatus,
 		deepCopy_api_BuildStrategy,
 		deepCopy_api_BuildTriggerPolicy,
+		deepCopy_api_CommitStatusNotification,
 		deepCopy_api_CustomBuildStrategy,
+		deepCopy_api_DockerBuildCache,
 		deepCopy_api_DockerBuildStrategy,
 		deepCopy_api_GitBuildSource,
 		deepCopy_api_GitSourceRevision,
 		deepCopy_api_ImageChangeTrigger,
 		deepCopy_api_ImageSource,
 		deepCopy_api_ImageSourcePath,
+		deepCopy_api_JenkinsPipelineBuildStrategy,
+		deepCopy_api_SecretBuildSource,
 		deepCopy_api_SecretSpec,
 		deepCopy_api_SourceBuildStrategy,
 		deepCopy_api_SourceControlUser,
 		deepCopy_api_SourceRevision,
 		deepCopy_api_WebHookTrigger,
+		deepCopy_api_BlueGreenDeploymentStrategyParams,
+		deepCopy_api_CanaryDeploymentStrategyParams,
+		deepCopy_api_CanaryStep,
 		deepCopy_api_CustomDeploymentStrategyParams,
 		deepCopy_api_DeploymentCause,
 		deepCopy_api_DeploymentCauseImageTrigger,
+		deepCopy_api_DeploymentCauseSecretTrigger,
+		deepCopy_api_DeploymentCondition,
 		deepCopy_api_DeploymentConfig,
 		deepCopy_api_DeploymentConfigList,
 		deepCopy_api_DeploymentConfigRollback,
@@ -2981,24 +3543,36 @@ func init() {
 		deepCopy_api_DeploymentStrategy,
 		deepCopy_api_DeploymentTriggerImageChangeParams,
 		deepCopy_api_DeploymentTriggerPolicy,
+		deepCopy_api_DeploymentTriggerSecretChangeParams,
 		deepCopy_api_ExecNewPodHook,
 		deepCopy_api_LifecycleHook,
 		deepCopy_api_RecreateDeploymentStrategyParams,
 		deepCopy_api_RollingDeploymentStrategyParams,
+		deepCopy_api_TagImageHook,
 		deepCopy_api_DockerConfig,
 		deepCopy_api_DockerImage,
 		deepCopy_api_Image,
+		deepCopy_api_ImageImportSpec,
+		deepCopy_api_ImageImportStatus,
+		deepCopy_api_ImageLayer,
 		deepCopy_api_ImageList,
 		deepCopy_api_ImageStream,
 		deepCopy_api_ImageStreamImage,
+		deepCopy_api_ImageStreamImport,
+		deepCopy_api_ImageStreamImportSpec,
+		deepCopy_api_ImageStreamImportStatus,
 		deepCopy_api_ImageStreamList,
 		deepCopy_api_ImageStreamMapping,
 		deepCopy_api_ImageStreamSpec,
 		deepCopy_api_ImageStreamStatus,
 		deepCopy_api_ImageStreamTag,
 		deepCopy_api_ImageStreamTagList,
+		deepCopy_api_RepositoryImportSpec,
+		deepCopy_api_RepositoryImportStatus,
 		deepCopy_api_TagEvent,
+		deepCopy_api_TagEventCondition,
 		deepCopy_api_TagEventList,
+		deepCopy_api_TagImportPolicy,
 		deepCopy_api_TagReference,
 		deepCopy_api_OAuthAccessToken,
 		deepCopy_api_OAuthAccessTokenList,
@@ -3014,6 +3588,8 @@ func init() {
 		deepCopy_api_ProjectSpec,
 		deepCopy_api_ProjectStatus,
 		deepCopy_api_Route,
+		deepCopy_api_RouteIngress,
+		deepCopy_api_RouteIngressCondition,
 		deepCopy_api_RouteList,
 		deepCopy_api_RoutePort,
 		deepCopy_api_RouteSpec,
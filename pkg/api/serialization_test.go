@@ -211,6 +211,11 @@ func fuzzInternalObject(t *testing.T, forVersion string, item runtime.Object, se
 				j.To.Name = strings.Replace(j.To.Name, ":", "-", -1)
 			}
 		},
+		func(j *build.BuildConfigSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(j)
+			policies := []build.BuildRunPolicy{build.BuildRunPolicyParallel, build.BuildRunPolicySerial, build.BuildRunPolicySerialLatestOnly}
+			j.RunPolicy = policies[c.Rand.Intn(len(policies))]
+		},
 		func(j *route.RouteSpec, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
 			j.To = api.ObjectReference{
@@ -234,7 +239,7 @@ func fuzzInternalObject(t *testing.T, forVersion string, item runtime.Object, se
 		},
 		func(j *deploy.DeploymentStrategy, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
-			strategyTypes := []deploy.DeploymentStrategyType{deploy.DeploymentStrategyTypeRecreate, deploy.DeploymentStrategyTypeRolling, deploy.DeploymentStrategyTypeCustom}
+			strategyTypes := []deploy.DeploymentStrategyType{deploy.DeploymentStrategyTypeRecreate, deploy.DeploymentStrategyTypeRolling, deploy.DeploymentStrategyTypeCanary, deploy.DeploymentStrategyTypeBlueGreen, deploy.DeploymentStrategyTypeCustom}
 			j.Type = strategyTypes[c.Rand.Intn(len(strategyTypes))]
 			switch j.Type {
 			case deploy.DeploymentStrategyTypeRolling:
@@ -279,6 +284,23 @@ func fuzzInternalObject(t *testing.T, forVersion string, item runtime.Object, se
 			default:
 				j.RollingParams = nil
 			}
+			if j.CanaryParams != nil {
+				// The timeout is defaulted in the versioned API.
+				timeout := int64(c.RandUint64())
+				j.CanaryParams.TimeoutSeconds = &timeout
+				for i := range j.CanaryParams.Steps {
+					if c.RandBool() {
+						j.CanaryParams.Steps[i].Replicas = util.NewIntOrStringFromInt(int(c.RandUint64()))
+					} else {
+						j.CanaryParams.Steps[i].Replicas = util.NewIntOrStringFromString(fmt.Sprintf("%d%%", c.RandUint64()))
+					}
+				}
+			}
+			if j.BlueGreenParams != nil {
+				// The timeout is defaulted in the versioned API.
+				timeout := int64(c.RandUint64())
+				j.BlueGreenParams.TimeoutSeconds = &timeout
+			}
 		},
 		func(j *deploy.DeploymentCauseImageTrigger, c fuzz.Continue) {
 			c.FuzzNoCustom(j)
@@ -1192,6 +1192,27 @@ func autoconvert_api_BuildConfigSpec_To_v1_BuildConfigSpec(in *buildapi.BuildCon
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = apiv1.BuildRunPolicy(in.RunPolicy)
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(apiv1.CommitStatusNotification)
+		if err := convert_api_CommitStatusNotification_To_v1_CommitStatusNotification(in.CommitStatus, out.CommitStatus, s); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	if err := convert_api_BuildSpec_To_v1_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
@@ -1418,13 +1439,15 @@ func autoconvert_api_BuildSource_To_v1_BuildSource(in *buildapi.BuildSource, out
 	} else {
 		out.Git = nil
 	}
-	if in.Image != nil {
-		out.Image = new(apiv1.ImageSource)
-		if err := convert_api_ImageSource_To_v1_ImageSource(in.Image, out.Image, s); err != nil {
-			return err
+	if in.Images != nil {
+		out.Images = make([]apiv1.ImageSource, len(in.Images))
+		for i := range in.Images {
+			if err := convert_api_ImageSource_To_v1_ImageSource(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
-		out.Image = nil
+		out.Images = nil
 	}
 	out.ContextDir = in.ContextDir
 	if in.SourceSecret != nil {
@@ -1435,6 +1458,16 @@ func autoconvert_api_BuildSource_To_v1_BuildSource(in *buildapi.BuildSource, out
 	} else {
 		out.SourceSecret = nil
 	}
+	if in.Secrets != nil {
+		out.Secrets = make([]apiv1.SecretBuildSource, len(in.Secrets))
+		for i := range in.Secrets {
+			if err := convert_api_SecretBuildSource_To_v1_SecretBuildSource(&in.Secrets[i], &out.Secrets[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Secrets = nil
+	}
 	return nil
 }
 
@@ -1539,6 +1572,14 @@ func autoconvert_api_BuildStrategy_To_v1_BuildStrategy(in *buildapi.BuildStrateg
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.JenkinsPipelineStrategy != nil {
+		out.JenkinsPipelineStrategy = new(apiv1.JenkinsPipelineBuildStrategy)
+		if err := convert_api_JenkinsPipelineBuildStrategy_To_v1_JenkinsPipelineBuildStrategy(in.JenkinsPipelineStrategy, out.JenkinsPipelineStrategy, s); err != nil {
+			return err
+		}
+	} else {
+		out.JenkinsPipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1563,6 +1604,22 @@ func autoconvert_api_BuildTriggerPolicy_To_v1_BuildTriggerPolicy(in *buildapi.Bu
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1.WebHookTrigger)
+		if err := convert_api_WebHookTrigger_To_v1_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1.WebHookTrigger)
+		if err := convert_api_WebHookTrigger_To_v1_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(apiv1.ImageChangeTrigger)
 		if err := convert_api_ImageChangeTrigger_To_v1_ImageChangeTrigger(in.ImageChange, out.ImageChange, s); err != nil {
@@ -1574,6 +1631,22 @@ func autoconvert_api_BuildTriggerPolicy_To_v1_BuildTriggerPolicy(in *buildapi.Bu
 	return nil
 }
 
+func autoconvert_api_CommitStatusNotification_To_v1_CommitStatusNotification(in *buildapi.CommitStatusNotification, out *apiv1.CommitStatusNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.CommitStatusNotification))(in)
+	}
+	out.Type = apiv1.CommitStatusHostType(in.Type)
+	out.APIURL = in.APIURL
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_CommitStatusNotification_To_v1_CommitStatusNotification(in *buildapi.CommitStatusNotification, out *apiv1.CommitStatusNotification, s conversion.Scope) error {
+	return autoconvert_api_CommitStatusNotification_To_v1_CommitStatusNotification(in, out, s)
+}
+
 func autoconvert_api_CustomBuildStrategy_To_v1_CustomBuildStrategy(in *buildapi.CustomBuildStrategy, out *apiv1.CustomBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.CustomBuildStrategy))(in)
@@ -1614,6 +1687,28 @@ func autoconvert_api_CustomBuildStrategy_To_v1_CustomBuildStrategy(in *buildapi.
 	return nil
 }
 
+func autoconvert_api_DockerBuildCache_To_v1_DockerBuildCache(in *buildapi.DockerBuildCache, out *apiv1.DockerBuildCache, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.DockerBuildCache))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.PersistentVolumeClaim, &out.PersistentVolumeClaim, s); err != nil {
+		return err
+	}
+	if in.Paths != nil {
+		out.Paths = make([]string, len(in.Paths))
+		for i := range in.Paths {
+			out.Paths[i] = in.Paths[i]
+		}
+	} else {
+		out.Paths = nil
+	}
+	return nil
+}
+
+func convert_api_DockerBuildCache_To_v1_DockerBuildCache(in *buildapi.DockerBuildCache, out *apiv1.DockerBuildCache, s conversion.Scope) error {
+	return autoconvert_api_DockerBuildCache_To_v1_DockerBuildCache(in, out, s)
+}
+
 func autoconvert_api_DockerBuildStrategy_To_v1_DockerBuildStrategy(in *buildapi.DockerBuildStrategy, out *apiv1.DockerBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.DockerBuildStrategy))(in)
@@ -1647,6 +1742,14 @@ func autoconvert_api_DockerBuildStrategy_To_v1_DockerBuildStrategy(in *buildapi.
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.BuildCache != nil {
+		out.BuildCache = new(apiv1.DockerBuildCache)
+		if err := convert_api_DockerBuildCache_To_v1_DockerBuildCache(in.BuildCache, out.BuildCache, s); err != nil {
+			return err
+		}
+	} else {
+		out.BuildCache = nil
+	}
 	return nil
 }
 
@@ -1749,6 +1852,34 @@ func convert_api_ImageSourcePath_To_v1_ImageSourcePath(in *buildapi.ImageSourceP
 	return autoconvert_api_ImageSourcePath_To_v1_ImageSourcePath(in, out, s)
 }
 
+func autoconvert_api_JenkinsPipelineBuildStrategy_To_v1_JenkinsPipelineBuildStrategy(in *buildapi.JenkinsPipelineBuildStrategy, out *apiv1.JenkinsPipelineBuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.JenkinsPipelineBuildStrategy))(in)
+	}
+	out.JenkinsfilePath = in.JenkinsfilePath
+	out.Jenkinsfile = in.Jenkinsfile
+	return nil
+}
+
+func convert_api_JenkinsPipelineBuildStrategy_To_v1_JenkinsPipelineBuildStrategy(in *buildapi.JenkinsPipelineBuildStrategy, out *apiv1.JenkinsPipelineBuildStrategy, s conversion.Scope) error {
+	return autoconvert_api_JenkinsPipelineBuildStrategy_To_v1_JenkinsPipelineBuildStrategy(in, out, s)
+}
+
+func autoconvert_api_SecretBuildSource_To_v1_SecretBuildSource(in *buildapi.SecretBuildSource, out *apiv1.SecretBuildSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.SecretBuildSource))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
+func convert_api_SecretBuildSource_To_v1_SecretBuildSource(in *buildapi.SecretBuildSource, out *apiv1.SecretBuildSource, s conversion.Scope) error {
+	return autoconvert_api_SecretBuildSource_To_v1_SecretBuildSource(in, out, s)
+}
+
 func autoconvert_api_SecretSpec_To_v1_SecretSpec(in *buildapi.SecretSpec, out *apiv1.SecretSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.SecretSpec))(in)
@@ -1958,6 +2089,27 @@ func autoconvert_v1_BuildConfigSpec_To_api_BuildConfigSpec(in *apiv1.BuildConfig
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = buildapi.BuildRunPolicy(in.RunPolicy)
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(buildapi.CommitStatusNotification)
+		if err := convert_v1_CommitStatusNotification_To_api_CommitStatusNotification(in.CommitStatus, out.CommitStatus, s); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	if err := convert_v1_BuildSpec_To_api_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
@@ -2185,13 +2337,16 @@ func autoconvert_v1_BuildSource_To_api_BuildSource(in *apiv1.BuildSource, out *b
 	} else {
 		out.Git = nil
 	}
-	if in.Image != nil {
-		out.Image = new(buildapi.ImageSource)
-		if err := convert_v1_ImageSource_To_api_ImageSource(in.Image, out.Image, s); err != nil {
-			return err
+	// in.Image has no peer in out
+	if in.Images != nil {
+		out.Images = make([]buildapi.ImageSource, len(in.Images))
+		for i := range in.Images {
+			if err := convert_v1_ImageSource_To_api_ImageSource(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
-		out.Image = nil
+		out.Images = nil
 	}
 	out.ContextDir = in.ContextDir
 	if in.SourceSecret != nil {
@@ -2202,6 +2357,16 @@ func autoconvert_v1_BuildSource_To_api_BuildSource(in *apiv1.BuildSource, out *b
 	} else {
 		out.SourceSecret = nil
 	}
+	if in.Secrets != nil {
+		out.Secrets = make([]buildapi.SecretBuildSource, len(in.Secrets))
+		for i := range in.Secrets {
+			if err := convert_v1_SecretBuildSource_To_api_SecretBuildSource(&in.Secrets[i], &out.Secrets[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Secrets = nil
+	}
 	return nil
 }
 
@@ -2307,6 +2472,14 @@ func autoconvert_v1_BuildStrategy_To_api_BuildStrategy(in *apiv1.BuildStrategy,
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.JenkinsPipelineStrategy != nil {
+		out.JenkinsPipelineStrategy = new(buildapi.JenkinsPipelineBuildStrategy)
+		if err := convert_v1_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy(in.JenkinsPipelineStrategy, out.JenkinsPipelineStrategy, s); err != nil {
+			return err
+		}
+	} else {
+		out.JenkinsPipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -2331,6 +2504,22 @@ func autoconvert_v1_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *apiv1.Build
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.WebHookTrigger)
+		if err := convert_v1_WebHookTrigger_To_api_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.WebHookTrigger)
+		if err := convert_v1_WebHookTrigger_To_api_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapi.ImageChangeTrigger)
 		if err := convert_v1_ImageChangeTrigger_To_api_ImageChangeTrigger(in.ImageChange, out.ImageChange, s); err != nil {
@@ -2342,6 +2531,22 @@ func autoconvert_v1_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *apiv1.Build
 	return nil
 }
 
+func autoconvert_v1_CommitStatusNotification_To_api_CommitStatusNotification(in *apiv1.CommitStatusNotification, out *buildapi.CommitStatusNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.CommitStatusNotification))(in)
+	}
+	out.Type = buildapi.CommitStatusHostType(in.Type)
+	out.APIURL = in.APIURL
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_CommitStatusNotification_To_api_CommitStatusNotification(in *apiv1.CommitStatusNotification, out *buildapi.CommitStatusNotification, s conversion.Scope) error {
+	return autoconvert_v1_CommitStatusNotification_To_api_CommitStatusNotification(in, out, s)
+}
+
 func autoconvert_v1_CustomBuildStrategy_To_api_CustomBuildStrategy(in *apiv1.CustomBuildStrategy, out *buildapi.CustomBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1.CustomBuildStrategy))(in)
@@ -2382,6 +2587,28 @@ func autoconvert_v1_CustomBuildStrategy_To_api_CustomBuildStrategy(in *apiv1.Cus
 	return nil
 }
 
+func autoconvert_v1_DockerBuildCache_To_api_DockerBuildCache(in *apiv1.DockerBuildCache, out *buildapi.DockerBuildCache, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.DockerBuildCache))(in)
+	}
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.PersistentVolumeClaim, &out.PersistentVolumeClaim, s); err != nil {
+		return err
+	}
+	if in.Paths != nil {
+		out.Paths = make([]string, len(in.Paths))
+		for i := range in.Paths {
+			out.Paths[i] = in.Paths[i]
+		}
+	} else {
+		out.Paths = nil
+	}
+	return nil
+}
+
+func convert_v1_DockerBuildCache_To_api_DockerBuildCache(in *apiv1.DockerBuildCache, out *buildapi.DockerBuildCache, s conversion.Scope) error {
+	return autoconvert_v1_DockerBuildCache_To_api_DockerBuildCache(in, out, s)
+}
+
 func autoconvert_v1_DockerBuildStrategy_To_api_DockerBuildStrategy(in *apiv1.DockerBuildStrategy, out *buildapi.DockerBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1.DockerBuildStrategy))(in)
@@ -2415,6 +2642,14 @@ func autoconvert_v1_DockerBuildStrategy_To_api_DockerBuildStrategy(in *apiv1.Doc
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.BuildCache != nil {
+		out.BuildCache = new(buildapi.DockerBuildCache)
+		if err := convert_v1_DockerBuildCache_To_api_DockerBuildCache(in.BuildCache, out.BuildCache, s); err != nil {
+			return err
+		}
+	} else {
+		out.BuildCache = nil
+	}
 	return nil
 }
 
@@ -2517,6 +2752,34 @@ func convert_v1_ImageSourcePath_To_api_ImageSourcePath(in *apiv1.ImageSourcePath
 	return autoconvert_v1_ImageSourcePath_To_api_ImageSourcePath(in, out, s)
 }
 
+func autoconvert_v1_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy(in *apiv1.JenkinsPipelineBuildStrategy, out *buildapi.JenkinsPipelineBuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.JenkinsPipelineBuildStrategy))(in)
+	}
+	out.JenkinsfilePath = in.JenkinsfilePath
+	out.Jenkinsfile = in.Jenkinsfile
+	return nil
+}
+
+func convert_v1_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy(in *apiv1.JenkinsPipelineBuildStrategy, out *buildapi.JenkinsPipelineBuildStrategy, s conversion.Scope) error {
+	return autoconvert_v1_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy(in, out, s)
+}
+
+func autoconvert_v1_SecretBuildSource_To_api_SecretBuildSource(in *apiv1.SecretBuildSource, out *buildapi.SecretBuildSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1.SecretBuildSource))(in)
+	}
+	if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
+func convert_v1_SecretBuildSource_To_api_SecretBuildSource(in *apiv1.SecretBuildSource, out *buildapi.SecretBuildSource, s conversion.Scope) error {
+	return autoconvert_v1_SecretBuildSource_To_api_SecretBuildSource(in, out, s)
+}
+
 func autoconvert_v1_SecretSpec_To_api_SecretSpec(in *apiv1.SecretSpec, out *buildapi.SecretSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1.SecretSpec))(in)
@@ -2604,6 +2867,107 @@ func convert_v1_WebHookTrigger_To_api_WebHookTrigger(in *apiv1.WebHookTrigger, o
 	return autoconvert_v1_WebHookTrigger_To_api_WebHookTrigger(in, out, s)
 }
 
+func autoconvert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams(in *deployapi.BlueGreenDeploymentStrategyParams, out *deployapiv1.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.BlueGreenDeploymentStrategyParams))(in)
+	}
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.KeepWarmSeconds = in.KeepWarmSeconds
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func convert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams(in *deployapi.BlueGreenDeploymentStrategyParams, out *deployapiv1.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	return autoconvert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams(in, out, s)
+}
+
+func autoconvert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams(in *deployapi.CanaryDeploymentStrategyParams, out *deployapiv1.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.CanaryDeploymentStrategyParams))(in)
+	}
+	if in.Steps != nil {
+		out.Steps = make([]deployapiv1.CanaryStep, len(in.Steps))
+		for i := range in.Steps {
+			if err := convert_api_CanaryStep_To_v1_CanaryStep(&in.Steps[i], &out.Steps[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func convert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams(in *deployapi.CanaryDeploymentStrategyParams, out *deployapiv1.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	return autoconvert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams(in, out, s)
+}
+
+func autoconvert_api_CanaryStep_To_v1_CanaryStep(in *deployapi.CanaryStep, out *deployapiv1.CanaryStep, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.CanaryStep))(in)
+	}
+	if err := s.Convert(&in.Replicas, &out.Replicas, 0); err != nil {
+		return err
+	}
+	out.PauseSeconds = in.PauseSeconds
+	if in.Verify != nil {
+		out.Verify = new(deployapiv1.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1_LifecycleHook(in.Verify, out.Verify, s); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	return nil
+}
+
+func convert_api_CanaryStep_To_v1_CanaryStep(in *deployapi.CanaryStep, out *deployapiv1.CanaryStep, s conversion.Scope) error {
+	return autoconvert_api_CanaryStep_To_v1_CanaryStep(in, out, s)
+}
+
 func autoconvert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams(in *deployapi.CustomDeploymentStrategyParams, out *deployapiv1.CustomDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.CustomDeploymentStrategyParams))(in)
@@ -2647,6 +3011,14 @@ func autoconvert_api_DeploymentCause_To_v1_DeploymentCause(in *deployapi.Deploym
 	} else {
 		out.ImageTrigger = nil
 	}
+	if in.SecretTrigger != nil {
+		out.SecretTrigger = new(deployapiv1.DeploymentCauseSecretTrigger)
+		if err := convert_api_DeploymentCauseSecretTrigger_To_v1_DeploymentCauseSecretTrigger(in.SecretTrigger, out.SecretTrigger, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretTrigger = nil
+	}
 	return nil
 }
 
@@ -2668,38 +3040,70 @@ func convert_api_DeploymentCauseImageTrigger_To_v1_DeploymentCauseImageTrigger(i
 	return autoconvert_api_DeploymentCauseImageTrigger_To_v1_DeploymentCauseImageTrigger(in, out, s)
 }
 
-func autoconvert_api_DeploymentConfig_To_v1_DeploymentConfig(in *deployapi.DeploymentConfig, out *deployapiv1.DeploymentConfig, s conversion.Scope) error {
+func autoconvert_api_DeploymentCauseSecretTrigger_To_v1_DeploymentCauseSecretTrigger(in *deployapi.DeploymentCauseSecretTrigger, out *deployapiv1.DeploymentCauseSecretTrigger, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*deployapi.DeploymentConfig))(in)
-	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
-		return err
-	}
-	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
-		return err
+		defaulting.(func(*deployapi.DeploymentCauseSecretTrigger))(in)
 	}
-	if err := convert_api_DeploymentConfigSpec_To_v1_DeploymentConfigSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := convert_api_DeploymentConfigStatus_To_v1_DeploymentConfigStatus(&in.Status, &out.Status, s); err != nil {
+	if err := convert_api_ObjectReference_To_v1_ObjectReference(&in.From, &out.From, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-func convert_api_DeploymentConfig_To_v1_DeploymentConfig(in *deployapi.DeploymentConfig, out *deployapiv1.DeploymentConfig, s conversion.Scope) error {
-	return autoconvert_api_DeploymentConfig_To_v1_DeploymentConfig(in, out, s)
+func convert_api_DeploymentCauseSecretTrigger_To_v1_DeploymentCauseSecretTrigger(in *deployapi.DeploymentCauseSecretTrigger, out *deployapiv1.DeploymentCauseSecretTrigger, s conversion.Scope) error {
+	return autoconvert_api_DeploymentCauseSecretTrigger_To_v1_DeploymentCauseSecretTrigger(in, out, s)
 }
 
-func autoconvert_api_DeploymentConfigList_To_v1_DeploymentConfigList(in *deployapi.DeploymentConfigList, out *deployapiv1.DeploymentConfigList, s conversion.Scope) error {
+func autoconvert_api_DeploymentCondition_To_v1_DeploymentCondition(in *deployapi.DeploymentCondition, out *deployapiv1.DeploymentCondition, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*deployapi.DeploymentConfigList))(in)
+		defaulting.(func(*deployapi.DeploymentCondition))(in)
 	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+	out.Type = deployapiv1.DeploymentConditionType(in.Type)
+	out.Status = pkgapiv1.ConditionStatus(in.Status)
+	if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
 		return err
 	}
-	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
-		return err
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func convert_api_DeploymentCondition_To_v1_DeploymentCondition(in *deployapi.DeploymentCondition, out *deployapiv1.DeploymentCondition, s conversion.Scope) error {
+	return autoconvert_api_DeploymentCondition_To_v1_DeploymentCondition(in, out, s)
+}
+
+func autoconvert_api_DeploymentConfig_To_v1_DeploymentConfig(in *deployapi.DeploymentConfig, out *deployapiv1.DeploymentConfig, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentConfig))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_api_DeploymentConfigSpec_To_v1_DeploymentConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_api_DeploymentConfigStatus_To_v1_DeploymentConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_DeploymentConfig_To_v1_DeploymentConfig(in *deployapi.DeploymentConfig, out *deployapiv1.DeploymentConfig, s conversion.Scope) error {
+	return autoconvert_api_DeploymentConfig_To_v1_DeploymentConfig(in, out, s)
+}
+
+func autoconvert_api_DeploymentConfigList_To_v1_DeploymentConfigList(in *deployapi.DeploymentConfigList, out *deployapiv1.DeploymentConfigList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentConfigList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
 	}
 	if in.Items != nil {
 		out.Items = make([]deployapiv1.DeploymentConfig, len(in.Items))
@@ -2787,6 +3191,13 @@ func autoconvert_api_DeploymentConfigSpec_To_v1_DeploymentConfigSpec(in *deploya
 	} else {
 		out.Template = nil
 	}
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
+	out.Paused = in.Paused
 	return nil
 }
 
@@ -2799,6 +3210,7 @@ func autoconvert_api_DeploymentConfigStatus_To_v1_DeploymentConfigStatus(in *dep
 		defaulting.(func(*deployapi.DeploymentConfigStatus))(in)
 	}
 	out.LatestVersion = in.LatestVersion
+	out.ObservedGeneration = in.ObservedGeneration
 	if in.Details != nil {
 		out.Details = new(deployapiv1.DeploymentDetails)
 		if err := convert_api_DeploymentDetails_To_v1_DeploymentDetails(in.Details, out.Details, s); err != nil {
@@ -2807,6 +3219,21 @@ func autoconvert_api_DeploymentConfigStatus_To_v1_DeploymentConfigStatus(in *dep
 	} else {
 		out.Details = nil
 	}
+	out.LiveColor = string(in.LiveColor)
+	out.LiveDeployment = in.LiveDeployment
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapiv1.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_api_DeploymentCondition_To_v1_DeploymentCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -2928,6 +3355,22 @@ func autoconvert_api_DeploymentStrategy_To_v1_DeploymentStrategy(in *deployapi.D
 	} else {
 		out.RollingParams = nil
 	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapiv1.CanaryDeploymentStrategyParams)
+		if err := convert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams(in.CanaryParams, out.CanaryParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapiv1.BlueGreenDeploymentStrategyParams)
+		if err := convert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams(in.BlueGreenParams, out.BlueGreenParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
 	if err := convert_api_ResourceRequirements_To_v1_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
@@ -2986,6 +3429,14 @@ func autoconvert_api_DeploymentTriggerPolicy_To_v1_DeploymentTriggerPolicy(in *d
 	} else {
 		out.ImageChangeParams = nil
 	}
+	if in.SecretChangeParams != nil {
+		out.SecretChangeParams = new(deployapiv1.DeploymentTriggerSecretChangeParams)
+		if err := convert_api_DeploymentTriggerSecretChangeParams_To_v1_DeploymentTriggerSecretChangeParams(in.SecretChangeParams, out.SecretChangeParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretChangeParams = nil
+	}
 	return nil
 }
 
@@ -2993,6 +3444,25 @@ func convert_api_DeploymentTriggerPolicy_To_v1_DeploymentTriggerPolicy(in *deplo
 	return autoconvert_api_DeploymentTriggerPolicy_To_v1_DeploymentTriggerPolicy(in, out, s)
 }
 
+func autoconvert_api_DeploymentTriggerSecretChangeParams_To_v1_DeploymentTriggerSecretChangeParams(in *deployapi.DeploymentTriggerSecretChangeParams, out *deployapiv1.DeploymentTriggerSecretChangeParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentTriggerSecretChangeParams))(in)
+	}
+	if in.LastTriggeredSecrets != nil {
+		out.LastTriggeredSecrets = make(map[string]string)
+		for key, val := range in.LastTriggeredSecrets {
+			out.LastTriggeredSecrets[key] = val
+		}
+	} else {
+		out.LastTriggeredSecrets = nil
+	}
+	return nil
+}
+
+func convert_api_DeploymentTriggerSecretChangeParams_To_v1_DeploymentTriggerSecretChangeParams(in *deployapi.DeploymentTriggerSecretChangeParams, out *deployapiv1.DeploymentTriggerSecretChangeParams, s conversion.Scope) error {
+	return autoconvert_api_DeploymentTriggerSecretChangeParams_To_v1_DeploymentTriggerSecretChangeParams(in, out, s)
+}
+
 func autoconvert_api_ExecNewPodHook_To_v1_ExecNewPodHook(in *deployapi.ExecNewPodHook, out *deployapiv1.ExecNewPodHook, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.ExecNewPodHook))(in)
@@ -3044,6 +3514,16 @@ func autoconvert_api_LifecycleHook_To_v1_LifecycleHook(in *deployapi.LifecycleHo
 	} else {
 		out.ExecNewPod = nil
 	}
+	if in.TagImages != nil {
+		out.TagImages = make([]deployapiv1.TagImageHook, len(in.TagImages))
+		for i := range in.TagImages {
+			if err := convert_api_TagImageHook_To_v1_TagImageHook(&in.TagImages[i], &out.TagImages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TagImages = nil
+	}
 	return nil
 }
 
@@ -3063,6 +3543,14 @@ func autoconvert_api_RecreateDeploymentStrategyParams_To_v1_RecreateDeploymentSt
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapiv1.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1_LifecycleHook(in.Mid, out.Mid, s); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapiv1.LifecycleHook)
 		if err := convert_api_LifecycleHook_To_v1_LifecycleHook(in.Post, out.Post, s); err != nil {
@@ -3100,6 +3588,13 @@ func autoconvert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStra
 	} else {
 		out.TimeoutSeconds = nil
 	}
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
+	out.AutoRollback = in.AutoRollback
 	if err := s.Convert(&in.MaxUnavailable, &out.MaxUnavailable, 0); err != nil {
 		return err
 	}
@@ -3131,6 +3626,122 @@ func autoconvert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStra
 	return nil
 }
 
+func autoconvert_api_TagImageHook_To_v1_TagImageHook(in *deployapi.TagImageHook, out *deployapiv1.TagImageHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.TagImageHook))(in)
+	}
+	out.ContainerName = in.ContainerName
+	if err := convert_api_ObjectReference_To_v1_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_TagImageHook_To_v1_TagImageHook(in *deployapi.TagImageHook, out *deployapiv1.TagImageHook, s conversion.Scope) error {
+	return autoconvert_api_TagImageHook_To_v1_TagImageHook(in, out, s)
+}
+
+func autoconvert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in *deployapiv1.BlueGreenDeploymentStrategyParams, out *deployapi.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.BlueGreenDeploymentStrategyParams))(in)
+	}
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.KeepWarmSeconds = in.KeepWarmSeconds
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := convert_v1_LifecycleHook_To_api_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := convert_v1_LifecycleHook_To_api_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func convert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in *deployapiv1.BlueGreenDeploymentStrategyParams, out *deployapi.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	return autoconvert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in, out, s)
+}
+
+func autoconvert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in *deployapiv1.CanaryDeploymentStrategyParams, out *deployapi.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.CanaryDeploymentStrategyParams))(in)
+	}
+	if in.Steps != nil {
+		out.Steps = make([]deployapi.CanaryStep, len(in.Steps))
+		for i := range in.Steps {
+			if err := convert_v1_CanaryStep_To_api_CanaryStep(&in.Steps[i], &out.Steps[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := convert_v1_LifecycleHook_To_api_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := convert_v1_LifecycleHook_To_api_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func convert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in *deployapiv1.CanaryDeploymentStrategyParams, out *deployapi.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	return autoconvert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in, out, s)
+}
+
+func autoconvert_v1_CanaryStep_To_api_CanaryStep(in *deployapiv1.CanaryStep, out *deployapi.CanaryStep, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.CanaryStep))(in)
+	}
+	if err := s.Convert(&in.Replicas, &out.Replicas, 0); err != nil {
+		return err
+	}
+	out.PauseSeconds = in.PauseSeconds
+	if in.Verify != nil {
+		out.Verify = new(deployapi.LifecycleHook)
+		if err := convert_v1_LifecycleHook_To_api_LifecycleHook(in.Verify, out.Verify, s); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	return nil
+}
+
+func convert_v1_CanaryStep_To_api_CanaryStep(in *deployapiv1.CanaryStep, out *deployapi.CanaryStep, s conversion.Scope) error {
+	return autoconvert_v1_CanaryStep_To_api_CanaryStep(in, out, s)
+}
+
 func autoconvert_v1_CustomDeploymentStrategyParams_To_api_CustomDeploymentStrategyParams(in *deployapiv1.CustomDeploymentStrategyParams, out *deployapi.CustomDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.CustomDeploymentStrategyParams))(in)
@@ -3174,6 +3785,14 @@ func autoconvert_v1_DeploymentCause_To_api_DeploymentCause(in *deployapiv1.Deplo
 	} else {
 		out.ImageTrigger = nil
 	}
+	if in.SecretTrigger != nil {
+		out.SecretTrigger = new(deployapi.DeploymentCauseSecretTrigger)
+		if err := convert_v1_DeploymentCauseSecretTrigger_To_api_DeploymentCauseSecretTrigger(in.SecretTrigger, out.SecretTrigger, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretTrigger = nil
+	}
 	return nil
 }
 
@@ -3195,6 +3814,38 @@ func convert_v1_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger(i
 	return autoconvert_v1_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger(in, out, s)
 }
 
+func autoconvert_v1_DeploymentCauseSecretTrigger_To_api_DeploymentCauseSecretTrigger(in *deployapiv1.DeploymentCauseSecretTrigger, out *deployapi.DeploymentCauseSecretTrigger, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentCauseSecretTrigger))(in)
+	}
+	if err := convert_v1_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_DeploymentCauseSecretTrigger_To_api_DeploymentCauseSecretTrigger(in *deployapiv1.DeploymentCauseSecretTrigger, out *deployapi.DeploymentCauseSecretTrigger, s conversion.Scope) error {
+	return autoconvert_v1_DeploymentCauseSecretTrigger_To_api_DeploymentCauseSecretTrigger(in, out, s)
+}
+
+func autoconvert_v1_DeploymentCondition_To_api_DeploymentCondition(in *deployapiv1.DeploymentCondition, out *deployapi.DeploymentCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentCondition))(in)
+	}
+	out.Type = deployapi.DeploymentConditionType(in.Type)
+	out.Status = pkgapi.ConditionStatus(in.Status)
+	if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func convert_v1_DeploymentCondition_To_api_DeploymentCondition(in *deployapiv1.DeploymentCondition, out *deployapi.DeploymentCondition, s conversion.Scope) error {
+	return autoconvert_v1_DeploymentCondition_To_api_DeploymentCondition(in, out, s)
+}
+
 func autoconvert_v1_DeploymentConfig_To_api_DeploymentConfig(in *deployapiv1.DeploymentConfig, out *deployapi.DeploymentConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.DeploymentConfig))(in)
@@ -3314,6 +3965,13 @@ func autoconvert_v1_DeploymentConfigSpec_To_api_DeploymentConfigSpec(in *deploya
 	} else {
 		out.Template = nil
 	}
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
+	out.Paused = in.Paused
 	return nil
 }
 
@@ -3326,6 +3984,7 @@ func autoconvert_v1_DeploymentConfigStatus_To_api_DeploymentConfigStatus(in *dep
 		defaulting.(func(*deployapiv1.DeploymentConfigStatus))(in)
 	}
 	out.LatestVersion = in.LatestVersion
+	out.ObservedGeneration = in.ObservedGeneration
 	if in.Details != nil {
 		out.Details = new(deployapi.DeploymentDetails)
 		if err := convert_v1_DeploymentDetails_To_api_DeploymentDetails(in.Details, out.Details, s); err != nil {
@@ -3334,6 +3993,21 @@ func autoconvert_v1_DeploymentConfigStatus_To_api_DeploymentConfigStatus(in *dep
 	} else {
 		out.Details = nil
 	}
+	out.LiveColor = deployapi.DeploymentColor(in.LiveColor)
+	out.LiveDeployment = in.LiveDeployment
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapi.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_v1_DeploymentCondition_To_api_DeploymentCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -3455,6 +4129,22 @@ func autoconvert_v1_DeploymentStrategy_To_api_DeploymentStrategy(in *deployapiv1
 	} else {
 		out.RollingParams = nil
 	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapi.CanaryDeploymentStrategyParams)
+		if err := convert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in.CanaryParams, out.CanaryParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapi.BlueGreenDeploymentStrategyParams)
+		if err := convert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in.BlueGreenParams, out.BlueGreenParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
 	if err := convert_v1_ResourceRequirements_To_api_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
@@ -3513,6 +4203,14 @@ func autoconvert_v1_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in *d
 	} else {
 		out.ImageChangeParams = nil
 	}
+	if in.SecretChangeParams != nil {
+		out.SecretChangeParams = new(deployapi.DeploymentTriggerSecretChangeParams)
+		if err := convert_v1_DeploymentTriggerSecretChangeParams_To_api_DeploymentTriggerSecretChangeParams(in.SecretChangeParams, out.SecretChangeParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretChangeParams = nil
+	}
 	return nil
 }
 
@@ -3520,6 +4218,25 @@ func convert_v1_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in *deplo
 	return autoconvert_v1_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in, out, s)
 }
 
+func autoconvert_v1_DeploymentTriggerSecretChangeParams_To_api_DeploymentTriggerSecretChangeParams(in *deployapiv1.DeploymentTriggerSecretChangeParams, out *deployapi.DeploymentTriggerSecretChangeParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.DeploymentTriggerSecretChangeParams))(in)
+	}
+	if in.LastTriggeredSecrets != nil {
+		out.LastTriggeredSecrets = make(map[string]string)
+		for key, val := range in.LastTriggeredSecrets {
+			out.LastTriggeredSecrets[key] = val
+		}
+	} else {
+		out.LastTriggeredSecrets = nil
+	}
+	return nil
+}
+
+func convert_v1_DeploymentTriggerSecretChangeParams_To_api_DeploymentTriggerSecretChangeParams(in *deployapiv1.DeploymentTriggerSecretChangeParams, out *deployapi.DeploymentTriggerSecretChangeParams, s conversion.Scope) error {
+	return autoconvert_v1_DeploymentTriggerSecretChangeParams_To_api_DeploymentTriggerSecretChangeParams(in, out, s)
+}
+
 func autoconvert_v1_ExecNewPodHook_To_api_ExecNewPodHook(in *deployapiv1.ExecNewPodHook, out *deployapi.ExecNewPodHook, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1.ExecNewPodHook))(in)
@@ -3571,6 +4288,16 @@ func autoconvert_v1_LifecycleHook_To_api_LifecycleHook(in *deployapiv1.Lifecycle
 	} else {
 		out.ExecNewPod = nil
 	}
+	if in.TagImages != nil {
+		out.TagImages = make([]deployapi.TagImageHook, len(in.TagImages))
+		for i := range in.TagImages {
+			if err := convert_v1_TagImageHook_To_api_TagImageHook(&in.TagImages[i], &out.TagImages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TagImages = nil
+	}
 	return nil
 }
 
@@ -3590,6 +4317,14 @@ func autoconvert_v1_RecreateDeploymentStrategyParams_To_api_RecreateDeploymentSt
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapi.LifecycleHook)
+		if err := convert_v1_LifecycleHook_To_api_LifecycleHook(in.Mid, out.Mid, s); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapi.LifecycleHook)
 		if err := convert_v1_LifecycleHook_To_api_LifecycleHook(in.Post, out.Post, s); err != nil {
@@ -3627,6 +4362,13 @@ func autoconvert_v1_RollingDeploymentStrategyParams_To_api_RollingDeploymentStra
 	} else {
 		out.TimeoutSeconds = nil
 	}
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
+	out.AutoRollback = in.AutoRollback
 	// in.MaxUnavailable has no peer in out
 	// in.MaxSurge has no peer in out
 	if in.UpdatePercent != nil {
@@ -3654,6 +4396,21 @@ func autoconvert_v1_RollingDeploymentStrategyParams_To_api_RollingDeploymentStra
 	return nil
 }
 
+func autoconvert_v1_TagImageHook_To_api_TagImageHook(in *deployapiv1.TagImageHook, out *deployapi.TagImageHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1.TagImageHook))(in)
+	}
+	out.ContainerName = in.ContainerName
+	if err := convert_v1_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_TagImageHook_To_api_TagImageHook(in *deployapiv1.TagImageHook, out *deployapi.TagImageHook, s conversion.Scope) error {
+	return autoconvert_v1_TagImageHook_To_api_TagImageHook(in, out, s)
+}
+
 func autoconvert_api_Image_To_v1_Image(in *imageapi.Image, out *imageapiv1.Image, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*imageapi.Image))(in)
@@ -3670,9 +4427,66 @@ func autoconvert_api_Image_To_v1_Image(in *imageapi.Image, out *imageapiv1.Image
 	}
 	out.DockerImageMetadataVersion = in.DockerImageMetadataVersion
 	out.DockerImageManifest = in.DockerImageManifest
+	if in.DockerImageLayers != nil {
+		out.DockerImageLayers = make([]imageapiv1.ImageLayer, len(in.DockerImageLayers))
+		for i := range in.DockerImageLayers {
+			if err := s.Convert(&in.DockerImageLayers[i], &out.DockerImageLayers[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DockerImageLayers = nil
+	}
+	return nil
+}
+
+func autoconvert_api_ImageImportSpec_To_v1_ImageImportSpec(in *imageapi.ImageImportSpec, out *imageapiv1.ImageImportSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.ImageImportSpec))(in)
+	}
+	if err := convert_api_ObjectReference_To_v1_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if in.To != nil {
+		out.To = new(pkgapiv1.LocalObjectReference)
+		if err := convert_api_LocalObjectReference_To_v1_LocalObjectReference(in.To, out.To, s); err != nil {
+			return err
+		}
+	} else {
+		out.To = nil
+	}
+	if err := convert_api_TagImportPolicy_To_v1_TagImportPolicy(&in.ImportPolicy, &out.ImportPolicy, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_ImageImportSpec_To_v1_ImageImportSpec(in *imageapi.ImageImportSpec, out *imageapiv1.ImageImportSpec, s conversion.Scope) error {
+	return autoconvert_api_ImageImportSpec_To_v1_ImageImportSpec(in, out, s)
+}
+
+func autoconvert_api_ImageImportStatus_To_v1_ImageImportStatus(in *imageapi.ImageImportStatus, out *imageapiv1.ImageImportStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.ImageImportStatus))(in)
+	}
+	if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+		return err
+	}
+	if in.Image != nil {
+		if err := s.Convert(&in.Image, &out.Image, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Image = nil
+	}
+	out.Tag = in.Tag
 	return nil
 }
 
+func convert_api_ImageImportStatus_To_v1_ImageImportStatus(in *imageapi.ImageImportStatus, out *imageapiv1.ImageImportStatus, s conversion.Scope) error {
+	return autoconvert_api_ImageImportStatus_To_v1_ImageImportStatus(in, out, s)
+}
+
 func autoconvert_api_ImageList_To_v1_ImageList(in *imageapi.ImageList, out *imageapiv1.ImageList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*imageapi.ImageList))(in)
@@ -3713,34 +4527,124 @@ func autoconvert_api_ImageStream_To_v1_ImageStream(in *imageapi.ImageStream, out
 	if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
 		return err
 	}
-	if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
-		return err
+	if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_ImageStream_To_v1_ImageStream(in *imageapi.ImageStream, out *imageapiv1.ImageStream, s conversion.Scope) error {
+	return autoconvert_api_ImageStream_To_v1_ImageStream(in, out, s)
+}
+
+func autoconvert_api_ImageStreamImage_To_v1_ImageStreamImage(in *imageapi.ImageStreamImage, out *imageapiv1.ImageStreamImage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.ImageStreamImage))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.Image, &out.Image, 0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_ImageStreamImage_To_v1_ImageStreamImage(in *imageapi.ImageStreamImage, out *imageapiv1.ImageStreamImage, s conversion.Scope) error {
+	return autoconvert_api_ImageStreamImage_To_v1_ImageStreamImage(in, out, s)
+}
+
+func autoconvert_api_ImageStreamImport_To_v1_ImageStreamImport(in *imageapi.ImageStreamImport, out *imageapiv1.ImageStreamImport, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.ImageStreamImport))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_api_ImageStreamImportSpec_To_v1_ImageStreamImportSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_api_ImageStreamImportStatus_To_v1_ImageStreamImportStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_ImageStreamImport_To_v1_ImageStreamImport(in *imageapi.ImageStreamImport, out *imageapiv1.ImageStreamImport, s conversion.Scope) error {
+	return autoconvert_api_ImageStreamImport_To_v1_ImageStreamImport(in, out, s)
+}
+
+func autoconvert_api_ImageStreamImportSpec_To_v1_ImageStreamImportSpec(in *imageapi.ImageStreamImportSpec, out *imageapiv1.ImageStreamImportSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.ImageStreamImportSpec))(in)
+	}
+	out.Import = in.Import
+	if in.Repository != nil {
+		out.Repository = new(imageapiv1.RepositoryImportSpec)
+		if err := convert_api_RepositoryImportSpec_To_v1_RepositoryImportSpec(in.Repository, out.Repository, s); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapiv1.ImageImportSpec, len(in.Images))
+		for i := range in.Images {
+			if err := convert_api_ImageImportSpec_To_v1_ImageImportSpec(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
 	}
 	return nil
 }
 
-func convert_api_ImageStream_To_v1_ImageStream(in *imageapi.ImageStream, out *imageapiv1.ImageStream, s conversion.Scope) error {
-	return autoconvert_api_ImageStream_To_v1_ImageStream(in, out, s)
+func convert_api_ImageStreamImportSpec_To_v1_ImageStreamImportSpec(in *imageapi.ImageStreamImportSpec, out *imageapiv1.ImageStreamImportSpec, s conversion.Scope) error {
+	return autoconvert_api_ImageStreamImportSpec_To_v1_ImageStreamImportSpec(in, out, s)
 }
 
-func autoconvert_api_ImageStreamImage_To_v1_ImageStreamImage(in *imageapi.ImageStreamImage, out *imageapiv1.ImageStreamImage, s conversion.Scope) error {
+func autoconvert_api_ImageStreamImportStatus_To_v1_ImageStreamImportStatus(in *imageapi.ImageStreamImportStatus, out *imageapiv1.ImageStreamImportStatus, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*imageapi.ImageStreamImage))(in)
+		defaulting.(func(*imageapi.ImageStreamImportStatus))(in)
 	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
-		return err
+	if in.Import != nil {
+		out.Import = new(imageapiv1.ImageStream)
+		if err := convert_api_ImageStream_To_v1_ImageStream(in.Import, out.Import, s); err != nil {
+			return err
+		}
+	} else {
+		out.Import = nil
 	}
-	if err := convert_api_ObjectMeta_To_v1_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
-		return err
+	if in.Repository != nil {
+		out.Repository = new(imageapiv1.RepositoryImportStatus)
+		if err := convert_api_RepositoryImportStatus_To_v1_RepositoryImportStatus(in.Repository, out.Repository, s); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
 	}
-	if err := s.Convert(&in.Image, &out.Image, 0); err != nil {
-		return err
+	if in.Images != nil {
+		out.Images = make([]imageapiv1.ImageImportStatus, len(in.Images))
+		for i := range in.Images {
+			if err := convert_api_ImageImportStatus_To_v1_ImageImportStatus(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
 	}
 	return nil
 }
 
-func convert_api_ImageStreamImage_To_v1_ImageStreamImage(in *imageapi.ImageStreamImage, out *imageapiv1.ImageStreamImage, s conversion.Scope) error {
-	return autoconvert_api_ImageStreamImage_To_v1_ImageStreamImage(in, out, s)
+func convert_api_ImageStreamImportStatus_To_v1_ImageStreamImportStatus(in *imageapi.ImageStreamImportStatus, out *imageapiv1.ImageStreamImportStatus, s conversion.Scope) error {
+	return autoconvert_api_ImageStreamImportStatus_To_v1_ImageStreamImportStatus(in, out, s)
 }
 
 func autoconvert_api_ImageStreamList_To_v1_ImageStreamList(in *imageapi.ImageStreamList, out *imageapiv1.ImageStreamList, s conversion.Scope) error {
@@ -3857,6 +4761,68 @@ func convert_api_ImageStreamTagList_To_v1_ImageStreamTagList(in *imageapi.ImageS
 	return autoconvert_api_ImageStreamTagList_To_v1_ImageStreamTagList(in, out, s)
 }
 
+func autoconvert_api_RepositoryImportSpec_To_v1_RepositoryImportSpec(in *imageapi.RepositoryImportSpec, out *imageapiv1.RepositoryImportSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.RepositoryImportSpec))(in)
+	}
+	if err := convert_api_ObjectReference_To_v1_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if err := convert_api_TagImportPolicy_To_v1_TagImportPolicy(&in.ImportPolicy, &out.ImportPolicy, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_RepositoryImportSpec_To_v1_RepositoryImportSpec(in *imageapi.RepositoryImportSpec, out *imageapiv1.RepositoryImportSpec, s conversion.Scope) error {
+	return autoconvert_api_RepositoryImportSpec_To_v1_RepositoryImportSpec(in, out, s)
+}
+
+func autoconvert_api_RepositoryImportStatus_To_v1_RepositoryImportStatus(in *imageapi.RepositoryImportStatus, out *imageapiv1.RepositoryImportStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.RepositoryImportStatus))(in)
+	}
+	if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+		return err
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapiv1.ImageImportStatus, len(in.Images))
+		for i := range in.Images {
+			if err := convert_api_ImageImportStatus_To_v1_ImageImportStatus(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	if in.AdditionalTags != nil {
+		out.AdditionalTags = make([]string, len(in.AdditionalTags))
+		for i := range in.AdditionalTags {
+			out.AdditionalTags[i] = in.AdditionalTags[i]
+		}
+	} else {
+		out.AdditionalTags = nil
+	}
+	return nil
+}
+
+func convert_api_RepositoryImportStatus_To_v1_RepositoryImportStatus(in *imageapi.RepositoryImportStatus, out *imageapiv1.RepositoryImportStatus, s conversion.Scope) error {
+	return autoconvert_api_RepositoryImportStatus_To_v1_RepositoryImportStatus(in, out, s)
+}
+
+func autoconvert_api_TagImportPolicy_To_v1_TagImportPolicy(in *imageapi.TagImportPolicy, out *imageapiv1.TagImportPolicy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.TagImportPolicy))(in)
+	}
+	out.Insecure = in.Insecure
+	out.Scheduled = in.Scheduled
+	return nil
+}
+
+func convert_api_TagImportPolicy_To_v1_TagImportPolicy(in *imageapi.TagImportPolicy, out *imageapiv1.TagImportPolicy, s conversion.Scope) error {
+	return autoconvert_api_TagImportPolicy_To_v1_TagImportPolicy(in, out, s)
+}
+
 func autoconvert_v1_Image_To_api_Image(in *imageapiv1.Image, out *imageapi.Image, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*imageapiv1.Image))(in)
@@ -3873,9 +4839,66 @@ func autoconvert_v1_Image_To_api_Image(in *imageapiv1.Image, out *imageapi.Image
 	}
 	out.DockerImageMetadataVersion = in.DockerImageMetadataVersion
 	out.DockerImageManifest = in.DockerImageManifest
+	if in.DockerImageLayers != nil {
+		out.DockerImageLayers = make([]imageapi.ImageLayer, len(in.DockerImageLayers))
+		for i := range in.DockerImageLayers {
+			if err := s.Convert(&in.DockerImageLayers[i], &out.DockerImageLayers[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DockerImageLayers = nil
+	}
+	return nil
+}
+
+func autoconvert_v1_ImageImportSpec_To_api_ImageImportSpec(in *imageapiv1.ImageImportSpec, out *imageapi.ImageImportSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1.ImageImportSpec))(in)
+	}
+	if err := convert_v1_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if in.To != nil {
+		out.To = new(pkgapi.LocalObjectReference)
+		if err := convert_v1_LocalObjectReference_To_api_LocalObjectReference(in.To, out.To, s); err != nil {
+			return err
+		}
+	} else {
+		out.To = nil
+	}
+	if err := convert_v1_TagImportPolicy_To_api_TagImportPolicy(&in.ImportPolicy, &out.ImportPolicy, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_ImageImportSpec_To_api_ImageImportSpec(in *imageapiv1.ImageImportSpec, out *imageapi.ImageImportSpec, s conversion.Scope) error {
+	return autoconvert_v1_ImageImportSpec_To_api_ImageImportSpec(in, out, s)
+}
+
+func autoconvert_v1_ImageImportStatus_To_api_ImageImportStatus(in *imageapiv1.ImageImportStatus, out *imageapi.ImageImportStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1.ImageImportStatus))(in)
+	}
+	if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+		return err
+	}
+	if in.Image != nil {
+		if err := s.Convert(&in.Image, &out.Image, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Image = nil
+	}
+	out.Tag = in.Tag
 	return nil
 }
 
+func convert_v1_ImageImportStatus_To_api_ImageImportStatus(in *imageapiv1.ImageImportStatus, out *imageapi.ImageImportStatus, s conversion.Scope) error {
+	return autoconvert_v1_ImageImportStatus_To_api_ImageImportStatus(in, out, s)
+}
+
 func autoconvert_v1_ImageList_To_api_ImageList(in *imageapiv1.ImageList, out *imageapi.ImageList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*imageapiv1.ImageList))(in)
@@ -3946,6 +4969,96 @@ func convert_v1_ImageStreamImage_To_api_ImageStreamImage(in *imageapiv1.ImageStr
 	return autoconvert_v1_ImageStreamImage_To_api_ImageStreamImage(in, out, s)
 }
 
+func autoconvert_v1_ImageStreamImport_To_api_ImageStreamImport(in *imageapiv1.ImageStreamImport, out *imageapi.ImageStreamImport, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1.ImageStreamImport))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ImageStreamImportSpec_To_api_ImageStreamImportSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1_ImageStreamImportStatus_To_api_ImageStreamImportStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_ImageStreamImport_To_api_ImageStreamImport(in *imageapiv1.ImageStreamImport, out *imageapi.ImageStreamImport, s conversion.Scope) error {
+	return autoconvert_v1_ImageStreamImport_To_api_ImageStreamImport(in, out, s)
+}
+
+func autoconvert_v1_ImageStreamImportSpec_To_api_ImageStreamImportSpec(in *imageapiv1.ImageStreamImportSpec, out *imageapi.ImageStreamImportSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1.ImageStreamImportSpec))(in)
+	}
+	out.Import = in.Import
+	if in.Repository != nil {
+		out.Repository = new(imageapi.RepositoryImportSpec)
+		if err := convert_v1_RepositoryImportSpec_To_api_RepositoryImportSpec(in.Repository, out.Repository, s); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapi.ImageImportSpec, len(in.Images))
+		for i := range in.Images {
+			if err := convert_v1_ImageImportSpec_To_api_ImageImportSpec(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	return nil
+}
+
+func convert_v1_ImageStreamImportSpec_To_api_ImageStreamImportSpec(in *imageapiv1.ImageStreamImportSpec, out *imageapi.ImageStreamImportSpec, s conversion.Scope) error {
+	return autoconvert_v1_ImageStreamImportSpec_To_api_ImageStreamImportSpec(in, out, s)
+}
+
+func autoconvert_v1_ImageStreamImportStatus_To_api_ImageStreamImportStatus(in *imageapiv1.ImageStreamImportStatus, out *imageapi.ImageStreamImportStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1.ImageStreamImportStatus))(in)
+	}
+	if in.Import != nil {
+		out.Import = new(imageapi.ImageStream)
+		if err := convert_v1_ImageStream_To_api_ImageStream(in.Import, out.Import, s); err != nil {
+			return err
+		}
+	} else {
+		out.Import = nil
+	}
+	if in.Repository != nil {
+		out.Repository = new(imageapi.RepositoryImportStatus)
+		if err := convert_v1_RepositoryImportStatus_To_api_RepositoryImportStatus(in.Repository, out.Repository, s); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapi.ImageImportStatus, len(in.Images))
+		for i := range in.Images {
+			if err := convert_v1_ImageImportStatus_To_api_ImageImportStatus(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	return nil
+}
+
+func convert_v1_ImageStreamImportStatus_To_api_ImageStreamImportStatus(in *imageapiv1.ImageStreamImportStatus, out *imageapi.ImageStreamImportStatus, s conversion.Scope) error {
+	return autoconvert_v1_ImageStreamImportStatus_To_api_ImageStreamImportStatus(in, out, s)
+}
+
 func autoconvert_v1_ImageStreamList_To_api_ImageStreamList(in *imageapiv1.ImageStreamList, out *imageapi.ImageStreamList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*imageapiv1.ImageStreamList))(in)
@@ -4059,6 +5172,68 @@ func convert_v1_ImageStreamTagList_To_api_ImageStreamTagList(in *imageapiv1.Imag
 	return autoconvert_v1_ImageStreamTagList_To_api_ImageStreamTagList(in, out, s)
 }
 
+func autoconvert_v1_RepositoryImportSpec_To_api_RepositoryImportSpec(in *imageapiv1.RepositoryImportSpec, out *imageapi.RepositoryImportSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1.RepositoryImportSpec))(in)
+	}
+	if err := convert_v1_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if err := convert_v1_TagImportPolicy_To_api_TagImportPolicy(&in.ImportPolicy, &out.ImportPolicy, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_RepositoryImportSpec_To_api_RepositoryImportSpec(in *imageapiv1.RepositoryImportSpec, out *imageapi.RepositoryImportSpec, s conversion.Scope) error {
+	return autoconvert_v1_RepositoryImportSpec_To_api_RepositoryImportSpec(in, out, s)
+}
+
+func autoconvert_v1_RepositoryImportStatus_To_api_RepositoryImportStatus(in *imageapiv1.RepositoryImportStatus, out *imageapi.RepositoryImportStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1.RepositoryImportStatus))(in)
+	}
+	if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+		return err
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapi.ImageImportStatus, len(in.Images))
+		for i := range in.Images {
+			if err := convert_v1_ImageImportStatus_To_api_ImageImportStatus(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	if in.AdditionalTags != nil {
+		out.AdditionalTags = make([]string, len(in.AdditionalTags))
+		for i := range in.AdditionalTags {
+			out.AdditionalTags[i] = in.AdditionalTags[i]
+		}
+	} else {
+		out.AdditionalTags = nil
+	}
+	return nil
+}
+
+func convert_v1_RepositoryImportStatus_To_api_RepositoryImportStatus(in *imageapiv1.RepositoryImportStatus, out *imageapi.RepositoryImportStatus, s conversion.Scope) error {
+	return autoconvert_v1_RepositoryImportStatus_To_api_RepositoryImportStatus(in, out, s)
+}
+
+func autoconvert_v1_TagImportPolicy_To_api_TagImportPolicy(in *imageapiv1.TagImportPolicy, out *imageapi.TagImportPolicy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1.TagImportPolicy))(in)
+	}
+	out.Insecure = in.Insecure
+	out.Scheduled = in.Scheduled
+	return nil
+}
+
+func convert_v1_TagImportPolicy_To_api_TagImportPolicy(in *imageapiv1.TagImportPolicy, out *imageapi.TagImportPolicy, s conversion.Scope) error {
+	return autoconvert_v1_TagImportPolicy_To_api_TagImportPolicy(in, out, s)
+}
+
 func autoconvert_api_OAuthAccessToken_To_v1_OAuthAccessToken(in *oauthapi.OAuthAccessToken, out *oauthapiv1.OAuthAccessToken, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*oauthapi.OAuthAccessToken))(in)
@@ -4734,6 +5909,47 @@ func convert_api_Route_To_v1_Route(in *routeapi.Route, out *routeapiv1.Route, s
 	return autoconvert_api_Route_To_v1_Route(in, out, s)
 }
 
+func autoconvert_api_RouteIngress_To_v1_RouteIngress(in *routeapi.RouteIngress, out *routeapiv1.RouteIngress, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteIngress))(in)
+	}
+	out.Host = in.Host
+	out.RouterName = in.RouterName
+	if in.Conditions != nil {
+		out.Conditions = make([]routeapiv1.RouteIngressCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_api_RouteIngressCondition_To_v1_RouteIngressCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+func convert_api_RouteIngress_To_v1_RouteIngress(in *routeapi.RouteIngress, out *routeapiv1.RouteIngress, s conversion.Scope) error {
+	return autoconvert_api_RouteIngress_To_v1_RouteIngress(in, out, s)
+}
+
+func autoconvert_api_RouteIngressCondition_To_v1_RouteIngressCondition(in *routeapi.RouteIngressCondition, out *routeapiv1.RouteIngressCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteIngressCondition))(in)
+	}
+	out.Type = routeapiv1.RouteIngressConditionType(in.Type)
+	out.Status = pkgapiv1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_RouteIngressCondition_To_v1_RouteIngressCondition(in *routeapi.RouteIngressCondition, out *routeapiv1.RouteIngressCondition, s conversion.Scope) error {
+	return autoconvert_api_RouteIngressCondition_To_v1_RouteIngressCondition(in, out, s)
+}
+
 func autoconvert_api_RouteList_To_v1_RouteList(in *routeapi.RouteList, out *routeapiv1.RouteList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapi.RouteList))(in)
@@ -4811,6 +6027,16 @@ func autoconvert_api_RouteStatus_To_v1_RouteStatus(in *routeapi.RouteStatus, out
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapi.RouteStatus))(in)
 	}
+	if in.Ingress != nil {
+		out.Ingress = make([]routeapiv1.RouteIngress, len(in.Ingress))
+		for i := range in.Ingress {
+			if err := convert_api_RouteIngress_To_v1_RouteIngress(&in.Ingress[i], &out.Ingress[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ingress = nil
+	}
 	return nil
 }
 
@@ -4858,6 +6084,47 @@ func convert_v1_Route_To_api_Route(in *routeapiv1.Route, out *routeapi.Route, s
 	return autoconvert_v1_Route_To_api_Route(in, out, s)
 }
 
+func autoconvert_v1_RouteIngress_To_api_RouteIngress(in *routeapiv1.RouteIngress, out *routeapi.RouteIngress, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1.RouteIngress))(in)
+	}
+	out.Host = in.Host
+	out.RouterName = in.RouterName
+	if in.Conditions != nil {
+		out.Conditions = make([]routeapi.RouteIngressCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_v1_RouteIngressCondition_To_api_RouteIngressCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+func convert_v1_RouteIngress_To_api_RouteIngress(in *routeapiv1.RouteIngress, out *routeapi.RouteIngress, s conversion.Scope) error {
+	return autoconvert_v1_RouteIngress_To_api_RouteIngress(in, out, s)
+}
+
+func autoconvert_v1_RouteIngressCondition_To_api_RouteIngressCondition(in *routeapiv1.RouteIngressCondition, out *routeapi.RouteIngressCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1.RouteIngressCondition))(in)
+	}
+	out.Type = routeapi.RouteIngressConditionType(in.Type)
+	out.Status = pkgapi.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1_RouteIngressCondition_To_api_RouteIngressCondition(in *routeapiv1.RouteIngressCondition, out *routeapi.RouteIngressCondition, s conversion.Scope) error {
+	return autoconvert_v1_RouteIngressCondition_To_api_RouteIngressCondition(in, out, s)
+}
+
 func autoconvert_v1_RouteList_To_api_RouteList(in *routeapiv1.RouteList, out *routeapi.RouteList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapiv1.RouteList))(in)
@@ -4935,6 +6202,16 @@ func autoconvert_v1_RouteStatus_To_api_RouteStatus(in *routeapiv1.RouteStatus, o
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapiv1.RouteStatus))(in)
 	}
+	if in.Ingress != nil {
+		out.Ingress = make([]routeapi.RouteIngress, len(in.Ingress))
+		for i := range in.Ingress {
+			if err := convert_v1_RouteIngress_To_api_RouteIngress(&in.Ingress[i], &out.Ingress[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ingress = nil
+	}
 	return nil
 }
 
@@ -7859,6 +9136,7 @@ func init() {
 		autoconvert_api_AWSElasticBlockStoreVolumeSource_To_v1_AWSElasticBlockStoreVolumeSource,
 		autoconvert_api_BinaryBuildRequestOptions_To_v1_BinaryBuildRequestOptions,
 		autoconvert_api_BinaryBuildSource_To_v1_BinaryBuildSource,
+		autoconvert_api_BlueGreenDeploymentStrategyParams_To_v1_BlueGreenDeploymentStrategyParams,
 		autoconvert_api_BuildConfigList_To_v1_BuildConfigList,
 		autoconvert_api_BuildConfigSpec_To_v1_BuildConfigSpec,
 		autoconvert_api_BuildConfigStatus_To_v1_BuildConfigStatus,
@@ -7874,6 +9152,8 @@ func init() {
 		autoconvert_api_BuildStrategy_To_v1_BuildStrategy,
 		autoconvert_api_BuildTriggerPolicy_To_v1_BuildTriggerPolicy,
 		autoconvert_api_Build_To_v1_Build,
+		autoconvert_api_CanaryDeploymentStrategyParams_To_v1_CanaryDeploymentStrategyParams,
+		autoconvert_api_CanaryStep_To_v1_CanaryStep,
 		autoconvert_api_Capabilities_To_v1_Capabilities,
 		autoconvert_api_CephFSVolumeSource_To_v1_CephFSVolumeSource,
 		autoconvert_api_CinderVolumeSource_To_v1_CinderVolumeSource,
@@ -7887,12 +9167,15 @@ func init() {
 		autoconvert_api_ClusterRoleBinding_To_v1_ClusterRoleBinding,
 		autoconvert_api_ClusterRoleList_To_v1_ClusterRoleList,
 		autoconvert_api_ClusterRole_To_v1_ClusterRole,
+		autoconvert_api_CommitStatusNotification_To_v1_CommitStatusNotification,
 		autoconvert_api_ContainerPort_To_v1_ContainerPort,
 		autoconvert_api_Container_To_v1_Container,
 		autoconvert_api_CustomBuildStrategy_To_v1_CustomBuildStrategy,
 		autoconvert_api_CustomDeploymentStrategyParams_To_v1_CustomDeploymentStrategyParams,
 		autoconvert_api_DeploymentCauseImageTrigger_To_v1_DeploymentCauseImageTrigger,
+		autoconvert_api_DeploymentCauseSecretTrigger_To_v1_DeploymentCauseSecretTrigger,
 		autoconvert_api_DeploymentCause_To_v1_DeploymentCause,
+		autoconvert_api_DeploymentCondition_To_v1_DeploymentCondition,
 		autoconvert_api_DeploymentConfigList_To_v1_DeploymentConfigList,
 		autoconvert_api_DeploymentConfigRollbackSpec_To_v1_DeploymentConfigRollbackSpec,
 		autoconvert_api_DeploymentConfigRollback_To_v1_DeploymentConfigRollback,
@@ -7905,6 +9188,8 @@ func init() {
 		autoconvert_api_DeploymentStrategy_To_v1_DeploymentStrategy,
 		autoconvert_api_DeploymentTriggerImageChangeParams_To_v1_DeploymentTriggerImageChangeParams,
 		autoconvert_api_DeploymentTriggerPolicy_To_v1_DeploymentTriggerPolicy,
+		autoconvert_api_DeploymentTriggerSecretChangeParams_To_v1_DeploymentTriggerSecretChangeParams,
+		autoconvert_api_DockerBuildCache_To_v1_DockerBuildCache,
 		autoconvert_api_DockerBuildStrategy_To_v1_DockerBuildStrategy,
 		autoconvert_api_DownwardAPIVolumeFile_To_v1_DownwardAPIVolumeFile,
 		autoconvert_api_DownwardAPIVolumeSource_To_v1_DownwardAPIVolumeSource,
@@ -7931,10 +9216,15 @@ func init() {
 		autoconvert_api_IdentityList_To_v1_IdentityList,
 		autoconvert_api_Identity_To_v1_Identity,
 		autoconvert_api_ImageChangeTrigger_To_v1_ImageChangeTrigger,
+		autoconvert_api_ImageImportSpec_To_v1_ImageImportSpec,
+		autoconvert_api_ImageImportStatus_To_v1_ImageImportStatus,
 		autoconvert_api_ImageList_To_v1_ImageList,
 		autoconvert_api_ImageSourcePath_To_v1_ImageSourcePath,
 		autoconvert_api_ImageSource_To_v1_ImageSource,
 		autoconvert_api_ImageStreamImage_To_v1_ImageStreamImage,
+		autoconvert_api_ImageStreamImportSpec_To_v1_ImageStreamImportSpec,
+		autoconvert_api_ImageStreamImportStatus_To_v1_ImageStreamImportStatus,
+		autoconvert_api_ImageStreamImport_To_v1_ImageStreamImport,
 		autoconvert_api_ImageStreamList_To_v1_ImageStreamList,
 		autoconvert_api_ImageStreamMapping_To_v1_ImageStreamMapping,
 		autoconvert_api_ImageStreamSpec_To_v1_ImageStreamSpec,
@@ -7944,6 +9234,7 @@ func init() {
 		autoconvert_api_ImageStream_To_v1_ImageStream,
 		autoconvert_api_Image_To_v1_Image,
 		autoconvert_api_IsPersonalSubjectAccessReview_To_v1_IsPersonalSubjectAccessReview,
+		autoconvert_api_JenkinsPipelineBuildStrategy_To_v1_JenkinsPipelineBuildStrategy,
 		autoconvert_api_LifecycleHook_To_v1_LifecycleHook,
 		autoconvert_api_Lifecycle_To_v1_Lifecycle,
 		autoconvert_api_LocalObjectReference_To_v1_LocalObjectReference,
@@ -7980,6 +9271,8 @@ func init() {
 		autoconvert_api_Project_To_v1_Project,
 		autoconvert_api_RBDVolumeSource_To_v1_RBDVolumeSource,
 		autoconvert_api_RecreateDeploymentStrategyParams_To_v1_RecreateDeploymentStrategyParams,
+		autoconvert_api_RepositoryImportSpec_To_v1_RepositoryImportSpec,
+		autoconvert_api_RepositoryImportStatus_To_v1_RepositoryImportStatus,
 		autoconvert_api_ResourceAccessReviewResponse_To_v1_ResourceAccessReviewResponse,
 		autoconvert_api_ResourceAccessReview_To_v1_ResourceAccessReview,
 		autoconvert_api_ResourceRequirements_To_v1_ResourceRequirements,
@@ -7988,12 +9281,15 @@ func init() {
 		autoconvert_api_RoleList_To_v1_RoleList,
 		autoconvert_api_Role_To_v1_Role,
 		autoconvert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStrategyParams,
+		autoconvert_api_RouteIngressCondition_To_v1_RouteIngressCondition,
+		autoconvert_api_RouteIngress_To_v1_RouteIngress,
 		autoconvert_api_RouteList_To_v1_RouteList,
 		autoconvert_api_RoutePort_To_v1_RoutePort,
 		autoconvert_api_RouteSpec_To_v1_RouteSpec,
 		autoconvert_api_RouteStatus_To_v1_RouteStatus,
 		autoconvert_api_Route_To_v1_Route,
 		autoconvert_api_SELinuxOptions_To_v1_SELinuxOptions,
+		autoconvert_api_SecretBuildSource_To_v1_SecretBuildSource,
 		autoconvert_api_SecretSpec_To_v1_SecretSpec,
 		autoconvert_api_SecretVolumeSource_To_v1_SecretVolumeSource,
 		autoconvert_api_SecurityContext_To_v1_SecurityContext,
@@ -8004,6 +9300,8 @@ func init() {
 		autoconvert_api_SubjectAccessReview_To_v1_SubjectAccessReview,
 		autoconvert_api_TCPSocketAction_To_v1_TCPSocketAction,
 		autoconvert_api_TLSConfig_To_v1_TLSConfig,
+		autoconvert_api_TagImageHook_To_v1_TagImageHook,
+		autoconvert_api_TagImportPolicy_To_v1_TagImportPolicy,
 		autoconvert_api_TemplateList_To_v1_TemplateList,
 		autoconvert_api_Template_To_v1_Template,
 		autoconvert_api_UserIdentityMapping_To_v1_UserIdentityMapping,
@@ -8016,6 +9314,7 @@ func init() {
 		autoconvert_v1_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource,
 		autoconvert_v1_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions,
 		autoconvert_v1_BinaryBuildSource_To_api_BinaryBuildSource,
+		autoconvert_v1_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams,
 		autoconvert_v1_BuildConfigList_To_api_BuildConfigList,
 		autoconvert_v1_BuildConfigSpec_To_api_BuildConfigSpec,
 		autoconvert_v1_BuildConfigStatus_To_api_BuildConfigStatus,
@@ -8031,6 +9330,8 @@ func init() {
 		autoconvert_v1_BuildStrategy_To_api_BuildStrategy,
 		autoconvert_v1_BuildTriggerPolicy_To_api_BuildTriggerPolicy,
 		autoconvert_v1_Build_To_api_Build,
+		autoconvert_v1_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams,
+		autoconvert_v1_CanaryStep_To_api_CanaryStep,
 		autoconvert_v1_Capabilities_To_api_Capabilities,
 		autoconvert_v1_CephFSVolumeSource_To_api_CephFSVolumeSource,
 		autoconvert_v1_CinderVolumeSource_To_api_CinderVolumeSource,
@@ -8044,12 +9345,15 @@ func init() {
 		autoconvert_v1_ClusterRoleBinding_To_api_ClusterRoleBinding,
 		autoconvert_v1_ClusterRoleList_To_api_ClusterRoleList,
 		autoconvert_v1_ClusterRole_To_api_ClusterRole,
+		autoconvert_v1_CommitStatusNotification_To_api_CommitStatusNotification,
 		autoconvert_v1_ContainerPort_To_api_ContainerPort,
 		autoconvert_v1_Container_To_api_Container,
 		autoconvert_v1_CustomBuildStrategy_To_api_CustomBuildStrategy,
 		autoconvert_v1_CustomDeploymentStrategyParams_To_api_CustomDeploymentStrategyParams,
 		autoconvert_v1_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger,
+		autoconvert_v1_DeploymentCauseSecretTrigger_To_api_DeploymentCauseSecretTrigger,
 		autoconvert_v1_DeploymentCause_To_api_DeploymentCause,
+		autoconvert_v1_DeploymentCondition_To_api_DeploymentCondition,
 		autoconvert_v1_DeploymentConfigList_To_api_DeploymentConfigList,
 		autoconvert_v1_DeploymentConfigRollbackSpec_To_api_DeploymentConfigRollbackSpec,
 		autoconvert_v1_DeploymentConfigRollback_To_api_DeploymentConfigRollback,
@@ -8062,6 +9366,8 @@ func init() {
 		autoconvert_v1_DeploymentStrategy_To_api_DeploymentStrategy,
 		autoconvert_v1_DeploymentTriggerImageChangeParams_To_api_DeploymentTriggerImageChangeParams,
 		autoconvert_v1_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy,
+		autoconvert_v1_DeploymentTriggerSecretChangeParams_To_api_DeploymentTriggerSecretChangeParams,
+		autoconvert_v1_DockerBuildCache_To_api_DockerBuildCache,
 		autoconvert_v1_DockerBuildStrategy_To_api_DockerBuildStrategy,
 		autoconvert_v1_DownwardAPIVolumeFile_To_api_DownwardAPIVolumeFile,
 		autoconvert_v1_DownwardAPIVolumeSource_To_api_DownwardAPIVolumeSource,
@@ -8088,10 +9394,15 @@ func init() {
 		autoconvert_v1_IdentityList_To_api_IdentityList,
 		autoconvert_v1_Identity_To_api_Identity,
 		autoconvert_v1_ImageChangeTrigger_To_api_ImageChangeTrigger,
+		autoconvert_v1_ImageImportSpec_To_api_ImageImportSpec,
+		autoconvert_v1_ImageImportStatus_To_api_ImageImportStatus,
 		autoconvert_v1_ImageList_To_api_ImageList,
 		autoconvert_v1_ImageSourcePath_To_api_ImageSourcePath,
 		autoconvert_v1_ImageSource_To_api_ImageSource,
 		autoconvert_v1_ImageStreamImage_To_api_ImageStreamImage,
+		autoconvert_v1_ImageStreamImportSpec_To_api_ImageStreamImportSpec,
+		autoconvert_v1_ImageStreamImportStatus_To_api_ImageStreamImportStatus,
+		autoconvert_v1_ImageStreamImport_To_api_ImageStreamImport,
 		autoconvert_v1_ImageStreamList_To_api_ImageStreamList,
 		autoconvert_v1_ImageStreamMapping_To_api_ImageStreamMapping,
 		autoconvert_v1_ImageStreamSpec_To_api_ImageStreamSpec,
@@ -8101,6 +9412,7 @@ func init() {
 		autoconvert_v1_ImageStream_To_api_ImageStream,
 		autoconvert_v1_Image_To_api_Image,
 		autoconvert_v1_IsPersonalSubjectAccessReview_To_api_IsPersonalSubjectAccessReview,
+		autoconvert_v1_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy,
 		autoconvert_v1_LifecycleHook_To_api_LifecycleHook,
 		autoconvert_v1_Lifecycle_To_api_Lifecycle,
 		autoconvert_v1_LocalObjectReference_To_api_LocalObjectReference,
@@ -8137,6 +9449,8 @@ func init() {
 		autoconvert_v1_Project_To_api_Project,
 		autoconvert_v1_RBDVolumeSource_To_api_RBDVolumeSource,
 		autoconvert_v1_RecreateDeploymentStrategyParams_To_api_RecreateDeploymentStrategyParams,
+		autoconvert_v1_RepositoryImportSpec_To_api_RepositoryImportSpec,
+		autoconvert_v1_RepositoryImportStatus_To_api_RepositoryImportStatus,
 		autoconvert_v1_ResourceAccessReviewResponse_To_api_ResourceAccessReviewResponse,
 		autoconvert_v1_ResourceAccessReview_To_api_ResourceAccessReview,
 		autoconvert_v1_ResourceRequirements_To_api_ResourceRequirements,
@@ -8145,12 +9459,15 @@ func init() {
 		autoconvert_v1_RoleList_To_api_RoleList,
 		autoconvert_v1_Role_To_api_Role,
 		autoconvert_v1_RollingDeploymentStrategyParams_To_api_RollingDeploymentStrategyParams,
+		autoconvert_v1_RouteIngressCondition_To_api_RouteIngressCondition,
+		autoconvert_v1_RouteIngress_To_api_RouteIngress,
 		autoconvert_v1_RouteList_To_api_RouteList,
 		autoconvert_v1_RoutePort_To_api_RoutePort,
 		autoconvert_v1_RouteSpec_To_api_RouteSpec,
 		autoconvert_v1_RouteStatus_To_api_RouteStatus,
 		autoconvert_v1_Route_To_api_Route,
 		autoconvert_v1_SELinuxOptions_To_api_SELinuxOptions,
+		autoconvert_v1_SecretBuildSource_To_api_SecretBuildSource,
 		autoconvert_v1_SecretSpec_To_api_SecretSpec,
 		autoconvert_v1_SecretVolumeSource_To_api_SecretVolumeSource,
 		autoconvert_v1_SecurityContext_To_api_SecurityContext,
@@ -8161,6 +9478,8 @@ func init() {
 		autoconvert_v1_SubjectAccessReview_To_api_SubjectAccessReview,
 		autoconvert_v1_TCPSocketAction_To_api_TCPSocketAction,
 		autoconvert_v1_TLSConfig_To_api_TLSConfig,
+		autoconvert_v1_TagImageHook_To_api_TagImageHook,
+		autoconvert_v1_TagImportPolicy_To_api_TagImportPolicy,
 		autoconvert_v1_TemplateList_To_api_TemplateList,
 		autoconvert_v1_Template_To_api_Template,
 		autoconvert_v1_UserIdentityMapping_To_api_UserIdentityMapping,
@@ -788,6 +788,27 @@ func deepCopy_v1_BuildConfigSpec(in apiv1.BuildConfigSpec, out *apiv1.BuildConfi
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = in.RunPolicy
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(apiv1.CommitStatusNotification)
+		if err := deepCopy_v1_CommitStatusNotification(*in.CommitStatus, out.CommitStatus, c); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	if err := deepCopy_v1_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
@@ -999,6 +1020,16 @@ func deepCopy_v1_BuildSource(in apiv1.BuildSource, out *apiv1.BuildSource, c *co
 	} else {
 		out.Image = nil
 	}
+	if in.Images != nil {
+		out.Images = make([]apiv1.ImageSource, len(in.Images))
+		for i := range in.Images {
+			if err := deepCopy_v1_ImageSource(in.Images[i], &out.Images[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
 	out.ContextDir = in.ContextDir
 	if in.SourceSecret != nil {
 		if newVal, err := c.DeepCopy(in.SourceSecret); err != nil {
@@ -1009,6 +1040,16 @@ func deepCopy_v1_BuildSource(in apiv1.BuildSource, out *apiv1.BuildSource, c *co
 	} else {
 		out.SourceSecret = nil
 	}
+	if in.Secrets != nil {
+		out.Secrets = make([]apiv1.SecretBuildSource, len(in.Secrets))
+		for i := range in.Secrets {
+			if err := deepCopy_v1_SecretBuildSource(in.Secrets[i], &out.Secrets[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Secrets = nil
+	}
 	return nil
 }
 
@@ -1108,6 +1149,14 @@ func deepCopy_v1_BuildStrategy(in apiv1.BuildStrategy, out *apiv1.BuildStrategy,
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.JenkinsPipelineStrategy != nil {
+		out.JenkinsPipelineStrategy = new(apiv1.JenkinsPipelineBuildStrategy)
+		if err := deepCopy_v1_JenkinsPipelineBuildStrategy(*in.JenkinsPipelineStrategy, out.JenkinsPipelineStrategy, c); err != nil {
+			return err
+		}
+	} else {
+		out.JenkinsPipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1129,6 +1178,22 @@ func deepCopy_v1_BuildTriggerPolicy(in apiv1.BuildTriggerPolicy, out *apiv1.Buil
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1.WebHookTrigger)
+		if err := deepCopy_v1_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1.WebHookTrigger)
+		if err := deepCopy_v1_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(apiv1.ImageChangeTrigger)
 		if err := deepCopy_v1_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1140,6 +1205,17 @@ func deepCopy_v1_BuildTriggerPolicy(in apiv1.BuildTriggerPolicy, out *apiv1.Buil
 	return nil
 }
 
+func deepCopy_v1_CommitStatusNotification(in apiv1.CommitStatusNotification, out *apiv1.CommitStatusNotification, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.APIURL = in.APIURL
+	if newVal, err := c.DeepCopy(in.Secret); err != nil {
+		return err
+	} else {
+		out.Secret = newVal.(pkgapiv1.LocalObjectReference)
+	}
+	return nil
+}
+
 func deepCopy_v1_CustomBuildStrategy(in apiv1.CustomBuildStrategy, out *apiv1.CustomBuildStrategy, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
@@ -1182,6 +1258,23 @@ func deepCopy_v1_CustomBuildStrategy(in apiv1.CustomBuildStrategy, out *apiv1.Cu
 	return nil
 }
 
+func deepCopy_v1_DockerBuildCache(in apiv1.DockerBuildCache, out *apiv1.DockerBuildCache, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.PersistentVolumeClaim); err != nil {
+		return err
+	} else {
+		out.PersistentVolumeClaim = newVal.(pkgapiv1.LocalObjectReference)
+	}
+	if in.Paths != nil {
+		out.Paths = make([]string, len(in.Paths))
+		for i := range in.Paths {
+			out.Paths[i] = in.Paths[i]
+		}
+	} else {
+		out.Paths = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_DockerBuildStrategy(in apiv1.DockerBuildStrategy, out *apiv1.DockerBuildStrategy, c *conversion.Cloner) error {
 	if in.From != nil {
 		if newVal, err := c.DeepCopy(in.From); err != nil {
@@ -1216,6 +1309,14 @@ func deepCopy_v1_DockerBuildStrategy(in apiv1.DockerBuildStrategy, out *apiv1.Do
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.BuildCache != nil {
+		out.BuildCache = new(apiv1.DockerBuildCache)
+		if err := deepCopy_v1_DockerBuildCache(*in.BuildCache, out.BuildCache, c); err != nil {
+			return err
+		}
+	} else {
+		out.BuildCache = nil
+	}
 	return nil
 }
 
@@ -1287,6 +1388,22 @@ func deepCopy_v1_ImageSourcePath(in apiv1.ImageSourcePath, out *apiv1.ImageSourc
 	return nil
 }
 
+func deepCopy_v1_JenkinsPipelineBuildStrategy(in apiv1.JenkinsPipelineBuildStrategy, out *apiv1.JenkinsPipelineBuildStrategy, c *conversion.Cloner) error {
+	out.JenkinsfilePath = in.JenkinsfilePath
+	out.Jenkinsfile = in.Jenkinsfile
+	return nil
+}
+
+func deepCopy_v1_SecretBuildSource(in apiv1.SecretBuildSource, out *apiv1.SecretBuildSource, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Secret); err != nil {
+		return err
+	} else {
+		out.Secret = newVal.(pkgapiv1.LocalObjectReference)
+	}
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
 func deepCopy_v1_SecretSpec(in apiv1.SecretSpec, out *apiv1.SecretSpec, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.SecretSource); err != nil {
 		return err
@@ -1354,6 +1471,88 @@ func deepCopy_v1_WebHookTrigger(in apiv1.WebHookTrigger, out *apiv1.WebHookTrigg
 	return nil
 }
 
+func deepCopy_v1_BlueGreenDeploymentStrategyParams(in deployapiv1.BlueGreenDeploymentStrategyParams, out *deployapiv1.BlueGreenDeploymentStrategyParams, c *conversion.Cloner) error {
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.KeepWarmSeconds = in.KeepWarmSeconds
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_CanaryDeploymentStrategyParams(in deployapiv1.CanaryDeploymentStrategyParams, out *deployapiv1.CanaryDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.Steps != nil {
+		out.Steps = make([]deployapiv1.CanaryStep, len(in.Steps))
+		for i := range in.Steps {
+			if err := deepCopy_v1_CanaryStep(in.Steps[i], &out.Steps[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_CanaryStep(in deployapiv1.CanaryStep, out *deployapiv1.CanaryStep, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Replicas); err != nil {
+		return err
+	} else {
+		out.Replicas = newVal.(util.IntOrString)
+	}
+	out.PauseSeconds = in.PauseSeconds
+	if in.Verify != nil {
+		out.Verify = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Verify, out.Verify, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_CustomDeploymentStrategyParams(in deployapiv1.CustomDeploymentStrategyParams, out *deployapiv1.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
 	out.Image = in.Image
 	if in.Environment != nil {
@@ -1389,6 +1588,14 @@ func deepCopy_v1_DeploymentCause(in deployapiv1.DeploymentCause, out *deployapiv
 	} else {
 		out.ImageTrigger = nil
 	}
+	if in.SecretTrigger != nil {
+		out.SecretTrigger = new(deployapiv1.DeploymentCauseSecretTrigger)
+		if err := deepCopy_v1_DeploymentCauseSecretTrigger(*in.SecretTrigger, out.SecretTrigger, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretTrigger = nil
+	}
 	return nil
 }
 
@@ -1401,6 +1608,28 @@ func deepCopy_v1_DeploymentCauseImageTrigger(in deployapiv1.DeploymentCauseImage
 	return nil
 }
 
+func deepCopy_v1_DeploymentCauseSecretTrigger(in deployapiv1.DeploymentCauseSecretTrigger, out *deployapiv1.DeploymentCauseSecretTrigger, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapiv1.ObjectReference)
+	}
+	return nil
+}
+
+func deepCopy_v1_DeploymentCondition(in deployapiv1.DeploymentCondition, out *deployapiv1.DeploymentCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_v1_DeploymentConfig(in deployapiv1.DeploymentConfig, out *deployapiv1.DeploymentConfig, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1502,11 +1731,19 @@ func deepCopy_v1_DeploymentConfigSpec(in deployapiv1.DeploymentConfigSpec, out *
 	} else {
 		out.Template = nil
 	}
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
+	out.Paused = in.Paused
 	return nil
 }
 
 func deepCopy_v1_DeploymentConfigStatus(in deployapiv1.DeploymentConfigStatus, out *deployapiv1.DeploymentConfigStatus, c *conversion.Cloner) error {
 	out.LatestVersion = in.LatestVersion
+	out.ObservedGeneration = in.ObservedGeneration
 	if in.Details != nil {
 		out.Details = new(deployapiv1.DeploymentDetails)
 		if err := deepCopy_v1_DeploymentDetails(*in.Details, out.Details, c); err != nil {
@@ -1515,6 +1752,21 @@ func deepCopy_v1_DeploymentConfigStatus(in deployapiv1.DeploymentConfigStatus, o
 	} else {
 		out.Details = nil
 	}
+	out.LiveColor = in.LiveColor
+	out.LiveDeployment = in.LiveDeployment
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapiv1.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_v1_DeploymentCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -1619,6 +1871,22 @@ func deepCopy_v1_DeploymentStrategy(in deployapiv1.DeploymentStrategy, out *depl
 	} else {
 		out.RollingParams = nil
 	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapiv1.CanaryDeploymentStrategyParams)
+		if err := deepCopy_v1_CanaryDeploymentStrategyParams(*in.CanaryParams, out.CanaryParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapiv1.BlueGreenDeploymentStrategyParams)
+		if err := deepCopy_v1_BlueGreenDeploymentStrategyParams(*in.BlueGreenParams, out.BlueGreenParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -1672,6 +1940,26 @@ func deepCopy_v1_DeploymentTriggerPolicy(in deployapiv1.DeploymentTriggerPolicy,
 	} else {
 		out.ImageChangeParams = nil
 	}
+	if in.SecretChangeParams != nil {
+		out.SecretChangeParams = new(deployapiv1.DeploymentTriggerSecretChangeParams)
+		if err := deepCopy_v1_DeploymentTriggerSecretChangeParams(*in.SecretChangeParams, out.SecretChangeParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretChangeParams = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_DeploymentTriggerSecretChangeParams(in deployapiv1.DeploymentTriggerSecretChangeParams, out *deployapiv1.DeploymentTriggerSecretChangeParams, c *conversion.Cloner) error {
+	if in.LastTriggeredSecrets != nil {
+		out.LastTriggeredSecrets = make(map[string]string)
+		for key, val := range in.LastTriggeredSecrets {
+			out.LastTriggeredSecrets[key] = val
+		}
+	} else {
+		out.LastTriggeredSecrets = nil
+	}
 	return nil
 }
 
@@ -1718,6 +2006,16 @@ func deepCopy_v1_LifecycleHook(in deployapiv1.LifecycleHook, out *deployapiv1.Li
 	} else {
 		out.ExecNewPod = nil
 	}
+	if in.TagImages != nil {
+		out.TagImages = make([]deployapiv1.TagImageHook, len(in.TagImages))
+		for i := range in.TagImages {
+			if err := deepCopy_v1_TagImageHook(in.TagImages[i], &out.TagImages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TagImages = nil
+	}
 	return nil
 }
 
@@ -1730,6 +2028,14 @@ func deepCopy_v1_RecreateDeploymentStrategyParams(in deployapiv1.RecreateDeploym
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapiv1.LifecycleHook)
+		if err := deepCopy_v1_LifecycleHook(*in.Mid, out.Mid, c); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapiv1.LifecycleHook)
 		if err := deepCopy_v1_LifecycleHook(*in.Post, out.Post, c); err != nil {
@@ -1760,6 +2066,13 @@ func deepCopy_v1_RollingDeploymentStrategyParams(in deployapiv1.RollingDeploymen
 	} else {
 		out.TimeoutSeconds = nil
 	}
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
+	out.AutoRollback = in.AutoRollback
 	if in.MaxUnavailable != nil {
 		if newVal, err := c.DeepCopy(in.MaxUnavailable); err != nil {
 			return err
@@ -1803,6 +2116,16 @@ func deepCopy_v1_RollingDeploymentStrategyParams(in deployapiv1.RollingDeploymen
 	return nil
 }
 
+func deepCopy_v1_TagImageHook(in deployapiv1.TagImageHook, out *deployapiv1.TagImageHook, c *conversion.Cloner) error {
+	out.ContainerName = in.ContainerName
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapiv1.ObjectReference)
+	}
+	return nil
+}
+
 func deepCopy_v1_Image(in imageapiv1.Image, out *imageapiv1.Image, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1822,6 +2145,61 @@ func deepCopy_v1_Image(in imageapiv1.Image, out *imageapiv1.Image, c *conversion
 	}
 	out.DockerImageMetadataVersion = in.DockerImageMetadataVersion
 	out.DockerImageManifest = in.DockerImageManifest
+	if in.DockerImageLayers != nil {
+		out.DockerImageLayers = make([]imageapiv1.ImageLayer, len(in.DockerImageLayers))
+		for i := range in.DockerImageLayers {
+			if err := deepCopy_v1_ImageLayer(in.DockerImageLayers[i], &out.DockerImageLayers[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DockerImageLayers = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_ImageImportSpec(in imageapiv1.ImageImportSpec, out *imageapiv1.ImageImportSpec, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapiv1.ObjectReference)
+	}
+	if in.To != nil {
+		if newVal, err := c.DeepCopy(in.To); err != nil {
+			return err
+		} else {
+			out.To = newVal.(*pkgapiv1.LocalObjectReference)
+		}
+	} else {
+		out.To = nil
+	}
+	if err := deepCopy_v1_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_ImageImportStatus(in imageapiv1.ImageImportStatus, out *imageapiv1.ImageImportStatus, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Status); err != nil {
+		return err
+	} else {
+		out.Status = newVal.(unversioned.Status)
+	}
+	if in.Image != nil {
+		out.Image = new(imageapiv1.Image)
+		if err := deepCopy_v1_Image(*in.Image, out.Image, c); err != nil {
+			return err
+		}
+	} else {
+		out.Image = nil
+	}
+	out.Tag = in.Tag
+	return nil
+}
+
+func deepCopy_v1_ImageLayer(in imageapiv1.ImageLayer, out *imageapiv1.ImageLayer, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Size = in.Size
 	return nil
 }
 
@@ -1886,6 +2264,79 @@ func deepCopy_v1_ImageStreamImage(in imageapiv1.ImageStreamImage, out *imageapiv
 	return nil
 }
 
+func deepCopy_v1_ImageStreamImport(in imageapiv1.ImageStreamImport, out *imageapiv1.ImageStreamImport, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1.ObjectMeta)
+	}
+	if err := deepCopy_v1_ImageStreamImportSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_v1_ImageStreamImportStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_ImageStreamImportSpec(in imageapiv1.ImageStreamImportSpec, out *imageapiv1.ImageStreamImportSpec, c *conversion.Cloner) error {
+	out.Import = in.Import
+	if in.Repository != nil {
+		out.Repository = new(imageapiv1.RepositoryImportSpec)
+		if err := deepCopy_v1_RepositoryImportSpec(*in.Repository, out.Repository, c); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapiv1.ImageImportSpec, len(in.Images))
+		for i := range in.Images {
+			if err := deepCopy_v1_ImageImportSpec(in.Images[i], &out.Images[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_ImageStreamImportStatus(in imageapiv1.ImageStreamImportStatus, out *imageapiv1.ImageStreamImportStatus, c *conversion.Cloner) error {
+	if in.Import != nil {
+		out.Import = new(imageapiv1.ImageStream)
+		if err := deepCopy_v1_ImageStream(*in.Import, out.Import, c); err != nil {
+			return err
+		}
+	} else {
+		out.Import = nil
+	}
+	if in.Repository != nil {
+		out.Repository = new(imageapiv1.RepositoryImportStatus)
+		if err := deepCopy_v1_RepositoryImportStatus(*in.Repository, out.Repository, c); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapiv1.ImageImportStatus, len(in.Images))
+		for i := range in.Images {
+			if err := deepCopy_v1_ImageImportStatus(in.Images[i], &out.Images[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	return nil
+}
+
 func deepCopy_v1_ImageStreamList(in imageapiv1.ImageStreamList, out *imageapiv1.ImageStreamList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2011,6 +2462,16 @@ func deepCopy_v1_NamedTagEventList(in imageapiv1.NamedTagEventList, out *imageap
 	} else {
 		out.Items = nil
 	}
+	if in.Conditions != nil {
+		out.Conditions = make([]imageapiv1.TagEventCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_v1_TagEventCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -2034,6 +2495,48 @@ func deepCopy_v1_NamedTagReference(in imageapiv1.NamedTagReference, out *imageap
 		out.From = nil
 	}
 	out.Reference = in.Reference
+	if err := deepCopy_v1_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_RepositoryImportSpec(in imageapiv1.RepositoryImportSpec, out *imageapiv1.RepositoryImportSpec, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapiv1.ObjectReference)
+	}
+	if err := deepCopy_v1_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1_RepositoryImportStatus(in imageapiv1.RepositoryImportStatus, out *imageapiv1.RepositoryImportStatus, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Status); err != nil {
+		return err
+	} else {
+		out.Status = newVal.(unversioned.Status)
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapiv1.ImageImportStatus, len(in.Images))
+		for i := range in.Images {
+			if err := deepCopy_v1_ImageImportStatus(in.Images[i], &out.Images[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	if in.AdditionalTags != nil {
+		out.AdditionalTags = make([]string, len(in.AdditionalTags))
+		for i := range in.AdditionalTags {
+			out.AdditionalTags[i] = in.AdditionalTags[i]
+		}
+	} else {
+		out.AdditionalTags = nil
+	}
 	return nil
 }
 
@@ -2048,6 +2551,25 @@ func deepCopy_v1_TagEvent(in imageapiv1.TagEvent, out *imageapiv1.TagEvent, c *c
 	return nil
 }
 
+func deepCopy_v1_TagEventCondition(in imageapiv1.TagEventCondition, out *imageapiv1.TagEventCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func deepCopy_v1_TagImportPolicy(in imageapiv1.TagImportPolicy, out *imageapiv1.TagImportPolicy, c *conversion.Cloner) error {
+	out.Insecure = in.Insecure
+	out.Scheduled = in.Scheduled
+	return nil
+}
+
 func deepCopy_v1_OAuthAccessToken(in oauthapiv1.OAuthAccessToken, out *oauthapiv1.OAuthAccessToken, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2347,6 +2869,35 @@ func deepCopy_v1_Route(in routeapiv1.Route, out *routeapiv1.Route, c *conversion
 	return nil
 }
 
+func deepCopy_v1_RouteIngress(in routeapiv1.RouteIngress, out *routeapiv1.RouteIngress, c *conversion.Cloner) error {
+	out.Host = in.Host
+	out.RouterName = in.RouterName
+	if in.Conditions != nil {
+		out.Conditions = make([]routeapiv1.RouteIngressCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_v1_RouteIngressCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+func deepCopy_v1_RouteIngressCondition(in routeapiv1.RouteIngressCondition, out *routeapiv1.RouteIngressCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	out.Reason = in.Reason
+	out.Message = in.Message
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	return nil
+}
+
 func deepCopy_v1_RouteList(in routeapiv1.RouteList, out *routeapiv1.RouteList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2408,6 +2959,16 @@ func deepCopy_v1_RouteSpec(in routeapiv1.RouteSpec, out *routeapiv1.RouteSpec, c
 }
 
 func deepCopy_v1_RouteStatus(in routeapiv1.RouteStatus, out *routeapiv1.RouteStatus, c *conversion.Cloner) error {
+	if in.Ingress != nil {
+		out.Ingress = make([]routeapiv1.RouteIngress, len(in.Ingress))
+		for i := range in.Ingress {
+			if err := deepCopy_v1_RouteIngress(in.Ingress[i], &out.Ingress[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ingress = nil
+	}
 	return nil
 }
 
@@ -2848,21 +3409,30 @@ func init() {
 		deepCopy_v1_BuildStatus,
 		deepCopy_v1_BuildStrategy,
 		deepCopy_v1_BuildTriggerPolicy,
+		deepCopy_v1_CommitStatusNotification,
 		deepCopy_v1_CustomBuildStrategy,
+		deepCopy_v1_DockerBuildCache,
 		deepCopy_v1_DockerBuildStrategy,
 		deepCopy_v1_GitBuildSource,
 		deepCopy_v1_GitSourceRevision,
 		deepCopy_v1_ImageChangeTrigger,
 		deepCopy_v1_ImageSource,
 		deepCopy_v1_ImageSourcePath,
+		deepCopy_v1_JenkinsPipelineBuildStrategy,
+		deepCopy_v1_SecretBuildSource,
 		deepCopy_v1_SecretSpec,
 		deepCopy_v1_SourceBuildStrategy,
 		deepCopy_v1_SourceControlUser,
 		deepCopy_v1_SourceRevision,
 		deepCopy_v1_WebHookTrigger,
+		deepCopy_v1_BlueGreenDeploymentStrategyParams,
+		deepCopy_v1_CanaryDeploymentStrategyParams,
+		deepCopy_v1_CanaryStep,
 		deepCopy_v1_CustomDeploymentStrategyParams,
 		deepCopy_v1_DeploymentCause,
 		deepCopy_v1_DeploymentCauseImageTrigger,
+		deepCopy_v1_DeploymentCauseSecretTrigger,
+		deepCopy_v1_DeploymentCondition,
 		deepCopy_v1_DeploymentConfig,
 		deepCopy_v1_DeploymentConfigList,
 		deepCopy_v1_DeploymentConfigRollback,
@@ -2875,14 +3445,22 @@ func init() {
 		deepCopy_v1_DeploymentStrategy,
 		deepCopy_v1_DeploymentTriggerImageChangeParams,
 		deepCopy_v1_DeploymentTriggerPolicy,
+		deepCopy_v1_DeploymentTriggerSecretChangeParams,
 		deepCopy_v1_ExecNewPodHook,
 		deepCopy_v1_LifecycleHook,
 		deepCopy_v1_RecreateDeploymentStrategyParams,
 		deepCopy_v1_RollingDeploymentStrategyParams,
+		deepCopy_v1_TagImageHook,
 		deepCopy_v1_Image,
+		deepCopy_v1_ImageImportSpec,
+		deepCopy_v1_ImageImportStatus,
+		deepCopy_v1_ImageLayer,
 		deepCopy_v1_ImageList,
 		deepCopy_v1_ImageStream,
 		deepCopy_v1_ImageStreamImage,
+		deepCopy_v1_ImageStreamImport,
+		deepCopy_v1_ImageStreamImportSpec,
+		deepCopy_v1_ImageStreamImportStatus,
 		deepCopy_v1_ImageStreamList,
 		deepCopy_v1_ImageStreamMapping,
 		deepCopy_v1_ImageStreamSpec,
@@ -2891,7 +3469,11 @@ func init() {
 		deepCopy_v1_ImageStreamTagList,
 		deepCopy_v1_NamedTagEventList,
 		deepCopy_v1_NamedTagReference,
+		deepCopy_v1_RepositoryImportSpec,
+		deepCopy_v1_RepositoryImportStatus,
 		deepCopy_v1_TagEvent,
+		deepCopy_v1_TagEventCondition,
+		deepCopy_v1_TagImportPolicy,
 		deepCopy_v1_OAuthAccessToken,
 		deepCopy_v1_OAuthAccessTokenList,
 		deepCopy_v1_OAuthAuthorizeToken,
@@ -2906,6 +3488,8 @@ func init() {
 		deepCopy_v1_ProjectSpec,
 		deepCopy_v1_ProjectStatus,
 		deepCopy_v1_Route,
+		deepCopy_v1_RouteIngress,
+		deepCopy_v1_RouteIngressCondition,
 		deepCopy_v1_RouteList,
 		deepCopy_v1_RoutePort,
 		deepCopy_v1_RouteSpec,
@@ -1201,6 +1201,27 @@ func autoconvert_api_BuildConfigSpec_To_v1beta3_BuildConfigSpec(in *buildapi.Bui
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = apiv1beta3.BuildRunPolicy(in.RunPolicy)
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(apiv1beta3.CommitStatusNotification)
+		if err := convert_api_CommitStatusNotification_To_v1beta3_CommitStatusNotification(in.CommitStatus, out.CommitStatus, s); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	if err := convert_api_BuildSpec_To_v1beta3_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
@@ -1427,13 +1448,15 @@ func autoconvert_api_BuildSource_To_v1beta3_BuildSource(in *buildapi.BuildSource
 	} else {
 		out.Git = nil
 	}
-	if in.Image != nil {
-		out.Image = new(apiv1beta3.ImageSource)
-		if err := convert_api_ImageSource_To_v1beta3_ImageSource(in.Image, out.Image, s); err != nil {
-			return err
+	if in.Images != nil {
+		out.Images = make([]apiv1beta3.ImageSource, len(in.Images))
+		for i := range in.Images {
+			if err := convert_api_ImageSource_To_v1beta3_ImageSource(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
-		out.Image = nil
+		out.Images = nil
 	}
 	out.ContextDir = in.ContextDir
 	if in.SourceSecret != nil {
@@ -1444,6 +1467,16 @@ func autoconvert_api_BuildSource_To_v1beta3_BuildSource(in *buildapi.BuildSource
 	} else {
 		out.SourceSecret = nil
 	}
+	if in.Secrets != nil {
+		out.Secrets = make([]apiv1beta3.SecretBuildSource, len(in.Secrets))
+		for i := range in.Secrets {
+			if err := convert_api_SecretBuildSource_To_v1beta3_SecretBuildSource(&in.Secrets[i], &out.Secrets[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Secrets = nil
+	}
 	return nil
 }
 
@@ -1548,6 +1581,14 @@ func autoconvert_api_BuildStrategy_To_v1beta3_BuildStrategy(in *buildapi.BuildSt
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.JenkinsPipelineStrategy != nil {
+		out.JenkinsPipelineStrategy = new(apiv1beta3.JenkinsPipelineBuildStrategy)
+		if err := convert_api_JenkinsPipelineBuildStrategy_To_v1beta3_JenkinsPipelineBuildStrategy(in.JenkinsPipelineStrategy, out.JenkinsPipelineStrategy, s); err != nil {
+			return err
+		}
+	} else {
+		out.JenkinsPipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1572,6 +1613,22 @@ func autoconvert_api_BuildTriggerPolicy_To_v1beta3_BuildTriggerPolicy(in *builda
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := convert_api_WebHookTrigger_To_v1beta3_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(apiv1beta3.ImageChangeTrigger)
 		if err := convert_api_ImageChangeTrigger_To_v1beta3_ImageChangeTrigger(in.ImageChange, out.ImageChange, s); err != nil {
@@ -1583,6 +1640,22 @@ func autoconvert_api_BuildTriggerPolicy_To_v1beta3_BuildTriggerPolicy(in *builda
 	return nil
 }
 
+func autoconvert_api_CommitStatusNotification_To_v1beta3_CommitStatusNotification(in *buildapi.CommitStatusNotification, out *apiv1beta3.CommitStatusNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.CommitStatusNotification))(in)
+	}
+	out.Type = apiv1beta3.CommitStatusHostType(in.Type)
+	out.APIURL = in.APIURL
+	if err := convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_CommitStatusNotification_To_v1beta3_CommitStatusNotification(in *buildapi.CommitStatusNotification, out *apiv1beta3.CommitStatusNotification, s conversion.Scope) error {
+	return autoconvert_api_CommitStatusNotification_To_v1beta3_CommitStatusNotification(in, out, s)
+}
+
 func autoconvert_api_CustomBuildStrategy_To_v1beta3_CustomBuildStrategy(in *buildapi.CustomBuildStrategy, out *apiv1beta3.CustomBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.CustomBuildStrategy))(in)
@@ -1623,6 +1696,28 @@ func autoconvert_api_CustomBuildStrategy_To_v1beta3_CustomBuildStrategy(in *buil
 	return nil
 }
 
+func autoconvert_api_DockerBuildCache_To_v1beta3_DockerBuildCache(in *buildapi.DockerBuildCache, out *apiv1beta3.DockerBuildCache, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.DockerBuildCache))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(&in.PersistentVolumeClaim, &out.PersistentVolumeClaim, s); err != nil {
+		return err
+	}
+	if in.Paths != nil {
+		out.Paths = make([]string, len(in.Paths))
+		for i := range in.Paths {
+			out.Paths[i] = in.Paths[i]
+		}
+	} else {
+		out.Paths = nil
+	}
+	return nil
+}
+
+func convert_api_DockerBuildCache_To_v1beta3_DockerBuildCache(in *buildapi.DockerBuildCache, out *apiv1beta3.DockerBuildCache, s conversion.Scope) error {
+	return autoconvert_api_DockerBuildCache_To_v1beta3_DockerBuildCache(in, out, s)
+}
+
 func autoconvert_api_DockerBuildStrategy_To_v1beta3_DockerBuildStrategy(in *buildapi.DockerBuildStrategy, out *apiv1beta3.DockerBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.DockerBuildStrategy))(in)
@@ -1656,6 +1751,14 @@ func autoconvert_api_DockerBuildStrategy_To_v1beta3_DockerBuildStrategy(in *buil
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.BuildCache != nil {
+		out.BuildCache = new(apiv1beta3.DockerBuildCache)
+		if err := convert_api_DockerBuildCache_To_v1beta3_DockerBuildCache(in.BuildCache, out.BuildCache, s); err != nil {
+			return err
+		}
+	} else {
+		out.BuildCache = nil
+	}
 	return nil
 }
 
@@ -1758,6 +1861,34 @@ func convert_api_ImageSourcePath_To_v1beta3_ImageSourcePath(in *buildapi.ImageSo
 	return autoconvert_api_ImageSourcePath_To_v1beta3_ImageSourcePath(in, out, s)
 }
 
+func autoconvert_api_JenkinsPipelineBuildStrategy_To_v1beta3_JenkinsPipelineBuildStrategy(in *buildapi.JenkinsPipelineBuildStrategy, out *apiv1beta3.JenkinsPipelineBuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.JenkinsPipelineBuildStrategy))(in)
+	}
+	out.JenkinsfilePath = in.JenkinsfilePath
+	out.Jenkinsfile = in.Jenkinsfile
+	return nil
+}
+
+func convert_api_JenkinsPipelineBuildStrategy_To_v1beta3_JenkinsPipelineBuildStrategy(in *buildapi.JenkinsPipelineBuildStrategy, out *apiv1beta3.JenkinsPipelineBuildStrategy, s conversion.Scope) error {
+	return autoconvert_api_JenkinsPipelineBuildStrategy_To_v1beta3_JenkinsPipelineBuildStrategy(in, out, s)
+}
+
+func autoconvert_api_SecretBuildSource_To_v1beta3_SecretBuildSource(in *buildapi.SecretBuildSource, out *apiv1beta3.SecretBuildSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*buildapi.SecretBuildSource))(in)
+	}
+	if err := convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
+func convert_api_SecretBuildSource_To_v1beta3_SecretBuildSource(in *buildapi.SecretBuildSource, out *apiv1beta3.SecretBuildSource, s conversion.Scope) error {
+	return autoconvert_api_SecretBuildSource_To_v1beta3_SecretBuildSource(in, out, s)
+}
+
 func autoconvert_api_SecretSpec_To_v1beta3_SecretSpec(in *buildapi.SecretSpec, out *apiv1beta3.SecretSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*buildapi.SecretSpec))(in)
@@ -1967,6 +2098,27 @@ func autoconvert_v1beta3_BuildConfigSpec_To_api_BuildConfigSpec(in *apiv1beta3.B
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = buildapi.BuildRunPolicy(in.RunPolicy)
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(buildapi.CommitStatusNotification)
+		if err := convert_v1beta3_CommitStatusNotification_To_api_CommitStatusNotification(in.CommitStatus, out.CommitStatus, s); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	if err := convert_v1beta3_BuildSpec_To_api_BuildSpec(&in.BuildSpec, &out.BuildSpec, s); err != nil {
 		return err
 	}
@@ -2194,13 +2346,16 @@ func autoconvert_v1beta3_BuildSource_To_api_BuildSource(in *apiv1beta3.BuildSour
 	} else {
 		out.Git = nil
 	}
-	if in.Image != nil {
-		out.Image = new(buildapi.ImageSource)
-		if err := convert_v1beta3_ImageSource_To_api_ImageSource(in.Image, out.Image, s); err != nil {
-			return err
+	// in.Image has no peer in out
+	if in.Images != nil {
+		out.Images = make([]buildapi.ImageSource, len(in.Images))
+		for i := range in.Images {
+			if err := convert_v1beta3_ImageSource_To_api_ImageSource(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
 		}
 	} else {
-		out.Image = nil
+		out.Images = nil
 	}
 	out.ContextDir = in.ContextDir
 	if in.SourceSecret != nil {
@@ -2211,6 +2366,16 @@ func autoconvert_v1beta3_BuildSource_To_api_BuildSource(in *apiv1beta3.BuildSour
 	} else {
 		out.SourceSecret = nil
 	}
+	if in.Secrets != nil {
+		out.Secrets = make([]buildapi.SecretBuildSource, len(in.Secrets))
+		for i := range in.Secrets {
+			if err := convert_v1beta3_SecretBuildSource_To_api_SecretBuildSource(&in.Secrets[i], &out.Secrets[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Secrets = nil
+	}
 	return nil
 }
 
@@ -2316,6 +2481,14 @@ func autoconvert_v1beta3_BuildStrategy_To_api_BuildStrategy(in *apiv1beta3.Build
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.JenkinsPipelineStrategy != nil {
+		out.JenkinsPipelineStrategy = new(buildapi.JenkinsPipelineBuildStrategy)
+		if err := convert_v1beta3_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy(in.JenkinsPipelineStrategy, out.JenkinsPipelineStrategy, s); err != nil {
+			return err
+		}
+	} else {
+		out.JenkinsPipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -2340,6 +2513,22 @@ func autoconvert_v1beta3_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *apiv1b
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(buildapi.WebHookTrigger)
+		if err := convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in.GitLabWebHook, out.GitLabWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(buildapi.WebHookTrigger)
+		if err := convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in.BitbucketWebHook, out.BitbucketWebHook, s); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(buildapi.ImageChangeTrigger)
 		if err := convert_v1beta3_ImageChangeTrigger_To_api_ImageChangeTrigger(in.ImageChange, out.ImageChange, s); err != nil {
@@ -2351,6 +2540,22 @@ func autoconvert_v1beta3_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *apiv1b
 	return nil
 }
 
+func autoconvert_v1beta3_CommitStatusNotification_To_api_CommitStatusNotification(in *apiv1beta3.CommitStatusNotification, out *buildapi.CommitStatusNotification, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.CommitStatusNotification))(in)
+	}
+	out.Type = buildapi.CommitStatusHostType(in.Type)
+	out.APIURL = in.APIURL
+	if err := convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta3_CommitStatusNotification_To_api_CommitStatusNotification(in *apiv1beta3.CommitStatusNotification, out *buildapi.CommitStatusNotification, s conversion.Scope) error {
+	return autoconvert_v1beta3_CommitStatusNotification_To_api_CommitStatusNotification(in, out, s)
+}
+
 func autoconvert_v1beta3_CustomBuildStrategy_To_api_CustomBuildStrategy(in *apiv1beta3.CustomBuildStrategy, out *buildapi.CustomBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1beta3.CustomBuildStrategy))(in)
@@ -2391,6 +2596,28 @@ func autoconvert_v1beta3_CustomBuildStrategy_To_api_CustomBuildStrategy(in *apiv
 	return nil
 }
 
+func autoconvert_v1beta3_DockerBuildCache_To_api_DockerBuildCache(in *apiv1beta3.DockerBuildCache, out *buildapi.DockerBuildCache, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.DockerBuildCache))(in)
+	}
+	if err := convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(&in.PersistentVolumeClaim, &out.PersistentVolumeClaim, s); err != nil {
+		return err
+	}
+	if in.Paths != nil {
+		out.Paths = make([]string, len(in.Paths))
+		for i := range in.Paths {
+			out.Paths[i] = in.Paths[i]
+		}
+	} else {
+		out.Paths = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_DockerBuildCache_To_api_DockerBuildCache(in *apiv1beta3.DockerBuildCache, out *buildapi.DockerBuildCache, s conversion.Scope) error {
+	return autoconvert_v1beta3_DockerBuildCache_To_api_DockerBuildCache(in, out, s)
+}
+
 func autoconvert_v1beta3_DockerBuildStrategy_To_api_DockerBuildStrategy(in *apiv1beta3.DockerBuildStrategy, out *buildapi.DockerBuildStrategy, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1beta3.DockerBuildStrategy))(in)
@@ -2424,6 +2651,14 @@ func autoconvert_v1beta3_DockerBuildStrategy_To_api_DockerBuildStrategy(in *apiv
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.BuildCache != nil {
+		out.BuildCache = new(buildapi.DockerBuildCache)
+		if err := convert_v1beta3_DockerBuildCache_To_api_DockerBuildCache(in.BuildCache, out.BuildCache, s); err != nil {
+			return err
+		}
+	} else {
+		out.BuildCache = nil
+	}
 	return nil
 }
 
@@ -2526,6 +2761,34 @@ func convert_v1beta3_ImageSourcePath_To_api_ImageSourcePath(in *apiv1beta3.Image
 	return autoconvert_v1beta3_ImageSourcePath_To_api_ImageSourcePath(in, out, s)
 }
 
+func autoconvert_v1beta3_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy(in *apiv1beta3.JenkinsPipelineBuildStrategy, out *buildapi.JenkinsPipelineBuildStrategy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.JenkinsPipelineBuildStrategy))(in)
+	}
+	out.JenkinsfilePath = in.JenkinsfilePath
+	out.Jenkinsfile = in.Jenkinsfile
+	return nil
+}
+
+func convert_v1beta3_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy(in *apiv1beta3.JenkinsPipelineBuildStrategy, out *buildapi.JenkinsPipelineBuildStrategy, s conversion.Scope) error {
+	return autoconvert_v1beta3_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy(in, out, s)
+}
+
+func autoconvert_v1beta3_SecretBuildSource_To_api_SecretBuildSource(in *apiv1beta3.SecretBuildSource, out *buildapi.SecretBuildSource, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*apiv1beta3.SecretBuildSource))(in)
+	}
+	if err := convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(&in.Secret, &out.Secret, s); err != nil {
+		return err
+	}
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
+func convert_v1beta3_SecretBuildSource_To_api_SecretBuildSource(in *apiv1beta3.SecretBuildSource, out *buildapi.SecretBuildSource, s conversion.Scope) error {
+	return autoconvert_v1beta3_SecretBuildSource_To_api_SecretBuildSource(in, out, s)
+}
+
 func autoconvert_v1beta3_SecretSpec_To_api_SecretSpec(in *apiv1beta3.SecretSpec, out *buildapi.SecretSpec, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*apiv1beta3.SecretSpec))(in)
@@ -2613,6 +2876,107 @@ func convert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in *apiv1beta3.WebHook
 	return autoconvert_v1beta3_WebHookTrigger_To_api_WebHookTrigger(in, out, s)
 }
 
+func autoconvert_api_BlueGreenDeploymentStrategyParams_To_v1beta3_BlueGreenDeploymentStrategyParams(in *deployapi.BlueGreenDeploymentStrategyParams, out *deployapiv1beta3.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.BlueGreenDeploymentStrategyParams))(in)
+	}
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.KeepWarmSeconds = in.KeepWarmSeconds
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1beta3.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1beta3_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1beta3.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1beta3_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func convert_api_BlueGreenDeploymentStrategyParams_To_v1beta3_BlueGreenDeploymentStrategyParams(in *deployapi.BlueGreenDeploymentStrategyParams, out *deployapiv1beta3.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	return autoconvert_api_BlueGreenDeploymentStrategyParams_To_v1beta3_BlueGreenDeploymentStrategyParams(in, out, s)
+}
+
+func autoconvert_api_CanaryDeploymentStrategyParams_To_v1beta3_CanaryDeploymentStrategyParams(in *deployapi.CanaryDeploymentStrategyParams, out *deployapiv1beta3.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.CanaryDeploymentStrategyParams))(in)
+	}
+	if in.Steps != nil {
+		out.Steps = make([]deployapiv1beta3.CanaryStep, len(in.Steps))
+		for i := range in.Steps {
+			if err := convert_api_CanaryStep_To_v1beta3_CanaryStep(&in.Steps[i], &out.Steps[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1beta3.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1beta3_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1beta3.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1beta3_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func convert_api_CanaryDeploymentStrategyParams_To_v1beta3_CanaryDeploymentStrategyParams(in *deployapi.CanaryDeploymentStrategyParams, out *deployapiv1beta3.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	return autoconvert_api_CanaryDeploymentStrategyParams_To_v1beta3_CanaryDeploymentStrategyParams(in, out, s)
+}
+
+func autoconvert_api_CanaryStep_To_v1beta3_CanaryStep(in *deployapi.CanaryStep, out *deployapiv1beta3.CanaryStep, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.CanaryStep))(in)
+	}
+	if err := s.Convert(&in.Replicas, &out.Replicas, 0); err != nil {
+		return err
+	}
+	out.PauseSeconds = in.PauseSeconds
+	if in.Verify != nil {
+		out.Verify = new(deployapiv1beta3.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1beta3_LifecycleHook(in.Verify, out.Verify, s); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	return nil
+}
+
+func convert_api_CanaryStep_To_v1beta3_CanaryStep(in *deployapi.CanaryStep, out *deployapiv1beta3.CanaryStep, s conversion.Scope) error {
+	return autoconvert_api_CanaryStep_To_v1beta3_CanaryStep(in, out, s)
+}
+
 func autoconvert_api_CustomDeploymentStrategyParams_To_v1beta3_CustomDeploymentStrategyParams(in *deployapi.CustomDeploymentStrategyParams, out *deployapiv1beta3.CustomDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.CustomDeploymentStrategyParams))(in)
@@ -2656,6 +3020,14 @@ func autoconvert_api_DeploymentCause_To_v1beta3_DeploymentCause(in *deployapi.De
 	} else {
 		out.ImageTrigger = nil
 	}
+	if in.SecretTrigger != nil {
+		out.SecretTrigger = new(deployapiv1beta3.DeploymentCauseSecretTrigger)
+		if err := convert_api_DeploymentCauseSecretTrigger_To_v1beta3_DeploymentCauseSecretTrigger(in.SecretTrigger, out.SecretTrigger, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretTrigger = nil
+	}
 	return nil
 }
 
@@ -2677,38 +3049,70 @@ func convert_api_DeploymentCauseImageTrigger_To_v1beta3_DeploymentCauseImageTrig
 	return autoconvert_api_DeploymentCauseImageTrigger_To_v1beta3_DeploymentCauseImageTrigger(in, out, s)
 }
 
-func autoconvert_api_DeploymentConfig_To_v1beta3_DeploymentConfig(in *deployapi.DeploymentConfig, out *deployapiv1beta3.DeploymentConfig, s conversion.Scope) error {
+func autoconvert_api_DeploymentCauseSecretTrigger_To_v1beta3_DeploymentCauseSecretTrigger(in *deployapi.DeploymentCauseSecretTrigger, out *deployapiv1beta3.DeploymentCauseSecretTrigger, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*deployapi.DeploymentConfig))(in)
-	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
-		return err
-	}
-	if err := convert_api_ObjectMeta_To_v1beta3_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
-		return err
+		defaulting.(func(*deployapi.DeploymentCauseSecretTrigger))(in)
 	}
-	if err := convert_api_DeploymentConfigSpec_To_v1beta3_DeploymentConfigSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := convert_api_DeploymentConfigStatus_To_v1beta3_DeploymentConfigStatus(&in.Status, &out.Status, s); err != nil {
+	if err := convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.From, &out.From, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-func convert_api_DeploymentConfig_To_v1beta3_DeploymentConfig(in *deployapi.DeploymentConfig, out *deployapiv1beta3.DeploymentConfig, s conversion.Scope) error {
-	return autoconvert_api_DeploymentConfig_To_v1beta3_DeploymentConfig(in, out, s)
+func convert_api_DeploymentCauseSecretTrigger_To_v1beta3_DeploymentCauseSecretTrigger(in *deployapi.DeploymentCauseSecretTrigger, out *deployapiv1beta3.DeploymentCauseSecretTrigger, s conversion.Scope) error {
+	return autoconvert_api_DeploymentCauseSecretTrigger_To_v1beta3_DeploymentCauseSecretTrigger(in, out, s)
 }
 
-func autoconvert_api_DeploymentConfigList_To_v1beta3_DeploymentConfigList(in *deployapi.DeploymentConfigList, out *deployapiv1beta3.DeploymentConfigList, s conversion.Scope) error {
+func autoconvert_api_DeploymentCondition_To_v1beta3_DeploymentCondition(in *deployapi.DeploymentCondition, out *deployapiv1beta3.DeploymentCondition, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*deployapi.DeploymentConfigList))(in)
+		defaulting.(func(*deployapi.DeploymentCondition))(in)
 	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+	out.Type = deployapiv1beta3.DeploymentConditionType(in.Type)
+	out.Status = pkgapiv1beta3.ConditionStatus(in.Status)
+	if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
 		return err
 	}
-	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
-		return err
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func convert_api_DeploymentCondition_To_v1beta3_DeploymentCondition(in *deployapi.DeploymentCondition, out *deployapiv1beta3.DeploymentCondition, s conversion.Scope) error {
+	return autoconvert_api_DeploymentCondition_To_v1beta3_DeploymentCondition(in, out, s)
+}
+
+func autoconvert_api_DeploymentConfig_To_v1beta3_DeploymentConfig(in *deployapi.DeploymentConfig, out *deployapiv1beta3.DeploymentConfig, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentConfig))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1beta3_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_api_DeploymentConfigSpec_To_v1beta3_DeploymentConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_api_DeploymentConfigStatus_To_v1beta3_DeploymentConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_DeploymentConfig_To_v1beta3_DeploymentConfig(in *deployapi.DeploymentConfig, out *deployapiv1beta3.DeploymentConfig, s conversion.Scope) error {
+	return autoconvert_api_DeploymentConfig_To_v1beta3_DeploymentConfig(in, out, s)
+}
+
+func autoconvert_api_DeploymentConfigList_To_v1beta3_DeploymentConfigList(in *deployapi.DeploymentConfigList, out *deployapiv1beta3.DeploymentConfigList, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentConfigList))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.ListMeta, &out.ListMeta, 0); err != nil {
+		return err
 	}
 	if in.Items != nil {
 		out.Items = make([]deployapiv1beta3.DeploymentConfig, len(in.Items))
@@ -2796,6 +3200,13 @@ func autoconvert_api_DeploymentConfigSpec_To_v1beta3_DeploymentConfigSpec(in *de
 	} else {
 		out.Template = nil
 	}
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
+	out.Paused = in.Paused
 	return nil
 }
 
@@ -2808,6 +3219,7 @@ func autoconvert_api_DeploymentConfigStatus_To_v1beta3_DeploymentConfigStatus(in
 		defaulting.(func(*deployapi.DeploymentConfigStatus))(in)
 	}
 	out.LatestVersion = in.LatestVersion
+	out.ObservedGeneration = in.ObservedGeneration
 	if in.Details != nil {
 		out.Details = new(deployapiv1beta3.DeploymentDetails)
 		if err := convert_api_DeploymentDetails_To_v1beta3_DeploymentDetails(in.Details, out.Details, s); err != nil {
@@ -2816,6 +3228,21 @@ func autoconvert_api_DeploymentConfigStatus_To_v1beta3_DeploymentConfigStatus(in
 	} else {
 		out.Details = nil
 	}
+	out.LiveColor = string(in.LiveColor)
+	out.LiveDeployment = in.LiveDeployment
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapiv1beta3.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_api_DeploymentCondition_To_v1beta3_DeploymentCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -2937,6 +3364,22 @@ func autoconvert_api_DeploymentStrategy_To_v1beta3_DeploymentStrategy(in *deploy
 	} else {
 		out.RollingParams = nil
 	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapiv1beta3.CanaryDeploymentStrategyParams)
+		if err := convert_api_CanaryDeploymentStrategyParams_To_v1beta3_CanaryDeploymentStrategyParams(in.CanaryParams, out.CanaryParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapiv1beta3.BlueGreenDeploymentStrategyParams)
+		if err := convert_api_BlueGreenDeploymentStrategyParams_To_v1beta3_BlueGreenDeploymentStrategyParams(in.BlueGreenParams, out.BlueGreenParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
 	if err := convert_api_ResourceRequirements_To_v1beta3_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
@@ -2995,6 +3438,14 @@ func autoconvert_api_DeploymentTriggerPolicy_To_v1beta3_DeploymentTriggerPolicy(
 	} else {
 		out.ImageChangeParams = nil
 	}
+	if in.SecretChangeParams != nil {
+		out.SecretChangeParams = new(deployapiv1beta3.DeploymentTriggerSecretChangeParams)
+		if err := convert_api_DeploymentTriggerSecretChangeParams_To_v1beta3_DeploymentTriggerSecretChangeParams(in.SecretChangeParams, out.SecretChangeParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretChangeParams = nil
+	}
 	return nil
 }
 
@@ -3002,6 +3453,25 @@ func convert_api_DeploymentTriggerPolicy_To_v1beta3_DeploymentTriggerPolicy(in *
 	return autoconvert_api_DeploymentTriggerPolicy_To_v1beta3_DeploymentTriggerPolicy(in, out, s)
 }
 
+func autoconvert_api_DeploymentTriggerSecretChangeParams_To_v1beta3_DeploymentTriggerSecretChangeParams(in *deployapi.DeploymentTriggerSecretChangeParams, out *deployapiv1beta3.DeploymentTriggerSecretChangeParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.DeploymentTriggerSecretChangeParams))(in)
+	}
+	if in.LastTriggeredSecrets != nil {
+		out.LastTriggeredSecrets = make(map[string]string)
+		for key, val := range in.LastTriggeredSecrets {
+			out.LastTriggeredSecrets[key] = val
+		}
+	} else {
+		out.LastTriggeredSecrets = nil
+	}
+	return nil
+}
+
+func convert_api_DeploymentTriggerSecretChangeParams_To_v1beta3_DeploymentTriggerSecretChangeParams(in *deployapi.DeploymentTriggerSecretChangeParams, out *deployapiv1beta3.DeploymentTriggerSecretChangeParams, s conversion.Scope) error {
+	return autoconvert_api_DeploymentTriggerSecretChangeParams_To_v1beta3_DeploymentTriggerSecretChangeParams(in, out, s)
+}
+
 func autoconvert_api_ExecNewPodHook_To_v1beta3_ExecNewPodHook(in *deployapi.ExecNewPodHook, out *deployapiv1beta3.ExecNewPodHook, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapi.ExecNewPodHook))(in)
@@ -3053,6 +3523,16 @@ func autoconvert_api_LifecycleHook_To_v1beta3_LifecycleHook(in *deployapi.Lifecy
 	} else {
 		out.ExecNewPod = nil
 	}
+	if in.TagImages != nil {
+		out.TagImages = make([]deployapiv1beta3.TagImageHook, len(in.TagImages))
+		for i := range in.TagImages {
+			if err := convert_api_TagImageHook_To_v1beta3_TagImageHook(&in.TagImages[i], &out.TagImages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TagImages = nil
+	}
 	return nil
 }
 
@@ -3072,6 +3552,14 @@ func autoconvert_api_RecreateDeploymentStrategyParams_To_v1beta3_RecreateDeploym
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapiv1beta3.LifecycleHook)
+		if err := convert_api_LifecycleHook_To_v1beta3_LifecycleHook(in.Mid, out.Mid, s); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapiv1beta3.LifecycleHook)
 		if err := convert_api_LifecycleHook_To_v1beta3_LifecycleHook(in.Post, out.Post, s); err != nil {
@@ -3109,6 +3597,13 @@ func autoconvert_api_RollingDeploymentStrategyParams_To_v1beta3_RollingDeploymen
 	} else {
 		out.TimeoutSeconds = nil
 	}
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
+	out.AutoRollback = in.AutoRollback
 	if err := s.Convert(&in.MaxUnavailable, &out.MaxUnavailable, 0); err != nil {
 		return err
 	}
@@ -3140,6 +3635,122 @@ func autoconvert_api_RollingDeploymentStrategyParams_To_v1beta3_RollingDeploymen
 	return nil
 }
 
+func autoconvert_api_TagImageHook_To_v1beta3_TagImageHook(in *deployapi.TagImageHook, out *deployapiv1beta3.TagImageHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapi.TagImageHook))(in)
+	}
+	out.ContainerName = in.ContainerName
+	if err := convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_TagImageHook_To_v1beta3_TagImageHook(in *deployapi.TagImageHook, out *deployapiv1beta3.TagImageHook, s conversion.Scope) error {
+	return autoconvert_api_TagImageHook_To_v1beta3_TagImageHook(in, out, s)
+}
+
+func autoconvert_v1beta3_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in *deployapiv1beta3.BlueGreenDeploymentStrategyParams, out *deployapi.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.BlueGreenDeploymentStrategyParams))(in)
+	}
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.KeepWarmSeconds = in.KeepWarmSeconds
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := convert_v1beta3_LifecycleHook_To_api_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := convert_v1beta3_LifecycleHook_To_api_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in *deployapiv1beta3.BlueGreenDeploymentStrategyParams, out *deployapi.BlueGreenDeploymentStrategyParams, s conversion.Scope) error {
+	return autoconvert_v1beta3_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in, out, s)
+}
+
+func autoconvert_v1beta3_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in *deployapiv1beta3.CanaryDeploymentStrategyParams, out *deployapi.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.CanaryDeploymentStrategyParams))(in)
+	}
+	if in.Steps != nil {
+		out.Steps = make([]deployapi.CanaryStep, len(in.Steps))
+		for i := range in.Steps {
+			if err := convert_v1beta3_CanaryStep_To_api_CanaryStep(&in.Steps[i], &out.Steps[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapi.LifecycleHook)
+		if err := convert_v1beta3_LifecycleHook_To_api_LifecycleHook(in.Pre, out.Pre, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapi.LifecycleHook)
+		if err := convert_v1beta3_LifecycleHook_To_api_LifecycleHook(in.Post, out.Post, s); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in *deployapiv1beta3.CanaryDeploymentStrategyParams, out *deployapi.CanaryDeploymentStrategyParams, s conversion.Scope) error {
+	return autoconvert_v1beta3_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in, out, s)
+}
+
+func autoconvert_v1beta3_CanaryStep_To_api_CanaryStep(in *deployapiv1beta3.CanaryStep, out *deployapi.CanaryStep, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.CanaryStep))(in)
+	}
+	if err := s.Convert(&in.Replicas, &out.Replicas, 0); err != nil {
+		return err
+	}
+	out.PauseSeconds = in.PauseSeconds
+	if in.Verify != nil {
+		out.Verify = new(deployapi.LifecycleHook)
+		if err := convert_v1beta3_LifecycleHook_To_api_LifecycleHook(in.Verify, out.Verify, s); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_CanaryStep_To_api_CanaryStep(in *deployapiv1beta3.CanaryStep, out *deployapi.CanaryStep, s conversion.Scope) error {
+	return autoconvert_v1beta3_CanaryStep_To_api_CanaryStep(in, out, s)
+}
+
 func autoconvert_v1beta3_CustomDeploymentStrategyParams_To_api_CustomDeploymentStrategyParams(in *deployapiv1beta3.CustomDeploymentStrategyParams, out *deployapi.CustomDeploymentStrategyParams, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.CustomDeploymentStrategyParams))(in)
@@ -3183,6 +3794,14 @@ func autoconvert_v1beta3_DeploymentCause_To_api_DeploymentCause(in *deployapiv1b
 	} else {
 		out.ImageTrigger = nil
 	}
+	if in.SecretTrigger != nil {
+		out.SecretTrigger = new(deployapi.DeploymentCauseSecretTrigger)
+		if err := convert_v1beta3_DeploymentCauseSecretTrigger_To_api_DeploymentCauseSecretTrigger(in.SecretTrigger, out.SecretTrigger, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretTrigger = nil
+	}
 	return nil
 }
 
@@ -3204,6 +3823,38 @@ func convert_v1beta3_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrig
 	return autoconvert_v1beta3_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger(in, out, s)
 }
 
+func autoconvert_v1beta3_DeploymentCauseSecretTrigger_To_api_DeploymentCauseSecretTrigger(in *deployapiv1beta3.DeploymentCauseSecretTrigger, out *deployapi.DeploymentCauseSecretTrigger, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentCauseSecretTrigger))(in)
+	}
+	if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta3_DeploymentCauseSecretTrigger_To_api_DeploymentCauseSecretTrigger(in *deployapiv1beta3.DeploymentCauseSecretTrigger, out *deployapi.DeploymentCauseSecretTrigger, s conversion.Scope) error {
+	return autoconvert_v1beta3_DeploymentCauseSecretTrigger_To_api_DeploymentCauseSecretTrigger(in, out, s)
+}
+
+func autoconvert_v1beta3_DeploymentCondition_To_api_DeploymentCondition(in *deployapiv1beta3.DeploymentCondition, out *deployapi.DeploymentCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentCondition))(in)
+	}
+	out.Type = deployapi.DeploymentConditionType(in.Type)
+	out.Status = pkgapi.ConditionStatus(in.Status)
+	if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+		return err
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func convert_v1beta3_DeploymentCondition_To_api_DeploymentCondition(in *deployapiv1beta3.DeploymentCondition, out *deployapi.DeploymentCondition, s conversion.Scope) error {
+	return autoconvert_v1beta3_DeploymentCondition_To_api_DeploymentCondition(in, out, s)
+}
+
 func autoconvert_v1beta3_DeploymentConfig_To_api_DeploymentConfig(in *deployapiv1beta3.DeploymentConfig, out *deployapi.DeploymentConfig, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.DeploymentConfig))(in)
@@ -3323,6 +3974,13 @@ func autoconvert_v1beta3_DeploymentConfigSpec_To_api_DeploymentConfigSpec(in *de
 	} else {
 		out.Template = nil
 	}
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
+	out.Paused = in.Paused
 	return nil
 }
 
@@ -3335,6 +3993,7 @@ func autoconvert_v1beta3_DeploymentConfigStatus_To_api_DeploymentConfigStatus(in
 		defaulting.(func(*deployapiv1beta3.DeploymentConfigStatus))(in)
 	}
 	out.LatestVersion = in.LatestVersion
+	out.ObservedGeneration = in.ObservedGeneration
 	if in.Details != nil {
 		out.Details = new(deployapi.DeploymentDetails)
 		if err := convert_v1beta3_DeploymentDetails_To_api_DeploymentDetails(in.Details, out.Details, s); err != nil {
@@ -3343,6 +4002,21 @@ func autoconvert_v1beta3_DeploymentConfigStatus_To_api_DeploymentConfigStatus(in
 	} else {
 		out.Details = nil
 	}
+	out.LiveColor = deployapi.DeploymentColor(in.LiveColor)
+	out.LiveDeployment = in.LiveDeployment
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapi.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_v1beta3_DeploymentCondition_To_api_DeploymentCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -3464,6 +4138,22 @@ func autoconvert_v1beta3_DeploymentStrategy_To_api_DeploymentStrategy(in *deploy
 	} else {
 		out.RollingParams = nil
 	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapi.CanaryDeploymentStrategyParams)
+		if err := convert_v1beta3_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams(in.CanaryParams, out.CanaryParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapi.BlueGreenDeploymentStrategyParams)
+		if err := convert_v1beta3_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams(in.BlueGreenParams, out.BlueGreenParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
 	if err := convert_v1beta3_ResourceRequirements_To_api_ResourceRequirements(&in.Resources, &out.Resources, s); err != nil {
 		return err
 	}
@@ -3522,6 +4212,14 @@ func autoconvert_v1beta3_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(
 	} else {
 		out.ImageChangeParams = nil
 	}
+	if in.SecretChangeParams != nil {
+		out.SecretChangeParams = new(deployapi.DeploymentTriggerSecretChangeParams)
+		if err := convert_v1beta3_DeploymentTriggerSecretChangeParams_To_api_DeploymentTriggerSecretChangeParams(in.SecretChangeParams, out.SecretChangeParams, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretChangeParams = nil
+	}
 	return nil
 }
 
@@ -3529,6 +4227,25 @@ func convert_v1beta3_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in *
 	return autoconvert_v1beta3_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy(in, out, s)
 }
 
+func autoconvert_v1beta3_DeploymentTriggerSecretChangeParams_To_api_DeploymentTriggerSecretChangeParams(in *deployapiv1beta3.DeploymentTriggerSecretChangeParams, out *deployapi.DeploymentTriggerSecretChangeParams, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.DeploymentTriggerSecretChangeParams))(in)
+	}
+	if in.LastTriggeredSecrets != nil {
+		out.LastTriggeredSecrets = make(map[string]string)
+		for key, val := range in.LastTriggeredSecrets {
+			out.LastTriggeredSecrets[key] = val
+		}
+	} else {
+		out.LastTriggeredSecrets = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_DeploymentTriggerSecretChangeParams_To_api_DeploymentTriggerSecretChangeParams(in *deployapiv1beta3.DeploymentTriggerSecretChangeParams, out *deployapi.DeploymentTriggerSecretChangeParams, s conversion.Scope) error {
+	return autoconvert_v1beta3_DeploymentTriggerSecretChangeParams_To_api_DeploymentTriggerSecretChangeParams(in, out, s)
+}
+
 func autoconvert_v1beta3_ExecNewPodHook_To_api_ExecNewPodHook(in *deployapiv1beta3.ExecNewPodHook, out *deployapi.ExecNewPodHook, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*deployapiv1beta3.ExecNewPodHook))(in)
@@ -3580,6 +4297,16 @@ func autoconvert_v1beta3_LifecycleHook_To_api_LifecycleHook(in *deployapiv1beta3
 	} else {
 		out.ExecNewPod = nil
 	}
+	if in.TagImages != nil {
+		out.TagImages = make([]deployapi.TagImageHook, len(in.TagImages))
+		for i := range in.TagImages {
+			if err := convert_v1beta3_TagImageHook_To_api_TagImageHook(&in.TagImages[i], &out.TagImages[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TagImages = nil
+	}
 	return nil
 }
 
@@ -3599,6 +4326,14 @@ func autoconvert_v1beta3_RecreateDeploymentStrategyParams_To_api_RecreateDeploym
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapi.LifecycleHook)
+		if err := convert_v1beta3_LifecycleHook_To_api_LifecycleHook(in.Mid, out.Mid, s); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapi.LifecycleHook)
 		if err := convert_v1beta3_LifecycleHook_To_api_LifecycleHook(in.Post, out.Post, s); err != nil {
@@ -3636,6 +4371,13 @@ func autoconvert_v1beta3_RollingDeploymentStrategyParams_To_api_RollingDeploymen
 	} else {
 		out.TimeoutSeconds = nil
 	}
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
+	out.AutoRollback = in.AutoRollback
 	// in.MaxUnavailable has no peer in out
 	// in.MaxSurge has no peer in out
 	if in.UpdatePercent != nil {
@@ -3663,6 +4405,21 @@ func autoconvert_v1beta3_RollingDeploymentStrategyParams_To_api_RollingDeploymen
 	return nil
 }
 
+func autoconvert_v1beta3_TagImageHook_To_api_TagImageHook(in *deployapiv1beta3.TagImageHook, out *deployapi.TagImageHook, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*deployapiv1beta3.TagImageHook))(in)
+	}
+	out.ContainerName = in.ContainerName
+	if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.To, &out.To, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta3_TagImageHook_To_api_TagImageHook(in *deployapiv1beta3.TagImageHook, out *deployapi.TagImageHook, s conversion.Scope) error {
+	return autoconvert_v1beta3_TagImageHook_To_api_TagImageHook(in, out, s)
+}
+
 func autoconvert_api_Image_To_v1beta3_Image(in *imageapi.Image, out *imageapiv1beta3.Image, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*imageapi.Image))(in)
@@ -3679,9 +4436,66 @@ func autoconvert_api_Image_To_v1beta3_Image(in *imageapi.Image, out *imageapiv1b
 	}
 	out.DockerImageMetadataVersion = in.DockerImageMetadataVersion
 	out.DockerImageManifest = in.DockerImageManifest
+	if in.DockerImageLayers != nil {
+		out.DockerImageLayers = make([]imageapiv1beta3.ImageLayer, len(in.DockerImageLayers))
+		for i := range in.DockerImageLayers {
+			if err := s.Convert(&in.DockerImageLayers[i], &out.DockerImageLayers[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DockerImageLayers = nil
+	}
 	return nil
 }
 
+func autoconvert_api_ImageImportSpec_To_v1beta3_ImageImportSpec(in *imageapi.ImageImportSpec, out *imageapiv1beta3.ImageImportSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.ImageImportSpec))(in)
+	}
+	if err := convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if in.To != nil {
+		out.To = new(pkgapiv1beta3.LocalObjectReference)
+		if err := convert_api_LocalObjectReference_To_v1beta3_LocalObjectReference(in.To, out.To, s); err != nil {
+			return err
+		}
+	} else {
+		out.To = nil
+	}
+	if err := convert_api_TagImportPolicy_To_v1beta3_TagImportPolicy(&in.ImportPolicy, &out.ImportPolicy, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_ImageImportSpec_To_v1beta3_ImageImportSpec(in *imageapi.ImageImportSpec, out *imageapiv1beta3.ImageImportSpec, s conversion.Scope) error {
+	return autoconvert_api_ImageImportSpec_To_v1beta3_ImageImportSpec(in, out, s)
+}
+
+func autoconvert_api_ImageImportStatus_To_v1beta3_ImageImportStatus(in *imageapi.ImageImportStatus, out *imageapiv1beta3.ImageImportStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.ImageImportStatus))(in)
+	}
+	if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+		return err
+	}
+	if in.Image != nil {
+		if err := s.Convert(&in.Image, &out.Image, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Image = nil
+	}
+	out.Tag = in.Tag
+	return nil
+}
+
+func convert_api_ImageImportStatus_To_v1beta3_ImageImportStatus(in *imageapi.ImageImportStatus, out *imageapiv1beta3.ImageImportStatus, s conversion.Scope) error {
+	return autoconvert_api_ImageImportStatus_To_v1beta3_ImageImportStatus(in, out, s)
+}
+
 func autoconvert_api_ImageList_To_v1beta3_ImageList(in *imageapi.ImageList, out *imageapiv1beta3.ImageList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*imageapi.ImageList))(in)
@@ -3722,28 +4536,117 @@ func autoconvert_api_ImageStream_To_v1beta3_ImageStream(in *imageapi.ImageStream
 	if err := s.Convert(&in.Spec, &out.Spec, 0); err != nil {
 		return err
 	}
-	if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
-		return err
+	if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoconvert_api_ImageStreamImage_To_v1beta3_ImageStreamImage(in *imageapi.ImageStreamImage, out *imageapiv1beta3.ImageStreamImage, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.ImageStreamImage))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1beta3_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := s.Convert(&in.Image, &out.Image, 0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoconvert_api_ImageStreamImport_To_v1beta3_ImageStreamImport(in *imageapi.ImageStreamImport, out *imageapiv1beta3.ImageStreamImport, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.ImageStreamImport))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_api_ObjectMeta_To_v1beta3_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_api_ImageStreamImportSpec_To_v1beta3_ImageStreamImportSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_api_ImageStreamImportStatus_To_v1beta3_ImageStreamImportStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_ImageStreamImport_To_v1beta3_ImageStreamImport(in *imageapi.ImageStreamImport, out *imageapiv1beta3.ImageStreamImport, s conversion.Scope) error {
+	return autoconvert_api_ImageStreamImport_To_v1beta3_ImageStreamImport(in, out, s)
+}
+
+func autoconvert_api_ImageStreamImportSpec_To_v1beta3_ImageStreamImportSpec(in *imageapi.ImageStreamImportSpec, out *imageapiv1beta3.ImageStreamImportSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.ImageStreamImportSpec))(in)
+	}
+	out.Import = in.Import
+	if in.Repository != nil {
+		out.Repository = new(imageapiv1beta3.RepositoryImportSpec)
+		if err := convert_api_RepositoryImportSpec_To_v1beta3_RepositoryImportSpec(in.Repository, out.Repository, s); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapiv1beta3.ImageImportSpec, len(in.Images))
+		for i := range in.Images {
+			if err := convert_api_ImageImportSpec_To_v1beta3_ImageImportSpec(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
 	}
 	return nil
 }
 
-func autoconvert_api_ImageStreamImage_To_v1beta3_ImageStreamImage(in *imageapi.ImageStreamImage, out *imageapiv1beta3.ImageStreamImage, s conversion.Scope) error {
+func convert_api_ImageStreamImportSpec_To_v1beta3_ImageStreamImportSpec(in *imageapi.ImageStreamImportSpec, out *imageapiv1beta3.ImageStreamImportSpec, s conversion.Scope) error {
+	return autoconvert_api_ImageStreamImportSpec_To_v1beta3_ImageStreamImportSpec(in, out, s)
+}
+
+func autoconvert_api_ImageStreamImportStatus_To_v1beta3_ImageStreamImportStatus(in *imageapi.ImageStreamImportStatus, out *imageapiv1beta3.ImageStreamImportStatus, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
-		defaulting.(func(*imageapi.ImageStreamImage))(in)
+		defaulting.(func(*imageapi.ImageStreamImportStatus))(in)
 	}
-	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
-		return err
+	if in.Import != nil {
+		if err := s.Convert(&in.Import, &out.Import, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Import = nil
 	}
-	if err := convert_api_ObjectMeta_To_v1beta3_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
-		return err
+	if in.Repository != nil {
+		out.Repository = new(imageapiv1beta3.RepositoryImportStatus)
+		if err := convert_api_RepositoryImportStatus_To_v1beta3_RepositoryImportStatus(in.Repository, out.Repository, s); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
 	}
-	if err := s.Convert(&in.Image, &out.Image, 0); err != nil {
-		return err
+	if in.Images != nil {
+		out.Images = make([]imageapiv1beta3.ImageImportStatus, len(in.Images))
+		for i := range in.Images {
+			if err := convert_api_ImageImportStatus_To_v1beta3_ImageImportStatus(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
 	}
 	return nil
 }
 
+func convert_api_ImageStreamImportStatus_To_v1beta3_ImageStreamImportStatus(in *imageapi.ImageStreamImportStatus, out *imageapiv1beta3.ImageStreamImportStatus, s conversion.Scope) error {
+	return autoconvert_api_ImageStreamImportStatus_To_v1beta3_ImageStreamImportStatus(in, out, s)
+}
+
 func autoconvert_api_ImageStreamList_To_v1beta3_ImageStreamList(in *imageapi.ImageStreamList, out *imageapiv1beta3.ImageStreamList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*imageapi.ImageStreamList))(in)
@@ -3854,6 +4757,68 @@ func convert_api_ImageStreamTagList_To_v1beta3_ImageStreamTagList(in *imageapi.I
 	return autoconvert_api_ImageStreamTagList_To_v1beta3_ImageStreamTagList(in, out, s)
 }
 
+func autoconvert_api_RepositoryImportSpec_To_v1beta3_RepositoryImportSpec(in *imageapi.RepositoryImportSpec, out *imageapiv1beta3.RepositoryImportSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.RepositoryImportSpec))(in)
+	}
+	if err := convert_api_ObjectReference_To_v1beta3_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if err := convert_api_TagImportPolicy_To_v1beta3_TagImportPolicy(&in.ImportPolicy, &out.ImportPolicy, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_RepositoryImportSpec_To_v1beta3_RepositoryImportSpec(in *imageapi.RepositoryImportSpec, out *imageapiv1beta3.RepositoryImportSpec, s conversion.Scope) error {
+	return autoconvert_api_RepositoryImportSpec_To_v1beta3_RepositoryImportSpec(in, out, s)
+}
+
+func autoconvert_api_RepositoryImportStatus_To_v1beta3_RepositoryImportStatus(in *imageapi.RepositoryImportStatus, out *imageapiv1beta3.RepositoryImportStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.RepositoryImportStatus))(in)
+	}
+	if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+		return err
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapiv1beta3.ImageImportStatus, len(in.Images))
+		for i := range in.Images {
+			if err := convert_api_ImageImportStatus_To_v1beta3_ImageImportStatus(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	if in.AdditionalTags != nil {
+		out.AdditionalTags = make([]string, len(in.AdditionalTags))
+		for i := range in.AdditionalTags {
+			out.AdditionalTags[i] = in.AdditionalTags[i]
+		}
+	} else {
+		out.AdditionalTags = nil
+	}
+	return nil
+}
+
+func convert_api_RepositoryImportStatus_To_v1beta3_RepositoryImportStatus(in *imageapi.RepositoryImportStatus, out *imageapiv1beta3.RepositoryImportStatus, s conversion.Scope) error {
+	return autoconvert_api_RepositoryImportStatus_To_v1beta3_RepositoryImportStatus(in, out, s)
+}
+
+func autoconvert_api_TagImportPolicy_To_v1beta3_TagImportPolicy(in *imageapi.TagImportPolicy, out *imageapiv1beta3.TagImportPolicy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapi.TagImportPolicy))(in)
+	}
+	out.Insecure = in.Insecure
+	out.Scheduled = in.Scheduled
+	return nil
+}
+
+func convert_api_TagImportPolicy_To_v1beta3_TagImportPolicy(in *imageapi.TagImportPolicy, out *imageapiv1beta3.TagImportPolicy, s conversion.Scope) error {
+	return autoconvert_api_TagImportPolicy_To_v1beta3_TagImportPolicy(in, out, s)
+}
+
 func autoconvert_v1beta3_Image_To_api_Image(in *imageapiv1beta3.Image, out *imageapi.Image, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*imageapiv1beta3.Image))(in)
@@ -3870,9 +4835,66 @@ func autoconvert_v1beta3_Image_To_api_Image(in *imageapiv1beta3.Image, out *imag
 	}
 	out.DockerImageMetadataVersion = in.DockerImageMetadataVersion
 	out.DockerImageManifest = in.DockerImageManifest
+	if in.DockerImageLayers != nil {
+		out.DockerImageLayers = make([]imageapi.ImageLayer, len(in.DockerImageLayers))
+		for i := range in.DockerImageLayers {
+			if err := s.Convert(&in.DockerImageLayers[i], &out.DockerImageLayers[i], 0); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DockerImageLayers = nil
+	}
+	return nil
+}
+
+func autoconvert_v1beta3_ImageImportSpec_To_api_ImageImportSpec(in *imageapiv1beta3.ImageImportSpec, out *imageapi.ImageImportSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1beta3.ImageImportSpec))(in)
+	}
+	if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if in.To != nil {
+		out.To = new(pkgapi.LocalObjectReference)
+		if err := convert_v1beta3_LocalObjectReference_To_api_LocalObjectReference(in.To, out.To, s); err != nil {
+			return err
+		}
+	} else {
+		out.To = nil
+	}
+	if err := convert_v1beta3_TagImportPolicy_To_api_TagImportPolicy(&in.ImportPolicy, &out.ImportPolicy, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta3_ImageImportSpec_To_api_ImageImportSpec(in *imageapiv1beta3.ImageImportSpec, out *imageapi.ImageImportSpec, s conversion.Scope) error {
+	return autoconvert_v1beta3_ImageImportSpec_To_api_ImageImportSpec(in, out, s)
+}
+
+func autoconvert_v1beta3_ImageImportStatus_To_api_ImageImportStatus(in *imageapiv1beta3.ImageImportStatus, out *imageapi.ImageImportStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1beta3.ImageImportStatus))(in)
+	}
+	if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+		return err
+	}
+	if in.Image != nil {
+		if err := s.Convert(&in.Image, &out.Image, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Image = nil
+	}
+	out.Tag = in.Tag
 	return nil
 }
 
+func convert_v1beta3_ImageImportStatus_To_api_ImageImportStatus(in *imageapiv1beta3.ImageImportStatus, out *imageapi.ImageImportStatus, s conversion.Scope) error {
+	return autoconvert_v1beta3_ImageImportStatus_To_api_ImageImportStatus(in, out, s)
+}
+
 func autoconvert_v1beta3_ImageList_To_api_ImageList(in *imageapiv1beta3.ImageList, out *imageapi.ImageList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*imageapiv1beta3.ImageList))(in)
@@ -3930,6 +4952,95 @@ func autoconvert_v1beta3_ImageStreamImage_To_api_ImageStreamImage(in *imageapiv1
 	return nil
 }
 
+func autoconvert_v1beta3_ImageStreamImport_To_api_ImageStreamImport(in *imageapiv1beta3.ImageStreamImport, out *imageapi.ImageStreamImport, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1beta3.ImageStreamImport))(in)
+	}
+	if err := s.Convert(&in.TypeMeta, &out.TypeMeta, 0); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_ObjectMeta_To_api_ObjectMeta(&in.ObjectMeta, &out.ObjectMeta, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_ImageStreamImportSpec_To_api_ImageStreamImportSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_ImageStreamImportStatus_To_api_ImageStreamImportStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta3_ImageStreamImport_To_api_ImageStreamImport(in *imageapiv1beta3.ImageStreamImport, out *imageapi.ImageStreamImport, s conversion.Scope) error {
+	return autoconvert_v1beta3_ImageStreamImport_To_api_ImageStreamImport(in, out, s)
+}
+
+func autoconvert_v1beta3_ImageStreamImportSpec_To_api_ImageStreamImportSpec(in *imageapiv1beta3.ImageStreamImportSpec, out *imageapi.ImageStreamImportSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1beta3.ImageStreamImportSpec))(in)
+	}
+	out.Import = in.Import
+	if in.Repository != nil {
+		out.Repository = new(imageapi.RepositoryImportSpec)
+		if err := convert_v1beta3_RepositoryImportSpec_To_api_RepositoryImportSpec(in.Repository, out.Repository, s); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapi.ImageImportSpec, len(in.Images))
+		for i := range in.Images {
+			if err := convert_v1beta3_ImageImportSpec_To_api_ImageImportSpec(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_ImageStreamImportSpec_To_api_ImageStreamImportSpec(in *imageapiv1beta3.ImageStreamImportSpec, out *imageapi.ImageStreamImportSpec, s conversion.Scope) error {
+	return autoconvert_v1beta3_ImageStreamImportSpec_To_api_ImageStreamImportSpec(in, out, s)
+}
+
+func autoconvert_v1beta3_ImageStreamImportStatus_To_api_ImageStreamImportStatus(in *imageapiv1beta3.ImageStreamImportStatus, out *imageapi.ImageStreamImportStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1beta3.ImageStreamImportStatus))(in)
+	}
+	if in.Import != nil {
+		if err := s.Convert(&in.Import, &out.Import, 0); err != nil {
+			return err
+		}
+	} else {
+		out.Import = nil
+	}
+	if in.Repository != nil {
+		out.Repository = new(imageapi.RepositoryImportStatus)
+		if err := convert_v1beta3_RepositoryImportStatus_To_api_RepositoryImportStatus(in.Repository, out.Repository, s); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapi.ImageImportStatus, len(in.Images))
+		for i := range in.Images {
+			if err := convert_v1beta3_ImageImportStatus_To_api_ImageImportStatus(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_ImageStreamImportStatus_To_api_ImageStreamImportStatus(in *imageapiv1beta3.ImageStreamImportStatus, out *imageapi.ImageStreamImportStatus, s conversion.Scope) error {
+	return autoconvert_v1beta3_ImageStreamImportStatus_To_api_ImageStreamImportStatus(in, out, s)
+}
+
 func autoconvert_v1beta3_ImageStreamList_To_api_ImageStreamList(in *imageapiv1beta3.ImageStreamList, out *imageapi.ImageStreamList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*imageapiv1beta3.ImageStreamList))(in)
@@ -4034,6 +5145,68 @@ func convert_v1beta3_ImageStreamTagList_To_api_ImageStreamTagList(in *imageapiv1
 	return autoconvert_v1beta3_ImageStreamTagList_To_api_ImageStreamTagList(in, out, s)
 }
 
+func autoconvert_v1beta3_RepositoryImportSpec_To_api_RepositoryImportSpec(in *imageapiv1beta3.RepositoryImportSpec, out *imageapi.RepositoryImportSpec, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1beta3.RepositoryImportSpec))(in)
+	}
+	if err := convert_v1beta3_ObjectReference_To_api_ObjectReference(&in.From, &out.From, s); err != nil {
+		return err
+	}
+	if err := convert_v1beta3_TagImportPolicy_To_api_TagImportPolicy(&in.ImportPolicy, &out.ImportPolicy, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta3_RepositoryImportSpec_To_api_RepositoryImportSpec(in *imageapiv1beta3.RepositoryImportSpec, out *imageapi.RepositoryImportSpec, s conversion.Scope) error {
+	return autoconvert_v1beta3_RepositoryImportSpec_To_api_RepositoryImportSpec(in, out, s)
+}
+
+func autoconvert_v1beta3_RepositoryImportStatus_To_api_RepositoryImportStatus(in *imageapiv1beta3.RepositoryImportStatus, out *imageapi.RepositoryImportStatus, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1beta3.RepositoryImportStatus))(in)
+	}
+	if err := s.Convert(&in.Status, &out.Status, 0); err != nil {
+		return err
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapi.ImageImportStatus, len(in.Images))
+		for i := range in.Images {
+			if err := convert_v1beta3_ImageImportStatus_To_api_ImageImportStatus(&in.Images[i], &out.Images[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	if in.AdditionalTags != nil {
+		out.AdditionalTags = make([]string, len(in.AdditionalTags))
+		for i := range in.AdditionalTags {
+			out.AdditionalTags[i] = in.AdditionalTags[i]
+		}
+	} else {
+		out.AdditionalTags = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_RepositoryImportStatus_To_api_RepositoryImportStatus(in *imageapiv1beta3.RepositoryImportStatus, out *imageapi.RepositoryImportStatus, s conversion.Scope) error {
+	return autoconvert_v1beta3_RepositoryImportStatus_To_api_RepositoryImportStatus(in, out, s)
+}
+
+func autoconvert_v1beta3_TagImportPolicy_To_api_TagImportPolicy(in *imageapiv1beta3.TagImportPolicy, out *imageapi.TagImportPolicy, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*imageapiv1beta3.TagImportPolicy))(in)
+	}
+	out.Insecure = in.Insecure
+	out.Scheduled = in.Scheduled
+	return nil
+}
+
+func convert_v1beta3_TagImportPolicy_To_api_TagImportPolicy(in *imageapiv1beta3.TagImportPolicy, out *imageapi.TagImportPolicy, s conversion.Scope) error {
+	return autoconvert_v1beta3_TagImportPolicy_To_api_TagImportPolicy(in, out, s)
+}
+
 func autoconvert_api_OAuthAccessToken_To_v1beta3_OAuthAccessToken(in *oauthapi.OAuthAccessToken, out *oauthapiv1beta3.OAuthAccessToken, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*oauthapi.OAuthAccessToken))(in)
@@ -4709,6 +5882,47 @@ func convert_api_Route_To_v1beta3_Route(in *routeapi.Route, out *routeapiv1beta3
 	return autoconvert_api_Route_To_v1beta3_Route(in, out, s)
 }
 
+func autoconvert_api_RouteIngress_To_v1beta3_RouteIngress(in *routeapi.RouteIngress, out *routeapiv1beta3.RouteIngress, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteIngress))(in)
+	}
+	out.Host = in.Host
+	out.RouterName = in.RouterName
+	if in.Conditions != nil {
+		out.Conditions = make([]routeapiv1beta3.RouteIngressCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_api_RouteIngressCondition_To_v1beta3_RouteIngressCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+func convert_api_RouteIngress_To_v1beta3_RouteIngress(in *routeapi.RouteIngress, out *routeapiv1beta3.RouteIngress, s conversion.Scope) error {
+	return autoconvert_api_RouteIngress_To_v1beta3_RouteIngress(in, out, s)
+}
+
+func autoconvert_api_RouteIngressCondition_To_v1beta3_RouteIngressCondition(in *routeapi.RouteIngressCondition, out *routeapiv1beta3.RouteIngressCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapi.RouteIngressCondition))(in)
+	}
+	out.Type = routeapiv1beta3.RouteIngressConditionType(in.Type)
+	out.Status = pkgapiv1beta3.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_api_RouteIngressCondition_To_v1beta3_RouteIngressCondition(in *routeapi.RouteIngressCondition, out *routeapiv1beta3.RouteIngressCondition, s conversion.Scope) error {
+	return autoconvert_api_RouteIngressCondition_To_v1beta3_RouteIngressCondition(in, out, s)
+}
+
 func autoconvert_api_RouteList_To_v1beta3_RouteList(in *routeapi.RouteList, out *routeapiv1beta3.RouteList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapi.RouteList))(in)
@@ -4786,6 +6000,16 @@ func autoconvert_api_RouteStatus_To_v1beta3_RouteStatus(in *routeapi.RouteStatus
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapi.RouteStatus))(in)
 	}
+	if in.Ingress != nil {
+		out.Ingress = make([]routeapiv1beta3.RouteIngress, len(in.Ingress))
+		for i := range in.Ingress {
+			if err := convert_api_RouteIngress_To_v1beta3_RouteIngress(&in.Ingress[i], &out.Ingress[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ingress = nil
+	}
 	return nil
 }
 
@@ -4833,6 +6057,47 @@ func convert_v1beta3_Route_To_api_Route(in *routeapiv1beta3.Route, out *routeapi
 	return autoconvert_v1beta3_Route_To_api_Route(in, out, s)
 }
 
+func autoconvert_v1beta3_RouteIngress_To_api_RouteIngress(in *routeapiv1beta3.RouteIngress, out *routeapi.RouteIngress, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1beta3.RouteIngress))(in)
+	}
+	out.Host = in.Host
+	out.RouterName = in.RouterName
+	if in.Conditions != nil {
+		out.Conditions = make([]routeapi.RouteIngressCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := convert_v1beta3_RouteIngressCondition_To_api_RouteIngressCondition(&in.Conditions[i], &out.Conditions[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+func convert_v1beta3_RouteIngress_To_api_RouteIngress(in *routeapiv1beta3.RouteIngress, out *routeapi.RouteIngress, s conversion.Scope) error {
+	return autoconvert_v1beta3_RouteIngress_To_api_RouteIngress(in, out, s)
+}
+
+func autoconvert_v1beta3_RouteIngressCondition_To_api_RouteIngressCondition(in *routeapiv1beta3.RouteIngressCondition, out *routeapi.RouteIngressCondition, s conversion.Scope) error {
+	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
+		defaulting.(func(*routeapiv1beta3.RouteIngressCondition))(in)
+	}
+	out.Type = routeapi.RouteIngressConditionType(in.Type)
+	out.Status = pkgapi.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	if err := s.Convert(&in.LastTransitionTime, &out.LastTransitionTime, 0); err != nil {
+		return err
+	}
+	return nil
+}
+
+func convert_v1beta3_RouteIngressCondition_To_api_RouteIngressCondition(in *routeapiv1beta3.RouteIngressCondition, out *routeapi.RouteIngressCondition, s conversion.Scope) error {
+	return autoconvert_v1beta3_RouteIngressCondition_To_api_RouteIngressCondition(in, out, s)
+}
+
 func autoconvert_v1beta3_RouteList_To_api_RouteList(in *routeapiv1beta3.RouteList, out *routeapi.RouteList, s conversion.Scope) error {
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapiv1beta3.RouteList))(in)
@@ -4910,6 +6175,16 @@ func autoconvert_v1beta3_RouteStatus_To_api_RouteStatus(in *routeapiv1beta3.Rout
 	if defaulting, found := s.DefaultingInterface(reflect.TypeOf(*in)); found {
 		defaulting.(func(*routeapiv1beta3.RouteStatus))(in)
 	}
+	if in.Ingress != nil {
+		out.Ingress = make([]routeapi.RouteIngress, len(in.Ingress))
+		for i := range in.Ingress {
+			if err := convert_v1beta3_RouteIngress_To_api_RouteIngress(&in.Ingress[i], &out.Ingress[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ingress = nil
+	}
 	return nil
 }
 
@@ -7826,6 +9101,7 @@ func init() {
 		autoconvert_api_AWSElasticBlockStoreVolumeSource_To_v1beta3_AWSElasticBlockStoreVolumeSource,
 		autoconvert_api_BinaryBuildRequestOptions_To_v1beta3_BinaryBuildRequestOptions,
 		autoconvert_api_BinaryBuildSource_To_v1beta3_BinaryBuildSource,
+		autoconvert_api_BlueGreenDeploymentStrategyParams_To_v1beta3_BlueGreenDeploymentStrategyParams,
 		autoconvert_api_BuildConfigList_To_v1beta3_BuildConfigList,
 		autoconvert_api_BuildConfigSpec_To_v1beta3_BuildConfigSpec,
 		autoconvert_api_BuildConfigStatus_To_v1beta3_BuildConfigStatus,
@@ -7841,6 +9117,8 @@ func init() {
 		autoconvert_api_BuildStrategy_To_v1beta3_BuildStrategy,
 		autoconvert_api_BuildTriggerPolicy_To_v1beta3_BuildTriggerPolicy,
 		autoconvert_api_Build_To_v1beta3_Build,
+		autoconvert_api_CanaryDeploymentStrategyParams_To_v1beta3_CanaryDeploymentStrategyParams,
+		autoconvert_api_CanaryStep_To_v1beta3_CanaryStep,
 		autoconvert_api_Capabilities_To_v1beta3_Capabilities,
 		autoconvert_api_CephFSVolumeSource_To_v1beta3_CephFSVolumeSource,
 		autoconvert_api_CinderVolumeSource_To_v1beta3_CinderVolumeSource,
@@ -7854,12 +9132,15 @@ func init() {
 		autoconvert_api_ClusterRoleBinding_To_v1beta3_ClusterRoleBinding,
 		autoconvert_api_ClusterRoleList_To_v1beta3_ClusterRoleList,
 		autoconvert_api_ClusterRole_To_v1beta3_ClusterRole,
+		autoconvert_api_CommitStatusNotification_To_v1beta3_CommitStatusNotification,
 		autoconvert_api_ContainerPort_To_v1beta3_ContainerPort,
 		autoconvert_api_Container_To_v1beta3_Container,
 		autoconvert_api_CustomBuildStrategy_To_v1beta3_CustomBuildStrategy,
 		autoconvert_api_CustomDeploymentStrategyParams_To_v1beta3_CustomDeploymentStrategyParams,
 		autoconvert_api_DeploymentCauseImageTrigger_To_v1beta3_DeploymentCauseImageTrigger,
+		autoconvert_api_DeploymentCauseSecretTrigger_To_v1beta3_DeploymentCauseSecretTrigger,
 		autoconvert_api_DeploymentCause_To_v1beta3_DeploymentCause,
+		autoconvert_api_DeploymentCondition_To_v1beta3_DeploymentCondition,
 		autoconvert_api_DeploymentConfigList_To_v1beta3_DeploymentConfigList,
 		autoconvert_api_DeploymentConfigRollbackSpec_To_v1beta3_DeploymentConfigRollbackSpec,
 		autoconvert_api_DeploymentConfigRollback_To_v1beta3_DeploymentConfigRollback,
@@ -7872,6 +9153,8 @@ func init() {
 		autoconvert_api_DeploymentStrategy_To_v1beta3_DeploymentStrategy,
 		autoconvert_api_DeploymentTriggerImageChangeParams_To_v1beta3_DeploymentTriggerImageChangeParams,
 		autoconvert_api_DeploymentTriggerPolicy_To_v1beta3_DeploymentTriggerPolicy,
+		autoconvert_api_DeploymentTriggerSecretChangeParams_To_v1beta3_DeploymentTriggerSecretChangeParams,
+		autoconvert_api_DockerBuildCache_To_v1beta3_DockerBuildCache,
 		autoconvert_api_DockerBuildStrategy_To_v1beta3_DockerBuildStrategy,
 		autoconvert_api_DownwardAPIVolumeFile_To_v1beta3_DownwardAPIVolumeFile,
 		autoconvert_api_DownwardAPIVolumeSource_To_v1beta3_DownwardAPIVolumeSource,
@@ -7898,10 +9181,15 @@ func init() {
 		autoconvert_api_IdentityList_To_v1beta3_IdentityList,
 		autoconvert_api_Identity_To_v1beta3_Identity,
 		autoconvert_api_ImageChangeTrigger_To_v1beta3_ImageChangeTrigger,
+		autoconvert_api_ImageImportSpec_To_v1beta3_ImageImportSpec,
+		autoconvert_api_ImageImportStatus_To_v1beta3_ImageImportStatus,
 		autoconvert_api_ImageList_To_v1beta3_ImageList,
 		autoconvert_api_ImageSourcePath_To_v1beta3_ImageSourcePath,
 		autoconvert_api_ImageSource_To_v1beta3_ImageSource,
 		autoconvert_api_ImageStreamImage_To_v1beta3_ImageStreamImage,
+		autoconvert_api_ImageStreamImportSpec_To_v1beta3_ImageStreamImportSpec,
+		autoconvert_api_ImageStreamImportStatus_To_v1beta3_ImageStreamImportStatus,
+		autoconvert_api_ImageStreamImport_To_v1beta3_ImageStreamImport,
 		autoconvert_api_ImageStreamList_To_v1beta3_ImageStreamList,
 		autoconvert_api_ImageStreamMapping_To_v1beta3_ImageStreamMapping,
 		autoconvert_api_ImageStreamSpec_To_v1beta3_ImageStreamSpec,
@@ -7911,6 +9199,7 @@ func init() {
 		autoconvert_api_ImageStream_To_v1beta3_ImageStream,
 		autoconvert_api_Image_To_v1beta3_Image,
 		autoconvert_api_IsPersonalSubjectAccessReview_To_v1beta3_IsPersonalSubjectAccessReview,
+		autoconvert_api_JenkinsPipelineBuildStrategy_To_v1beta3_JenkinsPipelineBuildStrategy,
 		autoconvert_api_LifecycleHook_To_v1beta3_LifecycleHook,
 		autoconvert_api_Lifecycle_To_v1beta3_Lifecycle,
 		autoconvert_api_LocalObjectReference_To_v1beta3_LocalObjectReference,
@@ -7947,6 +9236,8 @@ func init() {
 		autoconvert_api_Project_To_v1beta3_Project,
 		autoconvert_api_RBDVolumeSource_To_v1beta3_RBDVolumeSource,
 		autoconvert_api_RecreateDeploymentStrategyParams_To_v1beta3_RecreateDeploymentStrategyParams,
+		autoconvert_api_RepositoryImportSpec_To_v1beta3_RepositoryImportSpec,
+		autoconvert_api_RepositoryImportStatus_To_v1beta3_RepositoryImportStatus,
 		autoconvert_api_ResourceAccessReviewResponse_To_v1beta3_ResourceAccessReviewResponse,
 		autoconvert_api_ResourceAccessReview_To_v1beta3_ResourceAccessReview,
 		autoconvert_api_ResourceRequirements_To_v1beta3_ResourceRequirements,
@@ -7955,12 +9246,15 @@ func init() {
 		autoconvert_api_RoleList_To_v1beta3_RoleList,
 		autoconvert_api_Role_To_v1beta3_Role,
 		autoconvert_api_RollingDeploymentStrategyParams_To_v1beta3_RollingDeploymentStrategyParams,
+		autoconvert_api_RouteIngressCondition_To_v1beta3_RouteIngressCondition,
+		autoconvert_api_RouteIngress_To_v1beta3_RouteIngress,
 		autoconvert_api_RouteList_To_v1beta3_RouteList,
 		autoconvert_api_RoutePort_To_v1beta3_RoutePort,
 		autoconvert_api_RouteSpec_To_v1beta3_RouteSpec,
 		autoconvert_api_RouteStatus_To_v1beta3_RouteStatus,
 		autoconvert_api_Route_To_v1beta3_Route,
 		autoconvert_api_SELinuxOptions_To_v1beta3_SELinuxOptions,
+		autoconvert_api_SecretBuildSource_To_v1beta3_SecretBuildSource,
 		autoconvert_api_SecretSpec_To_v1beta3_SecretSpec,
 		autoconvert_api_SecretVolumeSource_To_v1beta3_SecretVolumeSource,
 		autoconvert_api_SecurityContext_To_v1beta3_SecurityContext,
@@ -7971,6 +9265,8 @@ func init() {
 		autoconvert_api_SubjectAccessReview_To_v1beta3_SubjectAccessReview,
 		autoconvert_api_TCPSocketAction_To_v1beta3_TCPSocketAction,
 		autoconvert_api_TLSConfig_To_v1beta3_TLSConfig,
+		autoconvert_api_TagImageHook_To_v1beta3_TagImageHook,
+		autoconvert_api_TagImportPolicy_To_v1beta3_TagImportPolicy,
 		autoconvert_api_TemplateList_To_v1beta3_TemplateList,
 		autoconvert_api_Template_To_v1beta3_Template,
 		autoconvert_api_UserIdentityMapping_To_v1beta3_UserIdentityMapping,
@@ -7983,6 +9279,7 @@ func init() {
 		autoconvert_v1beta3_AWSElasticBlockStoreVolumeSource_To_api_AWSElasticBlockStoreVolumeSource,
 		autoconvert_v1beta3_BinaryBuildRequestOptions_To_api_BinaryBuildRequestOptions,
 		autoconvert_v1beta3_BinaryBuildSource_To_api_BinaryBuildSource,
+		autoconvert_v1beta3_BlueGreenDeploymentStrategyParams_To_api_BlueGreenDeploymentStrategyParams,
 		autoconvert_v1beta3_BuildConfigList_To_api_BuildConfigList,
 		autoconvert_v1beta3_BuildConfigSpec_To_api_BuildConfigSpec,
 		autoconvert_v1beta3_BuildConfigStatus_To_api_BuildConfigStatus,
@@ -7998,6 +9295,8 @@ func init() {
 		autoconvert_v1beta3_BuildStrategy_To_api_BuildStrategy,
 		autoconvert_v1beta3_BuildTriggerPolicy_To_api_BuildTriggerPolicy,
 		autoconvert_v1beta3_Build_To_api_Build,
+		autoconvert_v1beta3_CanaryDeploymentStrategyParams_To_api_CanaryDeploymentStrategyParams,
+		autoconvert_v1beta3_CanaryStep_To_api_CanaryStep,
 		autoconvert_v1beta3_Capabilities_To_api_Capabilities,
 		autoconvert_v1beta3_CephFSVolumeSource_To_api_CephFSVolumeSource,
 		autoconvert_v1beta3_CinderVolumeSource_To_api_CinderVolumeSource,
@@ -8011,12 +9310,15 @@ func init() {
 		autoconvert_v1beta3_ClusterRoleBinding_To_api_ClusterRoleBinding,
 		autoconvert_v1beta3_ClusterRoleList_To_api_ClusterRoleList,
 		autoconvert_v1beta3_ClusterRole_To_api_ClusterRole,
+		autoconvert_v1beta3_CommitStatusNotification_To_api_CommitStatusNotification,
 		autoconvert_v1beta3_ContainerPort_To_api_ContainerPort,
 		autoconvert_v1beta3_Container_To_api_Container,
 		autoconvert_v1beta3_CustomBuildStrategy_To_api_CustomBuildStrategy,
 		autoconvert_v1beta3_CustomDeploymentStrategyParams_To_api_CustomDeploymentStrategyParams,
 		autoconvert_v1beta3_DeploymentCauseImageTrigger_To_api_DeploymentCauseImageTrigger,
+		autoconvert_v1beta3_DeploymentCauseSecretTrigger_To_api_DeploymentCauseSecretTrigger,
 		autoconvert_v1beta3_DeploymentCause_To_api_DeploymentCause,
+		autoconvert_v1beta3_DeploymentCondition_To_api_DeploymentCondition,
 		autoconvert_v1beta3_DeploymentConfigList_To_api_DeploymentConfigList,
 		autoconvert_v1beta3_DeploymentConfigRollbackSpec_To_api_DeploymentConfigRollbackSpec,
 		autoconvert_v1beta3_DeploymentConfigRollback_To_api_DeploymentConfigRollback,
@@ -8029,6 +9331,8 @@ func init() {
 		autoconvert_v1beta3_DeploymentStrategy_To_api_DeploymentStrategy,
 		autoconvert_v1beta3_DeploymentTriggerImageChangeParams_To_api_DeploymentTriggerImageChangeParams,
 		autoconvert_v1beta3_DeploymentTriggerPolicy_To_api_DeploymentTriggerPolicy,
+		autoconvert_v1beta3_DeploymentTriggerSecretChangeParams_To_api_DeploymentTriggerSecretChangeParams,
+		autoconvert_v1beta3_DockerBuildCache_To_api_DockerBuildCache,
 		autoconvert_v1beta3_DockerBuildStrategy_To_api_DockerBuildStrategy,
 		autoconvert_v1beta3_DownwardAPIVolumeFile_To_api_DownwardAPIVolumeFile,
 		autoconvert_v1beta3_DownwardAPIVolumeSource_To_api_DownwardAPIVolumeSource,
@@ -8055,10 +9359,15 @@ func init() {
 		autoconvert_v1beta3_IdentityList_To_api_IdentityList,
 		autoconvert_v1beta3_Identity_To_api_Identity,
 		autoconvert_v1beta3_ImageChangeTrigger_To_api_ImageChangeTrigger,
+		autoconvert_v1beta3_ImageImportSpec_To_api_ImageImportSpec,
+		autoconvert_v1beta3_ImageImportStatus_To_api_ImageImportStatus,
 		autoconvert_v1beta3_ImageList_To_api_ImageList,
 		autoconvert_v1beta3_ImageSourcePath_To_api_ImageSourcePath,
 		autoconvert_v1beta3_ImageSource_To_api_ImageSource,
 		autoconvert_v1beta3_ImageStreamImage_To_api_ImageStreamImage,
+		autoconvert_v1beta3_ImageStreamImportSpec_To_api_ImageStreamImportSpec,
+		autoconvert_v1beta3_ImageStreamImportStatus_To_api_ImageStreamImportStatus,
+		autoconvert_v1beta3_ImageStreamImport_To_api_ImageStreamImport,
 		autoconvert_v1beta3_ImageStreamList_To_api_ImageStreamList,
 		autoconvert_v1beta3_ImageStreamMapping_To_api_ImageStreamMapping,
 		autoconvert_v1beta3_ImageStreamSpec_To_api_ImageStreamSpec,
@@ -8068,6 +9377,7 @@ func init() {
 		autoconvert_v1beta3_ImageStream_To_api_ImageStream,
 		autoconvert_v1beta3_Image_To_api_Image,
 		autoconvert_v1beta3_IsPersonalSubjectAccessReview_To_api_IsPersonalSubjectAccessReview,
+		autoconvert_v1beta3_JenkinsPipelineBuildStrategy_To_api_JenkinsPipelineBuildStrategy,
 		autoconvert_v1beta3_LifecycleHook_To_api_LifecycleHook,
 		autoconvert_v1beta3_Lifecycle_To_api_Lifecycle,
 		autoconvert_v1beta3_LocalObjectReference_To_api_LocalObjectReference,
@@ -8104,6 +9414,8 @@ func init() {
 		autoconvert_v1beta3_Project_To_api_Project,
 		autoconvert_v1beta3_RBDVolumeSource_To_api_RBDVolumeSource,
 		autoconvert_v1beta3_RecreateDeploymentStrategyParams_To_api_RecreateDeploymentStrategyParams,
+		autoconvert_v1beta3_RepositoryImportSpec_To_api_RepositoryImportSpec,
+		autoconvert_v1beta3_RepositoryImportStatus_To_api_RepositoryImportStatus,
 		autoconvert_v1beta3_ResourceAccessReviewResponse_To_api_ResourceAccessReviewResponse,
 		autoconvert_v1beta3_ResourceAccessReview_To_api_ResourceAccessReview,
 		autoconvert_v1beta3_ResourceRequirements_To_api_ResourceRequirements,
@@ -8112,12 +9424,15 @@ func init() {
 		autoconvert_v1beta3_RoleList_To_api_RoleList,
 		autoconvert_v1beta3_Role_To_api_Role,
 		autoconvert_v1beta3_RollingDeploymentStrategyParams_To_api_RollingDeploymentStrategyParams,
+		autoconvert_v1beta3_RouteIngressCondition_To_api_RouteIngressCondition,
+		autoconvert_v1beta3_RouteIngress_To_api_RouteIngress,
 		autoconvert_v1beta3_RouteList_To_api_RouteList,
 		autoconvert_v1beta3_RoutePort_To_api_RoutePort,
 		autoconvert_v1beta3_RouteSpec_To_api_RouteSpec,
 		autoconvert_v1beta3_RouteStatus_To_api_RouteStatus,
 		autoconvert_v1beta3_Route_To_api_Route,
 		autoconvert_v1beta3_SELinuxOptions_To_api_SELinuxOptions,
+		autoconvert_v1beta3_SecretBuildSource_To_api_SecretBuildSource,
 		autoconvert_v1beta3_SecretSpec_To_api_SecretSpec,
 		autoconvert_v1beta3_SecretVolumeSource_To_api_SecretVolumeSource,
 		autoconvert_v1beta3_SecurityContext_To_api_SecurityContext,
@@ -8128,6 +9443,8 @@ func init() {
 		autoconvert_v1beta3_SubjectAccessReview_To_api_SubjectAccessReview,
 		autoconvert_v1beta3_TCPSocketAction_To_api_TCPSocketAction,
 		autoconvert_v1beta3_TLSConfig_To_api_TLSConfig,
+		autoconvert_v1beta3_TagImageHook_To_api_TagImageHook,
+		autoconvert_v1beta3_TagImportPolicy_To_api_TagImportPolicy,
 		autoconvert_v1beta3_TemplateList_To_api_TemplateList,
 		autoconvert_v1beta3_Template_To_api_Template,
 		autoconvert_v1beta3_UserIdentityMapping_To_api_UserIdentityMapping,
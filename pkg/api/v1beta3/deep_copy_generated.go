@@ -796,6 +796,27 @@ func deepCopy_v1beta3_BuildConfigSpec(in apiv1beta3.BuildConfigSpec, out *apiv1b
 	} else {
 		out.Triggers = nil
 	}
+	out.RunPolicy = in.RunPolicy
+	if in.SuccessfulBuildsHistoryLimit != nil {
+		out.SuccessfulBuildsHistoryLimit = new(int)
+		*out.SuccessfulBuildsHistoryLimit = *in.SuccessfulBuildsHistoryLimit
+	} else {
+		out.SuccessfulBuildsHistoryLimit = nil
+	}
+	if in.FailedBuildsHistoryLimit != nil {
+		out.FailedBuildsHistoryLimit = new(int)
+		*out.FailedBuildsHistoryLimit = *in.FailedBuildsHistoryLimit
+	} else {
+		out.FailedBuildsHistoryLimit = nil
+	}
+	if in.CommitStatus != nil {
+		out.CommitStatus = new(apiv1beta3.CommitStatusNotification)
+		if err := deepCopy_v1beta3_CommitStatusNotification(*in.CommitStatus, out.CommitStatus, c); err != nil {
+			return err
+		}
+	} else {
+		out.CommitStatus = nil
+	}
 	if err := deepCopy_v1beta3_BuildSpec(in.BuildSpec, &out.BuildSpec, c); err != nil {
 		return err
 	}
@@ -1007,6 +1028,16 @@ func deepCopy_v1beta3_BuildSource(in apiv1beta3.BuildSource, out *apiv1beta3.Bui
 	} else {
 		out.Image = nil
 	}
+	if in.Images != nil {
+		out.Images = make([]apiv1beta3.ImageSource, len(in.Images))
+		for i := range in.Images {
+			if err := deepCopy_v1beta3_ImageSource(in.Images[i], &out.Images[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
 	out.ContextDir = in.ContextDir
 	if in.SourceSecret != nil {
 		if newVal, err := c.DeepCopy(in.SourceSecret); err != nil {
@@ -1017,6 +1048,16 @@ func deepCopy_v1beta3_BuildSource(in apiv1beta3.BuildSource, out *apiv1beta3.Bui
 	} else {
 		out.SourceSecret = nil
 	}
+	if in.Secrets != nil {
+		out.Secrets = make([]apiv1beta3.SecretBuildSource, len(in.Secrets))
+		for i := range in.Secrets {
+			if err := deepCopy_v1beta3_SecretBuildSource(in.Secrets[i], &out.Secrets[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Secrets = nil
+	}
 	return nil
 }
 
@@ -1116,6 +1157,14 @@ func deepCopy_v1beta3_BuildStrategy(in apiv1beta3.BuildStrategy, out *apiv1beta3
 	} else {
 		out.CustomStrategy = nil
 	}
+	if in.JenkinsPipelineStrategy != nil {
+		out.JenkinsPipelineStrategy = new(apiv1beta3.JenkinsPipelineBuildStrategy)
+		if err := deepCopy_v1beta3_JenkinsPipelineBuildStrategy(*in.JenkinsPipelineStrategy, out.JenkinsPipelineStrategy, c); err != nil {
+			return err
+		}
+	} else {
+		out.JenkinsPipelineStrategy = nil
+	}
 	return nil
 }
 
@@ -1137,6 +1186,22 @@ func deepCopy_v1beta3_BuildTriggerPolicy(in apiv1beta3.BuildTriggerPolicy, out *
 	} else {
 		out.GenericWebHook = nil
 	}
+	if in.GitLabWebHook != nil {
+		out.GitLabWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := deepCopy_v1beta3_WebHookTrigger(*in.GitLabWebHook, out.GitLabWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.GitLabWebHook = nil
+	}
+	if in.BitbucketWebHook != nil {
+		out.BitbucketWebHook = new(apiv1beta3.WebHookTrigger)
+		if err := deepCopy_v1beta3_WebHookTrigger(*in.BitbucketWebHook, out.BitbucketWebHook, c); err != nil {
+			return err
+		}
+	} else {
+		out.BitbucketWebHook = nil
+	}
 	if in.ImageChange != nil {
 		out.ImageChange = new(apiv1beta3.ImageChangeTrigger)
 		if err := deepCopy_v1beta3_ImageChangeTrigger(*in.ImageChange, out.ImageChange, c); err != nil {
@@ -1148,6 +1213,17 @@ func deepCopy_v1beta3_BuildTriggerPolicy(in apiv1beta3.BuildTriggerPolicy, out *
 	return nil
 }
 
+func deepCopy_v1beta3_CommitStatusNotification(in apiv1beta3.CommitStatusNotification, out *apiv1beta3.CommitStatusNotification, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.APIURL = in.APIURL
+	if newVal, err := c.DeepCopy(in.Secret); err != nil {
+		return err
+	} else {
+		out.Secret = newVal.(pkgapiv1beta3.LocalObjectReference)
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_CustomBuildStrategy(in apiv1beta3.CustomBuildStrategy, out *apiv1beta3.CustomBuildStrategy, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.From); err != nil {
 		return err
@@ -1190,6 +1266,23 @@ func deepCopy_v1beta3_CustomBuildStrategy(in apiv1beta3.CustomBuildStrategy, out
 	return nil
 }
 
+func deepCopy_v1beta3_DockerBuildCache(in apiv1beta3.DockerBuildCache, out *apiv1beta3.DockerBuildCache, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.PersistentVolumeClaim); err != nil {
+		return err
+	} else {
+		out.PersistentVolumeClaim = newVal.(pkgapiv1beta3.LocalObjectReference)
+	}
+	if in.Paths != nil {
+		out.Paths = make([]string, len(in.Paths))
+		for i := range in.Paths {
+			out.Paths[i] = in.Paths[i]
+		}
+	} else {
+		out.Paths = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_DockerBuildStrategy(in apiv1beta3.DockerBuildStrategy, out *apiv1beta3.DockerBuildStrategy, c *conversion.Cloner) error {
 	if in.From != nil {
 		if newVal, err := c.DeepCopy(in.From); err != nil {
@@ -1224,6 +1317,14 @@ func deepCopy_v1beta3_DockerBuildStrategy(in apiv1beta3.DockerBuildStrategy, out
 	}
 	out.ForcePull = in.ForcePull
 	out.DockerfilePath = in.DockerfilePath
+	if in.BuildCache != nil {
+		out.BuildCache = new(apiv1beta3.DockerBuildCache)
+		if err := deepCopy_v1beta3_DockerBuildCache(*in.BuildCache, out.BuildCache, c); err != nil {
+			return err
+		}
+	} else {
+		out.BuildCache = nil
+	}
 	return nil
 }
 
@@ -1295,6 +1396,22 @@ func deepCopy_v1beta3_ImageSourcePath(in apiv1beta3.ImageSourcePath, out *apiv1b
 	return nil
 }
 
+func deepCopy_v1beta3_JenkinsPipelineBuildStrategy(in apiv1beta3.JenkinsPipelineBuildStrategy, out *apiv1beta3.JenkinsPipelineBuildStrategy, c *conversion.Cloner) error {
+	out.JenkinsfilePath = in.JenkinsfilePath
+	out.Jenkinsfile = in.Jenkinsfile
+	return nil
+}
+
+func deepCopy_v1beta3_SecretBuildSource(in apiv1beta3.SecretBuildSource, out *apiv1beta3.SecretBuildSource, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Secret); err != nil {
+		return err
+	} else {
+		out.Secret = newVal.(pkgapiv1beta3.LocalObjectReference)
+	}
+	out.DestinationDir = in.DestinationDir
+	return nil
+}
+
 func deepCopy_v1beta3_SecretSpec(in apiv1beta3.SecretSpec, out *apiv1beta3.SecretSpec, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.SecretSource); err != nil {
 		return err
@@ -1362,6 +1479,88 @@ func deepCopy_v1beta3_WebHookTrigger(in apiv1beta3.WebHookTrigger, out *apiv1bet
 	return nil
 }
 
+func deepCopy_v1beta3_BlueGreenDeploymentStrategyParams(in deployapiv1beta3.BlueGreenDeploymentStrategyParams, out *deployapiv1beta3.BlueGreenDeploymentStrategyParams, c *conversion.Cloner) error {
+	out.ServiceName = in.ServiceName
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	out.KeepWarmSeconds = in.KeepWarmSeconds
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_CanaryDeploymentStrategyParams(in deployapiv1beta3.CanaryDeploymentStrategyParams, out *deployapiv1beta3.CanaryDeploymentStrategyParams, c *conversion.Cloner) error {
+	if in.Steps != nil {
+		out.Steps = make([]deployapiv1beta3.CanaryStep, len(in.Steps))
+		for i := range in.Steps {
+			if err := deepCopy_v1beta3_CanaryStep(in.Steps[i], &out.Steps[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Steps = nil
+	}
+	if in.TimeoutSeconds != nil {
+		out.TimeoutSeconds = new(int64)
+		*out.TimeoutSeconds = *in.TimeoutSeconds
+	} else {
+		out.TimeoutSeconds = nil
+	}
+	if in.Pre != nil {
+		out.Pre = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Pre, out.Pre, c); err != nil {
+			return err
+		}
+	} else {
+		out.Pre = nil
+	}
+	if in.Post != nil {
+		out.Post = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Post, out.Post, c); err != nil {
+			return err
+		}
+	} else {
+		out.Post = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_CanaryStep(in deployapiv1beta3.CanaryStep, out *deployapiv1beta3.CanaryStep, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Replicas); err != nil {
+		return err
+	} else {
+		out.Replicas = newVal.(util.IntOrString)
+	}
+	out.PauseSeconds = in.PauseSeconds
+	if in.Verify != nil {
+		out.Verify = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Verify, out.Verify, c); err != nil {
+			return err
+		}
+	} else {
+		out.Verify = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_CustomDeploymentStrategyParams(in deployapiv1beta3.CustomDeploymentStrategyParams, out *deployapiv1beta3.CustomDeploymentStrategyParams, c *conversion.Cloner) error {
 	out.Image = in.Image
 	if in.Environment != nil {
@@ -1397,6 +1596,14 @@ func deepCopy_v1beta3_DeploymentCause(in deployapiv1beta3.DeploymentCause, out *
 	} else {
 		out.ImageTrigger = nil
 	}
+	if in.SecretTrigger != nil {
+		out.SecretTrigger = new(deployapiv1beta3.DeploymentCauseSecretTrigger)
+		if err := deepCopy_v1beta3_DeploymentCauseSecretTrigger(*in.SecretTrigger, out.SecretTrigger, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretTrigger = nil
+	}
 	return nil
 }
 
@@ -1409,6 +1616,28 @@ func deepCopy_v1beta3_DeploymentCauseImageTrigger(in deployapiv1beta3.Deployment
 	return nil
 }
 
+func deepCopy_v1beta3_DeploymentCauseSecretTrigger(in deployapiv1beta3.DeploymentCauseSecretTrigger, out *deployapiv1beta3.DeploymentCauseSecretTrigger, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapiv1beta3.ObjectReference)
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_DeploymentCondition(in deployapiv1beta3.DeploymentCondition, out *deployapiv1beta3.DeploymentCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
 func deepCopy_v1beta3_DeploymentConfig(in deployapiv1beta3.DeploymentConfig, out *deployapiv1beta3.DeploymentConfig, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1510,11 +1739,19 @@ func deepCopy_v1beta3_DeploymentConfigSpec(in deployapiv1beta3.DeploymentConfigS
 	} else {
 		out.Template = nil
 	}
+	if in.RevisionHistoryLimit != nil {
+		out.RevisionHistoryLimit = new(int)
+		*out.RevisionHistoryLimit = *in.RevisionHistoryLimit
+	} else {
+		out.RevisionHistoryLimit = nil
+	}
+	out.Paused = in.Paused
 	return nil
 }
 
 func deepCopy_v1beta3_DeploymentConfigStatus(in deployapiv1beta3.DeploymentConfigStatus, out *deployapiv1beta3.DeploymentConfigStatus, c *conversion.Cloner) error {
 	out.LatestVersion = in.LatestVersion
+	out.ObservedGeneration = in.ObservedGeneration
 	if in.Details != nil {
 		out.Details = new(deployapiv1beta3.DeploymentDetails)
 		if err := deepCopy_v1beta3_DeploymentDetails(*in.Details, out.Details, c); err != nil {
@@ -1523,6 +1760,21 @@ func deepCopy_v1beta3_DeploymentConfigStatus(in deployapiv1beta3.DeploymentConfi
 	} else {
 		out.Details = nil
 	}
+	out.LiveColor = in.LiveColor
+	out.LiveDeployment = in.LiveDeployment
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	if in.Conditions != nil {
+		out.Conditions = make([]deployapiv1beta3.DeploymentCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_v1beta3_DeploymentCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -1627,6 +1879,22 @@ func deepCopy_v1beta3_DeploymentStrategy(in deployapiv1beta3.DeploymentStrategy,
 	} else {
 		out.RollingParams = nil
 	}
+	if in.CanaryParams != nil {
+		out.CanaryParams = new(deployapiv1beta3.CanaryDeploymentStrategyParams)
+		if err := deepCopy_v1beta3_CanaryDeploymentStrategyParams(*in.CanaryParams, out.CanaryParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.CanaryParams = nil
+	}
+	if in.BlueGreenParams != nil {
+		out.BlueGreenParams = new(deployapiv1beta3.BlueGreenDeploymentStrategyParams)
+		if err := deepCopy_v1beta3_BlueGreenDeploymentStrategyParams(*in.BlueGreenParams, out.BlueGreenParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreenParams = nil
+	}
 	if newVal, err := c.DeepCopy(in.Resources); err != nil {
 		return err
 	} else {
@@ -1680,6 +1948,26 @@ func deepCopy_v1beta3_DeploymentTriggerPolicy(in deployapiv1beta3.DeploymentTrig
 	} else {
 		out.ImageChangeParams = nil
 	}
+	if in.SecretChangeParams != nil {
+		out.SecretChangeParams = new(deployapiv1beta3.DeploymentTriggerSecretChangeParams)
+		if err := deepCopy_v1beta3_DeploymentTriggerSecretChangeParams(*in.SecretChangeParams, out.SecretChangeParams, c); err != nil {
+			return err
+		}
+	} else {
+		out.SecretChangeParams = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_DeploymentTriggerSecretChangeParams(in deployapiv1beta3.DeploymentTriggerSecretChangeParams, out *deployapiv1beta3.DeploymentTriggerSecretChangeParams, c *conversion.Cloner) error {
+	if in.LastTriggeredSecrets != nil {
+		out.LastTriggeredSecrets = make(map[string]string)
+		for key, val := range in.LastTriggeredSecrets {
+			out.LastTriggeredSecrets[key] = val
+		}
+	} else {
+		out.LastTriggeredSecrets = nil
+	}
 	return nil
 }
 
@@ -1726,6 +2014,16 @@ func deepCopy_v1beta3_LifecycleHook(in deployapiv1beta3.LifecycleHook, out *depl
 	} else {
 		out.ExecNewPod = nil
 	}
+	if in.TagImages != nil {
+		out.TagImages = make([]deployapiv1beta3.TagImageHook, len(in.TagImages))
+		for i := range in.TagImages {
+			if err := deepCopy_v1beta3_TagImageHook(in.TagImages[i], &out.TagImages[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.TagImages = nil
+	}
 	return nil
 }
 
@@ -1738,6 +2036,14 @@ func deepCopy_v1beta3_RecreateDeploymentStrategyParams(in deployapiv1beta3.Recre
 	} else {
 		out.Pre = nil
 	}
+	if in.Mid != nil {
+		out.Mid = new(deployapiv1beta3.LifecycleHook)
+		if err := deepCopy_v1beta3_LifecycleHook(*in.Mid, out.Mid, c); err != nil {
+			return err
+		}
+	} else {
+		out.Mid = nil
+	}
 	if in.Post != nil {
 		out.Post = new(deployapiv1beta3.LifecycleHook)
 		if err := deepCopy_v1beta3_LifecycleHook(*in.Post, out.Post, c); err != nil {
@@ -1768,6 +2074,13 @@ func deepCopy_v1beta3_RollingDeploymentStrategyParams(in deployapiv1beta3.Rollin
 	} else {
 		out.TimeoutSeconds = nil
 	}
+	if in.ProgressDeadlineSeconds != nil {
+		out.ProgressDeadlineSeconds = new(int64)
+		*out.ProgressDeadlineSeconds = *in.ProgressDeadlineSeconds
+	} else {
+		out.ProgressDeadlineSeconds = nil
+	}
+	out.AutoRollback = in.AutoRollback
 	if in.MaxUnavailable != nil {
 		if newVal, err := c.DeepCopy(in.MaxUnavailable); err != nil {
 			return err
@@ -1811,6 +2124,16 @@ func deepCopy_v1beta3_RollingDeploymentStrategyParams(in deployapiv1beta3.Rollin
 	return nil
 }
 
+func deepCopy_v1beta3_TagImageHook(in deployapiv1beta3.TagImageHook, out *deployapiv1beta3.TagImageHook, c *conversion.Cloner) error {
+	out.ContainerName = in.ContainerName
+	if newVal, err := c.DeepCopy(in.To); err != nil {
+		return err
+	} else {
+		out.To = newVal.(pkgapiv1beta3.ObjectReference)
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_Image(in imageapiv1beta3.Image, out *imageapiv1beta3.Image, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -1830,6 +2153,61 @@ func deepCopy_v1beta3_Image(in imageapiv1beta3.Image, out *imageapiv1beta3.Image
 	}
 	out.DockerImageMetadataVersion = in.DockerImageMetadataVersion
 	out.DockerImageManifest = in.DockerImageManifest
+	if in.DockerImageLayers != nil {
+		out.DockerImageLayers = make([]imageapiv1beta3.ImageLayer, len(in.DockerImageLayers))
+		for i := range in.DockerImageLayers {
+			if err := deepCopy_v1beta3_ImageLayer(in.DockerImageLayers[i], &out.DockerImageLayers[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.DockerImageLayers = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_ImageImportSpec(in imageapiv1beta3.ImageImportSpec, out *imageapiv1beta3.ImageImportSpec, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapiv1beta3.ObjectReference)
+	}
+	if in.To != nil {
+		if newVal, err := c.DeepCopy(in.To); err != nil {
+			return err
+		} else {
+			out.To = newVal.(*pkgapiv1beta3.LocalObjectReference)
+		}
+	} else {
+		out.To = nil
+	}
+	if err := deepCopy_v1beta3_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_ImageImportStatus(in imageapiv1beta3.ImageImportStatus, out *imageapiv1beta3.ImageImportStatus, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Status); err != nil {
+		return err
+	} else {
+		out.Status = newVal.(unversioned.Status)
+	}
+	if in.Image != nil {
+		out.Image = new(imageapiv1beta3.Image)
+		if err := deepCopy_v1beta3_Image(*in.Image, out.Image, c); err != nil {
+			return err
+		}
+	} else {
+		out.Image = nil
+	}
+	out.Tag = in.Tag
+	return nil
+}
+
+func deepCopy_v1beta3_ImageLayer(in imageapiv1beta3.ImageLayer, out *imageapiv1beta3.ImageLayer, c *conversion.Cloner) error {
+	out.Name = in.Name
+	out.Size = in.Size
 	return nil
 }
 
@@ -1885,6 +2263,79 @@ func deepCopy_v1beta3_ImageStreamImage(in imageapiv1beta3.ImageStreamImage, out
 	return nil
 }
 
+func deepCopy_v1beta3_ImageStreamImport(in imageapiv1beta3.ImageStreamImport, out *imageapiv1beta3.ImageStreamImport, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
+		return err
+	} else {
+		out.TypeMeta = newVal.(unversioned.TypeMeta)
+	}
+	if newVal, err := c.DeepCopy(in.ObjectMeta); err != nil {
+		return err
+	} else {
+		out.ObjectMeta = newVal.(pkgapiv1beta3.ObjectMeta)
+	}
+	if err := deepCopy_v1beta3_ImageStreamImportSpec(in.Spec, &out.Spec, c); err != nil {
+		return err
+	}
+	if err := deepCopy_v1beta3_ImageStreamImportStatus(in.Status, &out.Status, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_ImageStreamImportSpec(in imageapiv1beta3.ImageStreamImportSpec, out *imageapiv1beta3.ImageStreamImportSpec, c *conversion.Cloner) error {
+	out.Import = in.Import
+	if in.Repository != nil {
+		out.Repository = new(imageapiv1beta3.RepositoryImportSpec)
+		if err := deepCopy_v1beta3_RepositoryImportSpec(*in.Repository, out.Repository, c); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapiv1beta3.ImageImportSpec, len(in.Images))
+		for i := range in.Images {
+			if err := deepCopy_v1beta3_ImageImportSpec(in.Images[i], &out.Images[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_ImageStreamImportStatus(in imageapiv1beta3.ImageStreamImportStatus, out *imageapiv1beta3.ImageStreamImportStatus, c *conversion.Cloner) error {
+	if in.Import != nil {
+		out.Import = new(imageapiv1beta3.ImageStream)
+		if err := deepCopy_v1beta3_ImageStream(*in.Import, out.Import, c); err != nil {
+			return err
+		}
+	} else {
+		out.Import = nil
+	}
+	if in.Repository != nil {
+		out.Repository = new(imageapiv1beta3.RepositoryImportStatus)
+		if err := deepCopy_v1beta3_RepositoryImportStatus(*in.Repository, out.Repository, c); err != nil {
+			return err
+		}
+	} else {
+		out.Repository = nil
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapiv1beta3.ImageImportStatus, len(in.Images))
+		for i := range in.Images {
+			if err := deepCopy_v1beta3_ImageImportStatus(in.Images[i], &out.Images[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_ImageStreamList(in imageapiv1beta3.ImageStreamList, out *imageapiv1beta3.ImageStreamList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2001,6 +2452,16 @@ func deepCopy_v1beta3_NamedTagEventList(in imageapiv1beta3.NamedTagEventList, ou
 	} else {
 		out.Items = nil
 	}
+	if in.Conditions != nil {
+		out.Conditions = make([]imageapiv1beta3.TagEventCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_v1beta3_TagEventCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
 	return nil
 }
 
@@ -2024,6 +2485,48 @@ func deepCopy_v1beta3_NamedTagReference(in imageapiv1beta3.NamedTagReference, ou
 		out.From = nil
 	}
 	out.Reference = in.Reference
+	if err := deepCopy_v1beta3_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_RepositoryImportSpec(in imageapiv1beta3.RepositoryImportSpec, out *imageapiv1beta3.RepositoryImportSpec, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.From); err != nil {
+		return err
+	} else {
+		out.From = newVal.(pkgapiv1beta3.ObjectReference)
+	}
+	if err := deepCopy_v1beta3_TagImportPolicy(in.ImportPolicy, &out.ImportPolicy, c); err != nil {
+		return err
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_RepositoryImportStatus(in imageapiv1beta3.RepositoryImportStatus, out *imageapiv1beta3.RepositoryImportStatus, c *conversion.Cloner) error {
+	if newVal, err := c.DeepCopy(in.Status); err != nil {
+		return err
+	} else {
+		out.Status = newVal.(unversioned.Status)
+	}
+	if in.Images != nil {
+		out.Images = make([]imageapiv1beta3.ImageImportStatus, len(in.Images))
+		for i := range in.Images {
+			if err := deepCopy_v1beta3_ImageImportStatus(in.Images[i], &out.Images[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Images = nil
+	}
+	if in.AdditionalTags != nil {
+		out.AdditionalTags = make([]string, len(in.AdditionalTags))
+		for i := range in.AdditionalTags {
+			out.AdditionalTags[i] = in.AdditionalTags[i]
+		}
+	} else {
+		out.AdditionalTags = nil
+	}
 	return nil
 }
 
@@ -2038,6 +2541,25 @@ func deepCopy_v1beta3_TagEvent(in imageapiv1beta3.TagEvent, out *imageapiv1beta3
 	return nil
 }
 
+func deepCopy_v1beta3_TagEventCondition(in imageapiv1beta3.TagEventCondition, out *imageapiv1beta3.TagEventCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+func deepCopy_v1beta3_TagImportPolicy(in imageapiv1beta3.TagImportPolicy, out *imageapiv1beta3.TagImportPolicy, c *conversion.Cloner) error {
+	out.Insecure = in.Insecure
+	out.Scheduled = in.Scheduled
+	return nil
+}
+
 func deepCopy_v1beta3_OAuthAccessToken(in oauthapiv1beta3.OAuthAccessToken, out *oauthapiv1beta3.OAuthAccessToken, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2337,6 +2859,35 @@ func deepCopy_v1beta3_Route(in routeapiv1beta3.Route, out *routeapiv1beta3.Route
 	return nil
 }
 
+func deepCopy_v1beta3_RouteIngress(in routeapiv1beta3.RouteIngress, out *routeapiv1beta3.RouteIngress, c *conversion.Cloner) error {
+	out.Host = in.Host
+	out.RouterName = in.RouterName
+	if in.Conditions != nil {
+		out.Conditions = make([]routeapiv1beta3.RouteIngressCondition, len(in.Conditions))
+		for i := range in.Conditions {
+			if err := deepCopy_v1beta3_RouteIngressCondition(in.Conditions[i], &out.Conditions[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+func deepCopy_v1beta3_RouteIngressCondition(in routeapiv1beta3.RouteIngressCondition, out *routeapiv1beta3.RouteIngressCondition, c *conversion.Cloner) error {
+	out.Type = in.Type
+	out.Status = in.Status
+	out.Reason = in.Reason
+	out.Message = in.Message
+	if newVal, err := c.DeepCopy(in.LastTransitionTime); err != nil {
+		return err
+	} else {
+		out.LastTransitionTime = newVal.(unversioned.Time)
+	}
+	return nil
+}
+
 func deepCopy_v1beta3_RouteList(in routeapiv1beta3.RouteList, out *routeapiv1beta3.RouteList, c *conversion.Cloner) error {
 	if newVal, err := c.DeepCopy(in.TypeMeta); err != nil {
 		return err
@@ -2398,6 +2949,16 @@ func deepCopy_v1beta3_RouteSpec(in routeapiv1beta3.RouteSpec, out *routeapiv1bet
 }
 
 func deepCopy_v1beta3_RouteStatus(in routeapiv1beta3.RouteStatus, out *routeapiv1beta3.RouteStatus, c *conversion.Cloner) error {
+	if in.Ingress != nil {
+		out.Ingress = make([]routeapiv1beta3.RouteIngress, len(in.Ingress))
+		for i := range in.Ingress {
+			if err := deepCopy_v1beta3_RouteIngress(in.Ingress[i], &out.Ingress[i], c); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ingress = nil
+	}
 	return nil
 }
 
@@ -2838,21 +3399,30 @@ func init() {
 		deepCopy_v1beta3_BuildStatus,
 		deepCopy_v1beta3_BuildStrategy,
 		deepCopy_v1beta3_BuildTriggerPolicy,
+		deepCopy_v1beta3_CommitStatusNotification,
 		deepCopy_v1beta3_CustomBuildStrategy,
+		deepCopy_v1beta3_DockerBuildCache,
 		deepCopy_v1beta3_DockerBuildStrategy,
 		deepCopy_v1beta3_GitBuildSource,
 		deepCopy_v1beta3_GitSourceRevision,
 		deepCopy_v1beta3_ImageChangeTrigger,
 		deepCopy_v1beta3_ImageSource,
 		deepCopy_v1beta3_ImageSourcePath,
+		deepCopy_v1beta3_JenkinsPipelineBuildStrategy,
+		deepCopy_v1beta3_SecretBuildSource,
 		deepCopy_v1beta3_SecretSpec,
 		deepCopy_v1beta3_SourceBuildStrategy,
 		deepCopy_v1beta3_SourceControlUser,
 		deepCopy_v1beta3_SourceRevision,
 		deepCopy_v1beta3_WebHookTrigger,
+		deepCopy_v1beta3_BlueGreenDeploymentStrategyParams,
+		deepCopy_v1beta3_CanaryDeploymentStrategyParams,
+		deepCopy_v1beta3_CanaryStep,
 		deepCopy_v1beta3_CustomDeploymentStrategyParams,
 		deepCopy_v1beta3_DeploymentCause,
 		deepCopy_v1beta3_DeploymentCauseImageTrigger,
+		deepCopy_v1beta3_DeploymentCauseSecretTrigger,
+		deepCopy_v1beta3_DeploymentCondition,
 		deepCopy_v1beta3_DeploymentConfig,
 		deepCopy_v1beta3_DeploymentConfigList,
 		deepCopy_v1beta3_DeploymentConfigRollback,
@@ -2865,14 +3435,22 @@ func init() {
 		deepCopy_v1beta3_DeploymentStrategy,
 		deepCopy_v1beta3_DeploymentTriggerImageChangeParams,
 		deepCopy_v1beta3_DeploymentTriggerPolicy,
+		deepCopy_v1beta3_DeploymentTriggerSecretChangeParams,
 		deepCopy_v1beta3_ExecNewPodHook,
 		deepCopy_v1beta3_LifecycleHook,
 		deepCopy_v1beta3_RecreateDeploymentStrategyParams,
 		deepCopy_v1beta3_RollingDeploymentStrategyParams,
+		deepCopy_v1beta3_TagImageHook,
 		deepCopy_v1beta3_Image,
+		deepCopy_v1beta3_ImageImportSpec,
+		deepCopy_v1beta3_ImageImportStatus,
+		deepCopy_v1beta3_ImageLayer,
 		deepCopy_v1beta3_ImageList,
 		deepCopy_v1beta3_ImageStream,
 		deepCopy_v1beta3_ImageStreamImage,
+		deepCopy_v1beta3_ImageStreamImport,
+		deepCopy_v1beta3_ImageStreamImportSpec,
+		deepCopy_v1beta3_ImageStreamImportStatus,
 		deepCopy_v1beta3_ImageStreamList,
 		deepCopy_v1beta3_ImageStreamMapping,
 		deepCopy_v1beta3_ImageStreamSpec,
@@ -2881,7 +3459,11 @@ func init() {
 		deepCopy_v1beta3_ImageStreamTagList,
 		deepCopy_v1beta3_NamedTagEventList,
 		deepCopy_v1beta3_NamedTagReference,
+		deepCopy_v1beta3_RepositoryImportSpec,
+		deepCopy_v1beta3_RepositoryImportStatus,
 		deepCopy_v1beta3_TagEvent,
+		deepCopy_v1beta3_TagEventCondition,
+		deepCopy_v1beta3_TagImportPolicy,
 		deepCopy_v1beta3_OAuthAccessToken,
 		deepCopy_v1beta3_OAuthAccessTokenList,
 		deepCopy_v1beta3_OAuthAuthorizeToken,
@@ -2896,6 +3478,8 @@ func init() {
 		deepCopy_v1beta3_ProjectSpec,
 		deepCopy_v1beta3_ProjectStatus,
 		deepCopy_v1beta3_Route,
+		deepCopy_v1beta3_RouteIngress,
+		deepCopy_v1beta3_RouteIngressCondition,
 		deepCopy_v1beta3_RouteList,
 		deepCopy_v1beta3_RoutePort,
 		deepCopy_v1beta3_RouteSpec,
@@ -79,6 +79,15 @@ func TestBuildAdmission(t *testing.T) {
 			expectedResource: authorizationapi.CustomBuildResource,
 			expectAccept:     true,
 		},
+		{
+			name:             "allowed jenkins pipeline build",
+			object:           testBuild(buildapi.BuildStrategy{JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{}}),
+			kind:             "Build",
+			resource:         buildsResource,
+			reviewResponse:   reviewResponse(true, ""),
+			expectedResource: authorizationapi.JenkinsPipelineBuildResource,
+			expectAccept:     true,
+		},
 		{
 			name:             "allowed build config",
 			object:           testBuildConfig(buildapi.BuildStrategy{DockerStrategy: &buildapi.DockerBuildStrategy{}}),
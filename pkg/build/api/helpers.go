@@ -1,6 +1,8 @@
 package api
 
 import (
+	"path"
+
 	kapi "k8s.io/kubernetes/pkg/api"
 )
 
@@ -18,6 +20,36 @@ func BuildToPodLogOptions(opts *BuildLogOptions) *kapi.PodLogOptions {
 	}
 }
 
+// CleanSecretDestinations cleans the destination directories of the build
+// secrets of source, so that equivalent directories are stored the same way and
+// the build directory itself is stored as an empty destination.
+func CleanSecretDestinations(source *BuildSource) {
+	for i := range source.Secrets {
+		secret := &source.Secrets[i]
+		if len(secret.DestinationDir) == 0 {
+			continue
+		}
+		secret.DestinationDir = path.Clean(secret.DestinationDir)
+		if secret.DestinationDir == "." {
+			secret.DestinationDir = ""
+		}
+	}
+}
+
+// CleanBuildCachePaths cleans the directories cached by the Docker build
+// strategy, so that equivalent directories are stored the same way.
+func CleanBuildCachePaths(strategy *BuildStrategy) {
+	if strategy.DockerStrategy == nil || strategy.DockerStrategy.BuildCache == nil {
+		return
+	}
+	paths := strategy.DockerStrategy.BuildCache.Paths
+	for i := range paths {
+		if len(paths[i]) > 0 {
+			paths[i] = path.Clean(paths[i])
+		}
+	}
+}
+
 // PredicateFunc is testing an argument and decides does it meet some criteria or not.
 // It can be used for filtering elements based on some conditions.
 type PredicateFunc func(interface{}) bool
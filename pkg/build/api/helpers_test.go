@@ -170,3 +170,37 @@ func assertThatArrayIsEmpty(t *testing.T, array []Build) {
 		t.Errorf("expected empty array, got %v", array)
 	}
 }
+
+func TestCleanSecretDestinations(t *testing.T) {
+	source := &BuildSource{
+		Secrets: []SecretBuildSource{
+			{DestinationDir: "./.m2/"},
+			{DestinationDir: "."},
+			{DestinationDir: ""},
+			{DestinationDir: "a/../../etc"},
+		},
+	}
+	CleanSecretDestinations(source)
+	expected := []string{".m2", "", "", "../etc"}
+	for i, secret := range source.Secrets {
+		if secret.DestinationDir != expected[i] {
+			t.Errorf("%d: expected the destination %q, got %q", i, expected[i], secret.DestinationDir)
+		}
+	}
+}
+
+func TestCleanBuildCachePaths(t *testing.T) {
+	strategy := &BuildStrategy{
+		DockerStrategy: &DockerBuildStrategy{
+			BuildCache: &DockerBuildCache{Paths: []string{"/root/.m2/", "/opt/app/../node_modules", ""}},
+		},
+	}
+	CleanBuildCachePaths(strategy)
+	expected := []string{"/root/.m2", "/opt/node_modules", ""}
+	if !reflect.DeepEqual(strategy.DockerStrategy.BuildCache.Paths, expected) {
+		t.Errorf("expected the paths %v, got %v", expected, strategy.DockerStrategy.BuildCache.Paths)
+	}
+	// Strategies without a build cache are left alone.
+	CleanBuildCachePaths(&BuildStrategy{DockerStrategy: &DockerBuildStrategy{}})
+	CleanBuildCachePaths(&BuildStrategy{})
+}
@@ -17,8 +17,22 @@ const (
 	BuildCloneAnnotation = "openshift.io/build.clone-of"
 	// BuildPodNameAnnotation is an annotation whose value is the name of the pod running this build
 	BuildPodNameAnnotation = "openshift.io/build.pod-name"
+	// BuildAcceptedAnnotation is an annotation used to update a build that can now be
+	// run based on the RunPolicy (e.g. Serial). Updating the build with this annotation
+	// forces the build to be processed by the build controller queue without waiting
+	// for a resync.
+	BuildAcceptedAnnotation = "openshift.io/build.accepted"
+	// BuildJenkinsBuildURIAnnotation is an annotation holding the URI of the
+	// Jenkins build that runs a Jenkins Pipeline strategy build.
+	BuildJenkinsBuildURIAnnotation = "openshift.io/jenkins-build-uri"
+	// BuildJenkinsQueueURIAnnotation is an annotation holding the URI of the
+	// Jenkins queue item created for a Jenkins Pipeline strategy build that
+	// has not started yet.
+	BuildJenkinsQueueURIAnnotation = "openshift.io/jenkins-queue-uri"
 	// BuildLabel is the key of a Pod label whose value is the Name of a Build which is run.
 	BuildLabel = "openshift.io/build.name"
+	// BuildRunPolicyLabel represents the run policy used to start the build.
+	BuildRunPolicyLabel = "openshift.io/build.start-policy"
 	// DefaultDockerLabelNamespace is the key of a Build label, whose values are build metadata.
 	DefaultDockerLabelNamespace = "io.openshift."
 )
@@ -149,6 +163,10 @@ const (
 	// cannot be created.
 	StatusReasonCannotCreateBuildPod = "CannotCreateBuildPod"
 
+	// StatusReasonCannotStartPipeline is an error condition when a Jenkins
+	// Pipeline build cannot be handed off to Jenkins.
+	StatusReasonCannotStartPipeline = "CannotStartPipeline"
+
 	// StatusReasonInvalidOutputReference is an error condition when the build
 	// output is an invalid reference.
 	StatusReasonInvalidOutputReference = "InvalidOutputReference"
@@ -188,8 +206,8 @@ type BuildSource struct {
 	// Git contains optional information about git build source
 	Git *GitBuildSource
 
-	// Image describes an image to be used to provide source for the build
-	Image *ImageSource
+	// Images describes a set of images to be used to provide source for the build
+	Images []ImageSource
 
 	// ContextDir specifies the sub-directory where the source code for the application exists.
 	// This allows to have buildable sources in directory other than root of
@@ -204,6 +222,28 @@ type BuildSource struct {
 	// TODO: This needs to move under the GitBuildSource struct since it's only
 	// used for git authentication
 	SourceSecret *kapi.LocalObjectReference
+
+	// Secrets represents a list of secrets and their destinations that will
+	// be used only for the build.
+	Secrets []SecretBuildSource
+}
+
+// SecretBuildSource describes a secret and its destination directory that will be
+// used only at the build time. The content of the secret referenced here will
+// be copied into the destination directory instead of mounting.
+type SecretBuildSource struct {
+	// Secret is a reference to an existing secret that you want to use in your
+	// build.
+	Secret kapi.LocalObjectReference
+
+	// DestinationDir is the directory where the files from the secret should be
+	// available for the build time.
+	// For the Source build strategy, these will be injected into a container
+	// where the assemble script runs. Later, when the script finishes, all files
+	// injected will be truncated to zero length.
+	// The Docker build strategy does not support build secrets, since the files
+	// a Dockerfile adds remain in the layers of the output image.
+	DestinationDir string
 }
 
 // ImageSource describes an image that is used as source for the build
@@ -297,6 +337,9 @@ type BuildStrategy struct {
 
 	// CustomStrategy holds the parameters to the Custom build strategy
 	CustomStrategy *CustomBuildStrategy
+
+	// JenkinsPipelineStrategy holds the parameters to the Jenkins Pipeline build strategy.
+	JenkinsPipelineStrategy *JenkinsPipelineBuildStrategy
 }
 
 // BuildStrategyType describes a particular way of performing a build.
@@ -360,6 +403,22 @@ type DockerBuildStrategy struct {
 	// DockerfilePath is the path of the Dockerfile that will be used to build the Docker image,
 	// relative to the root of the context (contextDir).
 	DockerfilePath string
+
+	// BuildCache describes directories of the built image, such as dependency caches, whose
+	// content is saved after a successful build and restored before the next build runs.
+	BuildCache *DockerBuildCache
+}
+
+// DockerBuildCache describes directories kept between Docker builds in a persistent volume.
+// The directories saved by the previous build are added to an image built from the image of
+// the last FROM instruction of the Dockerfile, which the Dockerfile is then built from. They are
+// not part of the build context, but they end up in a layer of the built image.
+type DockerBuildCache struct {
+	// PersistentVolumeClaim is the claim of the volume the directories are saved in.
+	PersistentVolumeClaim kapi.LocalObjectReference
+
+	// Paths are the absolute paths of the directories to keep, for example /root/.m2.
+	Paths []string
 }
 
 // SourceBuildStrategy defines input parameters specific to an Source build.
@@ -386,6 +445,18 @@ type SourceBuildStrategy struct {
 	ForcePull bool
 }
 
+// JenkinsPipelineBuildStrategy holds parameters specific to a Jenkins Pipeline build.
+// The build is not run in a build pod; instead it is handed off to the Jenkins
+// instance running in the namespace of the build.
+type JenkinsPipelineBuildStrategy struct {
+	// JenkinsfilePath is the path of the Jenkinsfile that will be used to configure the pipeline,
+	// relative to the root of the context (contextDir) of the Git source.
+	JenkinsfilePath string
+
+	// Jenkinsfile defines the optional raw contents of a Jenkinsfile which defines a Jenkins pipeline build.
+	Jenkinsfile string
+}
+
 // BuildOutput is input to a build strategy and describes the Docker image that the strategy
 // should produce.
 type BuildOutput struct {
@@ -429,10 +500,82 @@ type BuildConfigSpec struct {
 	// are defined, a new build can only occur as a result of an explicit client build creation.
 	Triggers []BuildTriggerPolicy
 
+	// RunPolicy describes how the new build created from this build
+	// configuration will be scheduled for execution.
+	// This is optional, if not specified we default to "Parallel".
+	RunPolicy BuildRunPolicy
+
+	// SuccessfulBuildsHistoryLimit is the number of old successful builds to retain.
+	// When a build completes, the oldest successful builds past this limit are
+	// deleted. If not specified, all successful builds are retained.
+	SuccessfulBuildsHistoryLimit *int
+
+	// FailedBuildsHistoryLimit is the number of old failed builds to retain.
+	// Failed, errored and cancelled builds count towards this limit. If not
+	// specified, all failed builds are retained.
+	FailedBuildsHistoryLimit *int
+
+	// CommitStatus configures reporting the progress of the builds back to
+	// the host of their Git source as commit statuses. If not specified, no
+	// statuses are reported.
+	CommitStatus *CommitStatusNotification
+
 	// BuildSpec is the desired build specification
 	BuildSpec
 }
 
+// CommitStatusNotification describes how the status of the builds of a
+// BuildConfig is reported to the host of their Git source. Statuses are set on
+// the commit recorded in the revision of each build.
+type CommitStatusNotification struct {
+	// Type is the kind of host the statuses are reported to.
+	Type CommitStatusHostType
+
+	// APIURL is the base URL of the API of the host. If not specified, the
+	// public API of GitHub is used for GitHub and the API of the host of the
+	// Git source for GitLab.
+	APIURL string
+
+	// Secret references the secret holding the API token under the
+	// CommitStatusTokenKey key.
+	Secret kapi.LocalObjectReference
+}
+
+// CommitStatusHostType is the kind of host commit statuses are reported to.
+type CommitStatusHostType string
+
+const (
+	// CommitStatusHostGitHub reports commit statuses to GitHub.
+	CommitStatusHostGitHub CommitStatusHostType = "GitHub"
+
+	// CommitStatusHostGitLab reports commit statuses to GitLab.
+	CommitStatusHostGitLab CommitStatusHostType = "GitLab"
+
+	// CommitStatusTokenKey is the key of the API token in the commit status
+	// secret.
+	CommitStatusTokenKey = "token"
+)
+
+// BuildRunPolicy defines the behaviour of how the new builds are executed
+// from the existing build configuration.
+type BuildRunPolicy string
+
+const (
+	// BuildRunPolicyParallel schedules new builds immediately after they are
+	// created. Builds will be executed in parallel. This is the default
+	// policy.
+	BuildRunPolicyParallel BuildRunPolicy = "Parallel"
+
+	// BuildRunPolicySerial schedules new builds to execute in a sequence as
+	// they are created. Every build gets queued up and will execute when the
+	// previous build completes.
+	BuildRunPolicySerial BuildRunPolicy = "Serial"
+
+	// BuildRunPolicySerialLatestOnly schedules only the latest build to execute,
+	// cancelling all the previously queued builds.
+	BuildRunPolicySerialLatestOnly BuildRunPolicy = "SerialLatestOnly"
+)
+
 // BuildConfigStatus contains current state of the build config object.
 type BuildConfigStatus struct {
 	// LastVersion is used to inform about number of last triggered build.
@@ -469,6 +612,12 @@ type BuildTriggerPolicy struct {
 	// GenericWebHook contains the parameters for a Generic webhook type of trigger
 	GenericWebHook *WebHookTrigger
 
+	// GitLabWebHook contains the parameters for a GitLab webhook type of trigger
+	GitLabWebHook *WebHookTrigger
+
+	// BitbucketWebHook contains the parameters for a Bitbucket webhook type of trigger
+	BitbucketWebHook *WebHookTrigger
+
 	// ImageChange contains parameters for an ImageChange type of trigger
 	ImageChange *ImageChangeTrigger
 }
@@ -480,6 +629,8 @@ type BuildTriggerType string
 var KnownTriggerTypes = sets.NewString(
 	string(GitHubWebHookBuildTriggerType),
 	string(GenericWebHookBuildTriggerType),
+	string(GitLabWebHookBuildTriggerType),
+	string(BitbucketWebHookBuildTriggerType),
 	string(ImageChangeBuildTriggerType),
 	string(ConfigChangeBuildTriggerType),
 )
@@ -495,6 +646,14 @@ const (
 	GenericWebHookBuildTriggerType           BuildTriggerType = "Generic"
 	GenericWebHookBuildTriggerTypeDeprecated BuildTriggerType = "generic"
 
+	// GitLabWebHookBuildTriggerType represents a trigger that launches builds on
+	// GitLab webhook invocations
+	GitLabWebHookBuildTriggerType BuildTriggerType = "GitLab"
+
+	// BitbucketWebHookBuildTriggerType represents a trigger that launches builds on
+	// Bitbucket webhook invocations
+	BitbucketWebHookBuildTriggerType BuildTriggerType = "Bitbucket"
+
 	// ImageChangeBuildTriggerType represents a trigger that launches builds on
 	// availability of a new version of an image
 	ImageChangeBuildTriggerType           BuildTriggerType = "ImageChange"
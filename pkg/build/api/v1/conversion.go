@@ -151,6 +151,14 @@ func convert_v1_BuildSource_To_api_BuildSource(in *BuildSource, out *newer.Build
 	if err := s.DefaultConvert(in, out, conversion.IgnoreMissingFields); err != nil {
 		return err
 	}
+	// the deprecated single image source is the first of the image sources
+	if in.Image != nil {
+		image := newer.ImageSource{}
+		if err := s.Convert(in.Image, &image, 0); err != nil {
+			return err
+		}
+		out.Images = append([]newer.ImageSource{image}, out.Images...)
+	}
 	return nil
 }
 
@@ -165,6 +173,8 @@ func convert_api_BuildStrategy_To_v1_BuildStrategy(in *newer.BuildStrategy, out
 		out.Type = DockerBuildStrategyType
 	case in.CustomStrategy != nil:
 		out.Type = CustomBuildStrategyType
+	case in.JenkinsPipelineStrategy != nil:
+		out.Type = JenkinsPipelineBuildStrategyType
 	}
 	return nil
 }
@@ -185,6 +195,12 @@ func init() {
 					strategy.DockerStrategy = &DockerBuildStrategy{}
 				}
 			}
+			if (strategy != nil) && (strategy.Type == JenkinsPipelineBuildStrategyType) {
+				//  initialize JenkinsPipelineStrategy to a default state if it's not set.
+				if strategy.JenkinsPipelineStrategy == nil {
+					strategy.JenkinsPipelineStrategy = &JenkinsPipelineBuildStrategy{}
+				}
+			}
 		},
 		func(obj *SourceBuildStrategy) {
 			if len(obj.From.Kind) == 0 {
@@ -206,6 +222,11 @@ func init() {
 				obj.ImageChange = &ImageChangeTrigger{}
 			}
 		},
+		func(obj *BuildConfigSpec) {
+			if len(obj.RunPolicy) == 0 {
+				obj.RunPolicy = BuildRunPolicyParallel
+			}
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -222,3 +222,40 @@ func TestBuildConfigConversion(t *testing.T) {
 		}
 	}
 }
+
+func TestBuildSourceImageConversion(t *testing.T) {
+	source := older.BuildSource{
+		Type: older.BuildSourceImage,
+		Image: &older.ImageSource{
+			From:  kolder.ObjectReference{Kind: "DockerImage", Name: "first"},
+			Paths: []older.ImageSourcePath{{SourcePath: "/first", DestinationDir: "first"}},
+		},
+		Images: []older.ImageSource{
+			{
+				From:  kolder.ObjectReference{Kind: "DockerImage", Name: "second"},
+				Paths: []older.ImageSourcePath{{SourcePath: "/second", DestinationDir: "second"}},
+			},
+		},
+	}
+
+	var internalSource newer.BuildSource
+	if err := Convert(&source, &internalSource); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(internalSource.Images) != 2 {
+		t.Fatalf("expected the deprecated image source to be merged into the image sources, got %#v", internalSource.Images)
+	}
+	for i, expected := range []string{"first", "second"} {
+		if internalSource.Images[i].From.Name != expected {
+			t.Errorf("expected image source %d to be %s, got %s", i, expected, internalSource.Images[i].From.Name)
+		}
+	}
+
+	var versionedSource older.BuildSource
+	if err := Convert(&internalSource, &versionedSource); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if versionedSource.Image != nil || len(versionedSource.Images) != 2 {
+		t.Errorf("expected only the image sources to be set, got %#v", versionedSource)
+	}
+}
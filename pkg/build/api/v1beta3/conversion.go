@@ -102,6 +102,10 @@ func convert_v1beta3_BuildTriggerPolicy_To_api_BuildTriggerPolicy(in *BuildTrigg
 		out.Type = newer.GenericWebHookBuildTriggerType
 	case GitHubWebHookBuildTriggerType:
 		out.Type = newer.GitHubWebHookBuildTriggerType
+	case GitLabWebHookBuildTriggerType:
+		out.Type = newer.GitLabWebHookBuildTriggerType
+	case BitbucketWebHookBuildTriggerType:
+		out.Type = newer.BitbucketWebHookBuildTriggerType
 	}
 	return nil
 }
@@ -117,6 +121,10 @@ func convert_api_BuildTriggerPolicy_To_v1beta3_BuildTriggerPolicy(in *newer.Buil
 		out.Type = GenericWebHookBuildTriggerType
 	case newer.GitHubWebHookBuildTriggerType:
 		out.Type = GitHubWebHookBuildTriggerType
+	case newer.GitLabWebHookBuildTriggerType:
+		out.Type = GitLabWebHookBuildTriggerType
+	case newer.BitbucketWebHookBuildTriggerType:
+		out.Type = BitbucketWebHookBuildTriggerType
 	}
 	return nil
 }
@@ -140,6 +148,14 @@ func convert_v1beta3_BuildSource_To_api_BuildSource(in *BuildSource, out *newer.
 	if err := s.DefaultConvert(in, out, conversion.IgnoreMissingFields); err != nil {
 		return err
 	}
+	// the deprecated single image source is the first of the image sources
+	if in.Image != nil {
+		image := newer.ImageSource{}
+		if err := s.Convert(in.Image, &image, 0); err != nil {
+			return err
+		}
+		out.Images = append([]newer.ImageSource{image}, out.Images...)
+	}
 	return nil
 }
 
@@ -180,6 +196,8 @@ func convert_api_BuildStrategy_To_v1beta3_BuildStrategy(in *newer.BuildStrategy,
 		out.Type = DockerBuildStrategyType
 	case in.CustomStrategy != nil:
 		out.Type = CustomBuildStrategyType
+	case in.JenkinsPipelineStrategy != nil:
+		out.Type = JenkinsPipelineBuildStrategyType
 	}
 	return nil
 }
@@ -192,6 +210,12 @@ func init() {
 					strategy.DockerStrategy = &DockerBuildStrategy{}
 				}
 			}
+			if (strategy != nil) && (strategy.Type == JenkinsPipelineBuildStrategyType) {
+				//  initialize JenkinsPipelineStrategy to a default state if it's not set.
+				if strategy.JenkinsPipelineStrategy == nil {
+					strategy.JenkinsPipelineStrategy = &JenkinsPipelineBuildStrategy{}
+				}
+			}
 		},
 		func(obj *SourceBuildStrategy) {
 			if len(obj.From.Kind) == 0 {
@@ -213,6 +237,11 @@ func init() {
 				obj.ImageChange = &ImageChangeTrigger{}
 			}
 		},
+		func(obj *BuildConfigSpec) {
+			if len(obj.RunPolicy) == 0 {
+				obj.RunPolicy = BuildRunPolicyParallel
+			}
+		},
 	)
 	if err != nil {
 		panic(err)
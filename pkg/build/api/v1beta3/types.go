@@ -156,9 +156,13 @@ type BuildSource struct {
 	// Git contains optional information about git build source.
 	Git *GitBuildSource `json:"git,omitempty"`
 
-	// Image describes an image to be used to provide source for the build
+	// Image describes an image to be used to provide source for the build.
+	// Deprecated: use Images instead. If set, it is merged into Images.
 	Image *ImageSource `json:"image,omitempty" description:"optional image build source"`
 
+	// Images describes a set of images to be used to provide source for the build
+	Images []ImageSource `json:"images,omitempty"`
+
 	// Specify the sub-directory where the source code for the application exists.
 	// This allows to have buildable sources in directory other than root of
 	// repository.
@@ -170,6 +174,28 @@ type BuildSource struct {
 	// data's key represent the authentication method to be used and value is
 	// the base64 encoded credentials. Supported auth methods are: ssh-privatekey.
 	SourceSecret *kapi.LocalObjectReference `json:"sourceSecret,omitempty" description:"supported auth methods are: ssh-privatekey"`
+
+	// Secrets represents a list of secrets and their destinations that will
+	// be used only for the build.
+	Secrets []SecretBuildSource `json:"secrets,omitempty"`
+}
+
+// SecretBuildSource describes a secret and its destination directory that will be
+// used only at the build time. The content of the secret referenced here will
+// be copied into the destination directory instead of mounting.
+type SecretBuildSource struct {
+	// Secret is a reference to an existing secret that you want to use in your
+	// build.
+	Secret kapi.LocalObjectReference `json:"secret"`
+
+	// DestinationDir is the directory where the files from the secret should be
+	// available for the build time.
+	// For the Source build strategy, these will be injected into a container
+	// where the assemble script runs. Later, when the script finishes, all files
+	// injected will be truncated to zero length.
+	// The Docker build strategy does not support build secrets, since the files
+	// a Dockerfile adds remain in the layers of the output image.
+	DestinationDir string `json:"destinationDir,omitempty"`
 }
 
 // ImageSource describes an image that is used as source for the build
@@ -263,6 +289,9 @@ type BuildStrategy struct {
 
 	// CustomStrategy holds the parameters to the Custom build strategy
 	CustomStrategy *CustomBuildStrategy `json:"customStrategy,omitempty"`
+
+	// JenkinsPipelineStrategy holds the parameters to the Jenkins Pipeline build strategy.
+	JenkinsPipelineStrategy *JenkinsPipelineBuildStrategy `json:"jenkinsPipelineStrategy,omitempty"`
 }
 
 // BuildStrategyType describes a particular way of performing a build.
@@ -279,6 +308,9 @@ const (
 
 	// CustomBuildStrategyType performs builds using custom builder Docker image.
 	CustomBuildStrategyType BuildStrategyType = "Custom"
+
+	// JenkinsPipelineBuildStrategyType performs builds using a Jenkins Pipeline.
+	JenkinsPipelineBuildStrategyType BuildStrategyType = "JenkinsPipeline"
 )
 
 // CustomBuildStrategy defines input parameters specific to Custom build.
@@ -333,6 +365,19 @@ type DockerBuildStrategy struct {
 	// DockerfilePath is the path of the Dockerfile that will be used to build the Docker image,
 	// relative to the root of the context (contextDir).
 	DockerfilePath string `json:"dockerfilePath,omitempty" description:"path of the Dockerfile to use for building the Docker image, relative to the contextDir, if set"`
+
+	// BuildCache describes directories of the built image, such as dependency caches, whose
+	// content is saved after a successful build and restored before the next build runs.
+	BuildCache *DockerBuildCache `json:"buildCache,omitempty"`
+}
+
+// DockerBuildCache describes directories kept between Docker builds in a persistent volume.
+type DockerBuildCache struct {
+	// PersistentVolumeClaim is the claim of the volume the directories are saved in.
+	PersistentVolumeClaim kapi.LocalObjectReference `json:"persistentVolumeClaim"`
+
+	// Paths are the absolute paths of the directories to keep, for example /root/.m2.
+	Paths []string `json:"paths"`
 }
 
 // SourceBuildStrategy defines input parameters specific to an Source build.
@@ -359,6 +404,18 @@ type SourceBuildStrategy struct {
 	ForcePull bool `json:"forcePull,omitempty" description:"forces the source build to pull the image if true"`
 }
 
+// JenkinsPipelineBuildStrategy holds parameters specific to a Jenkins Pipeline build.
+// The build is not run in a build pod; instead it is handed off to the Jenkins
+// instance running in the namespace of the build.
+type JenkinsPipelineBuildStrategy struct {
+	// JenkinsfilePath is the path of the Jenkinsfile that will be used to configure the pipeline,
+	// relative to the root of the context (contextDir) of the Git source.
+	JenkinsfilePath string `json:"jenkinsfilePath,omitempty"`
+
+	// Jenkinsfile defines the optional raw contents of a Jenkinsfile which defines a Jenkins pipeline build.
+	Jenkinsfile string `json:"jenkinsfile,omitempty"`
+}
+
 // BuildOutput is input to a build strategy and describes the Docker image that the strategy
 // should produce.
 type BuildOutput struct {
@@ -392,9 +449,77 @@ type BuildConfigSpec struct {
 	// are defined, a new build can only occur as a result of an explicit client build creation.
 	Triggers []BuildTriggerPolicy `json:"triggers"`
 
+	// RunPolicy describes how the new build created from this build
+	// configuration will be scheduled for execution.
+	// This is optional, if not specified we default to "Parallel".
+	RunPolicy BuildRunPolicy `json:"runPolicy,omitempty"`
+
+	// SuccessfulBuildsHistoryLimit is the number of old successful builds to retain.
+	// When a build completes, the oldest successful builds past this limit are
+	// deleted. If not specified, all successful builds are retained.
+	SuccessfulBuildsHistoryLimit *int `json:"successfulBuildsHistoryLimit,omitempty"`
+
+	// FailedBuildsHistoryLimit is the number of old failed builds to retain.
+	// Failed, errored and cancelled builds count towards this limit. If not
+	// specified, all failed builds are retained.
+	FailedBuildsHistoryLimit *int `json:"failedBuildsHistoryLimit,omitempty"`
+
+	// CommitStatus configures reporting the progress of the builds back to
+	// the host of their Git source as commit statuses. If not specified, no
+	// statuses are reported.
+	CommitStatus *CommitStatusNotification `json:"commitStatus,omitempty"`
+
 	BuildSpec `json:",inline"`
 }
 
+// CommitStatusNotification describes how the status of the builds of a
+// BuildConfig is reported to the host of their Git source. Statuses are set on
+// the commit recorded in the revision of each build.
+type CommitStatusNotification struct {
+	// Type is the kind of host the statuses are reported to.
+	Type CommitStatusHostType `json:"type"`
+
+	// APIURL is the base URL of the API of the host. If not specified, the
+	// public API of GitHub is used for GitHub and the API of the host of the
+	// Git source for GitLab.
+	APIURL string `json:"apiURL,omitempty"`
+
+	// Secret references the secret holding the API token under the "token"
+	// key.
+	Secret kapi.LocalObjectReference `json:"secret"`
+}
+
+// CommitStatusHostType is the kind of host commit statuses are reported to.
+type CommitStatusHostType string
+
+const (
+	// CommitStatusHostGitHub reports commit statuses to GitHub.
+	CommitStatusHostGitHub CommitStatusHostType = "GitHub"
+
+	// CommitStatusHostGitLab reports commit statuses to GitLab.
+	CommitStatusHostGitLab CommitStatusHostType = "GitLab"
+)
+
+// BuildRunPolicy defines the behaviour of how the new builds are executed
+// from the existing build configuration.
+type BuildRunPolicy string
+
+const (
+	// BuildRunPolicyParallel schedules new builds immediately after they are
+	// created. Builds will be executed in parallel. This is the default
+	// policy.
+	BuildRunPolicyParallel BuildRunPolicy = "Parallel"
+
+	// BuildRunPolicySerial schedules new builds to execute in a sequence as
+	// they are created. Every build gets queued up and will execute when the
+	// previous build completes.
+	BuildRunPolicySerial BuildRunPolicy = "Serial"
+
+	// BuildRunPolicySerialLatestOnly schedules only the latest build to execute,
+	// cancelling all the previously queued builds.
+	BuildRunPolicySerialLatestOnly BuildRunPolicy = "SerialLatestOnly"
+)
+
 // BuildConfigStatus contains current state of the build config object.
 type BuildConfigStatus struct {
 	// LastVersion is used to inform about number of last triggered build.
@@ -431,6 +556,12 @@ type BuildTriggerPolicy struct {
 	// GenericWebHook contains the parameters for a Generic webhook type of trigger
 	GenericWebHook *WebHookTrigger `json:"generic,omitempty"`
 
+	// GitLabWebHook contains the parameters for a GitLab webhook type of trigger
+	GitLabWebHook *WebHookTrigger `json:"gitlab,omitempty"`
+
+	// BitbucketWebHook contains the parameters for a Bitbucket webhook type of trigger
+	BitbucketWebHook *WebHookTrigger `json:"bitbucket,omitempty"`
+
 	// ImageChange contains parameters for an ImageChange type of trigger
 	ImageChange *ImageChangeTrigger `json:"imageChange,omitempty"`
 }
@@ -447,6 +578,14 @@ const (
 	// generic webhook invocations
 	GenericWebHookBuildTriggerType BuildTriggerType = "generic"
 
+	// GitLabWebHookBuildTriggerType represents a trigger that launches builds on
+	// GitLab webhook invocations
+	GitLabWebHookBuildTriggerType BuildTriggerType = "gitlab"
+
+	// BitbucketWebHookBuildTriggerType represents a trigger that launches builds on
+	// Bitbucket webhook invocations
+	BitbucketWebHookBuildTriggerType BuildTriggerType = "bitbucket"
+
 	// ImageChangeBuildTriggerType represents a trigger that launches builds on
 	// availability of a new version of an image
 	ImageChangeBuildTriggerType BuildTriggerType = "imageChange"
@@ -9,6 +9,7 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/validation"
 	"k8s.io/kubernetes/pkg/util/fielderrors"
+	"k8s.io/kubernetes/pkg/util/sets"
 	kvalidation "k8s.io/kubernetes/pkg/util/validation"
 
 	oapi "github.com/openshift/origin/pkg/api"
@@ -79,6 +80,16 @@ func ValidateBuildConfig(config *buildapi.BuildConfig) fielderrors.ValidationErr
 	}
 
 	allErrs = append(allErrs, validateBuildSpec(&config.Spec.BuildSpec).Prefix("spec")...)
+	allErrs = append(allErrs, validateRunPolicy(config.Spec.RunPolicy).Prefix("spec")...)
+	if config.Spec.SuccessfulBuildsHistoryLimit != nil && *config.Spec.SuccessfulBuildsHistoryLimit < 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("spec.successfulBuildsHistoryLimit", *config.Spec.SuccessfulBuildsHistoryLimit, "must be greater than or equal to 0"))
+	}
+	if config.Spec.FailedBuildsHistoryLimit != nil && *config.Spec.FailedBuildsHistoryLimit < 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("spec.failedBuildsHistoryLimit", *config.Spec.FailedBuildsHistoryLimit, "must be greater than or equal to 0"))
+	}
+	if config.Spec.CommitStatus != nil {
+		allErrs = append(allErrs, validateCommitStatus(config.Spec.CommitStatus, &config.Spec.Source).Prefix("spec.commitStatus")...)
+	}
 
 	// validate ImageChangeTriggers of DockerStrategy builds
 	strategy := config.Spec.BuildSpec.Strategy
@@ -101,6 +112,22 @@ func ValidateBuildConfigUpdate(config *buildapi.BuildConfig, older *buildapi.Bui
 	return allErrs
 }
 
+// validateRunPolicy ensures the run policy, when set, is one of the policies
+// known to the build controller.
+func validateRunPolicy(policy buildapi.BuildRunPolicy) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	switch policy {
+	case "", buildapi.BuildRunPolicyParallel, buildapi.BuildRunPolicySerial, buildapi.BuildRunPolicySerialLatestOnly:
+	default:
+		allErrs = append(allErrs, fielderrors.NewFieldValueNotSupported("runPolicy", policy, []string{
+			string(buildapi.BuildRunPolicyParallel),
+			string(buildapi.BuildRunPolicySerial),
+			string(buildapi.BuildRunPolicySerialLatestOnly),
+		}))
+	}
+	return allErrs
+}
+
 // ValidateBuildRequest validates a BuildRequest object
 func ValidateBuildRequest(request *buildapi.BuildRequest) fielderrors.ValidationErrorList {
 	allErrs := fielderrors.ValidationErrorList{}
@@ -113,9 +140,17 @@ func validateBuildSpec(spec *buildapi.BuildSpec) fielderrors.ValidationErrorList
 	allErrs := fielderrors.ValidationErrorList{}
 	s := spec.Strategy
 
-	if s.CustomStrategy == nil && spec.Source.Git == nil && spec.Source.Binary == nil && spec.Source.Dockerfile == nil {
+	if s.CustomStrategy == nil && s.JenkinsPipelineStrategy == nil && spec.Source.Git == nil && spec.Source.Binary == nil && spec.Source.Dockerfile == nil {
 		allErrs = append(allErrs, fielderrors.NewFieldInvalid("source", spec.Source, "must provide a value for at least one of source, binary, or dockerfile"))
 	}
+	if s.JenkinsPipelineStrategy != nil && len(s.JenkinsPipelineStrategy.Jenkinsfile) == 0 && spec.Source.Git == nil {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("source.git", spec.Source.Git, "must be provided when the Jenkinsfile of a Jenkins Pipeline build is not specified inline"))
+	}
+	// A Dockerfile can only read the build secrets by adding them to a layer,
+	// which would leave them in the output image.
+	if s.DockerStrategy != nil && len(spec.Source.Secrets) > 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("source.secrets", "", "secrets are not supported by the Docker strategy, they would remain in the output image"))
+	}
 
 	allErrs = append(allErrs, validateSource(&spec.Source, s.CustomStrategy != nil).Prefix("source")...)
 
@@ -133,6 +168,7 @@ func validateBuildSpec(spec *buildapi.BuildSpec) fielderrors.ValidationErrorList
 }
 
 const maxDockerfileLengthBytes = 60 * 1000
+const maxJenkinsfileLengthBytes = 100 * 1000
 
 func hasProxy(source *buildapi.GitBuildSource) bool {
 	return len(source.HTTPProxy) > 0 || len(source.HTTPSProxy) > 0
@@ -158,11 +194,12 @@ func validateSource(input *buildapi.BuildSource, isCustomStrategy bool) fielderr
 	if input.Dockerfile != nil {
 		allErrs = append(allErrs, validateDockerfile(*input.Dockerfile)...)
 	}
-	if input.Image != nil {
-		allErrs = append(allErrs, validateImageSource(input.Image).Prefix("image")...)
+	for i := range input.Images {
+		allErrs = append(allErrs, validateImageSource(&input.Images[i]).PrefixIndex(i).Prefix("images")...)
 	}
 
 	allErrs = append(allErrs, validateSecretRef(input.SourceSecret).Prefix("sourceSecret")...)
+	allErrs = append(allErrs, validateSecrets(input.Secrets).Prefix("secrets")...)
 
 	if len(input.ContextDir) != 0 {
 		cleaned := path.Clean(input.ContextDir)
@@ -198,6 +235,32 @@ func validateSecretRef(ref *kapi.LocalObjectReference) fielderrors.ValidationErr
 	return allErrs
 }
 
+// validateCommitStatus ensures the commit statuses can be reported to a known
+// kind of host, which requires the builds to have a Git source.
+func validateCommitStatus(status *buildapi.CommitStatusNotification, source *buildapi.BuildSource) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	switch status.Type {
+	case buildapi.CommitStatusHostGitHub, buildapi.CommitStatusHostGitLab:
+	case "":
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("type"))
+	default:
+		allErrs = append(allErrs, fielderrors.NewFieldValueNotSupported("type", status.Type, []string{
+			string(buildapi.CommitStatusHostGitHub),
+			string(buildapi.CommitStatusHostGitLab),
+		}))
+	}
+	if len(status.APIURL) != 0 && !isHTTPScheme(status.APIURL) {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("apiURL", status.APIURL, "must be an http or https URL"))
+	}
+	if len(status.Secret.Name) == 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("secret.name"))
+	}
+	if source.Git == nil {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("type", status.Type, "commit statuses can only be reported for builds with a Git source"))
+	}
+	return allErrs
+}
+
 func isHTTPScheme(in string) bool {
 	u, err := url.Parse(in)
 	if err != nil {
@@ -237,6 +300,29 @@ func validateImageSource(imageSource *buildapi.ImageSource) fielderrors.Validati
 	return allErrs
 }
 
+func validateSecrets(secrets []buildapi.SecretBuildSource) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	names := sets.NewString()
+	for i, secret := range secrets {
+		errs := fielderrors.ValidationErrorList{}
+		if len(secret.Secret.Name) == 0 {
+			errs = append(errs, fielderrors.NewFieldRequired("secret.name"))
+		} else if names.Has(secret.Secret.Name) {
+			errs = append(errs, fielderrors.NewFieldDuplicate("secret.name", secret.Secret.Name))
+		} else {
+			names.Insert(secret.Secret.Name)
+		}
+		if len(secret.DestinationDir) != 0 {
+			cleaned := path.Clean(secret.DestinationDir)
+			if path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+				errs = append(errs, fielderrors.NewFieldInvalid("destinationDir", secret.DestinationDir, "destination dir must be a relative path within the build directory"))
+			}
+		}
+		allErrs = append(allErrs, errs.PrefixIndex(i)...)
+	}
+	return allErrs
+}
+
 func validateBinarySource(source *buildapi.BinaryBuildSource) fielderrors.ValidationErrorList {
 	allErrs := fielderrors.ValidationErrorList{}
 	if len(source.AsFile) != 0 {
@@ -346,8 +432,11 @@ func validateStrategy(strategy *buildapi.BuildStrategy) fielderrors.ValidationEr
 	if strategy.CustomStrategy != nil {
 		strategyCount++
 	}
+	if strategy.JenkinsPipelineStrategy != nil {
+		strategyCount++
+	}
 	if strategyCount != 1 {
-		return append(allErrs, fielderrors.NewFieldInvalid("", strategy, "must provide a value for exactly one of sourceStrategy, customStrategy, or dockerStrategy"))
+		return append(allErrs, fielderrors.NewFieldInvalid("", strategy, "must provide a value for exactly one of sourceStrategy, customStrategy, dockerStrategy, or jenkinsPipelineStrategy"))
 	}
 
 	if strategy.SourceStrategy != nil {
@@ -359,6 +448,9 @@ func validateStrategy(strategy *buildapi.BuildStrategy) fielderrors.ValidationEr
 	if strategy.CustomStrategy != nil {
 		allErrs = append(allErrs, validateCustomStrategy(strategy.CustomStrategy).Prefix("customStrategy")...)
 	}
+	if strategy.JenkinsPipelineStrategy != nil {
+		allErrs = append(allErrs, validateJenkinsPipelineStrategy(strategy.JenkinsPipelineStrategy).Prefix("jenkinsPipelineStrategy")...)
+	}
 
 	return allErrs
 }
@@ -387,6 +479,40 @@ func validateDockerStrategy(strategy *buildapi.DockerBuildStrategy) fielderrors.
 		}
 	}
 
+	if strategy.BuildCache != nil {
+		allErrs = append(allErrs, validateDockerBuildCache(strategy.BuildCache).Prefix("buildCache")...)
+	}
+
+	return allErrs
+}
+
+// validateDockerBuildCache ensures the cached directories are distinct
+// absolute paths other than the root directory.
+func validateDockerBuildCache(cache *buildapi.DockerBuildCache) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+	if len(cache.PersistentVolumeClaim.Name) == 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("persistentVolumeClaim.name"))
+	}
+	if len(cache.Paths) == 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldRequired("paths"))
+	}
+	seen := sets.NewString()
+	for i, p := range cache.Paths {
+		field := fmt.Sprintf("paths[%d]", i)
+		cleaned := path.Clean(p)
+		switch {
+		case len(p) == 0:
+			allErrs = append(allErrs, fielderrors.NewFieldRequired(field))
+		case !path.IsAbs(cleaned):
+			allErrs = append(allErrs, fielderrors.NewFieldInvalid(field, p, "must be an absolute path"))
+		case cleaned == "/":
+			allErrs = append(allErrs, fielderrors.NewFieldInvalid(field, p, "must not be the root directory"))
+		case seen.Has(cleaned):
+			allErrs = append(allErrs, fielderrors.NewFieldDuplicate(field, p))
+		default:
+			seen.Insert(cleaned)
+		}
+	}
 	return allErrs
 }
 
@@ -404,6 +530,33 @@ func validateCustomStrategy(strategy *buildapi.CustomBuildStrategy) fielderrors.
 	return allErrs
 }
 
+func validateJenkinsPipelineStrategy(strategy *buildapi.JenkinsPipelineBuildStrategy) fielderrors.ValidationErrorList {
+	allErrs := fielderrors.ValidationErrorList{}
+
+	if len(strategy.JenkinsfilePath) != 0 && len(strategy.Jenkinsfile) != 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("jenkinsfilePath", strategy.JenkinsfilePath, "only one of jenkinsfilePath or jenkinsfile may be specified"))
+	}
+	if len(strategy.Jenkinsfile) > maxJenkinsfileLengthBytes {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("jenkinsfile", "", fmt.Sprintf("must be smaller than %d bytes", maxJenkinsfileLengthBytes)))
+	}
+
+	if len(strategy.JenkinsfilePath) != 0 {
+		cleaned := path.Clean(strategy.JenkinsfilePath)
+		switch {
+		case strings.HasPrefix(cleaned, "/"):
+			allErrs = append(allErrs, fielderrors.NewFieldInvalid("jenkinsfilePath", strategy.JenkinsfilePath, "jenkinsfilePath must not be an absolute path"))
+		case strings.HasPrefix(cleaned, ".."):
+			allErrs = append(allErrs, fielderrors.NewFieldInvalid("jenkinsfilePath", strategy.JenkinsfilePath, "jenkinsfilePath must not start with .."))
+		case cleaned == ".":
+			allErrs = append(allErrs, fielderrors.NewFieldInvalid("jenkinsfilePath", strategy.JenkinsfilePath, "jenkinsfilePath must point to a file"))
+		default:
+			strategy.JenkinsfilePath = cleaned
+		}
+	}
+
+	return allErrs
+}
+
 func validateTrigger(trigger *buildapi.BuildTriggerPolicy) fielderrors.ValidationErrorList {
 	allErrs := fielderrors.ValidationErrorList{}
 	if len(trigger.Type) == 0 {
@@ -425,6 +578,18 @@ func validateTrigger(trigger *buildapi.BuildTriggerPolicy) fielderrors.Validatio
 		} else {
 			allErrs = append(allErrs, validateWebHook(trigger.GenericWebHook).Prefix("generic")...)
 		}
+	case buildapi.GitLabWebHookBuildTriggerType:
+		if trigger.GitLabWebHook == nil {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("gitlab"))
+		} else {
+			allErrs = append(allErrs, validateWebHook(trigger.GitLabWebHook).Prefix("gitlab")...)
+		}
+	case buildapi.BitbucketWebHookBuildTriggerType:
+		if trigger.BitbucketWebHook == nil {
+			allErrs = append(allErrs, fielderrors.NewFieldRequired("bitbucket"))
+		} else {
+			allErrs = append(allErrs, validateWebHook(trigger.BitbucketWebHook).Prefix("bitbucket")...)
+		}
 	case buildapi.ImageChangeBuildTriggerType:
 		if trigger.ImageChange == nil {
 			allErrs = append(allErrs, fielderrors.NewFieldRequired("imageChange"))
@@ -1,6 +1,7 @@
 package validation
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 
@@ -328,6 +329,61 @@ func TestBuildConfigValidationFailureRequiredName(t *testing.T) {
 	}
 }
 
+func TestBuildConfigValidationRunPolicy(t *testing.T) {
+	tests := []struct {
+		policy      buildapi.BuildRunPolicy
+		expectError bool
+	}{
+		{policy: ""},
+		{policy: buildapi.BuildRunPolicyParallel},
+		{policy: buildapi.BuildRunPolicySerial},
+		{policy: buildapi.BuildRunPolicySerialLatestOnly},
+		{policy: "Unknown", expectError: true},
+	}
+	for _, tc := range tests {
+		buildConfig := &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+			Spec: buildapi.BuildConfigSpec{
+				RunPolicy: tc.policy,
+				BuildSpec: buildapi.BuildSpec{
+					Source: buildapi.BuildSource{
+						Git: &buildapi.GitBuildSource{
+							URI: "http://github.com/my/repository",
+						},
+					},
+					Strategy: buildapi.BuildStrategy{
+						DockerStrategy: &buildapi.DockerBuildStrategy{},
+					},
+					Output: buildapi.BuildOutput{
+						To: &kapi.ObjectReference{
+							Kind: "DockerImage",
+							Name: "repository/data",
+						},
+					},
+				},
+			},
+		}
+		errors := ValidateBuildConfig(buildConfig)
+		if !tc.expectError {
+			if len(errors) != 0 {
+				t.Errorf("%q: unexpected validation errors %v", tc.policy, errors)
+			}
+			continue
+		}
+		if len(errors) != 1 {
+			t.Errorf("%q: expected a single validation error, got %v", tc.policy, errors)
+			continue
+		}
+		err := errors[0].(*fielderrors.ValidationError)
+		if err.Type != fielderrors.ValidationErrorTypeNotSupported {
+			t.Errorf("%q: unexpected error type, expected %s, got %s", tc.policy, fielderrors.ValidationErrorTypeNotSupported, err.Type)
+		}
+		if err.Field != "spec.runPolicy" {
+			t.Errorf("%q: unexpected field name expected spec.runPolicy, got %s", tc.policy, err.Field)
+		}
+	}
+}
+
 func TestBuildConfigImageChangeTriggers(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -785,15 +841,17 @@ func TestValidateSource(t *testing.T) {
 		{
 			ok: true,
 			source: &buildapi.BuildSource{
-				Image: &buildapi.ImageSource{
-					From: kapi.ObjectReference{
-						Kind: "ImageStreamTag",
-						Name: "my-image:latest",
-					},
-					Paths: []buildapi.ImageSourcePath{
-						{
-							SourcePath:     "/some/path",
-							DestinationDir: "test/dir",
+				Images: []buildapi.ImageSource{
+					{
+						From: kapi.ObjectReference{
+							Kind: "ImageStreamTag",
+							Name: "my-image:latest",
+						},
+						Paths: []buildapi.ImageSourcePath{
+							{
+								SourcePath:     "/some/path",
+								DestinationDir: "test/dir",
+							},
 						},
 					},
 				},
@@ -802,12 +860,14 @@ func TestValidateSource(t *testing.T) {
 		// 16
 		{
 			t:    fielderrors.ValidationErrorTypeRequired,
-			path: "image.paths",
+			path: "images[0].paths",
 			source: &buildapi.BuildSource{
-				Image: &buildapi.ImageSource{
-					From: kapi.ObjectReference{
-						Kind: "ImageStreamTag",
-						Name: "my-image:latest",
+				Images: []buildapi.ImageSource{
+					{
+						From: kapi.ObjectReference{
+							Kind: "ImageStreamTag",
+							Name: "my-image:latest",
+						},
 					},
 				},
 			},
@@ -815,17 +875,19 @@ func TestValidateSource(t *testing.T) {
 		// 17
 		{
 			t:    fielderrors.ValidationErrorTypeInvalid,
-			path: "image.from.kind",
+			path: "images[0].from.kind",
 			source: &buildapi.BuildSource{
-				Image: &buildapi.ImageSource{
-					From: kapi.ObjectReference{
-						Kind: "InvalidKind",
-						Name: "my-image:latest",
-					},
-					Paths: []buildapi.ImageSourcePath{
-						{
-							SourcePath:     "/some/path",
-							DestinationDir: "test/dir",
+				Images: []buildapi.ImageSource{
+					{
+						From: kapi.ObjectReference{
+							Kind: "InvalidKind",
+							Name: "my-image:latest",
+						},
+						Paths: []buildapi.ImageSourcePath{
+							{
+								SourcePath:     "/some/path",
+								DestinationDir: "test/dir",
+							},
 						},
 					},
 				},
@@ -834,25 +896,78 @@ func TestValidateSource(t *testing.T) {
 		// 18
 		{
 			t:    fielderrors.ValidationErrorTypeRequired,
-			path: "image.pullSecret.name",
+			path: "images[0].pullSecret.name",
 			source: &buildapi.BuildSource{
-				Image: &buildapi.ImageSource{
-					From: kapi.ObjectReference{
-						Kind: "DockerImage",
-						Name: "my-image:latest",
-					},
-					PullSecret: &kapi.LocalObjectReference{
-						Name: "",
-					},
-					Paths: []buildapi.ImageSourcePath{
-						{
-							SourcePath:     "/some/path",
-							DestinationDir: "test/dir",
+				Images: []buildapi.ImageSource{
+					{
+						From: kapi.ObjectReference{
+							Kind: "DockerImage",
+							Name: "my-image:latest",
+						},
+						PullSecret: &kapi.LocalObjectReference{
+							Name: "",
+						},
+						Paths: []buildapi.ImageSourcePath{
+							{
+								SourcePath:     "/some/path",
+								DestinationDir: "test/dir",
+							},
 						},
 					},
 				},
 			},
 		},
+		// 19
+		{
+			ok: true,
+			source: &buildapi.BuildSource{
+				Secrets: []buildapi.SecretBuildSource{
+					{Secret: kapi.LocalObjectReference{Name: "maven-settings"}, DestinationDir: "./.m2"},
+					{Secret: kapi.LocalObjectReference{Name: "npmrc"}},
+				},
+			},
+		},
+		// 20
+		{
+			t:    fielderrors.ValidationErrorTypeRequired,
+			path: "secrets[0].secret.name",
+			source: &buildapi.BuildSource{
+				Secrets: []buildapi.SecretBuildSource{
+					{DestinationDir: ".m2"},
+				},
+			},
+		},
+		// 21
+		{
+			t:    fielderrors.ValidationErrorTypeDuplicate,
+			path: "secrets[1].secret.name",
+			source: &buildapi.BuildSource{
+				Secrets: []buildapi.SecretBuildSource{
+					{Secret: kapi.LocalObjectReference{Name: "npmrc"}},
+					{Secret: kapi.LocalObjectReference{Name: "npmrc"}, DestinationDir: "app"},
+				},
+			},
+		},
+		// 22
+		{
+			t:    fielderrors.ValidationErrorTypeInvalid,
+			path: "secrets[0].destinationDir",
+			source: &buildapi.BuildSource{
+				Secrets: []buildapi.SecretBuildSource{
+					{Secret: kapi.LocalObjectReference{Name: "npmrc"}, DestinationDir: "app/../../etc"},
+				},
+			},
+		},
+		// 23
+		{
+			t:    fielderrors.ValidationErrorTypeInvalid,
+			path: "secrets[0].destinationDir",
+			source: &buildapi.BuildSource{
+				Secrets: []buildapi.SecretBuildSource{
+					{Secret: kapi.LocalObjectReference{Name: "npmrc"}, DestinationDir: "/etc"},
+				},
+			},
+		},
 	}
 	for i, tc := range errorCases {
 		errors := validateSource(tc.source, false)
@@ -887,6 +1002,9 @@ func TestValidateSource(t *testing.T) {
 	if len(errorCases[11].source.ContextDir) != 0 {
 		t.Errorf("ContextDir was not cleaned: %s", errorCases[11].source.ContextDir)
 	}
+	if dir := errorCases[19].source.Secrets[0].DestinationDir; dir != "./.m2" {
+		t.Errorf("DestinationDir was changed by the validation: %s", dir)
+	}
 }
 
 func TestValidateStrategy(t *testing.T) {
@@ -1514,6 +1632,206 @@ func TestValidateDockerfilePath(t *testing.T) {
 	}
 }
 
+func TestValidateDockerBuildCache(t *testing.T) {
+	claim := kapi.LocalObjectReference{Name: "cache"}
+	tests := []struct {
+		cache          *buildapi.DockerBuildCache
+		expectedPaths  []string
+		expectedErrors []string
+	}{
+		{
+			cache:         &buildapi.DockerBuildCache{PersistentVolumeClaim: claim, Paths: []string{"/root/.m2/", "/opt/app/../node_modules"}},
+			expectedPaths: []string{"/root/.m2/", "/opt/app/../node_modules"},
+		},
+		{
+			cache:          &buildapi.DockerBuildCache{Paths: []string{"/root/.m2"}},
+			expectedPaths:  []string{"/root/.m2"},
+			expectedErrors: []string{"buildCache.persistentVolumeClaim.name"},
+		},
+		{
+			cache:          &buildapi.DockerBuildCache{PersistentVolumeClaim: claim},
+			expectedErrors: []string{"buildCache.paths"},
+		},
+		{
+			cache:          &buildapi.DockerBuildCache{PersistentVolumeClaim: claim, Paths: []string{"", "node_modules", "/", "/root/.m2", "/root/.m2/"}},
+			expectedPaths:  []string{"", "node_modules", "/", "/root/.m2", "/root/.m2/"},
+			expectedErrors: []string{"buildCache.paths[0]", "buildCache.paths[1]", "buildCache.paths[2]", "buildCache.paths[4]"},
+		},
+	}
+
+	for count, test := range tests {
+		errors := validateDockerStrategy(&buildapi.DockerBuildStrategy{BuildCache: test.cache})
+		fields := []string{}
+		for _, err := range errors {
+			fields = append(fields, err.(*fielderrors.ValidationError).Field)
+		}
+		if len(fields) != len(test.expectedErrors) || (len(fields) > 0 && !reflect.DeepEqual(fields, test.expectedErrors)) {
+			t.Errorf("Test[%d] Expected errors for %v, got: %v", count, test.expectedErrors, errors)
+		}
+		if len(test.cache.Paths) != len(test.expectedPaths) || (len(test.expectedPaths) > 0 && !reflect.DeepEqual(test.cache.Paths, test.expectedPaths)) {
+			t.Errorf("Test[%d] Paths changed by the validation: %v (expected: %v)", count, test.cache.Paths, test.expectedPaths)
+		}
+	}
+}
+
+func TestValidateJenkinsPipelineStrategy(t *testing.T) {
+	tests := []struct {
+		strategy                *buildapi.JenkinsPipelineBuildStrategy
+		expectedJenkinsfilePath string
+		expectedErrors          int
+	}{
+		{
+			strategy: &buildapi.JenkinsPipelineBuildStrategy{},
+		},
+		{
+			strategy: &buildapi.JenkinsPipelineBuildStrategy{
+				JenkinsfilePath: "somedir/../Jenkinsfile",
+			},
+			expectedJenkinsfilePath: "Jenkinsfile",
+		},
+		{
+			strategy: &buildapi.JenkinsPipelineBuildStrategy{
+				Jenkinsfile: "node { echo 'hello' }",
+			},
+		},
+		{
+			strategy: &buildapi.JenkinsPipelineBuildStrategy{
+				JenkinsfilePath: "Jenkinsfile",
+				Jenkinsfile:     "node { echo 'hello' }",
+			},
+			expectedJenkinsfilePath: "Jenkinsfile",
+			expectedErrors:          1,
+		},
+		{
+			strategy: &buildapi.JenkinsPipelineBuildStrategy{
+				JenkinsfilePath: "/Jenkinsfile",
+			},
+			expectedJenkinsfilePath: "/Jenkinsfile",
+			expectedErrors:          1,
+		},
+		{
+			strategy: &buildapi.JenkinsPipelineBuildStrategy{
+				JenkinsfilePath: "../Jenkinsfile",
+			},
+			expectedJenkinsfilePath: "../Jenkinsfile",
+			expectedErrors:          1,
+		},
+		{
+			strategy: &buildapi.JenkinsPipelineBuildStrategy{
+				JenkinsfilePath: "somedir/..",
+			},
+			expectedJenkinsfilePath: "somedir/..",
+			expectedErrors:          1,
+		},
+	}
+
+	for count, test := range tests {
+		errors := validateJenkinsPipelineStrategy(test.strategy)
+		if len(errors) != test.expectedErrors {
+			t.Errorf("Test[%d] Expected %d validation errors, got: %v", count, test.expectedErrors, errors)
+		}
+		if test.strategy.JenkinsfilePath != test.expectedJenkinsfilePath {
+			t.Errorf("Test[%d] Unexpected JenkinsfilePath: %v (expected: %s)", count, test.strategy.JenkinsfilePath, test.expectedJenkinsfilePath)
+		}
+	}
+}
+
+func TestValidateBuildSpecJenkinsPipelineSource(t *testing.T) {
+	tests := []struct {
+		spec        *buildapi.BuildSpec
+		expectError bool
+	}{
+		{
+			spec: &buildapi.BuildSpec{
+				Strategy: buildapi.BuildStrategy{
+					JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{
+						Jenkinsfile: "node { echo 'hello' }",
+					},
+				},
+			},
+		},
+		{
+			spec: &buildapi.BuildSpec{
+				Source: buildapi.BuildSource{
+					Git: &buildapi.GitBuildSource{
+						URI: "http://github.com/my/repository",
+					},
+				},
+				Strategy: buildapi.BuildStrategy{
+					JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{
+						JenkinsfilePath: "Jenkinsfile",
+					},
+				},
+			},
+		},
+		{
+			spec: &buildapi.BuildSpec{
+				Strategy: buildapi.BuildStrategy{
+					JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{
+						JenkinsfilePath: "Jenkinsfile",
+					},
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for count, test := range tests {
+		errors := validateBuildSpec(test.spec)
+		if test.expectError && len(errors) == 0 {
+			t.Errorf("Test[%d] Expected a validation error", count)
+		}
+		if !test.expectError && len(errors) != 0 {
+			t.Errorf("Test[%d] Unexpected validation errors: %v", count, errors)
+		}
+	}
+}
+
+func TestValidateBuildSpecSecrets(t *testing.T) {
+	source := buildapi.BuildSource{
+		Git: &buildapi.GitBuildSource{
+			URI: "http://github.com/my/repository",
+		},
+		Secrets: []buildapi.SecretBuildSource{
+			{Secret: kapi.LocalObjectReference{Name: "maven-settings"}, DestinationDir: ".m2"},
+		},
+	}
+	output := buildapi.BuildOutput{
+		To: &kapi.ObjectReference{Kind: "DockerImage", Name: "repository/data"},
+	}
+	tests := []struct {
+		strategy    buildapi.BuildStrategy
+		expectError bool
+	}{
+		{
+			strategy: buildapi.BuildStrategy{
+				SourceStrategy: &buildapi.SourceBuildStrategy{
+					From: kapi.ObjectReference{Kind: "DockerImage", Name: "builder/image"},
+				},
+			},
+		},
+		{
+			strategy: buildapi.BuildStrategy{
+				DockerStrategy: &buildapi.DockerBuildStrategy{},
+			},
+			expectError: true,
+		},
+	}
+
+	for count, test := range tests {
+		errors := validateBuildSpec(&buildapi.BuildSpec{Source: source, Strategy: test.strategy, Output: output})
+		if test.expectError {
+			if len(errors) != 1 || errors[0].(*fielderrors.ValidationError).Field != "source.secrets" {
+				t.Errorf("Test[%d] Expected a source.secrets validation error, got %v", count, errors)
+			}
+			continue
+		}
+		if len(errors) != 0 {
+			t.Errorf("Test[%d] Unexpected validation errors: %v", count, errors)
+		}
+	}
+}
+
 func TestValidateTrigger(t *testing.T) {
 	tests := map[string]struct {
 		trigger  buildapi.BuildTriggerPolicy
@@ -1569,6 +1887,30 @@ func TestValidateTrigger(t *testing.T) {
 			},
 			expected: []*fielderrors.ValidationError{fielderrors.NewFieldRequired("generic")},
 		},
+		"GitLab trigger with no gitlab webhook": {
+			trigger:  buildapi.BuildTriggerPolicy{Type: buildapi.GitLabWebHookBuildTriggerType},
+			expected: []*fielderrors.ValidationError{fielderrors.NewFieldRequired("gitlab")},
+		},
+		"GitLab trigger with no secret": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type:          buildapi.GitLabWebHookBuildTriggerType,
+				GitLabWebHook: &buildapi.WebHookTrigger{},
+			},
+			expected: []*fielderrors.ValidationError{fielderrors.NewFieldRequired("gitlab.secret")},
+		},
+		"Bitbucket trigger with no bitbucket webhook": {
+			trigger:  buildapi.BuildTriggerPolicy{Type: buildapi.BitbucketWebHookBuildTriggerType},
+			expected: []*fielderrors.ValidationError{fielderrors.NewFieldRequired("bitbucket")},
+		},
+		"Bitbucket trigger with github webhook": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.BitbucketWebHookBuildTriggerType,
+				GitHubWebHook: &buildapi.WebHookTrigger{
+					Secret: "secret101",
+				},
+			},
+			expected: []*fielderrors.ValidationError{fielderrors.NewFieldRequired("bitbucket")},
+		},
 		"ImageChange trigger without params": {
 			trigger: buildapi.BuildTriggerPolicy{
 				Type: buildapi.ImageChangeBuildTriggerType,
@@ -1591,6 +1933,22 @@ func TestValidateTrigger(t *testing.T) {
 				},
 			},
 		},
+		"valid GitLab trigger": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.GitLabWebHookBuildTriggerType,
+				GitLabWebHook: &buildapi.WebHookTrigger{
+					Secret: "secret101",
+				},
+			},
+		},
+		"valid Bitbucket trigger": {
+			trigger: buildapi.BuildTriggerPolicy{
+				Type: buildapi.BitbucketWebHookBuildTriggerType,
+				BitbucketWebHook: &buildapi.WebHookTrigger{
+					Secret: "secret101",
+				},
+			},
+		},
 		"valid ImageChange trigger": {
 			trigger: buildapi.BuildTriggerPolicy{
 				Type: buildapi.ImageChangeBuildTriggerType,
@@ -1651,3 +2009,128 @@ func TestValidateToImageReference(t *testing.T) {
 		t.Errorf("Error on wrong field, expected %s, got %s", "namespace", err.Field)
 	}
 }
+
+func TestBuildConfigValidationHistoryLimits(t *testing.T) {
+	negative, zero := -1, 0
+	tests := []struct {
+		successfulLimit *int
+		failedLimit     *int
+		expectedFields  []string
+	}{
+		{},
+		{successfulLimit: &zero, failedLimit: &zero},
+		{successfulLimit: &negative, expectedFields: []string{"spec.successfulBuildsHistoryLimit"}},
+		{failedLimit: &negative, expectedFields: []string{"spec.failedBuildsHistoryLimit"}},
+	}
+	for i, tc := range tests {
+		buildConfig := &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+			Spec: buildapi.BuildConfigSpec{
+				SuccessfulBuildsHistoryLimit: tc.successfulLimit,
+				FailedBuildsHistoryLimit:     tc.failedLimit,
+				BuildSpec: buildapi.BuildSpec{
+					Source: buildapi.BuildSource{
+						Git: &buildapi.GitBuildSource{
+							URI: "http://github.com/my/repository",
+						},
+					},
+					Strategy: buildapi.BuildStrategy{
+						DockerStrategy: &buildapi.DockerBuildStrategy{},
+					},
+					Output: buildapi.BuildOutput{
+						To: &kapi.ObjectReference{
+							Kind: "DockerImage",
+							Name: "repository/data",
+						},
+					},
+				},
+			},
+		}
+		errors := ValidateBuildConfig(buildConfig)
+		if len(errors) != len(tc.expectedFields) {
+			t.Errorf("%d: expected %d validation errors, got %v", i, len(tc.expectedFields), errors)
+			continue
+		}
+		for j, field := range tc.expectedFields {
+			err := errors[j].(*fielderrors.ValidationError)
+			if err.Type != fielderrors.ValidationErrorTypeInvalid {
+				t.Errorf("%d: unexpected error type, expected %s, got %s", i, fielderrors.ValidationErrorTypeInvalid, err.Type)
+			}
+			if err.Field != field {
+				t.Errorf("%d: unexpected field name expected %s, got %s", i, field, err.Field)
+			}
+		}
+	}
+}
+
+func TestBuildConfigValidationCommitStatus(t *testing.T) {
+	dockerfile := "FROM centos"
+	tests := []struct {
+		status         buildapi.CommitStatusNotification
+		source         buildapi.BuildSource
+		expectedFields []string
+	}{
+		{
+			status: buildapi.CommitStatusNotification{Type: buildapi.CommitStatusHostGitHub, Secret: kapi.LocalObjectReference{Name: "token"}},
+		},
+		{
+			status: buildapi.CommitStatusNotification{Type: buildapi.CommitStatusHostGitLab, APIURL: "https://gitlab.example.com/api/v4", Secret: kapi.LocalObjectReference{Name: "token"}},
+		},
+		{
+			status:         buildapi.CommitStatusNotification{Secret: kapi.LocalObjectReference{Name: "token"}},
+			expectedFields: []string{"spec.commitStatus.type"},
+		},
+		{
+			status:         buildapi.CommitStatusNotification{Type: "Gitea", Secret: kapi.LocalObjectReference{Name: "token"}},
+			expectedFields: []string{"spec.commitStatus.type"},
+		},
+		{
+			status:         buildapi.CommitStatusNotification{Type: buildapi.CommitStatusHostGitHub, APIURL: "ftp://github.example.com", Secret: kapi.LocalObjectReference{Name: "token"}},
+			expectedFields: []string{"spec.commitStatus.apiURL"},
+		},
+		{
+			status:         buildapi.CommitStatusNotification{Type: buildapi.CommitStatusHostGitHub},
+			expectedFields: []string{"spec.commitStatus.secret.name"},
+		},
+		{
+			status:         buildapi.CommitStatusNotification{Type: buildapi.CommitStatusHostGitHub, Secret: kapi.LocalObjectReference{Name: "token"}},
+			source:         buildapi.BuildSource{Dockerfile: &dockerfile},
+			expectedFields: []string{"spec.commitStatus.type"},
+		},
+	}
+	for i, tc := range tests {
+		source := tc.source
+		if source.Dockerfile == nil {
+			source.Git = &buildapi.GitBuildSource{URI: "http://github.com/my/repository"}
+		}
+		status := tc.status
+		buildConfig := &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: "config-id", Namespace: "namespace"},
+			Spec: buildapi.BuildConfigSpec{
+				CommitStatus: &status,
+				BuildSpec: buildapi.BuildSpec{
+					Source: source,
+					Strategy: buildapi.BuildStrategy{
+						DockerStrategy: &buildapi.DockerBuildStrategy{},
+					},
+					Output: buildapi.BuildOutput{
+						To: &kapi.ObjectReference{
+							Kind: "DockerImage",
+							Name: "repository/data",
+						},
+					},
+				},
+			},
+		}
+		errors := ValidateBuildConfig(buildConfig)
+		if len(errors) != len(tc.expectedFields) {
+			t.Errorf("%d: expected %d validation errors, got %v", i, len(tc.expectedFields), errors)
+			continue
+		}
+		for j, field := range tc.expectedFields {
+			if err := errors[j].(*fielderrors.ValidationError); err.Field != field {
+				t.Errorf("%d: unexpected field name expected %s, got %s", i, field, err.Field)
+			}
+		}
+	}
+}
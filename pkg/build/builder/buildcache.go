@@ -0,0 +1,149 @@
+package builder
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	dockercmd "github.com/docker/docker/builder/command"
+	"github.com/docker/docker/builder/parser"
+	docker "github.com/fsouza/go-dockerclient"
+	"github.com/golang/glog"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/util/docker/dockerfile"
+)
+
+const (
+	// buildCacheMountPath is the directory the volume of the Docker build cache
+	// is mounted in. It must match the path used by the build controller when
+	// creating the build pod.
+	buildCacheMountPath = "/var/run/openshift.io/build-cache"
+)
+
+// buildCacheArchive returns the name of the tar archive a cached directory is
+// saved as in the build cache.
+func buildCacheArchive(dir string) string {
+	return url.QueryEscape(dir) + ".tar"
+}
+
+// buildCacheImage returns the name of the image the directories saved by the
+// previous build are restored in for build.
+func buildCacheImage(build *api.Build) string {
+	return fmt.Sprintf("%s-%s-build-cache", build.Namespace, build.Name)
+}
+
+// restoreBuildCache builds the image named image from the image of the last
+// FROM instruction of node with the directories saved in cacheDir by the
+// previous build added, and changes that instruction to build from it. The
+// saved archives are staged in a build context of their own which build
+// builds, so they are not part of the build context of the Dockerfile and
+// cannot be added to the image by it. Docker extracts the archives when
+// adding them, keeping the owners of the files. Directories which were not
+// saved or cannot be staged are skipped, the build runs without them. It
+// returns false if no directory was restored.
+func restoreBuildCache(node *parser.Node, cacheDir, image string, dirs []string, build func(contextDir string) error) (bool, error) {
+	indices := dockerfile.FindAll(node, dockercmd.From)
+	if len(indices) == 0 {
+		return false, nil
+	}
+	from := node.Children[indices[len(indices)-1]]
+	if from.Next == nil {
+		return false, nil
+	}
+
+	contextDir, err := ioutil.TempDir("", "docker-build-cache")
+	if err != nil {
+		return false, err
+	}
+	defer os.RemoveAll(contextDir)
+
+	instruction, err := dockerfile.From(from.Next.Value)
+	if err != nil {
+		return false, err
+	}
+	instructions := []string{instruction}
+	for _, dir := range dirs {
+		archive := buildCacheArchive(dir)
+		src := filepath.Join(cacheDir, archive)
+		if _, err := os.Stat(src); err != nil {
+			if !os.IsNotExist(err) {
+				glog.Warningf("Unable to restore the cached directory %s: %v", dir, err)
+			} else {
+				glog.V(4).Infof("The directory %s was not cached by a previous build", dir)
+			}
+			continue
+		}
+		if err := copyFile(src, filepath.Join(contextDir, archive)); err != nil {
+			glog.Warningf("Unable to restore the cached directory %s: %v", dir, err)
+			continue
+		}
+		dest := path.Dir(dir)
+		if !strings.HasSuffix(dest, "/") {
+			dest += "/"
+		}
+		instruction, err := dockerfile.Add(archive, dest)
+		if err != nil {
+			return false, err
+		}
+		glog.Infof("Restoring the cached directory %s", dir)
+		instructions = append(instructions, instruction)
+	}
+	if len(instructions) == 1 {
+		return false, nil
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(contextDir, defaultDockerfilePath), []byte(strings.Join(instructions, "\n")+"\n"), 0644); err != nil {
+		return false, err
+	}
+	if err := build(contextDir); err != nil {
+		return false, fmt.Errorf("unable to restore the build cache: %v", err)
+	}
+	return true, replaceLastFrom(node, image)
+}
+
+// saveBuildCache saves the directories of the built image into cacheDir, for
+// the next build to restore them. Each directory is saved as a tar archive
+// replacing the one saved by the previous build, if any. Directories which
+// cannot be saved keep their previous archive.
+func saveBuildCache(client DockerClient, image, cacheDir string, dirs []string) error {
+	container, err := client.CreateContainer(docker.CreateContainerOptions{
+		Config: &docker.Config{
+			Image: image,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	defer client.RemoveContainer(docker.RemoveContainerOptions{ID: container.ID})
+
+	for _, dir := range dirs {
+		archive := buildCacheArchive(dir)
+		f, err := ioutil.TempFile(cacheDir, "."+archive)
+		if err != nil {
+			return err
+		}
+		glog.V(4).Infof("Saving the directory %s of image %s to %s", dir, image, f.Name())
+		err = client.DownloadFromContainer(container.ID, docker.DownloadFromContainerOptions{
+			OutputStream: f,
+			Path:         dir,
+		})
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err == nil {
+			err = os.Rename(f.Name(), filepath.Join(cacheDir, archive))
+		}
+		if err != nil {
+			os.Remove(f.Name())
+			glog.Warningf("Unable to save the directory %s to the build cache: %v", dir, err)
+			continue
+		}
+		glog.Infof("Saved the directory %s to the build cache", dir)
+	}
+	return nil
+}
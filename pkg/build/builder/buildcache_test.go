@@ -0,0 +1,139 @@
+package builder
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/docker/docker/builder/parser"
+	docker "github.com/fsouza/go-dockerclient"
+
+	"github.com/openshift/origin/pkg/util/docker/dockerfile"
+)
+
+func TestRestoreBuildCache(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "build-cache")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(cacheDir)
+
+	for _, dir := range []string{"/root/.m2", "/node_modules"} {
+		if err := ioutil.WriteFile(filepath.Join(cacheDir, buildCacheArchive(dir)), []byte(dir), 0600); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	node, err := parser.Parse(strings.NewReader("FROM scratch\nFROM busybox\nRUN make\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var cacheDockerfile, archive string
+	build := func(contextDir string) error {
+		data, err := ioutil.ReadFile(filepath.Join(contextDir, "Dockerfile"))
+		if err != nil {
+			return err
+		}
+		cacheDockerfile = string(data)
+		data, err = ioutil.ReadFile(filepath.Join(contextDir, buildCacheArchive("/root/.m2")))
+		archive = string(data)
+		return err
+	}
+	restored, err := restoreBuildCache(node, cacheDir, "test-build-1-build-cache", []string{"/root/.m2", "/opt/app/vendor", "/node_modules"}, build)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !restored {
+		t.Fatalf("expected the build cache to be restored")
+	}
+
+	// The cached directories are added to an image of their own, so the
+	// archives are not part of the build context of the Dockerfile.
+	expected := `FROM busybox
+ADD ["%2Froot%2F.m2.tar","/root/"]
+ADD ["%2Fnode_modules.tar","/"]
+`
+	if cacheDockerfile != expected {
+		t.Errorf("unexpected Dockerfile of the cache image:\n%s", cacheDockerfile)
+	}
+	if archive != "/root/.m2" {
+		t.Errorf("expected the cached directory to be staged in the build context of the cache image, got %q", archive)
+	}
+	want, err := parser.Parse(strings.NewReader("FROM scratch\nFROM test-build-1-build-cache\nRUN make\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(node, want) {
+		t.Errorf("unexpected Dockerfile:\n%s", dockerfile.ParseTreeToDockerfile(node))
+	}
+
+	// Nothing is built when no directory was saved.
+	node, err = parser.Parse(strings.NewReader("FROM busybox\nRUN make\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	restored, err = restoreBuildCache(node, cacheDir, "test-build-1-build-cache", []string{"/opt/app/vendor"}, func(string) error {
+		t.Errorf("unexpected build of the cache image")
+		return nil
+	})
+	if err != nil || restored {
+		t.Errorf("expected nothing to be restored, got %v: %v", restored, err)
+	}
+}
+
+func TestSaveBuildCache(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "build-cache")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(cacheDir)
+	for _, dir := range []string{"/root/.m2", "/missing"} {
+		if err := ioutil.WriteFile(filepath.Join(cacheDir, buildCacheArchive(dir)), []byte("previous"), 0600); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	removed := false
+	client := &FakeDocker{
+		createContainerFunc: func(opts docker.CreateContainerOptions) (*docker.Container, error) {
+			if opts.Config.Image != "test/image" {
+				t.Errorf("unexpected image %s", opts.Config.Image)
+			}
+			return &docker.Container{ID: "cache"}, nil
+		},
+		downloadFromContainerFunc: func(id string, opts docker.DownloadFromContainerOptions) error {
+			if opts.Path == "/missing" {
+				io.WriteString(opts.OutputStream, "partial")
+				return errors.New("no such file or directory")
+			}
+			_, err := io.WriteString(opts.OutputStream, "saved "+opts.Path)
+			return err
+		},
+	}
+	client.removeContainerFunc = func(opts docker.RemoveContainerOptions) error {
+		removed = opts.ID == "cache"
+		return nil
+	}
+
+	if err := saveBuildCache(client, "test/image", cacheDir, []string{"/root/.m2", "/missing"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]string{"/root/.m2": "saved /root/.m2", "/missing": "previous"}
+	for dir, content := range expected {
+		data, err := ioutil.ReadFile(filepath.Join(cacheDir, buildCacheArchive(dir)))
+		if err != nil || string(data) != content {
+			t.Errorf("expected %q to be saved for %s, got %q: %v", content, dir, string(data), err)
+		}
+	}
+	if files, _ := ioutil.ReadDir(cacheDir); len(files) != 2 {
+		t.Errorf("expected the temporary files to be removed, got %d files", len(files))
+	}
+	if !removed {
+		t.Errorf("expected the container to be removed")
+	}
+}
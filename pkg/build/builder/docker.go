@@ -36,17 +36,23 @@ type DockerBuilder struct {
 	build        *api.Build
 	urlTimeout   time.Duration
 	client       client.BuildInterface
+	// buildCacheDir is the directory the build cache is read from and saved to.
+	buildCacheDir string
+	// buildCacheRestored is set when the Dockerfile was changed to build from
+	// the image the build cache was restored in.
+	buildCacheRestored bool
 }
 
 // NewDockerBuilder creates a new instance of DockerBuilder
 func NewDockerBuilder(dockerClient DockerClient, buildsClient client.BuildInterface, build *api.Build, gitClient GitClient) *DockerBuilder {
 	return &DockerBuilder{
-		dockerClient: dockerClient,
-		build:        build,
-		gitClient:    gitClient,
-		tar:          tar.New(),
-		urlTimeout:   urlCheckTimeout,
-		client:       buildsClient,
+		dockerClient:  dockerClient,
+		build:         build,
+		gitClient:     gitClient,
+		tar:           tar.New(),
+		urlTimeout:    urlCheckTimeout,
+		client:        buildsClient,
+		buildCacheDir: buildCacheMountPath,
 	}
 }
 
@@ -65,6 +71,9 @@ func (d *DockerBuilder) Build() error {
 	if sourceInfo != nil {
 		updateBuildRevision(d.client, d.build, sourceInfo)
 	}
+	if d.build.Spec.Strategy.DockerStrategy.BuildCache != nil {
+		defer removeImage(d.dockerClient, buildCacheImage(d.build))
+	}
 	if err := d.addBuildParameters(buildDir); err != nil {
 		return err
 	}
@@ -98,6 +107,13 @@ func (d *DockerBuilder) Build() error {
 		}
 		glog.Infof("Push successful")
 	}
+
+	// Failing to save the build cache only slows down the next build.
+	if cache := d.build.Spec.Strategy.DockerStrategy.BuildCache; cache != nil {
+		if err := saveBuildCache(d.dockerClient, d.build.Status.OutputDockerImageReference, d.buildCacheDir, cache.Paths); err != nil {
+			glog.Warningf("Unable to save the build cache: %v", err)
+		}
+	}
 	return nil
 }
 
@@ -161,6 +177,15 @@ func (d *DockerBuilder) addBuildParameters(dir string) error {
 		return err
 	}
 
+	// Build from an image with the directories saved by the previous build.
+	if cache := d.build.Spec.Strategy.DockerStrategy.BuildCache; cache != nil {
+		restored, err := restoreBuildCache(node, d.buildCacheDir, buildCacheImage(d.build), cache.Paths, d.buildCacheImage)
+		if err != nil {
+			return err
+		}
+		d.buildCacheRestored = restored
+	}
+
 	instructions := dockerfile.ParseTreeToDockerfile(node)
 
 	// Overwrite the Dockerfile.
@@ -171,6 +196,19 @@ func (d *DockerBuilder) addBuildParameters(dir string) error {
 	return ioutil.WriteFile(dockerfilePath, instructions, fi.Mode())
 }
 
+// buildCacheImage builds the image the directories saved by the previous
+// build are restored in from the build context in dir. The base image is
+// pulled here when the strategy forces pulls, since the image built is only
+// available locally.
+func (d *DockerBuilder) buildCacheImage(dir string) error {
+	auth, err := d.setupPullSecret()
+	if err != nil {
+		return err
+	}
+	strategy := d.build.Spec.Strategy.DockerStrategy
+	return buildImage(d.dockerClient, dir, defaultDockerfilePath, strategy.NoCache, buildCacheImage(d.build), d.tar, auth, strategy.ForcePull)
+}
+
 // buildInfo converts the buildInfo output to a format that appendEnv can
 // consume.
 func (d *DockerBuilder) buildInfo() []dockerfile.KeyValue {
@@ -234,7 +272,9 @@ func (d *DockerBuilder) dockerBuild(dir string) error {
 			dockerfilePath = d.build.Spec.Strategy.DockerStrategy.DockerfilePath
 		}
 		noCache = d.build.Spec.Strategy.DockerStrategy.NoCache
-		forcePull = d.build.Spec.Strategy.DockerStrategy.ForcePull
+		// The image the build cache was restored in only exists locally and
+		// its base image was already pulled when building it.
+		forcePull = d.build.Spec.Strategy.DockerStrategy.ForcePull && !d.buildCacheRestored
 	}
 	auth, err := d.setupPullSecret()
 	if err != nil {
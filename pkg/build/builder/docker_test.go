@@ -1,6 +1,7 @@
 package builder
 
 import (
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -252,3 +253,65 @@ func TestDockerfilePath(t *testing.T) {
 		}
 	}
 }
+
+func TestDockerBuildCacheForcePull(t *testing.T) {
+	buildDir, err := ioutil.TempDir("", "docker-build")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(buildDir)
+	cacheDir, err := ioutil.TempDir("", "build-cache")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(cacheDir)
+
+	if err := ioutil.WriteFile(filepath.Join(buildDir, "Dockerfile"), []byte("FROM openshift/origin-base\nRUN make\n"), 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(cacheDir, buildCacheArchive("/root/.m2")), []byte("cache"), 0600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	build := &api.Build{
+		ObjectMeta: kapi.ObjectMeta{Namespace: "test", Name: "build-1"},
+		Spec: api.BuildSpec{
+			Strategy: api.BuildStrategy{
+				DockerStrategy: &api.DockerBuildStrategy{
+					ForcePull: true,
+					BuildCache: &api.DockerBuildCache{
+						PersistentVolumeClaim: kapi.LocalObjectReference{Name: "cache"},
+						Paths:                 []string{"/root/.m2"},
+					},
+				},
+			},
+		},
+		Status: api.BuildStatus{OutputDockerImageReference: "test/build-1"},
+	}
+	pulls := map[string]bool{}
+	dockerBuilder := &DockerBuilder{
+		dockerClient: &FakeDocker{
+			buildImageFunc: func(opts docker.BuildImageOptions) error {
+				pulls[opts.Name] = opts.Pull
+				_, err := io.Copy(ioutil.Discard, opts.InputStream)
+				return err
+			},
+		},
+		build:         build,
+		tar:           tar.New(),
+		buildCacheDir: cacheDir,
+	}
+	if err := dockerBuilder.addBuildParameters(buildDir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := dockerBuilder.dockerBuild(buildDir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Only the base image is pulled, the image the cache is restored in does
+	// not exist in any registry.
+	expected := map[string]bool{"test-build-1-build-cache": true, "test/build-1": false}
+	if !reflect.DeepEqual(pulls, expected) {
+		t.Errorf("expected the images %v to be built, got %v", expected, pulls)
+	}
+}
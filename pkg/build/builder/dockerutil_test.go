@@ -7,9 +7,12 @@ import (
 )
 
 type FakeDocker struct {
-	pushImageFunc   func(opts docker.PushImageOptions, auth docker.AuthConfiguration) error
-	buildImageFunc  func(opts docker.BuildImageOptions) error
-	removeImageFunc func(name string) error
+	pushImageFunc             func(opts docker.PushImageOptions, auth docker.AuthConfiguration) error
+	buildImageFunc            func(opts docker.BuildImageOptions) error
+	removeImageFunc           func(name string) error
+	createContainerFunc       func(opts docker.CreateContainerOptions) (*docker.Container, error)
+	downloadFromContainerFunc func(id string, opts docker.DownloadFromContainerOptions) error
+	removeContainerFunc       func(opts docker.RemoveContainerOptions) error
 }
 
 func (d *FakeDocker) BuildImage(opts docker.BuildImageOptions) error {
@@ -34,16 +37,25 @@ func (d *FakeDocker) RemoveImage(name string) error {
 }
 
 func (d *FakeDocker) CreateContainer(opts docker.CreateContainerOptions) (*docker.Container, error) {
+	if d.createContainerFunc != nil {
+		return d.createContainerFunc(opts)
+	}
 	return nil, nil
 }
 
 func (d *FakeDocker) DownloadFromContainer(id string, opts docker.DownloadFromContainerOptions) error {
+	if d.downloadFromContainerFunc != nil {
+		return d.downloadFromContainerFunc(id, opts)
+	}
 	return nil
 }
 func (d *FakeDocker) PullImage(opts docker.PullImageOptions, auth docker.AuthConfiguration) error {
 	return nil
 }
 func (d *FakeDocker) RemoveContainer(opts docker.RemoveContainerOptions) error {
+	if d.removeContainerFunc != nil {
+		return d.removeContainerFunc(opts)
+	}
 	return nil
 }
 
@@ -0,0 +1,176 @@
+package builder
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/glog"
+
+	"github.com/openshift/source-to-image/pkg/scripts"
+
+	"github.com/openshift/origin/pkg/build/api"
+)
+
+const (
+	// buildSecretsMountPath is the directory the build secrets are mounted in,
+	// each in a directory named after the secret. It must match the path used
+	// by the build controller when creating the build pod.
+	buildSecretsMountPath = "/var/run/secrets/openshift.io/build"
+
+	// s2iDir is the directory of the application source S2I reads the scripts
+	// from.
+	s2iDir = ".sti"
+	// s2iSecretsDir is the directory the build secrets are staged in, relative
+	// to the application source, until the assemble script injects them.
+	s2iSecretsDir = s2iDir + "/secrets"
+	// s2iOriginalAssemble is the assemble script the generated one delegates to,
+	// relative to the application source.
+	s2iOriginalAssemble = s2iDir + "/assemble-original"
+)
+
+// copySecret copies the files of the secret mounted in src into dest and
+// returns their paths. The hidden entries kubelet uses to manage the secret
+// volume are skipped.
+func copySecret(src, dest string) ([]string, error) {
+	entries, err := ioutil.ReadDir(src)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the build secret %s: %v", src, err)
+	}
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return nil, err
+	}
+	copied := []string{}
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), "..") {
+			continue
+		}
+		target := filepath.Join(dest, entry.Name())
+		glog.V(5).Infof("Copying build secret file %s to %s", filepath.Join(src, entry.Name()), target)
+		if err := copyFile(filepath.Join(src, entry.Name()), target); err != nil {
+			return nil, err
+		}
+		copied = append(copied, target)
+	}
+	return copied, nil
+}
+
+func copyFile(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// s2iAssembleTemplate is the assemble script used for Source builds with
+// build secrets. The secrets are staged in the application source, which is
+// part of the container the image is committed from, so the script moves them
+// to their destination directories (relative to the working directory of the
+// builder image), runs the original assemble script and truncates and removes
+// every injected file before the container is committed.
+const s2iAssembleTemplate = `#!/bin/sh
+# Generated by the OpenShift builder to provide the build secrets to the
+# assemble script. The secrets are removed before the image is committed.
+src=$(cd "$(dirname "$0")/../src" && pwd)
+injected="$(dirname "$0")/.injected-secrets"
+: > "$injected"
+
+inject() {
+	(cd "$1" && find . -type f) | while read -r f; do
+		mkdir -p "$(dirname "$2/$f")"
+		cp "$1/$f" "$2/$f"
+		echo "$2/$f" >> "$injected"
+	done
+}
+
+cleanup() {
+	while read -r f; do
+		: > "$f"
+		rm -f "$f"
+	done < "$injected"
+	rm -f "$injected"
+}
+trap cleanup EXIT
+
+%s
+rm -rf "$src/%s"
+
+%s "$@"
+`
+
+// setupS2ISecrets stages the build secrets in the application source in srcDir
+// and replaces the assemble script with one injecting them for the duration
+// of the original assemble script. The original script is the one provided by
+// the application source, or the one of the builder image located through
+// scriptsURL.
+func setupS2ISecrets(secrets []api.SecretBuildSource, mountPath, srcDir string, scriptsURL func() (string, error)) error {
+	binDir := filepath.Join(srcDir, s2iDir, "bin")
+	if err := os.MkdirAll(binDir, 0755); err != nil {
+		return err
+	}
+
+	var original string
+	assemble := filepath.Join(binDir, "assemble")
+	if _, err := os.Stat(assemble); err == nil {
+		if err := os.Rename(assemble, filepath.Join(srcDir, s2iOriginalAssemble)); err != nil {
+			return err
+		}
+		original = fmt.Sprintf(`"$src/%s"`, s2iOriginalAssemble)
+	} else {
+		imageScriptsURL, err := scriptsURL()
+		if err != nil {
+			return fmt.Errorf("unable to locate the assemble script of the builder image: %v", err)
+		}
+		if len(imageScriptsURL) == 0 {
+			return fmt.Errorf("the builder image does not define a scripts URL and the source does not provide an assemble script")
+		}
+		if strings.HasPrefix(imageScriptsURL, "image://") {
+			original = shellQuote(filepath.Join(strings.TrimPrefix(imageScriptsURL, "image://"), "assemble"))
+		} else {
+			u, err := url.Parse(imageScriptsURL + "/assemble")
+			if err != nil {
+				return err
+			}
+			if _, err := scripts.NewDownloader().Download(u, filepath.Join(srcDir, s2iOriginalAssemble)); err != nil {
+				return fmt.Errorf("unable to download the assemble script of the builder image: %v", err)
+			}
+			original = fmt.Sprintf(`"$src/%s"`, s2iOriginalAssemble)
+		}
+	}
+
+	injections := &bytes.Buffer{}
+	for i, s := range secrets {
+		staged := filepath.Join(s2iSecretsDir, fmt.Sprintf("%d", i))
+		if _, err := copySecret(filepath.Join(mountPath, s.Secret.Name), filepath.Join(srcDir, staged)); err != nil {
+			return err
+		}
+		dest := s.DestinationDir
+		if len(dest) == 0 {
+			dest = "."
+		}
+		fmt.Fprintf(injections, "inject \"$src/%s\" %s\n", staged, shellQuote(dest))
+	}
+
+	script := fmt.Sprintf(s2iAssembleTemplate, strings.TrimSuffix(injections.String(), "\n"), s2iSecretsDir, original)
+	return ioutil.WriteFile(assemble, []byte(script), 0755)
+}
+
+// shellQuote quotes s for use as a single word in a shell script.
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
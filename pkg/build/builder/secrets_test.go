@@ -0,0 +1,150 @@
+package builder
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+)
+
+// writeSecretVolume lays out a secret volume the way kubelet does, with the
+// data files next to hidden bookkeeping entries.
+func writeSecretVolume(t *testing.T, mountPath, name string, data map[string]string) {
+	dir := filepath.Join(mountPath, name)
+	if err := os.MkdirAll(filepath.Join(dir, "..data"), 0755); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for key, value := range data {
+		if err := ioutil.WriteFile(filepath.Join(dir, key), []byte(value), 0644); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
+func readFile(t *testing.T, path string) string {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return string(data)
+}
+
+func TestCopySecret(t *testing.T) {
+	mountPath, err := ioutil.TempDir("", "build-secrets")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(mountPath)
+	buildDir, err := ioutil.TempDir("", "build-dir")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(buildDir)
+
+	writeSecretVolume(t, mountPath, "maven-settings", map[string]string{"settings.xml": "<settings/>"})
+
+	copied, err := copySecret(filepath.Join(mountPath, "maven-settings"), filepath.Join(buildDir, ".m2"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{filepath.Join(buildDir, ".m2", "settings.xml")}
+	if len(copied) != len(expected) || copied[0] != expected[0] {
+		t.Fatalf("expected %v to be copied, got %v", expected, copied)
+	}
+	if data := readFile(t, expected[0]); data != "<settings/>" {
+		t.Errorf("unexpected content of the copied secret: %s", data)
+	}
+	if _, err := os.Stat(filepath.Join(buildDir, ".m2", "..data")); !os.IsNotExist(err) {
+		t.Errorf("expected the secret volume bookkeeping not to be copied")
+	}
+
+	if _, err := copySecret(filepath.Join(mountPath, "missing"), buildDir); err == nil {
+		t.Errorf("expected an error for a secret that is not mounted")
+	}
+}
+
+func TestSetupS2ISecrets(t *testing.T) {
+	mountPath, err := ioutil.TempDir("", "build-secrets")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(mountPath)
+	writeSecretVolume(t, mountPath, "maven-settings", map[string]string{"settings.xml": "<settings/>"})
+	secrets := []api.SecretBuildSource{
+		{Secret: kapi.LocalObjectReference{Name: "maven-settings"}, DestinationDir: ".m2"},
+	}
+
+	tests := []struct {
+		name             string
+		sourceAssemble   bool
+		scriptsURL       string
+		expectedOriginal string
+	}{
+		{
+			name:             "assemble script from the source",
+			sourceAssemble:   true,
+			expectedOriginal: `"$src/.sti/assemble-original" "$@"`,
+		},
+		{
+			name:             "assemble script inside the builder image",
+			scriptsURL:       "image:///usr/libexec/s2i",
+			expectedOriginal: `'/usr/libexec/s2i/assemble' "$@"`,
+		},
+	}
+	for _, test := range tests {
+		srcDir, err := ioutil.TempDir("", "s2i-src")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer os.RemoveAll(srcDir)
+		if test.sourceAssemble {
+			if err := os.MkdirAll(filepath.Join(srcDir, ".sti", "bin"), 0755); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err := ioutil.WriteFile(filepath.Join(srcDir, ".sti", "bin", "assemble"), []byte("#!/bin/sh\n"), 0755); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		scriptsURL := func() (string, error) { return test.scriptsURL, nil }
+
+		if err := setupS2ISecrets(secrets, mountPath, srcDir, scriptsURL); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		assemble := readFile(t, filepath.Join(srcDir, ".sti", "bin", "assemble"))
+		if !strings.Contains(assemble, test.expectedOriginal) {
+			t.Errorf("%s: expected the assemble script to run %s, got:\n%s", test.name, test.expectedOriginal, assemble)
+		}
+		if !strings.Contains(assemble, `inject "$src/.sti/secrets/0" '.m2'`) {
+			t.Errorf("%s: expected the assemble script to inject the secret, got:\n%s", test.name, assemble)
+		}
+		if test.sourceAssemble {
+			if data := readFile(t, filepath.Join(srcDir, ".sti", "assemble-original")); data != "#!/bin/sh\n" {
+				t.Errorf("%s: unexpected original assemble script: %s", test.name, data)
+			}
+		}
+		if data := readFile(t, filepath.Join(srcDir, ".sti", "secrets", "0", "settings.xml")); data != "<settings/>" {
+			t.Errorf("%s: unexpected content of the staged secret: %s", test.name, data)
+		}
+	}
+
+	srcDir, err := ioutil.TempDir("", "s2i-src")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(srcDir)
+	if err := setupS2ISecrets(secrets, mountPath, srcDir, func() (string, error) { return "", nil }); err == nil {
+		t.Errorf("expected an error when no assemble script can be located")
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	if e, a := `'it'\''s'`, shellQuote("it's"); e != a {
+		t.Errorf("expected %s, got %s", e, a)
+	}
+}
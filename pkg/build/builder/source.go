@@ -65,10 +65,11 @@ func fetchSource(dockerClient DockerClient, dir string, build *api.Build, urlTim
 		}
 	}
 
-	// extract source from an Image if specified
-	if build.Spec.Source.Image != nil {
+	// extract source from the Images if specified
+	for i, image := range build.Spec.Source.Images {
 		// fetch image source
-		err := extractSourceFromImage(dockerClient, build.Spec.Source.Image.From.Name, dir, build.Spec.Source.Image.Paths)
+		authType := fmt.Sprintf("%s_%d", dockercfg.PullSourceAuthType, i)
+		err := extractSourceFromImage(dockerClient, image.From.Name, dir, image.Paths, authType)
 		if err != nil {
 			return nil, err
 		}
@@ -256,13 +257,16 @@ func copyImageSource(dockerClient DockerClient, containerID, sourceDir, destDir
 	return tarHelper.ExtractTarStreamWithLogging(destDir, file, tarOutput)
 }
 
-func extractSourceFromImage(dockerClient DockerClient, image, buildDir string, paths []api.ImageSourcePath) error {
+// extractSourceFromImage copies the given paths from the image into the build
+// directory. authType is the name of the environment variable pointing to the
+// dockercfg file used to pull the image, if any.
+func extractSourceFromImage(dockerClient DockerClient, image, buildDir string, paths []api.ImageSourcePath, authType string) error {
 	glog.V(4).Infof("Extracting image source from %s", image)
 
 	// Pre-pull image if a secret is specified
-	pullSecret := os.Getenv(dockercfg.PullSourceAuthType)
+	pullSecret := os.Getenv(authType)
 	if len(pullSecret) > 0 {
-		dockerAuth, present := dockercfg.NewHelper().GetDockerAuth(image, dockercfg.PullSourceAuthType)
+		dockerAuth, present := dockercfg.NewHelper().GetDockerAuth(image, authType)
 		if present {
 			dockerClient.PullImage(docker.PullImageOptions{Repository: image}, dockerAuth)
 		}
@@ -18,6 +18,7 @@ import (
 	"github.com/openshift/source-to-image/pkg/api/validation"
 	s2ibuild "github.com/openshift/source-to-image/pkg/build"
 	s2i "github.com/openshift/source-to-image/pkg/build/strategies"
+	s2idocker "github.com/openshift/source-to-image/pkg/docker"
 
 	"github.com/openshift/origin/pkg/build/api"
 	"github.com/openshift/origin/pkg/build/builder/cmd/dockercfg"
@@ -277,6 +278,24 @@ func (d *downloader) Download(config *s2iapi.Config) (*s2iapi.SourceInfo, error)
 			return nil, err
 		}
 	}
+
+	// the build secrets are injected by a generated assemble script, which
+	// would be ignored in favor of the scripts from the strategy scripts URL.
+	if secrets := d.s.build.Spec.Source.Secrets; len(secrets) > 0 {
+		if len(config.ScriptsURL) > 0 {
+			return nil, fmt.Errorf("build secrets can not be used together with a custom scripts URL")
+		}
+		scriptsURL := func() (string, error) {
+			docker, err := s2idocker.New(config.DockerConfig, config.PullAuthentication)
+			if err != nil {
+				return "", err
+			}
+			return docker.GetScriptsURL(config.BuilderImage)
+		}
+		if err := setupS2ISecrets(secrets, buildSecretsMountPath, d.dir, scriptsURL); err != nil {
+			return nil, err
+		}
+	}
 	if sourceInfo != nil {
 		return &sourceInfo.SourceInfo, nil
 	}
@@ -1,6 +1,9 @@
 package client
 
 import (
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	osclient "github.com/openshift/origin/pkg/client"
 )
@@ -41,6 +44,16 @@ type BuildUpdater interface {
 	Update(namespace string, build *buildapi.Build) error
 }
 
+// BuildLister provides methods for listing the Builds.
+type BuildLister interface {
+	List(namespace string, label labels.Selector, field fields.Selector) (*buildapi.BuildList, error)
+}
+
+// BuildDeleter provides methods for deleting existing Builds.
+type BuildDeleter interface {
+	Delete(namespace, name string) error
+}
+
 // OSClientBuildClient deletes build create and update operations to the OpenShift client interface
 type OSClientBuildClient struct {
 	Client osclient.Interface
@@ -57,6 +70,16 @@ func (c OSClientBuildClient) Update(namespace string, build *buildapi.Build) err
 	return e
 }
 
+// List lists the builds using the OpenShift client.
+func (c OSClientBuildClient) List(namespace string, label labels.Selector, field fields.Selector) (*buildapi.BuildList, error) {
+	return c.Client.Builds(namespace).List(label, field)
+}
+
+// Delete deletes a build using the OpenShift client.
+func (c OSClientBuildClient) Delete(namespace, name string) error {
+	return c.Client.Builds(namespace).Delete(name)
+}
+
 // BuildCloner provides methods for cloning builds
 type BuildCloner interface {
 	Clone(namespace string, request *buildapi.BuildRequest) (*buildapi.Build, error)
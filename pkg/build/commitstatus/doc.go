@@ -0,0 +1,3 @@
+// Package commitstatus reports the progress of builds to the host of their Git
+// source as commit statuses.
+package commitstatus
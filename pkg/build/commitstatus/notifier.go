@@ -0,0 +1,257 @@
+package commitstatus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// DefaultGitHubAPIURL is the API used to report statuses to GitHub when the
+// BuildConfig does not set one.
+const DefaultGitHubAPIURL = "https://api.github.com"
+
+// States of a build reported as commit statuses. GitHub and GitLab support
+// different sets of states, they are mapped to the closest state of the host.
+const (
+	statePending   = "pending"
+	stateRunning   = "running"
+	stateSuccess   = "success"
+	stateFailure   = "failure"
+	stateError     = "error"
+	stateCancelled = "cancelled"
+)
+
+// Notifier reports the phase of builds as statuses of the commit they build,
+// using the commit status settings of their BuildConfig.
+type Notifier struct {
+	BuildConfigGetter buildclient.BuildConfigGetter
+	SecretsClient     kclient.SecretsNamespacer
+	// PublicURL is the public URL of the master, statuses link to the logs of
+	// the builds under it. If empty, statuses do not link to the build logs.
+	PublicURL string
+	// HTTPClient is used to talk to the source host. If nil, a client which
+	// times out after defaultTimeout is used.
+	HTTPClient *http.Client
+}
+
+// defaultTimeout bounds the requests reporting commit statuses. The build
+// controller reports statuses while it handles builds, so an unresponsive
+// source host must not hold it up for long.
+const defaultTimeout = 10 * time.Second
+
+var defaultHTTPClient = &http.Client{Timeout: defaultTimeout}
+
+// Notify sets the status of the commit built by the given build from the
+// phase of the build. Builds not created from a BuildConfig that reports
+// commit statuses, or without a Git revision, are ignored.
+func (n *Notifier) Notify(build *buildapi.Build) error {
+	if build.Spec.Revision == nil || build.Spec.Revision.Git == nil || len(build.Spec.Revision.Git.Commit) == 0 {
+		return nil
+	}
+	if build.Spec.Source.Git == nil {
+		return nil
+	}
+	bcName := buildutil.ConfigNameForBuild(build)
+	if len(bcName) == 0 {
+		return nil
+	}
+	bc, err := n.BuildConfigGetter.Get(build.Namespace, bcName)
+	if err != nil {
+		return err
+	}
+	config := bc.Spec.CommitStatus
+	if config == nil {
+		return nil
+	}
+
+	secret, err := n.SecretsClient.Secrets(build.Namespace).Get(config.Secret.Name)
+	if err != nil {
+		return fmt.Errorf("unable to get the commit status secret %s/%s: %v", build.Namespace, config.Secret.Name, err)
+	}
+	token := strings.TrimSpace(string(secret.Data[buildapi.CommitStatusTokenKey]))
+	if len(token) == 0 {
+		return fmt.Errorf("the commit status secret %s/%s has no %q key", build.Namespace, config.Secret.Name, buildapi.CommitStatusTokenKey)
+	}
+	hostURL, repo, err := parseRepository(build.Spec.Source.Git.URI)
+	if err != nil {
+		return err
+	}
+
+	state, description := stateForBuild(build)
+	status := commitStatus{
+		state:       state,
+		targetURL:   n.logURL(build),
+		description: description,
+		context:     fmt.Sprintf("openshift/%s/%s", build.Namespace, bcName),
+	}
+	commit := build.Spec.Revision.Git.Commit
+	glog.V(4).Infof("Reporting status %q of build %s/%s for commit %s of %s", state, build.Namespace, build.Name, commit, repo)
+
+	switch config.Type {
+	case buildapi.CommitStatusHostGitHub:
+		return n.notifyGitHub(config.APIURL, token, repo, commit, status)
+	case buildapi.CommitStatusHostGitLab:
+		apiURL := config.APIURL
+		if len(apiURL) == 0 {
+			apiURL = hostURL + "/api/v4"
+		}
+		return n.notifyGitLab(apiURL, token, repo, commit, status)
+	default:
+		return fmt.Errorf("unknown commit status host type %q", config.Type)
+	}
+}
+
+type commitStatus struct {
+	state       string
+	targetURL   string
+	description string
+	context     string
+}
+
+// stateForBuild returns the commit status state and description matching the
+// phase of the build.
+func stateForBuild(build *buildapi.Build) (string, string) {
+	switch build.Status.Phase {
+	case buildapi.BuildPhaseComplete:
+		return stateSuccess, "The build succeeded"
+	case buildapi.BuildPhaseFailed:
+		return stateFailure, "The build failed"
+	case buildapi.BuildPhaseError:
+		return stateError, "The build could not be run"
+	case buildapi.BuildPhaseCancelled:
+		return stateCancelled, "The build was cancelled"
+	case buildapi.BuildPhaseRunning:
+		return stateRunning, "The build is running"
+	default:
+		return statePending, "The build is pending"
+	}
+}
+
+// logURL returns the URL of the log of the build on the master.
+func (n *Notifier) logURL(build *buildapi.Build) string {
+	if len(n.PublicURL) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s/oapi/v1/namespaces/%s/builds/%s/log", strings.TrimSuffix(n.PublicURL, "/"), build.Namespace, build.Name)
+}
+
+func (n *Notifier) notifyGitHub(apiURL, token, repo, commit string, status commitStatus) error {
+	if len(apiURL) == 0 {
+		apiURL = DefaultGitHubAPIURL
+	}
+	state := status.state
+	switch state {
+	case stateRunning:
+		state = statePending
+	case stateCancelled:
+		state = stateError
+	}
+	body := map[string]string{
+		"state":       state,
+		"description": status.description,
+		"context":     status.context,
+	}
+	if len(status.targetURL) > 0 {
+		body["target_url"] = status.targetURL
+	}
+	statusURL := fmt.Sprintf("%s/repos/%s/statuses/%s", strings.TrimSuffix(apiURL, "/"), repo, commit)
+	return n.post(statusURL, map[string]string{"Authorization": "token " + token}, body)
+}
+
+func (n *Notifier) notifyGitLab(apiURL, token, repo, commit string, status commitStatus) error {
+	state := status.state
+	switch state {
+	case stateFailure, stateError:
+		state = "failed"
+	case stateCancelled:
+		state = "canceled"
+	}
+	body := map[string]string{
+		"state":       state,
+		"description": status.description,
+		"name":        status.context,
+	}
+	if len(status.targetURL) > 0 {
+		body["target_url"] = status.targetURL
+	}
+	// GitLab identifies projects by their URL encoded path.
+	statusURL := fmt.Sprintf("%s/projects/%s/statuses/%s", strings.TrimSuffix(apiURL, "/"), url.QueryEscape(repo), commit)
+	return n.post(statusURL, map[string]string{"PRIVATE-TOKEN": token}, body)
+}
+
+func (n *Notifier) post(statusURL string, headers map[string]string, body interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", statusURL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	client := n.HTTPClient
+	if client == nil {
+		client = defaultHTTPClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response reporting the commit status to %s: %s", req.URL.Host, resp.Status)
+	}
+	return nil
+}
+
+// parseRepository returns the web URL of the host and the path of the
+// repository of a Git URI, for instance "https://github.com" and
+// "openshift/origin". Both URLs and the scp like "git@host:path" form are
+// accepted. The host of non HTTP URIs is assumed to serve HTTPS.
+func parseRepository(uri string) (string, string, error) {
+	var hostURL, repoPath string
+	if strings.Contains(uri, "://") {
+		u, err := url.Parse(uri)
+		if err != nil {
+			return "", "", err
+		}
+		repoPath = u.Path
+		if u.Scheme == "http" || u.Scheme == "https" {
+			hostURL = u.Scheme + "://" + u.Host
+		} else if host := strings.SplitN(u.Host, ":", 2)[0]; len(host) > 0 {
+			hostURL = "https://" + host
+		}
+	} else if parts := strings.SplitN(uri, ":", 2); len(parts) == 2 {
+		host := parts[0]
+		if i := strings.LastIndex(host, "@"); i != -1 {
+			host = host[i+1:]
+		}
+		if len(host) > 0 {
+			hostURL = "https://" + host
+		}
+		repoPath = parts[1]
+	}
+	repoPath = strings.TrimSuffix(strings.Trim(repoPath, "/"), ".git")
+	if len(hostURL) == 0 || !strings.Contains(repoPath, "/") {
+		return "", "", fmt.Errorf("unable to determine the repository of %s", uri)
+	}
+	return hostURL, repoPath, nil
+}
@@ -0,0 +1,231 @@
+package commitstatus
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+// fakeHost is a minimal source host API recording the commit statuses it
+// receives.
+type fakeHost struct {
+	lock     sync.Mutex
+	server   *httptest.Server
+	paths    []string
+	headers  []http.Header
+	statuses []map[string]string
+}
+
+func newFakeHost() *fakeHost {
+	h := &fakeHost{}
+	h.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.lock.Lock()
+		defer h.lock.Unlock()
+		status := map[string]string{}
+		if err := json.NewDecoder(r.Body).Decode(&status); err != nil || r.Method != "POST" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		h.paths = append(h.paths, r.URL.EscapedPath())
+		h.headers = append(h.headers, r.Header)
+		h.statuses = append(h.statuses, status)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	return h
+}
+
+type fakeBuildConfigGetter struct {
+	bc *buildapi.BuildConfig
+}
+
+func (g *fakeBuildConfigGetter) Get(namespace, name string) (*buildapi.BuildConfig, error) {
+	return g.bc, nil
+}
+
+func newNotifier(hostType buildapi.CommitStatusHostType, apiURL string) *Notifier {
+	bc := &buildapi.BuildConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "app", Namespace: "ns"},
+		Spec: buildapi.BuildConfigSpec{
+			CommitStatus: &buildapi.CommitStatusNotification{
+				Type:   hostType,
+				APIURL: apiURL,
+				Secret: kapi.LocalObjectReference{Name: "status-token"},
+			},
+		},
+	}
+	secret := &kapi.Secret{
+		ObjectMeta: kapi.ObjectMeta{Name: "status-token", Namespace: "ns"},
+		Data:       map[string][]byte{buildapi.CommitStatusTokenKey: []byte("s3cr3t\n")},
+	}
+	return &Notifier{
+		BuildConfigGetter: &fakeBuildConfigGetter{bc: bc},
+		SecretsClient:     ktestclient.NewSimpleFake(secret),
+		PublicURL:         "https://master.example.com:8443/",
+	}
+}
+
+func newBuild(uri string, phase buildapi.BuildPhase) *buildapi.Build {
+	return &buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      "app-1",
+			Namespace: "ns",
+			Labels:    map[string]string{buildapi.BuildConfigLabel: "app"},
+		},
+		Spec: buildapi.BuildSpec{
+			Source: buildapi.BuildSource{
+				Git: &buildapi.GitBuildSource{URI: uri},
+			},
+			Revision: &buildapi.SourceRevision{
+				Git: &buildapi.GitSourceRevision{Commit: "2602ace61490de0513dfbd7c7de949356cf9bd17"},
+			},
+		},
+		Status: buildapi.BuildStatus{Phase: phase},
+	}
+}
+
+func TestNotifyGitHub(t *testing.T) {
+	host := newFakeHost()
+	defer host.server.Close()
+	notifier := newNotifier(buildapi.CommitStatusHostGitHub, host.server.URL)
+
+	phases := []buildapi.BuildPhase{buildapi.BuildPhasePending, buildapi.BuildPhaseRunning, buildapi.BuildPhaseComplete, buildapi.BuildPhaseFailed, buildapi.BuildPhaseCancelled}
+	for _, phase := range phases {
+		if err := notifier.Notify(newBuild("git@github.com:openshift/origin.git", phase)); err != nil {
+			t.Fatalf("%s: unexpected error: %v", phase, err)
+		}
+	}
+
+	expectedStates := []string{"pending", "pending", "success", "failure", "error"}
+	if len(host.statuses) != len(expectedStates) {
+		t.Fatalf("expected %d statuses, got %v", len(expectedStates), host.statuses)
+	}
+	for i, state := range expectedStates {
+		if e, a := "/repos/openshift/origin/statuses/2602ace61490de0513dfbd7c7de949356cf9bd17", host.paths[i]; e != a {
+			t.Errorf("%d: expected the status to be posted to %s, got %s", i, e, a)
+		}
+		if e, a := "token s3cr3t", host.headers[i].Get("Authorization"); e != a {
+			t.Errorf("%d: expected the Authorization header %q, got %q", i, e, a)
+		}
+		status := host.statuses[i]
+		if status["state"] != state {
+			t.Errorf("%d: expected state %s, got %s", i, state, status["state"])
+		}
+		if e, a := "https://master.example.com:8443/oapi/v1/namespaces/ns/builds/app-1/log", status["target_url"]; e != a {
+			t.Errorf("%d: expected target URL %s, got %s", i, e, a)
+		}
+		if e, a := "openshift/ns/app", status["context"]; e != a {
+			t.Errorf("%d: expected context %s, got %s", i, e, a)
+		}
+	}
+}
+
+func TestNotifyGitLab(t *testing.T) {
+	host := newFakeHost()
+	defer host.server.Close()
+	notifier := newNotifier(buildapi.CommitStatusHostGitLab, "")
+
+	phases := []buildapi.BuildPhase{buildapi.BuildPhaseRunning, buildapi.BuildPhaseError, buildapi.BuildPhaseCancelled}
+	for _, phase := range phases {
+		// the API of GitLab is located from the Git source by default
+		if err := notifier.Notify(newBuild(host.server.URL+"/group/sub/app.git", phase)); err != nil {
+			t.Fatalf("%s: unexpected error: %v", phase, err)
+		}
+	}
+
+	expectedStates := []string{"running", "failed", "canceled"}
+	if len(host.statuses) != len(expectedStates) {
+		t.Fatalf("expected %d statuses, got %v", len(expectedStates), host.statuses)
+	}
+	for i, state := range expectedStates {
+		if e, a := "/api/v4/projects/group%2Fsub%2Fapp/statuses/2602ace61490de0513dfbd7c7de949356cf9bd17", host.paths[i]; e != a {
+			t.Errorf("%d: expected the status to be posted to %s, got %s", i, e, a)
+		}
+		if e, a := "s3cr3t", host.headers[i].Get("PRIVATE-TOKEN"); e != a {
+			t.Errorf("%d: expected the PRIVATE-TOKEN header %q, got %q", i, e, a)
+		}
+		if status := host.statuses[i]; status["state"] != state || status["name"] != "openshift/ns/app" {
+			t.Errorf("%d: expected state %s, got %v", i, state, status)
+		}
+	}
+}
+
+func TestNotifySkipsBuilds(t *testing.T) {
+	host := newFakeHost()
+	defer host.server.Close()
+	notifier := newNotifier(buildapi.CommitStatusHostGitHub, host.server.URL)
+
+	noRevision := newBuild("https://github.com/openshift/origin", buildapi.BuildPhaseComplete)
+	noRevision.Spec.Revision = nil
+	noConfig := newBuild("https://github.com/openshift/origin", buildapi.BuildPhaseComplete)
+	noConfig.Labels = nil
+	for _, build := range []*buildapi.Build{noRevision, noConfig} {
+		if err := notifier.Notify(build); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+
+	notifier.BuildConfigGetter.(*fakeBuildConfigGetter).bc.Spec.CommitStatus = nil
+	if err := notifier.Notify(newBuild("https://github.com/openshift/origin", buildapi.BuildPhaseComplete)); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if len(host.statuses) != 0 {
+		t.Errorf("expected no statuses to be reported, got %v", host.statuses)
+	}
+}
+
+func TestNotifyFailures(t *testing.T) {
+	notifier := newNotifier(buildapi.CommitStatusHostGitHub, "")
+	notifier.SecretsClient = ktestclient.NewSimpleFake()
+	if err := notifier.Notify(newBuild("https://github.com/openshift/origin", buildapi.BuildPhaseComplete)); err == nil {
+		t.Errorf("expected an error when the token secret does not exist")
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+	notifier = newNotifier(buildapi.CommitStatusHostGitHub, server.URL)
+	if err := notifier.Notify(newBuild("https://github.com/openshift/origin", buildapi.BuildPhaseComplete)); err == nil {
+		t.Errorf("expected an error when the host rejects the status")
+	}
+}
+
+func TestParseRepository(t *testing.T) {
+	tests := []struct {
+		uri     string
+		hostURL string
+		repo    string
+	}{
+		{uri: "https://github.com/openshift/origin", hostURL: "https://github.com", repo: "openshift/origin"},
+		{uri: "http://gitlab.example.com:8080/group/sub/app.git", hostURL: "http://gitlab.example.com:8080", repo: "group/sub/app"},
+		{uri: "git://github.com/openshift/origin.git", hostURL: "https://github.com", repo: "openshift/origin"},
+		{uri: "ssh://git@gitlab.example.com:2222/group/app.git", hostURL: "https://gitlab.example.com", repo: "group/app"},
+		{uri: "git@github.com:openshift/origin.git", hostURL: "https://github.com", repo: "openshift/origin"},
+		{uri: "https://github.com/origin"},
+		{uri: "/var/lib/git/repo"},
+	}
+	for _, test := range tests {
+		hostURL, repo, err := parseRepository(test.uri)
+		if len(test.repo) == 0 {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %s %s", test.uri, hostURL, repo)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.uri, err)
+			continue
+		}
+		if hostURL != test.hostURL || repo != test.repo {
+			t.Errorf("%s: expected %s %s, got %s %s", test.uri, test.hostURL, test.repo, hostURL, repo)
+		}
+	}
+}
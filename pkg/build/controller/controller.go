@@ -13,6 +13,8 @@ import (
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildclient "github.com/openshift/origin/pkg/build/client"
+	"github.com/openshift/origin/pkg/build/controller/policy"
+	"github.com/openshift/origin/pkg/build/jenkins"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 )
@@ -24,6 +26,10 @@ type BuildController struct {
 	BuildStrategy     BuildStrategy
 	ImageStreamClient imageStreamClient
 	Recorder          record.EventRecorder
+	RunPolicies       []policy.RunPolicy
+	HistoryPruner     historyPruner
+	PipelineClient    pipelineClient
+	StatusNotifier    commitStatusNotifier
 }
 
 // BuildStrategy knows how to create a pod spec for a pod which can execute a build.
@@ -41,6 +47,32 @@ type imageStreamClient interface {
 	GetImageStream(namespace, name string) (*imageapi.ImageStream, error)
 }
 
+// commitStatusNotifier reports the phase of a build to the host of its source.
+type commitStatusNotifier interface {
+	Notify(build *buildapi.Build) error
+}
+
+// logArchiver copies the log of a completed build out of its build pod.
+type logArchiver interface {
+	ArchiveBuildLog(build *buildapi.Build) error
+}
+
+// archivedLogDeleter removes the archived log of a build.
+type archivedLogDeleter interface {
+	Delete(namespace, name string) error
+}
+
+type pipelineClient interface {
+	Start(build *buildapi.Build) (string, error)
+	GetStatus(build *buildapi.Build) (*jenkins.Status, error)
+	Stop(build *buildapi.Build) error
+}
+
+// pipelineJobDeleter removes the Jenkins job of a Jenkins Pipeline build.
+type pipelineJobDeleter interface {
+	DeleteJob(build *buildapi.Build) error
+}
+
 // CancelBuild updates a build status to Cancelled, after its associated pod is deleted.
 func (bc *BuildController) CancelBuild(build *buildapi.Build) error {
 	if !isBuildCancellable(build) {
@@ -50,15 +82,24 @@ func (bc *BuildController) CancelBuild(build *buildapi.Build) error {
 
 	glog.V(4).Infof("Cancelling build %s/%s.", build.Namespace, build.Name)
 
-	pod, err := bc.PodManager.GetPod(build.Namespace, buildutil.GetBuildPodName(build))
-	if err != nil {
-		if !errors.IsNotFound(err) {
-			return fmt.Errorf("Failed to get pod for build %s/%s: %v", build.Namespace, build.Name, err)
+	if build.Spec.Strategy.JenkinsPipelineStrategy != nil {
+		if bc.PipelineClient == nil {
+			return fmt.Errorf("Jenkins Pipeline builds are not supported")
+		}
+		if err := bc.PipelineClient.Stop(build); err != nil {
+			return fmt.Errorf("Failed to stop the Jenkins pipeline for build %s/%s: %v", build.Namespace, build.Name, err)
 		}
 	} else {
-		err := bc.PodManager.DeletePod(build.Namespace, pod)
-		if err != nil && !errors.IsNotFound(err) {
-			return fmt.Errorf("Couldn't delete build pod %s/%s: %v", build.Namespace, pod.Name, err)
+		pod, err := bc.PodManager.GetPod(build.Namespace, buildutil.GetBuildPodName(build))
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				return fmt.Errorf("Failed to get pod for build %s/%s: %v", build.Namespace, build.Name, err)
+			}
+		} else {
+			err := bc.PodManager.DeletePod(build.Namespace, pod)
+			if err != nil && !errors.IsNotFound(err) {
+				return fmt.Errorf("Couldn't delete build pod %s/%s: %v", build.Namespace, pod.Name, err)
+			}
 		}
 	}
 
@@ -72,6 +113,8 @@ func (bc *BuildController) CancelBuild(build *buildapi.Build) error {
 	}
 
 	glog.V(4).Infof("Build %s/%s was successfully cancelled.", build.Namespace, build.Name)
+	notifyCommitStatus(build, bc.StatusNotifier)
+	handleBuildCompletion(build, bc.RunPolicies, bc.HistoryPruner)
 	return nil
 }
 
@@ -90,9 +133,27 @@ func (bc *BuildController) HandleBuild(build *buildapi.Build) error {
 
 	// Handle new builds
 	if build.Status.Phase != buildapi.BuildPhaseNew {
+		// Jenkins Pipeline builds do not have a build pod, so their progress
+		// is synced from Jenkins.
+		if build.Spec.Strategy.JenkinsPipelineStrategy != nil && !build.Status.Cancelled && !buildutil.IsBuildComplete(build) {
+			return bc.syncPipelineBuild(build)
+		}
 		return nil
 	}
 
+	// Only start the build when its run policy allows it, otherwise it stays
+	// in the New phase until the policy accepts it.
+	runPolicy := policy.ForBuild(build, bc.RunPolicies)
+	if runPolicy == nil {
+		return fmt.Errorf("unable to determine build run policy for %s/%s", build.Namespace, build.Name)
+	}
+	if run, err := runPolicy.IsRunnable(build); err != nil || !run {
+		if err == nil {
+			glog.V(5).Infof("Build %s/%s is not runnable yet, it will wait in the %s phase", build.Namespace, build.Name, build.Status.Phase)
+		}
+		return err
+	}
+
 	if err := bc.nextBuildPhase(build); err != nil {
 		return err
 	}
@@ -103,7 +164,9 @@ func (bc *BuildController) HandleBuild(build *buildapi.Build) error {
 		// same "new" imageid change in the future, which is better than guaranteeing we
 		// run the build 2+ times by retrying it here.
 		glog.V(2).Infof("Failed to record changes to build %s/%s: %v", build.Namespace, build.Name, err)
+		return nil
 	}
+	notifyCommitStatus(build, bc.StatusNotifier)
 	return nil
 }
 
@@ -120,6 +183,12 @@ func (bc *BuildController) nextBuildPhase(build *buildapi.Build) error {
 		return nil
 	}
 
+	// Jenkins Pipeline builds are handed off to Jenkins instead of running
+	// in a build pod.
+	if build.Spec.Strategy.JenkinsPipelineStrategy != nil {
+		return bc.startPipelineBuild(build)
+	}
+
 	// Set the output Docker image reference.
 	ref, err := bc.resolveOutputDockerImageReference(build)
 	if err != nil {
@@ -181,6 +250,94 @@ func (bc *BuildController) nextBuildPhase(build *buildapi.Build) error {
 	return nil
 }
 
+// startPipelineBuild hands the build off to Jenkins and moves it to the
+// pending phase until Jenkins starts running the pipeline.
+func (bc *BuildController) startPipelineBuild(build *buildapi.Build) error {
+	if bc.PipelineClient == nil {
+		build.Status.Reason = buildapi.StatusReasonCannotStartPipeline
+		return fmt.Errorf("Jenkins Pipeline builds are not supported")
+	}
+	queueURI, err := bc.PipelineClient.Start(build)
+	if err != nil {
+		bc.Recorder.Eventf(build, "FailedCreate", "Error starting Jenkins pipeline: %v", err)
+		build.Status.Reason = buildapi.StatusReasonCannotStartPipeline
+		return fmt.Errorf("failed to start the Jenkins pipeline for build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+	if build.Annotations == nil {
+		build.Annotations = make(map[string]string)
+	}
+	build.Annotations[buildapi.BuildJenkinsQueueURIAnnotation] = queueURI
+	glog.V(4).Infof("Build %s/%s was handed off to Jenkins: %s", build.Namespace, build.Name, queueURI)
+
+	build.Status.Phase = buildapi.BuildPhasePending
+	build.Status.Reason = ""
+	build.Status.Message = ""
+	return nil
+}
+
+// syncPipelineBuild updates the phase of a Jenkins Pipeline build from the
+// state of its pipeline run in Jenkins.
+func (bc *BuildController) syncPipelineBuild(build *buildapi.Build) error {
+	if bc.PipelineClient == nil {
+		return nil
+	}
+	status, err := bc.PipelineClient.GetStatus(build)
+	if err != nil {
+		return fmt.Errorf("failed to get the Jenkins pipeline status for build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+
+	nextStatus := build.Status.Phase
+	switch status.Result {
+	case jenkins.ResultSuccess:
+		nextStatus = buildapi.BuildPhaseComplete
+	case jenkins.ResultFailure, jenkins.ResultUnstable:
+		nextStatus = buildapi.BuildPhaseFailed
+	case jenkins.ResultAborted:
+		nextStatus = buildapi.BuildPhaseCancelled
+	default:
+		if status.Started {
+			nextStatus = buildapi.BuildPhaseRunning
+		}
+	}
+
+	changed, phaseChanged := false, false
+	if build.Annotations == nil {
+		build.Annotations = make(map[string]string)
+	}
+	if len(status.BuildURI) > 0 && build.Annotations[buildapi.BuildJenkinsBuildURIAnnotation] != status.BuildURI {
+		build.Annotations[buildapi.BuildJenkinsBuildURIAnnotation] = status.BuildURI
+		changed = true
+	}
+	if nextStatus != build.Status.Phase {
+		glog.V(4).Infof("Updating build %s/%s status %s -> %s", build.Namespace, build.Name, build.Status.Phase, nextStatus)
+		now := unversioned.Now()
+		if build.Status.StartTimestamp == nil && nextStatus != buildapi.BuildPhaseCancelled {
+			build.Status.StartTimestamp = &now
+		}
+		build.Status.Phase = nextStatus
+		build.Status.Reason = ""
+		build.Status.Message = ""
+		if buildutil.IsBuildComplete(build) {
+			build.Status.CompletionTimestamp = &now
+		}
+		changed, phaseChanged = true, true
+	}
+	if !changed {
+		return nil
+	}
+
+	if err := bc.BuildUpdater.Update(build.Namespace, build); err != nil {
+		return fmt.Errorf("failed to update build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+	if phaseChanged {
+		notifyCommitStatus(build, bc.StatusNotifier)
+	}
+	if buildutil.IsBuildComplete(build) {
+		handleBuildCompletion(build, bc.RunPolicies, bc.HistoryPruner)
+	}
+	return nil
+}
+
 // resolveOutputDockerImageReference returns a reference to a Docker image
 // computed from the buid.Spec.Output.To reference.
 func (bc *BuildController) resolveOutputDockerImageReference(build *buildapi.Build) (string, error) {
@@ -228,9 +385,13 @@ func (bc *BuildController) resolveOutputDockerImageReference(build *buildapi.Bui
 
 // BuildPodController watches pods running builds and manages the build state
 type BuildPodController struct {
-	BuildStore   cache.Store
-	BuildUpdater buildclient.BuildUpdater
-	PodManager   podManager
+	BuildStore     cache.Store
+	BuildUpdater   buildclient.BuildUpdater
+	PodManager     podManager
+	RunPolicies    []policy.RunPolicy
+	HistoryPruner  historyPruner
+	StatusNotifier commitStatusNotifier
+	LogArchiver    logArchiver
 }
 
 // HandlePod updates the state of the build based on the pod state
@@ -289,6 +450,11 @@ func (bc *BuildPodController) HandlePod(pod *kapi.Pod) error {
 			return fmt.Errorf("failed to update build %s/%s: %v", build.Namespace, build.Name, err)
 		}
 		glog.V(4).Infof("Build %s/%s status was updated %s -> %s", build.Namespace, build.Name, build.Status.Phase, nextStatus)
+		notifyCommitStatus(build, bc.StatusNotifier)
+		if buildutil.IsBuildComplete(build) {
+			archiveBuildLog(build, bc.LogArchiver)
+			handleBuildCompletion(build, bc.RunPolicies, bc.HistoryPruner)
+		}
 	}
 	return nil
 }
@@ -300,8 +466,11 @@ func isBuildCancellable(build *buildapi.Build) bool {
 
 // BuildPodDeleteController watches pods running builds and updates the build if the pod is deleted
 type BuildPodDeleteController struct {
-	BuildStore   cache.Store
-	BuildUpdater buildclient.BuildUpdater
+	BuildStore     cache.Store
+	BuildUpdater   buildclient.BuildUpdater
+	RunPolicies    []policy.RunPolicy
+	HistoryPruner  historyPruner
+	StatusNotifier commitStatusNotifier
 }
 
 // HandleBuildPodDeletion sets the status of a build to error if the build pod has been deleted
@@ -340,18 +509,40 @@ func (bc *BuildPodDeleteController) HandleBuildPodDeletion(pod *kapi.Pod) error
 		if err := bc.BuildUpdater.Update(build.Namespace, build); err != nil {
 			return fmt.Errorf("Failed to update build %s/%s: %v", build.Namespace, build.Name, err)
 		}
+		notifyCommitStatus(build, bc.StatusNotifier)
+		handleBuildCompletion(build, bc.RunPolicies, bc.HistoryPruner)
 	}
 	return nil
 }
 
 // BuildDeleteController watches for builds being deleted and cleans up associated pods
 type BuildDeleteController struct {
-	PodManager podManager
+	PodManager     podManager
+	LogArchive     archivedLogDeleter
+	PipelineClient pipelineJobDeleter
 }
 
-// HandleBuildDeletion deletes a build pod if the corresponding build has been deleted
+// HandleBuildDeletion deletes a build pod, the archived build log and the
+// Jenkins job of a Jenkins Pipeline build if the corresponding build has been
+// deleted
 func (bc *BuildDeleteController) HandleBuildDeletion(build *buildapi.Build) error {
 	glog.V(4).Infof("Handling deletion of build %s", build.Name)
+	if bc.LogArchive != nil {
+		if err := bc.LogArchive.Delete(build.Namespace, build.Name); err != nil {
+			glog.V(2).Infof("Failed to delete the archived log of build %s/%s due to error: %v", build.Namespace, build.Name, err)
+			return err
+		}
+	}
+	if build.Spec.Strategy.JenkinsPipelineStrategy != nil {
+		if bc.PipelineClient == nil {
+			return nil
+		}
+		if err := bc.PipelineClient.DeleteJob(build); err != nil {
+			glog.V(2).Infof("Failed to delete the Jenkins job of build %s/%s due to error: %v", build.Namespace, build.Name, err)
+			return err
+		}
+		return nil
+	}
 	podName := buildutil.GetBuildPodName(build)
 	pod, err := bc.PodManager.GetPod(build.Namespace, podName)
 	if err != nil && !errors.IsNotFound(err) {
@@ -374,6 +565,47 @@ func (bc *BuildDeleteController) HandleBuildDeletion(build *buildapi.Build) erro
 	return nil
 }
 
+// handleBuildCompletion notifies the run policy of the build that the build
+// has completed, so the policy can start the next queued build, and prunes the
+// build history of its BuildConfig.
+func handleBuildCompletion(build *buildapi.Build, runPolicies []policy.RunPolicy, pruner historyPruner) {
+	runPolicy := policy.ForBuild(build, runPolicies)
+	if runPolicy == nil {
+		glog.Errorf("Unable to determine build run policy for %s/%s", build.Namespace, build.Name)
+	} else if err := runPolicy.OnComplete(build); err != nil {
+		glog.Errorf("Failed to run onComplete for build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+	if pruner == nil {
+		return
+	}
+	if err := pruner.Prune(build); err != nil {
+		glog.Errorf("Failed to prune build history for build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+}
+
+// notifyCommitStatus reports the phase of the build to the host of its source.
+// Failing to report the status does not affect the build.
+func notifyCommitStatus(build *buildapi.Build, notifier commitStatusNotifier) {
+	if notifier == nil {
+		return
+	}
+	if err := notifier.Notify(build); err != nil {
+		glog.Errorf("Failed to report the status of build %s/%s to its source: %v", build.Namespace, build.Name, err)
+	}
+}
+
+// archiveBuildLog copies the log of a completed build into the log archive
+// while its build pod still exists. Failing to archive the log does not affect
+// the build.
+func archiveBuildLog(build *buildapi.Build, archiver logArchiver) {
+	if archiver == nil {
+		return
+	}
+	if err := archiver.ArchiveBuildLog(build); err != nil {
+		glog.Errorf("Failed to archive the log of build %s/%s: %v", build.Namespace, build.Name, err)
+	}
+}
+
 // buildKey returns a build object that can be used to lookup a build
 // in the cache store, given a pod for the build
 func buildKey(pod *kapi.Pod) *buildapi.Build {
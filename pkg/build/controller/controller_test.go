@@ -9,10 +9,14 @@ import (
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/record"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildclient "github.com/openshift/origin/pkg/build/client"
+	"github.com/openshift/origin/pkg/build/controller/policy"
 	buildtest "github.com/openshift/origin/pkg/build/controller/test"
+	"github.com/openshift/origin/pkg/build/jenkins"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 )
 
@@ -28,6 +32,12 @@ func (ec *errBuildUpdater) Update(namespace string, build *buildapi.Build) error
 	return errors.New("UpdateBuild error!")
 }
 
+type okBuildLister struct{}
+
+func (okc *okBuildLister) List(namespace string, label labels.Selector, field fields.Selector) (*buildapi.BuildList, error) {
+	return &buildapi.BuildList{}, nil
+}
+
 type okStrategy struct {
 	build *buildapi.Build
 }
@@ -142,6 +152,7 @@ func mockBuildController() *BuildController {
 		BuildStrategy:     &okStrategy{},
 		ImageStreamClient: &okImageStreamClient{},
 		Recorder:          &record.FakeRecorder{},
+		RunPolicies:       policy.GetAllRunPolicies(&okBuildLister{}, &okBuildUpdater{}),
 	}
 }
 
@@ -150,6 +161,7 @@ func mockBuildPodController(build *buildapi.Build) *BuildPodController {
 		BuildStore:   buildtest.NewFakeBuildStore(build),
 		BuildUpdater: &okBuildUpdater{},
 		PodManager:   &okPodManager{},
+		RunPolicies:  policy.GetAllRunPolicies(&okBuildLister{}, &okBuildUpdater{}),
 	}
 }
 
@@ -417,6 +429,130 @@ func TestHandleBuild(t *testing.T) {
 	}
 }
 
+type runningBuildLister struct{}
+
+func (*runningBuildLister) List(namespace string, label labels.Selector, field fields.Selector) (*buildapi.BuildList, error) {
+	build := mockBuild(buildapi.BuildPhaseRunning, buildapi.BuildOutput{})
+	build.Name = "data-build-1"
+	build.Labels[buildapi.BuildConfigLabel] = "data"
+	build.Labels[buildapi.BuildRunPolicyLabel] = string(buildapi.BuildRunPolicySerial)
+	build.Annotations = map[string]string{buildapi.BuildNumberAnnotation: "1"}
+	return &buildapi.BuildList{Items: []buildapi.Build{*build}}, nil
+}
+
+func TestHandleBuildRunPolicy(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseNew, buildapi.BuildOutput{})
+	build.Name = "data-build-2"
+	build.Labels[buildapi.BuildConfigLabel] = "data"
+	build.Labels[buildapi.BuildRunPolicyLabel] = string(buildapi.BuildRunPolicySerial)
+	build.Annotations = map[string]string{buildapi.BuildNumberAnnotation: "2"}
+	build.Status.Config = &kapi.ObjectReference{Kind: "BuildConfig", Name: "data", Namespace: build.Namespace}
+
+	ctrl := mockBuildController()
+	ctrl.RunPolicies = policy.GetAllRunPolicies(&runningBuildLister{}, &okBuildUpdater{})
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if build.Status.Phase != buildapi.BuildPhaseNew {
+		t.Errorf("Expected the serial build to wait in the %s phase, got %s", buildapi.BuildPhaseNew, build.Status.Phase)
+	}
+
+	ctrl.RunPolicies = nil
+	if err := ctrl.HandleBuild(build); err == nil {
+		t.Errorf("Expected an error when no run policy handles the build")
+	}
+}
+
+type fakePipelineClient struct {
+	status  *jenkins.Status
+	started bool
+	stopped bool
+	deleted bool
+}
+
+func (c *fakePipelineClient) Start(build *buildapi.Build) (string, error) {
+	c.started = true
+	return "http://jenkins/queue/item/1/", nil
+}
+
+func (c *fakePipelineClient) GetStatus(build *buildapi.Build) (*jenkins.Status, error) {
+	return c.status, nil
+}
+
+func (c *fakePipelineClient) Stop(build *buildapi.Build) error {
+	c.stopped = true
+	return nil
+}
+
+func (c *fakePipelineClient) DeleteJob(build *buildapi.Build) error {
+	c.deleted = true
+	return nil
+}
+
+func TestHandlePipelineBuild(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseNew, buildapi.BuildOutput{})
+	build.Spec.Strategy = buildapi.BuildStrategy{JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{}}
+	strategy := &okStrategy{}
+	pipelineClient := &fakePipelineClient{status: &jenkins.Status{}}
+	ctrl := mockBuildController()
+	ctrl.BuildStrategy = strategy
+	ctrl.PipelineClient = pipelineClient
+
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if !pipelineClient.started {
+		t.Errorf("Expected the build to be handed off to Jenkins")
+	}
+	if strategy.build != nil {
+		t.Errorf("Expected no build pod to be created for a pipeline build")
+	}
+	if build.Status.Phase != buildapi.BuildPhasePending {
+		t.Errorf("Expected the build to be %s, got %s", buildapi.BuildPhasePending, build.Status.Phase)
+	}
+	if build.Annotations[buildapi.BuildJenkinsQueueURIAnnotation] != "http://jenkins/queue/item/1/" {
+		t.Errorf("Expected the Jenkins queue URI to be recorded, got %v", build.Annotations)
+	}
+
+	pipelineClient.status = &jenkins.Status{Started: true, Building: true, BuildURI: "http://jenkins/job/1/"}
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if build.Status.Phase != buildapi.BuildPhaseRunning || build.Status.StartTimestamp == nil {
+		t.Errorf("Expected the build to be %s with a start timestamp, got %s", buildapi.BuildPhaseRunning, build.Status.Phase)
+	}
+	if build.Annotations[buildapi.BuildJenkinsBuildURIAnnotation] != "http://jenkins/job/1/" {
+		t.Errorf("Expected the Jenkins build URI to be recorded, got %v", build.Annotations)
+	}
+
+	pipelineClient.status = &jenkins.Status{Started: true, Result: jenkins.ResultFailure, BuildURI: "http://jenkins/job/1/"}
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if build.Status.Phase != buildapi.BuildPhaseFailed || build.Status.CompletionTimestamp == nil {
+		t.Errorf("Expected the build to be %s with a completion timestamp, got %s", buildapi.BuildPhaseFailed, build.Status.Phase)
+	}
+}
+
+func TestCancelPipelineBuild(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseRunning, buildapi.BuildOutput{})
+	build.Spec.Strategy = buildapi.BuildStrategy{JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{}}
+	pipelineClient := &fakePipelineClient{}
+	ctrl := mockBuildController()
+	ctrl.PodManager = &errPodManager{}
+	ctrl.PipelineClient = pipelineClient
+
+	if err := ctrl.CancelBuild(build); err != nil {
+		t.Fatalf("Unexpected error %v", err)
+	}
+	if !pipelineClient.stopped {
+		t.Errorf("Expected the Jenkins pipeline to be stopped")
+	}
+	if build.Status.Phase != buildapi.BuildPhaseCancelled {
+		t.Errorf("Expected the build to be %s, got %s", buildapi.BuildPhaseCancelled, build.Status.Phase)
+	}
+}
+
 func TestHandlePod(t *testing.T) {
 	type handlePodTest struct {
 		matchID             bool
@@ -545,6 +681,69 @@ func TestHandlePod(t *testing.T) {
 	}
 }
 
+type fakeStatusNotifier struct {
+	phases []buildapi.BuildPhase
+}
+
+func (n *fakeStatusNotifier) Notify(build *buildapi.Build) error {
+	n.phases = append(n.phases, build.Status.Phase)
+	return errors.New("Notify error!")
+}
+
+func TestCommitStatusNotification(t *testing.T) {
+	notifier := &fakeStatusNotifier{}
+
+	build := mockBuild(buildapi.BuildPhaseNew, buildapi.BuildOutput{})
+	ctrl := mockBuildController()
+	ctrl.StatusNotifier = notifier
+	if err := ctrl.HandleBuild(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	build.Name = "name"
+	podCtrl := mockBuildPodController(build)
+	podCtrl.StatusNotifier = notifier
+	// the pod still pending does not change the phase of the build
+	for _, pod := range []*kapi.Pod{mockPod(kapi.PodPending, 0), mockPod(kapi.PodRunning, 0), mockPod(kapi.PodSucceeded, 0)} {
+		if err := podCtrl.HandlePod(pod); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	expected := []buildapi.BuildPhase{buildapi.BuildPhasePending, buildapi.BuildPhaseRunning, buildapi.BuildPhaseComplete}
+	if !reflect.DeepEqual(notifier.phases, expected) {
+		t.Errorf("expected the phases %v to be reported, got %v", expected, notifier.phases)
+	}
+}
+
+type fakeLogArchiver struct {
+	archived []string
+}
+
+func (a *fakeLogArchiver) ArchiveBuildLog(build *buildapi.Build) error {
+	a.archived = append(a.archived, build.Name)
+	return errors.New("ArchiveBuildLog error!")
+}
+
+func TestArchiveBuildLog(t *testing.T) {
+	archiver := &fakeLogArchiver{}
+
+	build := mockBuild(buildapi.BuildPhasePending, buildapi.BuildOutput{})
+	build.Name = "name"
+	ctrl := mockBuildPodController(build)
+	ctrl.LogArchiver = archiver
+	// the log is archived once the build completes, even if it failed
+	for _, pod := range []*kapi.Pod{mockPod(kapi.PodRunning, 0), mockPod(kapi.PodFailed, 1), mockPod(kapi.PodFailed, 1)} {
+		if err := ctrl.HandlePod(pod); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if expected := []string{"name"}; !reflect.DeepEqual(archiver.archived, expected) {
+		t.Errorf("expected the logs of %v to be archived, got %v", expected, archiver.archived)
+	}
+}
+
 func TestCancelBuild(t *testing.T) {
 	type handleCancelBuildTest struct {
 		inStatus            buildapi.BuildPhase
@@ -699,7 +898,7 @@ func (c *customPodManager) GetPod(namespace, name string) (*kapi.Pod, error) {
 func TestHandleHandleBuildDeletionOK(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{buildapi.BuildLabel: build.Name}}}, nil
 		},
@@ -721,7 +920,7 @@ func TestHandleHandleBuildDeletionOK(t *testing.T) {
 func TestHandleHandleBuildDeletionOKDeprecatedLabel(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{buildapi.BuildLabel: build.Name}}}, nil
 		},
@@ -742,7 +941,7 @@ func TestHandleHandleBuildDeletionOKDeprecatedLabel(t *testing.T) {
 
 func TestHandleHandleBuildDeletionFailGetPod(t *testing.T) {
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, name string) (*kapi.Pod, error) {
 			return nil, errors.New("random")
 		},
@@ -757,7 +956,7 @@ func TestHandleHandleBuildDeletionFailGetPod(t *testing.T) {
 func TestHandleHandleBuildDeletionGetPodNotFound(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, name string) (*kapi.Pod, error) {
 			return nil, kerrors.NewNotFound("Pod", name)
 		},
@@ -779,7 +978,7 @@ func TestHandleHandleBuildDeletionGetPodNotFound(t *testing.T) {
 func TestHandleHandleBuildDeletionMismatchedLabels(t *testing.T) {
 	deleteWasCalled := false
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{}, nil
 		},
@@ -800,7 +999,7 @@ func TestHandleHandleBuildDeletionMismatchedLabels(t *testing.T) {
 
 func TestHandleHandleBuildDeletionDeletePodError(t *testing.T) {
 	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
-	ctrl := BuildDeleteController{&customPodManager{
+	ctrl := BuildDeleteController{PodManager: &customPodManager{
 		GetPodFunc: func(namespace, names string) (*kapi.Pod, error) {
 			return &kapi.Pod{ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{buildapi.BuildLabel: build.Name}}}, nil
 		},
@@ -815,6 +1014,64 @@ func TestHandleHandleBuildDeletionDeletePodError(t *testing.T) {
 	}
 }
 
+type fakeArchivedLogDeleter struct {
+	deleted []string
+	err     error
+}
+
+func (d *fakeArchivedLogDeleter) Delete(namespace, name string) error {
+	d.deleted = append(d.deleted, namespace+"/"+name)
+	return d.err
+}
+
+func TestHandleHandleBuildDeletionArchivedLog(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
+	build.Namespace = "namespace"
+	deleter := &fakeArchivedLogDeleter{}
+	ctrl := BuildDeleteController{
+		PodManager: &customPodManager{
+			GetPodFunc: func(namespace, name string) (*kapi.Pod, error) {
+				return nil, kerrors.NewNotFound("Pod", name)
+			},
+		},
+		LogArchive: deleter,
+	}
+
+	if err := ctrl.HandleBuildDeletion(build); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if expected := []string{"namespace/" + build.Name}; !reflect.DeepEqual(deleter.deleted, expected) {
+		t.Errorf("Expected the archived log of %v to be deleted, got %v", expected, deleter.deleted)
+	}
+
+	deleter.err = errors.New("random")
+	if err := ctrl.HandleBuildDeletion(build); err == nil {
+		t.Error("Expected random error got none!")
+	}
+}
+
+func TestHandleBuildDeletionPipelineJob(t *testing.T) {
+	build := mockBuild(buildapi.BuildPhaseComplete, buildapi.BuildOutput{})
+	build.Spec.Strategy = buildapi.BuildStrategy{JenkinsPipelineStrategy: &buildapi.JenkinsPipelineBuildStrategy{}}
+	pipelineClient := &fakePipelineClient{}
+	ctrl := BuildDeleteController{
+		PodManager: &customPodManager{
+			GetPodFunc: func(namespace, name string) (*kapi.Pod, error) {
+				t.Errorf("Unexpected lookup of the pod %s/%s of a pipeline build", namespace, name)
+				return nil, kerrors.NewNotFound("Pod", name)
+			},
+		},
+		PipelineClient: pipelineClient,
+	}
+
+	if err := ctrl.HandleBuildDeletion(build); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if !pipelineClient.deleted {
+		t.Errorf("Expected the Jenkins job of the build to be deleted")
+	}
+}
+
 type customBuildUpdater struct {
 	UpdateFunc func(namespace string, build *buildapi.Build) error
 }
@@ -827,6 +1084,7 @@ func mockBuildPodDeleteController(build *buildapi.Build, buildUpdater *customBui
 	return &BuildPodDeleteController{
 		BuildStore:   buildtest.FakeBuildStore{Build: build, Err: err},
 		BuildUpdater: buildUpdater,
+		RunPolicies:  policy.GetAllRunPolicies(&okBuildLister{}, buildUpdater),
 	}
 }
 
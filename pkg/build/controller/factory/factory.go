@@ -19,8 +19,12 @@ import (
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildclient "github.com/openshift/origin/pkg/build/client"
+	"github.com/openshift/origin/pkg/build/commitstatus"
 	buildcontroller "github.com/openshift/origin/pkg/build/controller"
+	"github.com/openshift/origin/pkg/build/controller/policy"
 	strategy "github.com/openshift/origin/pkg/build/controller/strategy"
+	"github.com/openshift/origin/pkg/build/jenkins"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 	osclient "github.com/openshift/origin/pkg/client"
 	controller "github.com/openshift/origin/pkg/controller"
@@ -61,6 +65,12 @@ type BuildControllerFactory struct {
 	DockerBuildStrategy *strategy.DockerBuildStrategy
 	SourceBuildStrategy *strategy.SourceBuildStrategy
 	CustomBuildStrategy *strategy.CustomBuildStrategy
+	// PublicURL is the public URL of the master, commit statuses reported for
+	// builds link to the build logs under it.
+	PublicURL string
+	// LogArchive holds the logs of completed builds. If set, the archived log
+	// of a build is deleted with the build.
+	LogArchive logarchive.Archive
 	// Stop may be set to allow controllers created by this factory to be terminated.
 	Stop <-chan struct{}
 }
@@ -74,6 +84,7 @@ func (factory *BuildControllerFactory) Create() controller.RunnableController {
 	eventBroadcaster.StartRecordingToSink(factory.KubeClient.Events(""))
 
 	client := ControllerClient{factory.KubeClient, factory.OSClient}
+	buildLister := buildclient.NewOSClientBuildClient(factory.OSClient)
 	buildController := &buildcontroller.BuildController{
 		BuildUpdater:      factory.BuildUpdater,
 		ImageStreamClient: client,
@@ -83,7 +94,13 @@ func (factory *BuildControllerFactory) Create() controller.RunnableController {
 			SourceBuildStrategy: factory.SourceBuildStrategy,
 			CustomBuildStrategy: factory.CustomBuildStrategy,
 		},
-		Recorder: eventBroadcaster.NewRecorder(kapi.EventSource{Component: "build-controller"}),
+		Recorder:      eventBroadcaster.NewRecorder(kapi.EventSource{Component: "build-controller"}),
+		RunPolicies:   policy.GetAllRunPolicies(buildLister, factory.BuildUpdater),
+		HistoryPruner: newBuildHistoryPruner(factory.OSClient),
+		PipelineClient: &jenkins.Client{
+			ResolveURL: jenkins.NewServiceURLResolver(factory.KubeClient, jenkins.DefaultServiceName),
+		},
+		StatusNotifier: newCommitStatusNotifier(factory.OSClient, factory.KubeClient, factory.PublicURL),
 	}
 
 	return &controller.RetryController{
@@ -123,6 +140,12 @@ func (factory *BuildControllerFactory) CreateDeleteController() controller.Runna
 
 	buildDeleteController := &buildcontroller.BuildDeleteController{
 		PodManager: client,
+		PipelineClient: &jenkins.Client{
+			ResolveURL: jenkins.NewServiceURLResolver(factory.KubeClient, jenkins.DefaultServiceName),
+		},
+	}
+	if factory.LogArchive != nil {
+		buildDeleteController.LogArchive = factory.LogArchive
 	}
 
 	return &controller.RetryController{
@@ -149,6 +172,12 @@ type BuildPodControllerFactory struct {
 	OSClient     osclient.Interface
 	KubeClient   kclient.Interface
 	BuildUpdater buildclient.BuildUpdater
+	// PublicURL is the public URL of the master, commit statuses reported for
+	// builds link to the build logs under it.
+	PublicURL string
+	// LogArchive holds the logs of completed builds. If set, the log of a
+	// build is copied into it when the build completes.
+	LogArchive logarchive.Archive
 	// Stop may be set to allow controllers created by this factory to be terminated.
 	Stop <-chan struct{}
 
@@ -186,10 +215,20 @@ func (factory *BuildPodControllerFactory) Create() controller.RunnableController
 	cache.NewReflector(&podLW{client: factory.KubeClient}, &kapi.Pod{}, queue, 2*time.Minute).RunUntil(factory.Stop)
 
 	client := ControllerClient{factory.KubeClient, factory.OSClient}
+	buildLister := buildclient.NewOSClientBuildClient(factory.OSClient)
 	buildPodController := &buildcontroller.BuildPodController{
-		BuildStore:   factory.buildStore,
-		BuildUpdater: factory.BuildUpdater,
-		PodManager:   client,
+		BuildStore:     factory.buildStore,
+		BuildUpdater:   factory.BuildUpdater,
+		PodManager:     client,
+		RunPolicies:    policy.GetAllRunPolicies(buildLister, factory.BuildUpdater),
+		HistoryPruner:  newBuildHistoryPruner(factory.OSClient),
+		StatusNotifier: newCommitStatusNotifier(factory.OSClient, factory.KubeClient, factory.PublicURL),
+	}
+	if factory.LogArchive != nil {
+		buildPodController.LogArchiver = &logarchive.Archiver{
+			Archive: factory.LogArchive,
+			PodLogs: factory.KubeClient,
+		}
 	}
 
 	return &controller.RetryController{
@@ -206,6 +245,27 @@ func (factory *BuildPodControllerFactory) Create() controller.RunnableController
 	}
 }
 
+// newBuildHistoryPruner returns a pruner that deletes the builds exceeding the
+// history limits of their BuildConfig using the OpenShift client.
+func newBuildHistoryPruner(client osclient.Interface) *buildcontroller.BuildHistoryPruner {
+	buildClient := buildclient.NewOSClientBuildClient(client)
+	return &buildcontroller.BuildHistoryPruner{
+		BuildConfigGetter: buildclient.NewOSClientBuildConfigClient(client),
+		BuildLister:       buildClient,
+		BuildDeleter:      buildClient,
+	}
+}
+
+// newCommitStatusNotifier returns a notifier reporting the phase of builds to
+// the host of their source as configured on their BuildConfig.
+func newCommitStatusNotifier(osClient osclient.Interface, kubeClient kclient.Interface, publicURL string) *commitstatus.Notifier {
+	return &commitstatus.Notifier{
+		BuildConfigGetter: buildclient.NewOSClientBuildConfigClient(osClient),
+		SecretsClient:     kubeClient,
+		PublicURL:         publicURL,
+	}
+}
+
 // keyListerGetter is a dummy implementation of a KeyListerGetter
 // which always returns a fake object and true for gets, and
 // returns no items for list.  This forces the DeltaFIFO queue
@@ -236,9 +296,13 @@ func (factory *BuildPodControllerFactory) CreateDeleteController() controller.Ru
 	queue := cache.NewDeltaFIFO(cache.MetaNamespaceKeyFunc, nil, keyListerGetter{})
 	cache.NewReflector(&buildPodDeleteLW{client, queue}, &kapi.Pod{}, queue, 5*time.Minute).RunUntil(factory.Stop)
 
+	buildLister := buildclient.NewOSClientBuildClient(factory.OSClient)
 	buildPodDeleteController := &buildcontroller.BuildPodDeleteController{
-		BuildStore:   factory.buildStore,
-		BuildUpdater: factory.BuildUpdater,
+		BuildStore:     factory.buildStore,
+		BuildUpdater:   factory.BuildUpdater,
+		RunPolicies:    policy.GetAllRunPolicies(buildLister, factory.BuildUpdater),
+		HistoryPruner:  newBuildHistoryPruner(factory.OSClient),
+		StatusNotifier: newCommitStatusNotifier(factory.OSClient, factory.KubeClient, factory.PublicURL),
 	}
 
 	return &controller.RetryController{
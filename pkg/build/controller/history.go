@@ -0,0 +1,71 @@
+package controller
+
+import (
+	"github.com/golang/glog"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	"github.com/openshift/origin/pkg/build/prune"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+type historyPruner interface {
+	Prune(build *buildapi.Build) error
+}
+
+// BuildHistoryPruner deletes the oldest completed builds of a BuildConfig once
+// their number exceeds the history limits set on the BuildConfig.
+type BuildHistoryPruner struct {
+	BuildConfigGetter buildclient.BuildConfigGetter
+	BuildLister       buildclient.BuildLister
+	BuildDeleter      buildclient.BuildDeleter
+}
+
+// Prune removes the builds of the given build's BuildConfig that are past the
+// successful and failed build history limits. Builds that were not created
+// from a BuildConfig, or whose BuildConfig sets no limits, are left alone.
+func (p *BuildHistoryPruner) Prune(build *buildapi.Build) error {
+	bcName := buildutil.ConfigNameForBuild(build)
+	if len(bcName) == 0 {
+		return nil
+	}
+	bc, err := p.BuildConfigGetter.Get(build.Namespace, bcName)
+	if err != nil {
+		return err
+	}
+	if bc.Spec.SuccessfulBuildsHistoryLimit == nil && bc.Spec.FailedBuildsHistoryLimit == nil {
+		return nil
+	}
+
+	builds, err := buildutil.BuildConfigBuilds(p.BuildLister, build.Namespace, bcName, func(b buildapi.Build) bool {
+		return buildutil.IsBuildComplete(&b)
+	})
+	if err != nil {
+		return err
+	}
+	candidates := []*buildapi.Build{}
+	for i := range builds.Items {
+		candidates = append(candidates, &builds.Items[i])
+	}
+
+	keepComplete, keepFailed := -1, -1
+	if bc.Spec.SuccessfulBuildsHistoryLimit != nil {
+		keepComplete = *bc.Spec.SuccessfulBuildsHistoryLimit
+	}
+	if bc.Spec.FailedBuildsHistoryLimit != nil {
+		keepFailed = *bc.Spec.FailedBuildsHistoryLimit
+	}
+	dataSet := prune.NewDataSet([]*buildapi.BuildConfig{bc}, candidates)
+	prunable, err := prune.NewPerBuildConfigResolver(dataSet, keepComplete, keepFailed).Resolve()
+	if err != nil {
+		return err
+	}
+
+	for _, b := range prunable {
+		glog.V(4).Infof("Deleting build %s/%s because it exceeds the history limit of BuildConfig %s", b.Namespace, b.Name, bcName)
+		if err := p.BuildDeleter.Delete(b.Namespace, b.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/util/sets"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+type historyBuildClient struct {
+	config  *buildapi.BuildConfig
+	builds  []buildapi.Build
+	deleted sets.String
+}
+
+func (c *historyBuildClient) Get(namespace, name string) (*buildapi.BuildConfig, error) {
+	return c.config, nil
+}
+
+func (c *historyBuildClient) List(namespace string, label labels.Selector, field fields.Selector) (*buildapi.BuildList, error) {
+	return &buildapi.BuildList{Items: c.builds}, nil
+}
+
+func (c *historyBuildClient) Delete(namespace, name string) error {
+	c.deleted.Insert(name)
+	return nil
+}
+
+func newHistoryBuildClient(successfulLimit, failedLimit *int, phases ...buildapi.BuildPhase) *historyBuildClient {
+	client := &historyBuildClient{
+		config: &buildapi.BuildConfig{
+			ObjectMeta: kapi.ObjectMeta{Name: "sample", Namespace: "test"},
+			Spec: buildapi.BuildConfigSpec{
+				SuccessfulBuildsHistoryLimit: successfulLimit,
+				FailedBuildsHistoryLimit:     failedLimit,
+			},
+		},
+		deleted: sets.NewString(),
+	}
+	now := time.Now()
+	for i, phase := range phases {
+		client.builds = append(client.builds, buildapi.Build{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:              "sample-" + strconv.Itoa(i+1),
+				Namespace:         "test",
+				CreationTimestamp: unversioned.NewTime(now.Add(time.Duration(i) * time.Minute)),
+				Labels:            map[string]string{buildapi.BuildConfigLabel: "sample"},
+			},
+			Status: buildapi.BuildStatus{
+				Phase:  phase,
+				Config: &kapi.ObjectReference{Kind: "BuildConfig", Namespace: "test", Name: "sample"},
+			},
+		})
+	}
+	return client
+}
+
+func TestBuildHistoryPruner(t *testing.T) {
+	zero, one := 0, 1
+	tests := []struct {
+		name            string
+		successfulLimit *int
+		failedLimit     *int
+		phases          []buildapi.BuildPhase
+		expectedDeleted []string
+	}{
+		{
+			name:   "no limits",
+			phases: []buildapi.BuildPhase{buildapi.BuildPhaseComplete, buildapi.BuildPhaseComplete, buildapi.BuildPhaseFailed},
+		},
+		{
+			name:            "successful limit",
+			successfulLimit: &one,
+			phases:          []buildapi.BuildPhase{buildapi.BuildPhaseComplete, buildapi.BuildPhaseFailed, buildapi.BuildPhaseComplete, buildapi.BuildPhaseComplete},
+			expectedDeleted: []string{"sample-1", "sample-3"},
+		},
+		{
+			name:            "failed limit",
+			failedLimit:     &one,
+			phases:          []buildapi.BuildPhase{buildapi.BuildPhaseFailed, buildapi.BuildPhaseError, buildapi.BuildPhaseCancelled, buildapi.BuildPhaseComplete},
+			expectedDeleted: []string{"sample-1", "sample-2"},
+		},
+		{
+			name:            "zero limits",
+			successfulLimit: &zero,
+			failedLimit:     &zero,
+			phases:          []buildapi.BuildPhase{buildapi.BuildPhaseComplete, buildapi.BuildPhaseFailed, buildapi.BuildPhaseRunning, buildapi.BuildPhaseNew},
+			expectedDeleted: []string{"sample-1", "sample-2"},
+		},
+	}
+	for _, tc := range tests {
+		client := newHistoryBuildClient(tc.successfulLimit, tc.failedLimit, tc.phases...)
+		pruner := &BuildHistoryPruner{BuildConfigGetter: client, BuildLister: client, BuildDeleter: client}
+		if err := pruner.Prune(&client.builds[0]); err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if expected := sets.NewString(tc.expectedDeleted...); !expected.Equal(client.deleted) {
+			t.Errorf("%s: expected deleted builds %v, got %v", tc.name, expected.List(), client.deleted.List())
+		}
+	}
+}
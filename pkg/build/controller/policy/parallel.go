@@ -0,0 +1,37 @@
+package policy
+
+import (
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// ParallelPolicy implements the RunPolicy interface. Build created using this
+// run policy will start immediately after they are created and they will run
+// in parallel with other builds created from the same build configuration.
+type ParallelPolicy struct {
+	BuildUpdater buildclient.BuildUpdater
+	BuildLister  buildclient.BuildLister
+}
+
+// IsRunnable implements the RunPolicy interface.
+// The parallel builds are runnable unless there is a serial build running for
+// the same build configuration, in which case they wait for it to complete.
+func (s *ParallelPolicy) IsRunnable(build *buildapi.Build) (bool, error) {
+	bcName := buildutil.ConfigNameForBuild(build)
+	if len(bcName) == 0 {
+		return true, nil
+	}
+	hasRunningBuilds, err := hasRunningSerialBuild(s.BuildLister, build.Namespace, bcName)
+	return !hasRunningBuilds, err
+}
+
+// OnComplete implements the RunPolicy interface.
+func (s *ParallelPolicy) OnComplete(build *buildapi.Build) error {
+	return nil
+}
+
+// Handles implements the RunPolicy interface.
+func (s *ParallelPolicy) Handles(policy buildapi.BuildRunPolicy) bool {
+	return policy == buildapi.BuildRunPolicyParallel
+}
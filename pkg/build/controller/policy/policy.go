@@ -0,0 +1,122 @@
+package policy
+
+import (
+	"github.com/golang/glog"
+
+	kutil "k8s.io/kubernetes/pkg/util"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// RunPolicy is an interface that defines a handler for the build runPolicy field.
+// The run policy controls how and when the new builds are 'run'.
+type RunPolicy interface {
+	// IsRunnable returns true if the given build should be executed.
+	IsRunnable(*buildapi.Build) (bool, error)
+
+	// OnComplete allows policy to execute action when the given build just
+	// completed.
+	OnComplete(*buildapi.Build) error
+
+	// Handles returns true if the run policy handles a specific policy
+	Handles(buildapi.BuildRunPolicy) bool
+}
+
+// GetAllRunPolicies returns a set of all run policies.
+func GetAllRunPolicies(lister buildclient.BuildLister, updater buildclient.BuildUpdater) []RunPolicy {
+	return []RunPolicy{
+		&ParallelPolicy{BuildLister: lister, BuildUpdater: updater},
+		&SerialPolicy{BuildLister: lister, BuildUpdater: updater},
+		&SerialLatestOnlyPolicy{BuildLister: lister, BuildUpdater: updater},
+	}
+}
+
+// ForBuild picks the appropriate run policy for the given build.
+func ForBuild(build *buildapi.Build, policies []RunPolicy) RunPolicy {
+	for _, s := range policies {
+		if s.Handles(buildutil.BuildRunPolicy(build)) {
+			glog.V(5).Infof("Using %T run policy for build %s/%s", s, build.Namespace, build.Name)
+			return s
+		}
+	}
+	return nil
+}
+
+// hasRunningSerialBuild indicates that there is a running or pending serial
+// build. This function is used to prevent running parallel builds because
+// serial builds should always run alone.
+func hasRunningSerialBuild(lister buildclient.BuildLister, namespace, buildConfigName string) (bool, error) {
+	var hasRunningBuilds bool
+	_, err := buildutil.BuildConfigBuilds(lister, namespace, buildConfigName, func(b buildapi.Build) bool {
+		switch b.Status.Phase {
+		case buildapi.BuildPhasePending, buildapi.BuildPhaseRunning:
+			switch buildutil.BuildRunPolicy(&b) {
+			case buildapi.BuildRunPolicySerial, buildapi.BuildRunPolicySerialLatestOnly:
+				hasRunningBuilds = true
+			}
+		}
+		return false
+	})
+	return hasRunningBuilds, err
+}
+
+// GetNextConfigBuild returns the build that will be executed next for the
+// given build configuration. It also returns the indication whether there are
+// currently running builds, to make sure there is no race-condition between
+// re-listing the builds.
+func GetNextConfigBuild(lister buildclient.BuildLister, namespace, buildConfigName string) (*buildapi.Build, bool, error) {
+	var (
+		nextBuild           *buildapi.Build
+		hasRunningBuilds    bool
+		previousBuildNumber int64
+	)
+	builds, err := buildutil.BuildConfigBuilds(lister, namespace, buildConfigName, func(b buildapi.Build) bool {
+		switch b.Status.Phase {
+		case buildapi.BuildPhasePending, buildapi.BuildPhaseRunning:
+			hasRunningBuilds = true
+			return false
+		}
+		// Only 'new' builds that were not cancelled are waiting to be run.
+		return b.Status.Phase == buildapi.BuildPhaseNew && !b.Status.Cancelled
+	})
+	if err != nil || hasRunningBuilds {
+		return nil, hasRunningBuilds, err
+	}
+	for i, b := range builds.Items {
+		buildNumber, err := buildutil.BuildNumber(&b)
+		if err != nil {
+			return nil, false, err
+		}
+		if previousBuildNumber == 0 || buildNumber < previousBuildNumber {
+			nextBuild = &builds.Items[i]
+			previousBuildNumber = buildNumber
+		}
+	}
+	return nextBuild, hasRunningBuilds, nil
+}
+
+// handleComplete represents the default OnComplete handler. This Handler will
+// check which build should be run next and set the BuildAcceptedAnnotation on
+// that build. That will trigger HandleBuild() to process that build immediately
+// and as a result the build is executed without waiting for a resync.
+func handleComplete(lister buildclient.BuildLister, updater buildclient.BuildUpdater, build *buildapi.Build) error {
+	bcName := buildutil.ConfigNameForBuild(build)
+	if len(bcName) == 0 {
+		return nil
+	}
+	nextBuild, hasRunningBuilds, err := GetNextConfigBuild(lister, build.Namespace, bcName)
+	if err != nil {
+		return err
+	}
+	if hasRunningBuilds || nextBuild == nil {
+		return nil
+	}
+	if nextBuild.Annotations == nil {
+		nextBuild.Annotations = map[string]string{}
+	}
+	nextBuild.Annotations[buildapi.BuildAcceptedAnnotation] = string(kutil.NewUUID())
+	glog.V(4).Infof("Build %s/%s completed, accepting build %s/%s to run next", build.Namespace, build.Name, nextBuild.Namespace, nextBuild.Name)
+	return updater.Update(nextBuild.Namespace, nextBuild)
+}
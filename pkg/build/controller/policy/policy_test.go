@@ -0,0 +1,251 @@
+package policy
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+type fakeBuildClient struct {
+	builds  *buildapi.BuildList
+	updated []*buildapi.Build
+}
+
+func (f *fakeBuildClient) List(namespace string, label labels.Selector, field fields.Selector) (*buildapi.BuildList, error) {
+	result := &buildapi.BuildList{}
+	for _, b := range f.builds.Items {
+		if label.Matches(labels.Set(b.Labels)) {
+			result.Items = append(result.Items, b)
+		}
+	}
+	return result, nil
+}
+
+func (f *fakeBuildClient) Update(namespace string, build *buildapi.Build) error {
+	f.updated = append(f.updated, build)
+	for i, b := range f.builds.Items {
+		if b.Name == build.Name {
+			f.builds.Items[i] = *build
+		}
+	}
+	return nil
+}
+
+func addBuild(client *fakeBuildClient, number int, phase buildapi.BuildPhase, policy buildapi.BuildRunPolicy) *buildapi.Build {
+	build := buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      "sample-" + strconv.Itoa(number),
+			Namespace: "test",
+			Labels: map[string]string{
+				buildapi.BuildConfigLabel:    "sample",
+				buildapi.BuildRunPolicyLabel: string(policy),
+			},
+			Annotations: map[string]string{
+				buildapi.BuildNumberAnnotation: strconv.Itoa(number),
+			},
+		},
+		Status: buildapi.BuildStatus{
+			Phase:  phase,
+			Config: &kapi.ObjectReference{Kind: "BuildConfig", Namespace: "test", Name: "sample"},
+		},
+	}
+	client.builds.Items = append(client.builds.Items, build)
+	return &build
+}
+
+func newFakeBuildClient() *fakeBuildClient {
+	return &fakeBuildClient{builds: &buildapi.BuildList{}}
+}
+
+func TestForBuild(t *testing.T) {
+	client := newFakeBuildClient()
+	policies := GetAllRunPolicies(client, client)
+	tests := map[buildapi.BuildRunPolicy]string{
+		"":                                      "*policy.ParallelPolicy",
+		buildapi.BuildRunPolicyParallel:         "*policy.ParallelPolicy",
+		buildapi.BuildRunPolicySerial:           "*policy.SerialPolicy",
+		buildapi.BuildRunPolicySerialLatestOnly: "*policy.SerialLatestOnlyPolicy",
+	}
+	for runPolicy, expected := range tests {
+		build := &buildapi.Build{ObjectMeta: kapi.ObjectMeta{Labels: map[string]string{buildapi.BuildRunPolicyLabel: string(runPolicy)}}}
+		p := ForBuild(build, policies)
+		if p == nil {
+			t.Errorf("%q: expected a run policy, got none", runPolicy)
+			continue
+		}
+		if actual := reflect.TypeOf(p).String(); actual != expected {
+			t.Errorf("%q: expected %s, got %s", runPolicy, expected, actual)
+		}
+	}
+}
+
+func TestParallelIsRunnable(t *testing.T) {
+	client := newFakeBuildClient()
+	addBuild(client, 1, buildapi.BuildPhaseRunning, buildapi.BuildRunPolicyParallel)
+	build := addBuild(client, 2, buildapi.BuildPhaseNew, buildapi.BuildRunPolicyParallel)
+	p := &ParallelPolicy{BuildLister: client, BuildUpdater: client}
+	if run, err := p.IsRunnable(build); err != nil || !run {
+		t.Errorf("expected parallel build to be runnable, got %v (%v)", run, err)
+	}
+
+	client = newFakeBuildClient()
+	addBuild(client, 1, buildapi.BuildPhaseRunning, buildapi.BuildRunPolicySerial)
+	build = addBuild(client, 2, buildapi.BuildPhaseNew, buildapi.BuildRunPolicyParallel)
+	p = &ParallelPolicy{BuildLister: client, BuildUpdater: client}
+	if run, err := p.IsRunnable(build); err != nil || run {
+		t.Errorf("expected parallel build to wait for the running serial build, got %v (%v)", run, err)
+	}
+}
+
+func TestSerialIsRunnable(t *testing.T) {
+	tests := []struct {
+		name     string
+		phases   []buildapi.BuildPhase
+		build    int
+		runnable bool
+	}{
+		{
+			name:     "first build",
+			phases:   []buildapi.BuildPhase{buildapi.BuildPhaseNew},
+			build:    1,
+			runnable: true,
+		},
+		{
+			name:     "previous build running",
+			phases:   []buildapi.BuildPhase{buildapi.BuildPhaseRunning, buildapi.BuildPhaseNew},
+			build:    2,
+			runnable: false,
+		},
+		{
+			name:     "previous build pending",
+			phases:   []buildapi.BuildPhase{buildapi.BuildPhasePending, buildapi.BuildPhaseNew},
+			build:    2,
+			runnable: false,
+		},
+		{
+			name:     "previous build queued",
+			phases:   []buildapi.BuildPhase{buildapi.BuildPhaseComplete, buildapi.BuildPhaseNew, buildapi.BuildPhaseNew},
+			build:    3,
+			runnable: false,
+		},
+		{
+			name:     "oldest queued build",
+			phases:   []buildapi.BuildPhase{buildapi.BuildPhaseComplete, buildapi.BuildPhaseNew, buildapi.BuildPhaseNew},
+			build:    2,
+			runnable: true,
+		},
+		{
+			name:     "previous builds completed",
+			phases:   []buildapi.BuildPhase{buildapi.BuildPhaseComplete, buildapi.BuildPhaseFailed, buildapi.BuildPhaseCancelled, buildapi.BuildPhaseNew},
+			build:    4,
+			runnable: true,
+		},
+	}
+	for _, tc := range tests {
+		client := newFakeBuildClient()
+		var build *buildapi.Build
+		for i, phase := range tc.phases {
+			b := addBuild(client, i+1, phase, buildapi.BuildRunPolicySerial)
+			if i+1 == tc.build {
+				build = b
+			}
+		}
+		p := &SerialPolicy{BuildLister: client, BuildUpdater: client}
+		run, err := p.IsRunnable(build)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if run != tc.runnable {
+			t.Errorf("%s: expected runnable to be %v, got %v", tc.name, tc.runnable, run)
+		}
+	}
+}
+
+func TestSerialOnComplete(t *testing.T) {
+	client := newFakeBuildClient()
+	completed := addBuild(client, 1, buildapi.BuildPhaseComplete, buildapi.BuildRunPolicySerial)
+	addBuild(client, 2, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial)
+	addBuild(client, 3, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerial)
+	p := &SerialPolicy{BuildLister: client, BuildUpdater: client}
+	if err := p.OnComplete(completed); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(client.updated) != 1 {
+		t.Fatalf("expected exactly one build to be updated, got %d", len(client.updated))
+	}
+	if client.updated[0].Name != "sample-2" {
+		t.Errorf("expected the next build sample-2 to be accepted, got %s", client.updated[0].Name)
+	}
+	if len(client.updated[0].Annotations[buildapi.BuildAcceptedAnnotation]) == 0 {
+		t.Errorf("expected the next build to have the %s annotation", buildapi.BuildAcceptedAnnotation)
+	}
+}
+
+func TestSerialLatestOnlyIsRunnable(t *testing.T) {
+	client := newFakeBuildClient()
+	addBuild(client, 1, buildapi.BuildPhaseComplete, buildapi.BuildRunPolicySerialLatestOnly)
+	addBuild(client, 2, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerialLatestOnly)
+	addBuild(client, 3, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerialLatestOnly)
+	latest := addBuild(client, 4, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerialLatestOnly)
+	p := &SerialLatestOnlyPolicy{BuildLister: client, BuildUpdater: client}
+
+	run, err := p.IsRunnable(latest)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !run {
+		t.Errorf("expected the latest build to be runnable")
+	}
+	if len(client.updated) != 2 {
+		t.Fatalf("expected two queued builds to be cancelled, got %d", len(client.updated))
+	}
+	for _, b := range client.updated {
+		if !b.Status.Cancelled {
+			t.Errorf("expected build %s to be cancelled", b.Name)
+		}
+		if b.Name == latest.Name {
+			t.Errorf("expected the latest build not to be cancelled")
+		}
+	}
+}
+
+func TestSerialLatestOnlyIsRunnableOlderBuild(t *testing.T) {
+	client := newFakeBuildClient()
+	older := addBuild(client, 1, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerialLatestOnly)
+	addBuild(client, 2, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerialLatestOnly)
+	p := &SerialLatestOnlyPolicy{BuildLister: client, BuildUpdater: client}
+
+	run, err := p.IsRunnable(older)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if run {
+		t.Errorf("expected the older build not to be runnable while a newer build is queued")
+	}
+}
+
+func TestSerialLatestOnlyIsRunnableWithRunningBuild(t *testing.T) {
+	client := newFakeBuildClient()
+	addBuild(client, 1, buildapi.BuildPhaseRunning, buildapi.BuildRunPolicySerialLatestOnly)
+	addBuild(client, 2, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerialLatestOnly)
+	latest := addBuild(client, 3, buildapi.BuildPhaseNew, buildapi.BuildRunPolicySerialLatestOnly)
+	p := &SerialLatestOnlyPolicy{BuildLister: client, BuildUpdater: client}
+
+	run, err := p.IsRunnable(latest)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if run {
+		t.Errorf("expected the latest build to wait for the running build")
+	}
+	if len(client.updated) != 1 || client.updated[0].Name != "sample-2" || !client.updated[0].Status.Cancelled {
+		t.Errorf("expected the queued build sample-2 to be cancelled, got %#v", client.updated)
+	}
+}
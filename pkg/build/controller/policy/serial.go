@@ -0,0 +1,41 @@
+package policy
+
+import (
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// SerialPolicy implements the RunPolicy interface. Using this run policy, every
+// created build is put into a queue. The serial run policy guarantees that
+// all builds are executed synchronously in the same order as they were
+// created. This will produce a repeatable order of build execution.
+type SerialPolicy struct {
+	BuildUpdater buildclient.BuildUpdater
+	BuildLister  buildclient.BuildLister
+}
+
+// IsRunnable implements the RunPolicy interface.
+// The serial build is runnable when there are no other builds running for the
+// same build configuration and this build is the oldest one in the queue.
+func (s *SerialPolicy) IsRunnable(build *buildapi.Build) (bool, error) {
+	bcName := buildutil.ConfigNameForBuild(build)
+	if len(bcName) == 0 {
+		return true, nil
+	}
+	nextBuild, hasRunningBuilds, err := GetNextConfigBuild(s.BuildLister, build.Namespace, bcName)
+	if err != nil || hasRunningBuilds {
+		return false, err
+	}
+	return nextBuild != nil && nextBuild.Name == build.Name, nil
+}
+
+// OnComplete implements the RunPolicy interface.
+func (s *SerialPolicy) OnComplete(build *buildapi.Build) error {
+	return handleComplete(s.BuildLister, s.BuildUpdater, build)
+}
+
+// Handles implements the RunPolicy interface.
+func (s *SerialPolicy) Handles(policy buildapi.BuildRunPolicy) bool {
+	return policy == buildapi.BuildRunPolicySerial
+}
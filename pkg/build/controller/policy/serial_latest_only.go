@@ -0,0 +1,105 @@
+package policy
+
+import (
+	"github.com/golang/glog"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// SerialLatestOnlyPolicy implements the RunPolicy interface. This variant of
+// the serial build policy makes sure that builds are executed in same order as
+// they were created, but when a new build is created, the previous, queued
+// build is cancelled, always making the latest created build run as the next
+// one. This can be used in cases where you want to make sure that the latest
+// code is always built.
+type SerialLatestOnlyPolicy struct {
+	BuildUpdater buildclient.BuildUpdater
+	BuildLister  buildclient.BuildLister
+}
+
+// IsRunnable implements the RunPolicy interface.
+// Calling this function on a build means that any previous build that is in
+// 'new' phase will be automatically cancelled. This will also cancel any
+// "serial" build (when you changed the build config run policy on-the-fly).
+func (s *SerialLatestOnlyPolicy) IsRunnable(build *buildapi.Build) (bool, error) {
+	bcName := buildutil.ConfigNameForBuild(build)
+	if len(bcName) == 0 {
+		return true, nil
+	}
+	if err := s.cancelPreviousBuilds(build); err != nil {
+		return false, err
+	}
+	// A newer queued build will cancel this one once it is handled.
+	if hasNewer, err := s.hasNewerQueuedBuild(build); err != nil || hasNewer {
+		return false, err
+	}
+	nextBuild, hasRunningBuilds, err := GetNextConfigBuild(s.BuildLister, build.Namespace, bcName)
+	if err != nil || hasRunningBuilds {
+		return false, err
+	}
+	return nextBuild != nil && nextBuild.Name == build.Name, nil
+}
+
+// OnComplete implements the RunPolicy interface.
+func (s *SerialLatestOnlyPolicy) OnComplete(build *buildapi.Build) error {
+	return handleComplete(s.BuildLister, s.BuildUpdater, build)
+}
+
+// Handles implements the RunPolicy interface.
+func (s *SerialLatestOnlyPolicy) Handles(policy buildapi.BuildRunPolicy) bool {
+	return policy == buildapi.BuildRunPolicySerialLatestOnly
+}
+
+// cancelPreviousBuilds cancels all queued builds that have the build sequence
+// number lower than the given build. It returns an error if one of the builds
+// cannot be cancelled.
+func (s *SerialLatestOnlyPolicy) cancelPreviousBuilds(build *buildapi.Build) error {
+	bcName := buildutil.ConfigNameForBuild(build)
+	currentBuildNumber, err := buildutil.BuildNumber(build)
+	if err != nil {
+		return err
+	}
+	builds, err := buildutil.BuildConfigBuilds(s.BuildLister, build.Namespace, bcName, func(b buildapi.Build) bool {
+		// Do not cancel the complete builds, builds that were already cancelled, or
+		// running builds.
+		if b.Status.Phase != buildapi.BuildPhaseNew || b.Status.Cancelled {
+			return false
+		}
+		buildNumber, _ := buildutil.BuildNumber(&b)
+		return buildNumber < currentBuildNumber
+	})
+	if err != nil {
+		return err
+	}
+	for i := range builds.Items {
+		b := &builds.Items[i]
+		b.Status.Cancelled = true
+		glog.V(4).Infof("Cancelling queued build %s/%s in favor of the newer build %s/%s", b.Namespace, b.Name, build.Namespace, build.Name)
+		if err := s.BuildUpdater.Update(b.Namespace, b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasNewerQueuedBuild returns true if there is a queued build with the build
+// sequence number higher than the given build.
+func (s *SerialLatestOnlyPolicy) hasNewerQueuedBuild(build *buildapi.Build) (bool, error) {
+	currentBuildNumber, err := buildutil.BuildNumber(build)
+	if err != nil {
+		return false, err
+	}
+	builds, err := buildutil.BuildConfigBuilds(s.BuildLister, build.Namespace, buildutil.ConfigNameForBuild(build), func(b buildapi.Build) bool {
+		if b.Status.Phase != buildapi.BuildPhaseNew || b.Status.Cancelled {
+			return false
+		}
+		buildNumber, _ := buildutil.BuildNumber(&b)
+		return buildNumber > currentBuildNumber
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(builds.Items) > 0, nil
+}
@@ -77,11 +77,8 @@ func (bs *DockerBuildStrategy) CreateBuildPod(build *buildapi.Build) (*kapi.Pod,
 	}
 
 	setupDockerSocket(pod)
-	var sourceImageSecret *kapi.LocalObjectReference
-	if build.Spec.Source.Image != nil {
-		sourceImageSecret = build.Spec.Source.Image.PullSecret
-	}
-	setupDockerSecrets(pod, build.Spec.Output.PushSecret, strategy.PullSecret, sourceImageSecret)
+	setupDockerSecrets(pod, build.Spec.Output.PushSecret, strategy.PullSecret, build.Spec.Source.Images)
 	setupSourceSecrets(pod, build.Spec.Source.SourceSecret)
+	setupBuildCache(pod, strategy.BuildCache)
 	return pod, nil
 }
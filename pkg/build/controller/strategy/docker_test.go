@@ -91,6 +91,32 @@ func TestDockerCreateBuildPod(t *testing.T) {
 	}
 }
 
+func TestDockerCreateBuildPodBuildCache(t *testing.T) {
+	strategy := DockerBuildStrategy{
+		Image: "docker-test-image",
+		Codec: latest.Codec,
+	}
+
+	build := mockDockerBuild()
+	build.Spec.Strategy.DockerStrategy.BuildCache = &buildapi.DockerBuildCache{
+		PersistentVolumeClaim: kapi.LocalObjectReference{Name: "maven-cache"},
+		Paths:                 []string{"/root/.m2"},
+	}
+	actual, err := strategy.CreateBuildPod(build)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	mounts := actual.Spec.Containers[0].VolumeMounts
+	if len(mounts) != 5 || mounts[4].MountPath != buildCacheMountPath {
+		t.Fatalf("Expected the build cache to be mounted in %s, got %#v", buildCacheMountPath, mounts)
+	}
+	volume := actual.Spec.Volumes[len(actual.Spec.Volumes)-1]
+	if volume.Name != mounts[4].Name || volume.PersistentVolumeClaim == nil || volume.PersistentVolumeClaim.ClaimName != "maven-cache" {
+		t.Errorf("Expected a volume for the claim maven-cache, got %#v", volume)
+	}
+}
+
 func mockDockerBuild() *buildapi.Build {
 	timeout := int64(60)
 	return &buildapi.Build{
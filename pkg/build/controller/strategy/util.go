@@ -1,7 +1,9 @@
 package strategy
 
 import (
+	"fmt"
 	"path/filepath"
+	"strconv"
 
 	"github.com/golang/glog"
 	buildapi "github.com/openshift/origin/pkg/build/api"
@@ -18,6 +20,13 @@ const (
 	DockerPullSecretMountPath      = "/var/run/secrets/openshift.io/pull"
 	SourceImagePullSecretMountPath = "/var/run/secrets/openshift.io/source-image"
 	sourceSecretMountPath          = "/var/run/secrets/openshift.io/source"
+	// buildSecretsMountPath is the directory under which the secrets used only
+	// at the build time are mounted, each in a directory named after the secret.
+	// It must match the path the builder copies the secrets from.
+	buildSecretsMountPath = "/var/run/secrets/openshift.io/build"
+	// buildCacheMountPath is the directory the volume of the Docker build cache
+	// is mounted in. It must match the path the builder saves the cache in.
+	buildCacheMountPath = "/var/run/openshift.io/build-cache"
 )
 
 var whitelistEnvVarNames = []string{"BUILD_LOGLEVEL"}
@@ -98,7 +107,7 @@ func mountSecretVolume(pod *kapi.Pod, secretName, mountPath, volumePrefix string
 
 // setupDockerSecrets mounts Docker Registry secrets into Pod running the build,
 // allowing Docker to authenticate against private registries or Docker Hub.
-func setupDockerSecrets(pod *kapi.Pod, pushSecret, pullSecret, sourceImageSecret *kapi.LocalObjectReference) {
+func setupDockerSecrets(pod *kapi.Pod, pushSecret, pullSecret *kapi.LocalObjectReference, imageSources []buildapi.ImageSource) {
 	if pushSecret != nil {
 		mountSecretVolume(pod, pushSecret.Name, DockerPushSecretMountPath, "push")
 		pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, []kapi.EnvVar{
@@ -115,14 +124,39 @@ func setupDockerSecrets(pod *kapi.Pod, pushSecret, pullSecret, sourceImageSecret
 		glog.V(3).Infof("%s will be used for docker pull in %s", DockerPullSecretMountPath, pod.Name)
 	}
 
-	if sourceImageSecret != nil {
-		mountSecretVolume(pod, sourceImageSecret.Name, SourceImagePullSecretMountPath, "source-image")
+	for i, imageSource := range imageSources {
+		if imageSource.PullSecret == nil {
+			continue
+		}
+		mountPath := filepath.Join(SourceImagePullSecretMountPath, strconv.Itoa(i))
+		mountSecretVolume(pod, imageSource.PullSecret.Name, mountPath, fmt.Sprintf("source-image-%d", i))
 		pod.Spec.Containers[0].Env = append(pod.Spec.Containers[0].Env, []kapi.EnvVar{
-			{Name: "PULL_SOURCE_DOCKERCFG_PATH", Value: filepath.Join(SourceImagePullSecretMountPath, kapi.DockerConfigKey)},
+			{Name: fmt.Sprintf("PULL_SOURCE_DOCKERCFG_PATH_%d", i), Value: filepath.Join(mountPath, kapi.DockerConfigKey)},
 		}...)
-		glog.V(3).Infof("%s will be used for docker pull in %s", SourceImagePullSecretMountPath, pod.Name)
+		glog.V(3).Infof("%s will be used for docker pull in %s", mountPath, pod.Name)
+	}
+}
 
+// setupBuildCache mounts the persistent volume claim holding the directories
+// kept between Docker builds into the pod running the build.
+func setupBuildCache(pod *kapi.Pod, cache *buildapi.DockerBuildCache) {
+	if cache == nil {
+		return
 	}
+	volume := kapi.Volume{
+		Name: "build-cache",
+		VolumeSource: kapi.VolumeSource{
+			PersistentVolumeClaim: &kapi.PersistentVolumeClaimVolumeSource{
+				ClaimName: cache.PersistentVolumeClaim.Name,
+			},
+		},
+	}
+	volumeMount := kapi.VolumeMount{
+		Name:      "build-cache",
+		MountPath: buildCacheMountPath,
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, volume)
+	pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, volumeMount)
 }
 
 // setupSourceSecrets mounts SSH key used for accessing private SCM to clone
@@ -139,6 +173,17 @@ func setupSourceSecrets(pod *kapi.Pod, sourceSecret *kapi.LocalObjectReference)
 	}...)
 }
 
+// setupBuildSecrets mounts the secrets used only at the build time into the Pod
+// running the build. The builder copies their content into the destination
+// directories before the build starts.
+func setupBuildSecrets(pod *kapi.Pod, secrets []buildapi.SecretBuildSource) {
+	for _, s := range secrets {
+		mountPath := filepath.Join(buildSecretsMountPath, s.Secret.Name)
+		mountSecretVolume(pod, s.Secret.Name, mountPath, "build")
+		glog.V(3).Infof("%s will be used as a build secret in %s", mountPath, pod.Name)
+	}
+}
+
 // addSourceEnvVars adds environment variables related to the source code
 // repository to builder container
 func addSourceEnvVars(source buildapi.BuildSource, output *[]kapi.EnvVar) {
@@ -3,6 +3,7 @@ package strategy
 import (
 	"testing"
 
+	buildapi "github.com/openshift/origin/pkg/build/api"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 	kapi "k8s.io/kubernetes/pkg/api"
 )
@@ -127,3 +128,60 @@ func TestTrustedMergeEnvWithoutDuplicates(t *testing.T) {
 		t.Errorf("Expected output env 'foo' to have value 'loglevel', got %+v", output[0])
 	}
 }
+
+func TestSetupDockerSecretsImageSources(t *testing.T) {
+	pod := kapi.Pod{Spec: kapi.PodSpec{Containers: []kapi.Container{{}}}}
+	imageSources := []buildapi.ImageSource{
+		{PullSecret: &kapi.LocalObjectReference{Name: "first"}},
+		{},
+		{PullSecret: &kapi.LocalObjectReference{Name: "third"}},
+	}
+
+	setupDockerSecrets(&pod, nil, nil, imageSources)
+
+	container := pod.Spec.Containers[0]
+	if len(pod.Spec.Volumes) != 2 || len(container.VolumeMounts) != 2 {
+		t.Fatalf("Expected 2 volumes, got: %#v", pod.Spec.Volumes)
+	}
+	for i, expected := range []string{SourceImagePullSecretMountPath + "/0", SourceImagePullSecretMountPath + "/2"} {
+		if container.VolumeMounts[i].MountPath != expected {
+			t.Errorf("Expected %s in VolumeMount[%d], got %s", expected, i, container.VolumeMounts[i].MountPath)
+		}
+	}
+	expectedEnv := []kapi.EnvVar{
+		{Name: "PULL_SOURCE_DOCKERCFG_PATH_0", Value: SourceImagePullSecretMountPath + "/0/" + kapi.DockerConfigKey},
+		{Name: "PULL_SOURCE_DOCKERCFG_PATH_2", Value: SourceImagePullSecretMountPath + "/2/" + kapi.DockerConfigKey},
+	}
+	if !kapi.Semantic.DeepEqual(container.Env, expectedEnv) {
+		t.Errorf("Expected %v, got %v", expectedEnv, container.Env)
+	}
+}
+
+func TestSetupBuildSecrets(t *testing.T) {
+	pod := kapi.Pod{Spec: kapi.PodSpec{Containers: []kapi.Container{{}}}}
+	secrets := []buildapi.SecretBuildSource{
+		{Secret: kapi.LocalObjectReference{Name: "maven-settings"}, DestinationDir: ".m2"},
+		{Secret: kapi.LocalObjectReference{Name: "npmrc"}},
+	}
+
+	setupBuildSecrets(&pod, secrets)
+
+	container := pod.Spec.Containers[0]
+	if len(pod.Spec.Volumes) != 2 || len(container.VolumeMounts) != 2 {
+		t.Fatalf("Expected 2 volumes, got: %#v", pod.Spec.Volumes)
+	}
+	for i, s := range secrets {
+		if e, a := s.Secret.Name, pod.Spec.Volumes[i].Secret.SecretName; e != a {
+			t.Errorf("Expected secret %s, got %s", e, a)
+		}
+		if e, a := buildSecretsMountPath+"/"+s.Secret.Name, container.VolumeMounts[i].MountPath; e != a {
+			t.Errorf("Expected %s, got %s", e, a)
+		}
+		if !container.VolumeMounts[i].ReadOnly {
+			t.Errorf("Expected the build secret %s to be mounted read only", s.Secret.Name)
+		}
+	}
+	if pod.Spec.Volumes[0].Name == pod.Spec.Volumes[1].Name {
+		t.Errorf("Expected unique volume names, got %s", pod.Spec.Volumes[0].Name)
+	}
+}
@@ -172,6 +172,8 @@ func updateBuildEnv(strategy *buildapi.BuildStrategy, env []kapi.EnvVar) {
 		buildEnv = &strategy.SourceStrategy.Env
 	case strategy.CustomStrategy != nil:
 		buildEnv = &strategy.SourceStrategy.Env
+	default:
+		return
 	}
 
 	newEnv := []kapi.EnvVar{}
@@ -387,6 +389,9 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 	}
 	build.Labels[buildapi.BuildConfigLabelDeprecated] = bcCopy.Name
 	build.Labels[buildapi.BuildConfigLabel] = bcCopy.Name
+	if len(bc.Spec.RunPolicy) > 0 {
+		build.Labels[buildapi.BuildRunPolicyLabel] = string(bc.Spec.RunPolicy)
+	}
 
 	builderSecrets, err := g.FetchServiceAccountSecrets(bc.Namespace, serviceAccount)
 	if err != nil {
@@ -397,17 +402,18 @@ func (g *BuildGenerator) generateBuildFromConfig(ctx kapi.Context, bc *buildapi.
 	}
 	strategyImageChangeTrigger := getStrategyImageChangeTrigger(bc)
 
-	// Resolve image source if present
-	if build.Spec.Source.Image != nil {
-		if build.Spec.Source.Image.PullSecret == nil {
-			build.Spec.Source.Image.PullSecret = g.resolveImageSecret(ctx, builderSecrets, &build.Spec.Source.Image.From, bc.Namespace)
+	// Resolve image sources if present
+	for i := range build.Spec.Source.Images {
+		imageSource := &build.Spec.Source.Images[i]
+		if imageSource.PullSecret == nil {
+			imageSource.PullSecret = g.resolveImageSecret(ctx, builderSecrets, &imageSource.From, bc.Namespace)
 		}
-		sourceImage, err := g.resolveImageStreamReference(ctx, build.Spec.Source.Image.From, bc.Namespace)
+		sourceImage, err := g.resolveImageStreamReference(ctx, imageSource.From, bc.Namespace)
 		if err != nil {
 			return nil, err
 		}
-		build.Spec.Source.Image.From.Kind = "DockerImage"
-		build.Spec.Source.Image.From.Name = sourceImage
+		imageSource.From.Kind = "DockerImage"
+		imageSource.From.Name = sourceImage
 	}
 
 	// If the Build is using a From reference instead of a resolved image, we need to resolve that From
@@ -635,6 +641,13 @@ func generateBuildFromBuild(build *buildapi.Build, buildConfig *buildapi.BuildCo
 	newBuild.Annotations[buildapi.BuildCloneAnnotation] = build.Name
 	if buildConfig != nil {
 		newBuild.Annotations[buildapi.BuildNumberAnnotation] = strconv.Itoa(buildConfig.Status.LastVersion)
+		// the clone is scheduled using the current run policy of the config
+		if len(buildConfig.Spec.RunPolicy) > 0 {
+			if newBuild.Labels == nil {
+				newBuild.Labels = make(map[string]string)
+			}
+			newBuild.Labels[buildapi.BuildRunPolicyLabel] = string(buildConfig.Spec.RunPolicy)
+		}
 	} else {
 		// builds without a buildconfig don't have build numbers.
 		delete(newBuild.Annotations, buildapi.BuildNumberAnnotation)
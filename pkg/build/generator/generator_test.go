@@ -545,6 +545,7 @@ func TestGenerateBuildFromConfig(t *testing.T) {
 			Labels:    map[string]string{"testlabel": "testvalue"},
 		},
 		Spec: buildapi.BuildConfigSpec{
+			RunPolicy: buildapi.BuildRunPolicySerial,
 			BuildSpec: buildapi.BuildSpec{
 				Source: source,
 				Revision: &buildapi.SourceRevision{
@@ -600,6 +601,9 @@ func TestGenerateBuildFromConfig(t *testing.T) {
 	if build.Labels[buildapi.BuildConfigLabelDeprecated] != bc.Name {
 		t.Errorf("Build does not contain labels from BuildConfig")
 	}
+	if build.Labels[buildapi.BuildRunPolicyLabel] != string(bc.Spec.RunPolicy) {
+		t.Errorf("Build does not contain the run policy label from BuildConfig")
+	}
 	if build.Status.Config.Name != bc.Name || build.Status.Config.Namespace != bc.Namespace || build.Status.Config.Kind != "BuildConfig" {
 		t.Errorf("Build does not contain correct BuildConfig reference: %v", build.Status.Config)
 	}
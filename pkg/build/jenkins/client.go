@@ -0,0 +1,344 @@
+package jenkins
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	kutil "k8s.io/kubernetes/pkg/util"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+// Results reported by Jenkins for finished builds.
+const (
+	ResultSuccess  = "SUCCESS"
+	ResultUnstable = "UNSTABLE"
+	ResultFailure  = "FAILURE"
+	ResultAborted  = "ABORTED"
+)
+
+// URLResolver returns the base URL of the Jenkins instance that runs the
+// pipelines of the given namespace.
+type URLResolver func(namespace string) (*url.URL, error)
+
+// DefaultServiceName is the name of the service exposing the Jenkins instance
+// of a namespace.
+const DefaultServiceName = "jenkins"
+
+// NewServiceURLResolver returns a URLResolver that locates Jenkins through the
+// service with the given name in the namespace of the build.
+func NewServiceURLResolver(client kclient.ServicesNamespacer, serviceName string) URLResolver {
+	return func(namespace string) (*url.URL, error) {
+		service, err := client.Services(namespace).Get(serviceName)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find the Jenkins service %s/%s: %v", namespace, serviceName, err)
+		}
+		if len(service.Spec.ClusterIP) == 0 || len(service.Spec.Ports) == 0 {
+			return nil, fmt.Errorf("the Jenkins service %s/%s has no cluster IP or port", namespace, serviceName)
+		}
+		return &url.URL{
+			Scheme: "http",
+			Host:   fmt.Sprintf("%s:%d", service.Spec.ClusterIP, service.Spec.Ports[0].Port),
+		}, nil
+	}
+}
+
+// Client hands Jenkins Pipeline strategy builds off to Jenkins and reports
+// their progress back.
+type Client struct {
+	// ResolveURL locates the Jenkins instance for a namespace.
+	ResolveURL URLResolver
+	// HTTPClient is used to talk to Jenkins. If nil, a client which times out
+	// after defaultTimeout is used.
+	HTTPClient *http.Client
+}
+
+// defaultTimeout bounds the requests made to Jenkins, so that an unresponsive
+// Jenkins does not block the build controller.
+const defaultTimeout = 30 * time.Second
+
+// defaultHTTPClient is used when the Client has no HTTPClient.
+var defaultHTTPClient = &http.Client{
+	Transport: kutil.SetTransportDefaults(&http.Transport{}),
+	Timeout:   defaultTimeout,
+}
+
+// errRedirect stops the client from following the redirects Jenkins answers
+// most POST requests with.
+var errRedirect = errors.New("redirect not followed")
+
+// Status is the state of a pipeline run in Jenkins.
+type Status struct {
+	// Started is true once Jenkins picked the queued pipeline run up.
+	Started bool
+	// Building is true while the pipeline is running.
+	Building bool
+	// Result is the result of a finished pipeline run, empty while it is
+	// queued or running.
+	Result string
+	// BuildURI is the URI of the Jenkins build as reported by Jenkins.
+	BuildURI string
+}
+
+type queueItem struct {
+	Cancelled  bool `json:"cancelled"`
+	Executable *struct {
+		Number int    `json:"number"`
+		URL    string `json:"url"`
+	} `json:"executable"`
+}
+
+type jenkinsBuild struct {
+	Building bool   `json:"building"`
+	Result   string `json:"result"`
+	URL      string `json:"url"`
+}
+
+// Start creates or updates the Jenkins job for the build and schedules a
+// run of it. It returns the URI of the Jenkins queue item for the run.
+func (c *Client) Start(build *buildapi.Build) (string, error) {
+	config, err := jobConfig(build)
+	if err != nil {
+		return "", err
+	}
+	base, err := c.ResolveURL(build.Namespace)
+	if err != nil {
+		return "", err
+	}
+	job := JobName(build)
+
+	resp, err := c.post(resolve(base, path.Join("job", job, "config.xml")), "application/xml", config)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		glog.V(4).Infof("Creating Jenkins job %s for build %s/%s", job, build.Namespace, build.Name)
+		createURL := resolve(base, "createItem")
+		createURL.RawQuery = url.Values{"name": []string{job}}.Encode()
+		if resp, err = c.post(createURL, "application/xml", config); err != nil {
+			return "", err
+		}
+	}
+	if err := checkResponse(resp, http.StatusOK); err != nil {
+		return "", fmt.Errorf("unable to configure Jenkins job %s: %v", job, err)
+	}
+
+	resp, err = c.post(resolve(base, path.Join("job", job, "build")), "", nil)
+	if err != nil {
+		return "", err
+	}
+	if err := checkResponse(resp, http.StatusCreated); err != nil {
+		return "", fmt.Errorf("unable to start Jenkins job %s: %v", job, err)
+	}
+	queueURI := resp.Header.Get("Location")
+	if len(queueURI) == 0 {
+		return "", fmt.Errorf("Jenkins did not return a queue item for job %s", job)
+	}
+	return queueURI, nil
+}
+
+// GetStatus returns the state of the Jenkins pipeline run of the given build,
+// based on the Jenkins annotations recorded on the build.
+func (c *Client) GetStatus(build *buildapi.Build) (*Status, error) {
+	base, err := c.ResolveURL(build.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	buildURI := build.Annotations[buildapi.BuildJenkinsBuildURIAnnotation]
+	if len(buildURI) == 0 {
+		queueURI := build.Annotations[buildapi.BuildJenkinsQueueURIAnnotation]
+		if len(queueURI) == 0 {
+			return nil, fmt.Errorf("build %s/%s has not been handed off to Jenkins", build.Namespace, build.Name)
+		}
+		item := &queueItem{}
+		if err := c.getJSON(base, queueURI, item); err != nil {
+			return nil, err
+		}
+		if item.Cancelled {
+			return &Status{Result: ResultAborted}, nil
+		}
+		if item.Executable == nil {
+			return &Status{}, nil
+		}
+		buildURI = item.Executable.URL
+	}
+
+	jb := &jenkinsBuild{}
+	if err := c.getJSON(base, buildURI, jb); err != nil {
+		return nil, err
+	}
+	return &Status{
+		Started:  true,
+		Building: jb.Building,
+		Result:   jb.Result,
+		BuildURI: buildURI,
+	}, nil
+}
+
+// LogURL returns the URL of the plain text console log of the Jenkins build
+// running the given build. The URL is built from the Jenkins service of the
+// namespace and the job of the build, only the build number is read from the
+// build URI recorded on the build.
+func (c *Client) LogURL(build *buildapi.Build) (*url.URL, error) {
+	number, err := buildNumber(build.Annotations[buildapi.BuildJenkinsBuildURIAnnotation])
+	if err != nil {
+		return nil, fmt.Errorf("build %s/%s has no Jenkins build: %v", build.Namespace, build.Name, err)
+	}
+	base, err := c.ResolveURL(build.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return resolve(base, path.Join("job", JobName(build), strconv.Itoa(number), "consoleText")), nil
+}
+
+// DeleteJob deletes the Jenkins job of the given build. Deleting a job that
+// does not exist is not an error.
+func (c *Client) DeleteJob(build *buildapi.Build) error {
+	base, err := c.ResolveURL(build.Namespace)
+	if err != nil {
+		return err
+	}
+	job := JobName(build)
+	resp, err := c.post(resolve(base, path.Join("job", job, "doDelete")), "", nil)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode {
+	// Jenkins redirects to the parent of the job once it is deleted.
+	case http.StatusOK, http.StatusFound, http.StatusNotFound:
+		return nil
+	}
+	return fmt.Errorf("unable to delete Jenkins job %s: unexpected response from Jenkins: %s", job, resp.Status)
+}
+
+// Stop aborts the Jenkins pipeline run of the given build, or removes it from
+// the Jenkins queue when it has not started yet.
+func (c *Client) Stop(build *buildapi.Build) error {
+	base, err := c.ResolveURL(build.Namespace)
+	if err != nil {
+		return err
+	}
+
+	var stopURL *url.URL
+	if buildURI := build.Annotations[buildapi.BuildJenkinsBuildURIAnnotation]; len(buildURI) > 0 {
+		stopURL = resolveURI(base, buildURI, "stop")
+	} else if queueURI := build.Annotations[buildapi.BuildJenkinsQueueURIAnnotation]; len(queueURI) > 0 {
+		stopURL = resolve(base, "queue/cancelItem")
+		stopURL.RawQuery = url.Values{"id": []string{path.Base(strings.TrimSuffix(queueURI, "/"))}}.Encode()
+	} else {
+		return nil
+	}
+
+	resp, err := c.post(stopURL, "", nil)
+	if err != nil {
+		return err
+	}
+	// Jenkins redirects after stopping a build or cancelling a queue item.
+	if resp.StatusCode == http.StatusFound {
+		return nil
+	}
+	return checkResponse(resp, http.StatusOK)
+}
+
+func (c *Client) client() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return defaultHTTPClient
+}
+
+func (c *Client) post(u *url.URL, contentType string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest("POST", u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if len(contentType) > 0 {
+		req.Header.Set("Content-Type", contentType)
+	}
+	client := *c.client()
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return errRedirect
+	}
+	resp, err := client.Do(req)
+	// The client returns the redirect response together with the error of
+	// CheckRedirect.
+	if urlErr, ok := err.(*url.Error); ok && urlErr.Err == errRedirect {
+		err = nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	return resp, nil
+}
+
+func (c *Client) getJSON(base *url.URL, uri string, into interface{}) error {
+	resp, err := c.client().Get(resolveURI(base, uri, "api/json").String())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := checkResponse(resp, http.StatusOK); err != nil {
+		return err
+	}
+	return json.NewDecoder(resp.Body).Decode(into)
+}
+
+// resolve returns the URL of the given path relative to the Jenkins base URL.
+func resolve(base *url.URL, p string) *url.URL {
+	u := *base
+	u.Path = path.Join("/", base.Path, p)
+	return &u
+}
+
+// resolveURI returns the URL of the sub path of a URI reported by Jenkins.
+// Jenkins reports URIs using its configured root URL, which may not be
+// reachable from the cluster, so only the path of the URI is used.
+func resolveURI(base *url.URL, uri, sub string) *url.URL {
+	p := uri
+	if parsed, err := url.Parse(uri); err == nil {
+		p = parsed.Path
+		if len(base.Path) > 0 && base.Path != "/" {
+			p = strings.TrimPrefix(p, base.Path)
+		}
+	}
+	return resolve(base, path.Join(p, sub))
+}
+
+// buildNumber returns the number of the Jenkins build with the given URI.
+func buildNumber(uri string) (int, error) {
+	if len(uri) == 0 {
+		return 0, fmt.Errorf("the pipeline has not started")
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return 0, err
+	}
+	number, err := strconv.Atoi(path.Base(strings.TrimSuffix(parsed.Path, "/")))
+	if err != nil || number <= 0 {
+		return 0, fmt.Errorf("invalid Jenkins build URI %q", uri)
+	}
+	return number, nil
+}
+
+func checkResponse(resp *http.Response, expected int) error {
+	if resp.StatusCode != expected {
+		return fmt.Errorf("unexpected response from Jenkins: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,347 @@
+package jenkins
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+// fakeJenkins is a minimal Jenkins HTTP API serving a single pipeline job.
+type fakeJenkins struct {
+	lock     sync.Mutex
+	server   *httptest.Server
+	jobs     map[string]string
+	queued   bool
+	started  bool
+	building bool
+	result   string
+	stopped  []string
+}
+
+func newFakeJenkins() *fakeJenkins {
+	j := &fakeJenkins{jobs: map[string]string{}}
+	j.server = httptest.NewServer(http.HandlerFunc(j.handle))
+	return j
+}
+
+func (j *fakeJenkins) handle(w http.ResponseWriter, r *http.Request) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	switch {
+	case r.Method == "POST" && r.URL.Path == "/createItem":
+		body, _ := ioutil.ReadAll(r.Body)
+		j.jobs[r.URL.Query().Get("name")] = string(body)
+	case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/config.xml"):
+		name := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/job/"), "/config.xml")
+		if _, ok := j.jobs[name]; !ok {
+			http.NotFound(w, r)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		j.jobs[name] = string(body)
+	case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/doDelete"):
+		name := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/job/"), "/doDelete")
+		if _, ok := j.jobs[name]; !ok {
+			http.NotFound(w, r)
+			return
+		}
+		delete(j.jobs, name)
+		w.Header().Set("Location", "/")
+		w.WriteHeader(http.StatusFound)
+	case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/build"):
+		j.queued = true
+		// Jenkins reports URIs using its configured root URL.
+		w.Header().Set("Location", "http://jenkins.example.com/queue/item/7/")
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == "GET" && r.URL.Path == "/queue/item/7/api/json":
+		if j.started {
+			fmt.Fprintf(w, `{"cancelled":false,"executable":{"number":1,"url":"http://jenkins.example.com/job/test_sample-1/1/"}}`)
+			return
+		}
+		fmt.Fprintf(w, `{"cancelled":false}`)
+	case r.Method == "GET" && r.URL.Path == "/job/test_sample-1/1/api/json":
+		result := "null"
+		if len(j.result) > 0 {
+			result = `"` + j.result + `"`
+		}
+		fmt.Fprintf(w, `{"building":%v,"result":%s,"url":"http://jenkins.example.com/job/test_sample-1/1/"}`, j.building, result)
+	case r.Method == "POST" && (r.URL.Path == "/job/test_sample-1/1/stop" || r.URL.Path == "/queue/cancelItem"):
+		j.stopped = append(j.stopped, r.URL.String())
+		w.Header().Set("Location", "/")
+		w.WriteHeader(http.StatusFound)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (j *fakeJenkins) client() *Client {
+	return &Client{
+		ResolveURL: func(namespace string) (*url.URL, error) {
+			return url.Parse(j.server.URL)
+		},
+	}
+}
+
+func pipelineBuild(strategy *buildapi.JenkinsPipelineBuildStrategy) *buildapi.Build {
+	return &buildapi.Build{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        "sample-1",
+			Namespace:   "test",
+			Annotations: map[string]string{},
+		},
+		Spec: buildapi.BuildSpec{
+			Source: buildapi.BuildSource{
+				Git: &buildapi.GitBuildSource{URI: "https://github.com/openshift/ruby-hello-world"},
+			},
+			Strategy: buildapi.BuildStrategy{JenkinsPipelineStrategy: strategy},
+		},
+		Status: buildapi.BuildStatus{
+			Config: &kapi.ObjectReference{Kind: "BuildConfig", Namespace: "test", Name: "sample"},
+		},
+	}
+}
+
+func TestClientStart(t *testing.T) {
+	jenkins := newFakeJenkins()
+	defer jenkins.server.Close()
+	client := jenkins.client()
+	build := pipelineBuild(&buildapi.JenkinsPipelineBuildStrategy{Jenkinsfile: "node { echo 'hello' }"})
+
+	// The first run creates the job, the second one updates it.
+	for i := 0; i < 2; i++ {
+		queueURI, err := client.Start(build)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if queueURI != "http://jenkins.example.com/queue/item/7/" {
+			t.Errorf("unexpected queue URI %q", queueURI)
+		}
+	}
+	if len(jenkins.jobs) != 1 {
+		t.Fatalf("expected one Jenkins job, got %v", jenkins.jobs)
+	}
+	config, ok := jenkins.jobs["test_sample-1"]
+	if !ok {
+		t.Fatalf("expected the Jenkins job test_sample-1 to be created, got %v", jenkins.jobs)
+	}
+	if !strings.Contains(config, "node { echo &#39;hello&#39; }") {
+		t.Errorf("expected the job to contain the inline Jenkinsfile, got %s", config)
+	}
+	if !jenkins.queued {
+		t.Errorf("expected the job to be queued")
+	}
+
+	// Another build of the same BuildConfig gets its own job, so that builds
+	// running in parallel do not overwrite each other's job.
+	other := pipelineBuild(&buildapi.JenkinsPipelineBuildStrategy{Jenkinsfile: "node { echo 'other' }"})
+	other.Name = "sample-2"
+	if _, err := client.Start(other); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(jenkins.jobs) != 2 || !strings.Contains(jenkins.jobs["test_sample-2"], "other") {
+		t.Errorf("expected a second Jenkins job test_sample-2, got %v", jenkins.jobs)
+	}
+	if strings.Contains(jenkins.jobs["test_sample-1"], "other") {
+		t.Errorf("expected the job of the first build to be left alone, got %s", jenkins.jobs["test_sample-1"])
+	}
+}
+
+func TestClientGetStatus(t *testing.T) {
+	jenkins := newFakeJenkins()
+	defer jenkins.server.Close()
+	client := jenkins.client()
+	build := pipelineBuild(&buildapi.JenkinsPipelineBuildStrategy{})
+
+	if _, err := client.GetStatus(build); err == nil {
+		t.Errorf("expected an error for a build not handed off to Jenkins")
+	}
+
+	build.Annotations[buildapi.BuildJenkinsQueueURIAnnotation] = "http://jenkins.example.com/queue/item/7/"
+	status, err := client.GetStatus(build)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status.Started {
+		t.Errorf("expected the queued pipeline not to be started, got %#v", status)
+	}
+
+	jenkins.started, jenkins.building = true, true
+	status, err = client.GetStatus(build)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !status.Started || !status.Building || len(status.Result) != 0 {
+		t.Errorf("expected the pipeline to be running, got %#v", status)
+	}
+	if status.BuildURI != "http://jenkins.example.com/job/test_sample-1/1/" {
+		t.Errorf("unexpected build URI %q", status.BuildURI)
+	}
+
+	jenkins.building, jenkins.result = false, ResultSuccess
+	build.Annotations[buildapi.BuildJenkinsBuildURIAnnotation] = status.BuildURI
+	status, err = client.GetStatus(build)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status.Building || status.Result != ResultSuccess {
+		t.Errorf("expected the pipeline to be successful, got %#v", status)
+	}
+}
+
+func TestClientLogURL(t *testing.T) {
+	jenkins := newFakeJenkins()
+	defer jenkins.server.Close()
+	client := jenkins.client()
+	build := pipelineBuild(&buildapi.JenkinsPipelineBuildStrategy{})
+
+	if _, err := client.LogURL(build); err == nil {
+		t.Errorf("expected an error for a build without a Jenkins build")
+	}
+
+	// Only the build number is taken from the build URI, the rest of the URL
+	// comes from the Jenkins service and the job of the build.
+	build.Annotations[buildapi.BuildJenkinsBuildURIAnnotation] = "http://internal.example.com/job/other/3/"
+	logURL, err := client.LogURL(build)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := jenkins.server.URL + "/job/test_sample-1/3/consoleText"; logURL.String() != expected {
+		t.Errorf("expected log URL %q, got %q", expected, logURL)
+	}
+
+	for _, uri := range []string{"http://jenkins.example.com/job/test_sample-1/", "http://jenkins.example.com/job/test_sample-1/0/", "%zz"} {
+		build.Annotations[buildapi.BuildJenkinsBuildURIAnnotation] = uri
+		if _, err := client.LogURL(build); err == nil {
+			t.Errorf("expected an error for the build URI %q", uri)
+		}
+	}
+}
+
+func TestClientDeleteJob(t *testing.T) {
+	jenkins := newFakeJenkins()
+	defer jenkins.server.Close()
+	client := jenkins.client()
+	build := pipelineBuild(&buildapi.JenkinsPipelineBuildStrategy{Jenkinsfile: "node { echo 'hello' }"})
+
+	if _, err := client.Start(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := client.DeleteJob(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(jenkins.jobs) != 0 {
+		t.Errorf("expected the job to be deleted, got %v", jenkins.jobs)
+	}
+	// The job is already gone.
+	if err := client.DeleteJob(build); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestClientTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer server.Close()
+	defer close(done)
+
+	client := &Client{
+		ResolveURL: func(namespace string) (*url.URL, error) {
+			return url.Parse(server.URL)
+		},
+		HTTPClient: &http.Client{Timeout: 100 * time.Millisecond},
+	}
+	build := pipelineBuild(&buildapi.JenkinsPipelineBuildStrategy{Jenkinsfile: "node { echo 'hello' }"})
+	result := make(chan error, 1)
+	go func() {
+		_, err := client.Start(build)
+		result <- err
+	}()
+	select {
+	case err := <-result:
+		if err == nil {
+			t.Errorf("expected the request to time out")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the request to Jenkins to time out")
+	}
+}
+
+func TestJobName(t *testing.T) {
+	a := pipelineBuild(&buildapi.JenkinsPipelineBuildStrategy{})
+	a.Namespace, a.Name = "a-b", "c"
+	b := pipelineBuild(&buildapi.JenkinsPipelineBuildStrategy{})
+	b.Namespace, b.Name = "a", "b-c"
+	if JobName(a) == JobName(b) {
+		t.Errorf("expected builds in different namespaces to have different jobs, got %s", JobName(a))
+	}
+}
+
+func TestClientStop(t *testing.T) {
+	jenkins := newFakeJenkins()
+	defer jenkins.server.Close()
+	client := jenkins.client()
+
+	build := pipelineBuild(&buildapi.JenkinsPipelineBuildStrategy{})
+	if err := client.Stop(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(jenkins.stopped) != 0 {
+		t.Errorf("expected nothing to be stopped for a build not handed off to Jenkins, got %v", jenkins.stopped)
+	}
+
+	build.Annotations[buildapi.BuildJenkinsQueueURIAnnotation] = "http://jenkins.example.com/queue/item/7/"
+	if err := client.Stop(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	build.Annotations[buildapi.BuildJenkinsBuildURIAnnotation] = "http://jenkins.example.com/job/test_sample-1/1/"
+	if err := client.Stop(build); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"/queue/cancelItem?id=7", "/job/test_sample-1/1/stop"}
+	if len(jenkins.stopped) != len(expected) {
+		t.Fatalf("expected %v to be stopped, got %v", expected, jenkins.stopped)
+	}
+	for i := range expected {
+		if jenkins.stopped[i] != expected[i] {
+			t.Errorf("expected %s to be stopped, got %s", expected[i], jenkins.stopped[i])
+		}
+	}
+}
+
+func TestJobConfigFromRepository(t *testing.T) {
+	build := pipelineBuild(&buildapi.JenkinsPipelineBuildStrategy{})
+	build.Spec.Source.ContextDir = "app"
+	build.Spec.Source.Git.Ref = "devel"
+
+	config, err := jobConfig(build)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, expected := range []string{
+		"<url>https://github.com/openshift/ruby-hello-world</url>",
+		"<name>devel</name>",
+		"<scriptPath>app/Jenkinsfile</scriptPath>",
+	} {
+		if !strings.Contains(string(config), expected) {
+			t.Errorf("expected the job config to contain %s, got %s", expected, config)
+		}
+	}
+
+	build.Spec.Source.Git = nil
+	if _, err := jobConfig(build); err == nil {
+		t.Errorf("expected an error for a Jenkinsfile from the repository without a Git source")
+	}
+}
@@ -0,0 +1,125 @@
+package jenkins
+
+import (
+	"encoding/xml"
+	"fmt"
+	"path"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+)
+
+const (
+	// defaultJenkinsfilePath is the path of the Jenkinsfile inside the Git
+	// source used when the strategy does not specify one.
+	defaultJenkinsfilePath = "Jenkinsfile"
+
+	// defaultGitRef is the Git reference built when the build does not
+	// specify a commit or a reference.
+	defaultGitRef = "master"
+)
+
+// flowDefinition is the config.xml of a Jenkins pipeline job.
+type flowDefinition struct {
+	XMLName     xml.Name   `xml:"flow-definition"`
+	Plugin      string     `xml:"plugin,attr"`
+	Description string     `xml:"description"`
+	Definition  definition `xml:"definition"`
+}
+
+// definition describes where the pipeline script of a Jenkins pipeline job
+// comes from. Either Script is set (inline Jenkinsfile) or SCM and ScriptPath
+// are set (Jenkinsfile stored in the Git repository).
+type definition struct {
+	Class      string `xml:"class,attr"`
+	Plugin     string `xml:"plugin,attr"`
+	Script     string `xml:"script,omitempty"`
+	Sandbox    bool   `xml:"sandbox,omitempty"`
+	SCM        *scm   `xml:"scm,omitempty"`
+	ScriptPath string `xml:"scriptPath,omitempty"`
+}
+
+type scm struct {
+	Class             string             `xml:"class,attr"`
+	Plugin            string             `xml:"plugin,attr"`
+	ConfigVersion     int                `xml:"configVersion"`
+	UserRemoteConfigs []userRemoteConfig `xml:"userRemoteConfigs>hudson.plugins.git.UserRemoteConfig"`
+	Branches          []branchSpec       `xml:"branches>hudson.plugins.git.BranchSpec"`
+}
+
+type userRemoteConfig struct {
+	URL string `xml:"url"`
+}
+
+type branchSpec struct {
+	Name string `xml:"name"`
+}
+
+// JobName returns the name of the Jenkins job used to run the given build.
+// Every build has its own job, so that builds of the same BuildConfig which
+// run in parallel do not overwrite the pipeline or the Git reference of each
+// other. Namespace and build names cannot contain an underscore, so the name
+// of the job is unique across namespaces.
+func JobName(build *buildapi.Build) string {
+	return fmt.Sprintf("%s_%s", build.Namespace, build.Name)
+}
+
+// jobConfig returns the Jenkins job configuration (config.xml) for the given
+// Jenkins Pipeline strategy build.
+func jobConfig(build *buildapi.Build) ([]byte, error) {
+	strategy := build.Spec.Strategy.JenkinsPipelineStrategy
+	if strategy == nil {
+		return nil, fmt.Errorf("build %s/%s does not use the Jenkins Pipeline strategy", build.Namespace, build.Name)
+	}
+
+	job := flowDefinition{
+		Plugin:      "workflow-job",
+		Description: fmt.Sprintf("Pipeline for build %s/%s", build.Namespace, build.Name),
+	}
+	if len(strategy.Jenkinsfile) > 0 {
+		job.Definition = definition{
+			Class:   "org.jenkinsci.plugins.workflow.cps.CpsFlowDefinition",
+			Plugin:  "workflow-cps",
+			Script:  strategy.Jenkinsfile,
+			Sandbox: true,
+		}
+	} else {
+		git := build.Spec.Source.Git
+		if git == nil {
+			return nil, fmt.Errorf("build %s/%s must have a Git source to use a Jenkinsfile from the repository", build.Namespace, build.Name)
+		}
+		jenkinsfilePath := strategy.JenkinsfilePath
+		if len(jenkinsfilePath) == 0 {
+			jenkinsfilePath = defaultJenkinsfilePath
+		}
+		job.Definition = definition{
+			Class:  "org.jenkinsci.plugins.workflow.cps.CpsScmFlowDefinition",
+			Plugin: "workflow-cps",
+			SCM: &scm{
+				Class:             "hudson.plugins.git.GitSCM",
+				Plugin:            "git",
+				ConfigVersion:     2,
+				UserRemoteConfigs: []userRemoteConfig{{URL: git.URI}},
+				Branches:          []branchSpec{{Name: gitRef(build)}},
+			},
+			ScriptPath: path.Join(build.Spec.Source.ContextDir, jenkinsfilePath),
+		}
+	}
+
+	out, err := xml.MarshalIndent(job, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+// gitRef returns the Git commit or reference the pipeline of the build
+// should be loaded from.
+func gitRef(build *buildapi.Build) string {
+	if rev := build.Spec.Revision; rev != nil && rev.Git != nil && len(rev.Git.Commit) > 0 {
+		return rev.Git.Commit
+	}
+	if len(build.Spec.Source.Git.Ref) > 0 {
+		return build.Spec.Source.Git.Ref
+	}
+	return defaultGitRef
+}
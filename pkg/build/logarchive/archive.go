@@ -0,0 +1,108 @@
+package logarchive
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
+	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildutil "github.com/openshift/origin/pkg/build/util"
+)
+
+// Archive stores the logs of builds. Logs are stored with a RFC3339 timestamp
+// at the beginning of every line, so that they can be filtered the same way
+// the logs of running builds are.
+type Archive interface {
+	// Save stores the log of a build read from r, replacing any log stored
+	// for it before.
+	Save(namespace, name string, r io.Reader) error
+	// Open returns the stored log of a build. If no log is stored for the
+	// build, the returned error satisfies os.IsNotExist.
+	Open(namespace, name string) (io.ReadCloser, error)
+	// Delete removes the stored log of a build, if any.
+	Delete(namespace, name string) error
+}
+
+// DirectoryArchive is an Archive storing the logs of builds as files in a
+// directory, typically a persistent volume. The log of a build is stored as
+// <namespace>/<name>.log under the directory.
+type DirectoryArchive struct {
+	Directory string
+}
+
+var _ Archive = &DirectoryArchive{}
+
+// NewDirectoryArchive returns an Archive storing logs under the given
+// directory.
+func NewDirectoryArchive(dir string) *DirectoryArchive {
+	return &DirectoryArchive{Directory: dir}
+}
+
+func (a *DirectoryArchive) path(namespace, name string) string {
+	return filepath.Join(a.Directory, namespace, name+".log")
+}
+
+// Save writes the log to a temporary file first, so that a log being replaced
+// or a failed copy never leaves a partial log behind.
+func (a *DirectoryArchive) Save(namespace, name string, r io.Reader) error {
+	dir := filepath.Join(a.Directory, namespace)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(dir, "."+name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, r)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), a.path(namespace, name))
+	}
+	if err != nil {
+		os.Remove(f.Name())
+	}
+	return err
+}
+
+func (a *DirectoryArchive) Open(namespace, name string) (io.ReadCloser, error) {
+	return os.Open(a.path(namespace, name))
+}
+
+func (a *DirectoryArchive) Delete(namespace, name string) error {
+	if err := os.Remove(a.path(namespace, name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Archiver copies the logs of builds from their build pods into an Archive.
+type Archiver struct {
+	Archive Archive
+	PodLogs kclient.PodLogsNamespacer
+}
+
+// ArchiveBuildLog copies the log of the build pod of the given build into the
+// archive. The build pod must still exist.
+func (a *Archiver) ArchiveBuildLog(build *buildapi.Build) error {
+	podName := buildutil.GetBuildPodName(build)
+	req, err := a.PodLogs.PodLogs(build.Namespace).Get(podName, &kapi.PodLogOptions{Timestamps: true})
+	if err != nil {
+		return err
+	}
+	stream, err := req.Stream()
+	if err != nil {
+		return fmt.Errorf("unable to get the log of build pod %s/%s: %v", build.Namespace, podName, err)
+	}
+	defer stream.Close()
+	glog.V(4).Infof("Archiving the log of build %s/%s", build.Namespace, build.Name)
+	return a.Archive.Save(build.Namespace, build.Name, stream)
+}
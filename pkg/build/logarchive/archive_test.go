@@ -0,0 +1,158 @@
+package logarchive
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+)
+
+const archivedLog = `2016-02-01T10:00:00.000000001Z Cloning "https://github.com/openshift/ruby-hello-world" ...
+2016-02-01T10:00:10Z Step 1 : FROM centos
+2016-02-01T10:00:20.5Z Step 2 : RUN make
+not timestamped
+2016-02-01T10:00:30Z Push successful
+`
+
+func newArchive(t *testing.T) (*DirectoryArchive, func()) {
+	dir, err := ioutil.TempDir("", "build-logs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return NewDirectoryArchive(dir), func() { os.RemoveAll(dir) }
+}
+
+func readLog(t *testing.T, archive Archive, opts *kapi.PodLogOptions) string {
+	r, err := OpenLog(archive, "ns", "build-1", opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer r.Close()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return string(data)
+}
+
+type failingReader struct{}
+
+func (failingReader) Read(p []byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func TestDirectoryArchive(t *testing.T) {
+	archive, cleanup := newArchive(t)
+	defer cleanup()
+
+	if _, err := archive.Open("ns", "build-1"); !os.IsNotExist(err) {
+		t.Errorf("expected a not exist error for a missing log, got %v", err)
+	}
+	if err := archive.Save("ns", "build-1", strings.NewReader("first\n")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := archive.Save("ns", "build-1", strings.NewReader(archivedLog)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if log := readLog(t, archive, &kapi.PodLogOptions{Timestamps: true}); log != archivedLog {
+		t.Errorf("expected the log to be replaced, got:\n%s", log)
+	}
+
+	// a failed copy keeps the log stored before
+	if err := archive.Save("ns", "build-1", failingReader{}); err == nil {
+		t.Errorf("expected an error for a failed copy")
+	}
+	if log := readLog(t, archive, &kapi.PodLogOptions{Timestamps: true}); log != archivedLog {
+		t.Errorf("expected the log to be kept, got:\n%s", log)
+	}
+	files, _ := ioutil.ReadDir(filepath.Join(archive.Directory, "ns"))
+	if len(files) != 1 {
+		t.Errorf("expected the temporary files to be removed, got %d files", len(files))
+	}
+
+	if err := archive.Delete("ns", "build-1"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := archive.Open("ns", "build-1"); !os.IsNotExist(err) {
+		t.Errorf("expected the log to be deleted, got %v", err)
+	}
+	if err := archive.Delete("ns", "build-1"); err != nil {
+		t.Errorf("unexpected error deleting a missing log: %v", err)
+	}
+}
+
+func TestFilterLog(t *testing.T) {
+	int64p := func(i int64) *int64 { return &i }
+	sinceTime := unversioned.NewTime(time.Date(2016, 2, 1, 10, 0, 10, 0, time.UTC))
+	now := time.Date(2016, 2, 1, 10, 0, 35, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		opts     kapi.PodLogOptions
+		expected string
+	}{
+		{
+			name: "no options",
+			expected: `Cloning "https://github.com/openshift/ruby-hello-world" ...
+Step 1 : FROM centos
+Step 2 : RUN make
+not timestamped
+Push successful
+`,
+		},
+		{
+			name:     "timestamps",
+			opts:     kapi.PodLogOptions{Timestamps: true},
+			expected: archivedLog,
+		},
+		{
+			name:     "tail lines",
+			opts:     kapi.PodLogOptions{TailLines: int64p(2)},
+			expected: "not timestamped\nPush successful\n",
+		},
+		{
+			name: "zero tail lines",
+			opts: kapi.PodLogOptions{TailLines: int64p(0)},
+		},
+		{
+			name:     "since time",
+			opts:     kapi.PodLogOptions{SinceTime: &sinceTime},
+			expected: "Step 1 : FROM centos\nStep 2 : RUN make\nnot timestamped\nPush successful\n",
+		},
+		{
+			name:     "since seconds",
+			opts:     kapi.PodLogOptions{SinceSeconds: int64p(10), Timestamps: true},
+			expected: "not timestamped\n2016-02-01T10:00:30Z Push successful\n",
+		},
+	}
+	for _, test := range tests {
+		out := &bytes.Buffer{}
+		if err := filterLog(out, strings.NewReader(archivedLog), &test.opts, now); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if out.String() != test.expected {
+			t.Errorf("%s: expected:\n%s\ngot:\n%s", test.name, test.expected, out.String())
+		}
+	}
+}
+
+func TestOpenLogLimitBytes(t *testing.T) {
+	archive, cleanup := newArchive(t)
+	defer cleanup()
+	if err := archive.Save("ns", "build-1", strings.NewReader(archivedLog)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	limit := int64(10)
+	if log := readLog(t, archive, &kapi.PodLogOptions{LimitBytes: &limit}); log != "Cloning \"h" {
+		t.Errorf("expected the log to be limited to %d bytes, got %q", limit, log)
+	}
+}
@@ -0,0 +1,3 @@
+// Package logarchive keeps the logs of completed builds, so they can be served
+// after the build pods are deleted.
+package logarchive
@@ -0,0 +1,100 @@
+package logarchive
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+)
+
+// OpenLog returns the archived log of a build, filtered by the given options
+// the same way the kubelet filters the logs of containers. Follow and
+// Previous do not apply to archived logs and are ignored.
+func OpenLog(archive Archive, namespace, name string, opts *kapi.PodLogOptions) (io.ReadCloser, error) {
+	log, err := archive.Open(namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	r, w := io.Pipe()
+	go func() {
+		err := filterLog(w, log, opts, time.Now())
+		log.Close()
+		w.CloseWithError(err)
+	}()
+	if opts.LimitBytes != nil {
+		return &limitedReadCloser{Reader: io.LimitReader(r, *opts.LimitBytes), Closer: r}, nil
+	}
+	return r, nil
+}
+
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// filterLog copies the lines of a timestamped log matching the options to w.
+// Lines without a valid timestamp are always copied as is.
+func filterLog(w io.Writer, log io.Reader, opts *kapi.PodLogOptions, now time.Time) error {
+	var since *time.Time
+	switch {
+	case opts.SinceTime != nil:
+		since = &opts.SinceTime.Time
+	case opts.SinceSeconds != nil:
+		t := now.Add(-time.Duration(*opts.SinceSeconds) * time.Second)
+		since = &t
+	}
+
+	if opts.TailLines != nil && *opts.TailLines <= 0 {
+		return nil
+	}
+	// Only the last lines are kept in memory when tailing the log.
+	var tail []string
+
+	reader := bufio.NewReader(log)
+	for {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			timestamp, content, ok := splitTimestamp(line)
+			if !ok || since == nil || !timestamp.Before(*since) {
+				if ok && !opts.Timestamps {
+					line = content
+				}
+				if opts.TailLines != nil {
+					tail = append(tail, line)
+					if int64(len(tail)) > *opts.TailLines {
+						tail = tail[1:]
+					}
+				} else if _, err := io.WriteString(w, line); err != nil {
+					return err
+				}
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, line := range tail {
+		if _, err := io.WriteString(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitTimestamp splits a log line into its leading timestamp and content.
+func splitTimestamp(line string) (time.Time, string, bool) {
+	i := strings.IndexByte(line, ' ')
+	if i == -1 {
+		return time.Time{}, line, false
+	}
+	timestamp, err := time.Parse(time.RFC3339Nano, line[:i])
+	if err != nil {
+		return time.Time{}, line, false
+	}
+	return timestamp, line[i+1:], true
+}
@@ -64,6 +64,8 @@ func (strategy) PrepareForCreate(obj runtime.Object) {
 	if len(build.Status.Phase) == 0 {
 		build.Status.Phase = api.BuildPhaseNew
 	}
+	api.CleanSecretDestinations(&build.Spec.Source)
+	api.CleanBuildCachePaths(&build.Spec.Strategy)
 }
 
 // PrepareForUpdate clears fields that are not allowed to be set by end users on update.
@@ -40,12 +40,16 @@ func (strategy) AllowUnconditionalUpdate() bool {
 func (strategy) PrepareForCreate(obj runtime.Object) {
 	bc := obj.(*api.BuildConfig)
 	dropUnknownTriggers(bc)
+	api.CleanSecretDestinations(&bc.Spec.Source)
+	api.CleanBuildCachePaths(&bc.Spec.Strategy)
 }
 
 // PrepareForUpdate clears fields that are not allowed to be set by end users on update.
 func (strategy) PrepareForUpdate(obj, old runtime.Object) {
 	bc := obj.(*api.BuildConfig)
 	dropUnknownTriggers(bc)
+	api.CleanSecretDestinations(&bc.Spec.Source)
+	api.CleanBuildCachePaths(&bc.Spec.Strategy)
 }
 
 // Validate validates a new policy.
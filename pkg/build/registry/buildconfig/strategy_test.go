@@ -34,9 +34,14 @@ func TestBuildConfigStrategy(t *testing.T) {
 						URI: "http://github.com/my/repository",
 					},
 					ContextDir: "context",
+					Secrets: []buildapi.SecretBuildSource{
+						{Secret: kapi.LocalObjectReference{Name: "maven-settings"}, DestinationDir: "./.m2/"},
+					},
 				},
 				Strategy: buildapi.BuildStrategy{
-					DockerStrategy: &buildapi.DockerBuildStrategy{},
+					SourceStrategy: &buildapi.SourceBuildStrategy{
+						From: kapi.ObjectReference{Kind: "DockerImage", Name: "builder/image"},
+					},
 				},
 				Output: buildapi.BuildOutput{
 					To: &kapi.ObjectReference{
@@ -52,6 +57,9 @@ func TestBuildConfigStrategy(t *testing.T) {
 	if len(errs) != 0 {
 		t.Errorf("Unexpected error validating %v", errs)
 	}
+	if dir := buildConfig.Spec.Source.Secrets[0].DestinationDir; dir != ".m2" {
+		t.Errorf("Expected the secret destination to be cleaned, got %q", dir)
+	}
 
 	buildConfig.ResourceVersion = "foo"
 	errs = Strategy.ValidateUpdate(ctx, buildConfig, buildConfig)
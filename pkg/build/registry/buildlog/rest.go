@@ -2,6 +2,9 @@ package buildlog
 
 import (
 	"fmt"
+	"io"
+	"net/url"
+	"os"
 	"time"
 
 	"github.com/golang/glog"
@@ -15,6 +18,7 @@ import (
 	"k8s.io/kubernetes/pkg/runtime"
 
 	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	"github.com/openshift/origin/pkg/build/registry"
 	buildutil "github.com/openshift/origin/pkg/build/util"
 )
@@ -26,6 +30,18 @@ type REST struct {
 	PodGetter      pod.ResourceGetter
 	ConnectionInfo kclient.ConnectionInfoGetter
 	Timeout        time.Duration
+	// Archive holds the logs of completed builds. If nil, logs are only
+	// served from the build pods.
+	Archive logarchive.Archive
+	// PipelineLogs locates the logs of Jenkins Pipeline builds. If nil, the
+	// logs of Jenkins Pipeline builds are not served.
+	PipelineLogs PipelineLogLocator
+}
+
+// PipelineLogLocator locates the console log of the Jenkins build running a
+// Jenkins Pipeline build.
+type PipelineLogLocator interface {
+	LogURL(build *api.Build) (*url.URL, error)
 }
 
 type podGetter struct {
@@ -45,13 +61,17 @@ const defaultTimeout time.Duration = 10 * time.Second
 // NewREST creates a new REST for BuildLog
 // Takes build registry and pod client to get necessary attributes to assemble
 // URL to which the request shall be redirected in order to get build logs.
-func NewREST(getter rest.Getter, watcher rest.Watcher, pn kclient.PodsNamespacer, connectionInfo kclient.ConnectionInfoGetter) *REST {
+// The logs of completed builds are served from the archive, if any, and the
+// logs of Jenkins Pipeline builds from Jenkins.
+func NewREST(getter rest.Getter, watcher rest.Watcher, pn kclient.PodsNamespacer, connectionInfo kclient.ConnectionInfoGetter, archive logarchive.Archive, pipelineLogs PipelineLogLocator) *REST {
 	return &REST{
 		Getter:         getter,
 		Watcher:        watcher,
 		PodGetter:      &podGetter{pn},
 		ConnectionInfo: connectionInfo,
 		Timeout:        defaultTimeout,
+		Archive:        archive,
+		PipelineLogs:   pipelineLogs,
 	}
 }
 
@@ -99,9 +119,27 @@ func (r *REST) Get(ctx kapi.Context, name string, opts runtime.Object) (runtime.
 	case api.BuildPhaseError:
 		return nil, errors.NewBadRequest(fmt.Sprintf("build %s is in an error state. %s", name, buildutil.NoBuildLogsMessage))
 	}
+	// Jenkins Pipeline builds do not run in a build pod, their log is served
+	// by Jenkins.
+	if build.Spec.Strategy.JenkinsPipelineStrategy != nil {
+		return r.pipelineLogStreamer(build, buildLogOpts)
+	}
+
+	logOpts := api.BuildToPodLogOptions(buildLogOpts)
+	// The build pod of a completed build may have been deleted, so its log is
+	// served from the archive when it was archived.
+	if r.Archive != nil && buildutil.IsBuildComplete(build) {
+		log, err := logarchive.OpenLog(r.Archive, build.Namespace, build.Name, logOpts)
+		if err == nil {
+			return &archivedLogStreamer{log: log}, nil
+		}
+		if !os.IsNotExist(err) {
+			glog.V(2).Infof("Unable to read the archived log of build %s/%s: %v", build.Namespace, build.Name, err)
+		}
+	}
+
 	// The container should be the default build container, so setting it to blank
 	buildPodName := buildutil.GetBuildPodName(build)
-	logOpts := api.BuildToPodLogOptions(buildLogOpts)
 	location, transport, err := pod.LogLocation(r.PodGetter, r.ConnectionInfo, ctx, buildPodName, logOpts)
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -118,6 +156,47 @@ func (r *REST) Get(ctx kapi.Context, name string, opts runtime.Object) (runtime.
 	}, nil
 }
 
+// pipelineLogStreamer returns a streamer for the console log of the Jenkins
+// build running the given Jenkins Pipeline build. The location of the log is
+// determined by the PipelineLogs locator and never taken from the build, which
+// users can edit.
+func (r *REST) pipelineLogStreamer(build *api.Build, buildLogOpts *api.BuildLogOptions) (runtime.Object, error) {
+	if r.PipelineLogs == nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("the logs of Jenkins pipeline build %s are not available", build.Name))
+	}
+	if len(build.Annotations[api.BuildJenkinsBuildURIAnnotation]) == 0 {
+		if buildLogOpts.NoWait {
+			return &genericrest.LocationStreamer{}, nil
+		}
+		return nil, errors.NewBadRequest(fmt.Sprintf("the Jenkins pipeline of build %s has not started yet", build.Name))
+	}
+	location, err := r.PipelineLogs.LogURL(build)
+	if err != nil {
+		return nil, errors.NewBadRequest(fmt.Sprintf("unable to locate the Jenkins log of build %s: %v", build.Name, err))
+	}
+	return &genericrest.LocationStreamer{
+		Location:        location,
+		ContentType:     "text/plain",
+		Flush:           buildLogOpts.Follow,
+		ResponseChecker: genericrest.NewGenericHttpResponseChecker("Build", build.Name),
+	}, nil
+}
+
+// archivedLogStreamer streams a build log read from the log archive.
+type archivedLogStreamer struct {
+	log io.ReadCloser
+}
+
+var _ rest.ResourceStreamer = &archivedLogStreamer{}
+
+// IsAnAPIObject marks this object as a runtime.Object
+func (*archivedLogStreamer) IsAnAPIObject() {}
+
+// InputStream returns the archived log.
+func (s *archivedLogStreamer) InputStream(apiVersion, acceptHeader string) (io.ReadCloser, bool, string, error) {
+	return s.log, false, "text/plain", nil
+}
+
 // NewGetOptions returns a new options object for build logs
 func (r *REST) NewGetOptions() (runtime.Object, bool, string) {
 	return &api.BuildLogOptions{}, false, ""
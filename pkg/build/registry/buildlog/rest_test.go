@@ -2,7 +2,10 @@ package buildlog
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"net/url"
+	"os"
 	"strings"
 	"testing"
 	"time"
@@ -17,6 +20,8 @@ import (
 	"k8s.io/kubernetes/pkg/watch"
 
 	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/jenkins"
+	"github.com/openshift/origin/pkg/build/logarchive"
 	"github.com/openshift/origin/pkg/build/registry/test"
 )
 
@@ -75,6 +80,120 @@ func TestRegistryResourceLocation(t *testing.T) {
 	}
 }
 
+func TestArchivedLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "build-logs")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	archive := logarchive.NewDirectoryArchive(dir)
+	for _, name := range []string{"archived", "running"} {
+		if err := archive.Save(kapi.NamespaceDefault, name, strings.NewReader("2016-02-01T10:00:00Z first\n2016-02-01T10:00:01Z second\n")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	tailLines := int64(1)
+	tests := []struct {
+		name     string
+		build    *api.Build
+		expected string
+	}{
+		{
+			name:     "archived",
+			build:    mockBuild(api.BuildPhaseComplete, "archived"),
+			expected: "second\n",
+		},
+		{
+			name:  "not archived",
+			build: mockBuild(api.BuildPhaseFailed, "failed"),
+		},
+		{
+			name:  "running",
+			build: mockBuild(api.BuildPhaseRunning, "running"),
+		},
+	}
+	for _, tt := range tests {
+		tt.build.Namespace = kapi.NamespaceDefault
+		storage := &REST{
+			Getter:         &test.BuildStorage{Build: tt.build},
+			PodGetter:      &testPodGetter{},
+			ConnectionInfo: &kclient.HTTPKubeletClient{Config: &kclient.KubeletConfig{EnableHttps: true, Port: 12345}, Client: &http.Client{}},
+			Timeout:        defaultTimeout,
+			Archive:        archive,
+		}
+		obj, err := storage.Get(kapi.NewDefaultContext(), tt.build.Name, &api.BuildLogOptions{NoWait: true, TailLines: &tailLines})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		streamer, ok := obj.(*archivedLogStreamer)
+		if len(tt.expected) == 0 {
+			if ok {
+				t.Errorf("%s: expected the log to be streamed from the build pod", tt.name)
+			}
+			continue
+		}
+		if !ok {
+			t.Errorf("%s: expected the log to be streamed from the archive, got %#v", tt.name, obj)
+			continue
+		}
+		stream, _, contentType, err := streamer.InputStream("", "")
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		data, _ := ioutil.ReadAll(stream)
+		stream.Close()
+		if string(data) != tt.expected || contentType != "text/plain" {
+			t.Errorf("%s: unexpected log %q with content type %q", tt.name, string(data), contentType)
+		}
+	}
+}
+
+func TestPipelineLog(t *testing.T) {
+	build := mockBuild(api.BuildPhaseRunning, "pipeline")
+	build.Namespace = kapi.NamespaceDefault
+	build.Spec.Strategy.JenkinsPipelineStrategy = &api.JenkinsPipelineBuildStrategy{}
+	storage := &REST{
+		Getter:  &test.BuildStorage{Build: build},
+		Timeout: defaultTimeout,
+		PipelineLogs: &jenkins.Client{
+			ResolveURL: func(namespace string) (*url.URL, error) {
+				return url.Parse("http://172.30.0.10:8080")
+			},
+		},
+	}
+
+	obj, err := storage.Get(kapi.NewDefaultContext(), build.Name, &api.BuildLogOptions{NoWait: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if streamer, ok := obj.(*genericrest.LocationStreamer); !ok || streamer.Location != nil {
+		t.Errorf("expected no log before the pipeline started, got %#v", obj)
+	}
+
+	// The log is always read from the Jenkins service, whatever host the
+	// build URI names.
+	build.Annotations = map[string]string{api.BuildJenkinsBuildURIAnnotation: "http://169.254.169.254/job/other/4/"}
+	obj, err = storage.Get(kapi.NewDefaultContext(), build.Name, &api.BuildLogOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	streamer, ok := obj.(*genericrest.LocationStreamer)
+	if !ok {
+		t.Fatalf("expected a LocationStreamer, got %#v", obj)
+	}
+	if expected := "http://172.30.0.10:8080/job/default_pipeline/4/consoleText"; streamer.Location == nil || streamer.Location.String() != expected {
+		t.Errorf("expected the log to be read from %s, got %v", expected, streamer.Location)
+	}
+
+	storage.PipelineLogs = nil
+	if _, err := storage.Get(kapi.NewDefaultContext(), build.Name, &api.BuildLogOptions{}); err == nil {
+		t.Errorf("expected an error without a pipeline log locator")
+	}
+}
+
 func TestWaitForBuild(t *testing.T) {
 	ctx := kapi.NewDefaultContext()
 	tests := []struct {
@@ -2,11 +2,15 @@ package util
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
 
 	buildapi "github.com/openshift/origin/pkg/build/api"
+	buildclient "github.com/openshift/origin/pkg/build/client"
 )
 
 const (
@@ -70,3 +74,54 @@ func IsBuildComplete(build *buildapi.Build) bool {
 func BuildNameForConfigVersion(name string, version int) string {
 	return fmt.Sprintf("%s-%d", name, version)
 }
+
+// BuildNumber returns the sequential number of the build, as recorded in the
+// BuildNumberAnnotation by the build generator.
+func BuildNumber(build *buildapi.Build) (int64, error) {
+	if stringNumber, ok := build.Annotations[buildapi.BuildNumberAnnotation]; ok {
+		return strconv.ParseInt(stringNumber, 10, 64)
+	}
+	return 0, fmt.Errorf("build %s/%s is missing the %q annotation", build.Namespace, build.Name, buildapi.BuildNumberAnnotation)
+}
+
+// BuildRunPolicy returns the scheduling policy for the build based on the
+// BuildRunPolicyLabel. Builds without the label are run in parallel.
+func BuildRunPolicy(build *buildapi.Build) buildapi.BuildRunPolicy {
+	if policy := build.Labels[buildapi.BuildRunPolicyLabel]; len(policy) > 0 {
+		return buildapi.BuildRunPolicy(policy)
+	}
+	return buildapi.BuildRunPolicyParallel
+}
+
+// ConfigNameForBuild returns the name of the BuildConfig the build was
+// created from, or an empty string if the build was created by hand.
+func ConfigNameForBuild(build *buildapi.Build) string {
+	if build.Status.Config != nil {
+		return build.Status.Config.Name
+	}
+	return build.Labels[buildapi.BuildConfigLabel]
+}
+
+// buildFilter is a function used to filter the builds returned by
+// BuildConfigBuilds.
+type buildFilter func(buildapi.Build) bool
+
+// BuildConfigBuilds return a list of builds for the given build config.
+// Optionally you can specify a filter function to select only builds that
+// matches your criteria.
+func BuildConfigBuilds(c buildclient.BuildLister, namespace, name string, filterFunc buildFilter) (*buildapi.BuildList, error) {
+	result, err := c.List(namespace, labels.Set{buildapi.BuildConfigLabel: name}.AsSelector(), fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+	if filterFunc == nil {
+		return result, nil
+	}
+	filteredList := &buildapi.BuildList{TypeMeta: result.TypeMeta, ListMeta: result.ListMeta}
+	for _, b := range result.Items {
+		if filterFunc(b) {
+			filteredList.Items = append(filteredList.Items, b)
+		}
+	}
+	return filteredList, nil
+}
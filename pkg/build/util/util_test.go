@@ -12,3 +12,28 @@ func TestGetBuildPodName(t *testing.T) {
 		t.Errorf("Expected %s, got %s", expected, actual)
 	}
 }
+
+func TestBuildNumber(t *testing.T) {
+	build := &buildapi.Build{ObjectMeta: kapi.ObjectMeta{
+		Name:        "mybuild-2",
+		Annotations: map[string]string{buildapi.BuildNumberAnnotation: "2"},
+	}}
+	if number, err := BuildNumber(build); err != nil || number != 2 {
+		t.Errorf("Expected build number 2, got %d (%v)", number, err)
+	}
+	delete(build.Annotations, buildapi.BuildNumberAnnotation)
+	if _, err := BuildNumber(build); err == nil {
+		t.Errorf("Expected an error for a build without a build number")
+	}
+}
+
+func TestBuildRunPolicy(t *testing.T) {
+	build := &buildapi.Build{}
+	if policy := BuildRunPolicy(build); policy != buildapi.BuildRunPolicyParallel {
+		t.Errorf("Expected %s policy for a build without label, got %s", buildapi.BuildRunPolicyParallel, policy)
+	}
+	build.Labels = map[string]string{buildapi.BuildRunPolicyLabel: string(buildapi.BuildRunPolicySerial)}
+	if policy := BuildRunPolicy(build); policy != buildapi.BuildRunPolicySerial {
+		t.Errorf("Expected %s policy, got %s", buildapi.BuildRunPolicySerial, policy)
+	}
+}
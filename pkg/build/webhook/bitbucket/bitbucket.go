@@ -0,0 +1,199 @@
+package bitbucket
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/mail"
+
+	"github.com/golang/glog"
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+// WebHook used for processing bitbucket webhook requests.
+type WebHook struct{}
+
+// New returns bitbucket webhook plugin.
+func New() *WebHook {
+	return &WebHook{}
+}
+
+const (
+	// cloudPushEvent is the push event sent by Bitbucket Cloud.
+	cloudPushEvent = "repo:push"
+	// serverPushEvent is the push event sent by Bitbucket Server.
+	serverPushEvent = "repo:refs_changed"
+	// serverPingEvent is sent by Bitbucket Server when testing the webhook.
+	serverPingEvent = "diagnostics:ping"
+)
+
+// cloudPush is the payload of a Bitbucket Cloud push.
+type cloudPush struct {
+	Push struct {
+		Changes []struct {
+			New *struct {
+				Type   string `json:"type"`
+				Name   string `json:"name"`
+				Target struct {
+					Hash    string `json:"hash"`
+					Message string `json:"message"`
+					Author  struct {
+						Raw string `json:"raw"`
+					} `json:"author"`
+				} `json:"target"`
+			} `json:"new"`
+		} `json:"changes"`
+	} `json:"push"`
+}
+
+// serverPush is the payload of a Bitbucket Server push.
+type serverPush struct {
+	Actor struct {
+		Name         string `json:"name"`
+		DisplayName  string `json:"displayName"`
+		EmailAddress string `json:"emailAddress"`
+	} `json:"actor"`
+	Changes []struct {
+		RefID  string `json:"refId"`
+		ToHash string `json:"toHash"`
+		Type   string `json:"type"`
+	} `json:"changes"`
+}
+
+// change is a single ref update of a push.
+type change struct {
+	ref      string
+	revision api.GitSourceRevision
+}
+
+// Extract services webhooks from Bitbucket Cloud and Bitbucket Server
+func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, proceed bool, err error) {
+	trigger, ok := webhook.FindTriggerPolicy(api.BitbucketWebHookBuildTriggerType, buildCfg)
+	if !ok {
+		err = webhook.ErrHookNotEnabled
+		return
+	}
+	glog.V(4).Infof("Checking if the provided secret for BuildConfig %s/%s matches", buildCfg.Namespace, buildCfg.Name)
+	if trigger.BitbucketWebHook.Secret != secret {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	glog.V(4).Infof("Verifying build request for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
+	if err = verifyRequest(req); err != nil {
+		return
+	}
+	method := req.Header.Get("X-Event-Key")
+	if method == serverPingEvent {
+		return
+	}
+	if method != cloudPushEvent && method != serverPushEvent {
+		err = fmt.Errorf("Unknown X-Event-Key %s", method)
+		return
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return
+	}
+	var changes []change
+	if method == cloudPushEvent {
+		changes, err = cloudChanges(body)
+	} else {
+		changes, err = serverChanges(body)
+	}
+	if err != nil {
+		return
+	}
+
+	for _, c := range changes {
+		if webhook.GitRefMatches(c.ref, buildCfg.Spec.Source.Git.Ref) {
+			rev := c.revision
+			return &api.SourceRevision{Git: &rev}, true, nil
+		}
+	}
+	glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  No branch reference from the push matches configuration", buildCfg.Namespace, buildCfg.Name)
+	return
+}
+
+// cloudChanges returns the branch updates of a Bitbucket Cloud push.
+func cloudChanges(body []byte) ([]change, error) {
+	var event cloudPush
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, err
+	}
+	changes := []change{}
+	for _, c := range event.Push.Changes {
+		// deleted branches have no new state, tags are not built
+		if c.New == nil || c.New.Type != "branch" {
+			continue
+		}
+		author := parseUser(c.New.Target.Author.Raw)
+		changes = append(changes, change{
+			ref: c.New.Name,
+			revision: api.GitSourceRevision{
+				Commit:    c.New.Target.Hash,
+				Author:    author,
+				Committer: author,
+				Message:   c.New.Target.Message,
+			},
+		})
+	}
+	return changes, nil
+}
+
+// serverChanges returns the branch updates of a Bitbucket Server push. The
+// payload does not describe the commits, the user who pushed is reported as
+// their author.
+func serverChanges(body []byte) ([]change, error) {
+	var event serverPush
+	if err := json.Unmarshal(body, &event); err != nil {
+		return nil, err
+	}
+	name := event.Actor.DisplayName
+	if len(name) == 0 {
+		name = event.Actor.Name
+	}
+	user := api.SourceControlUser{Name: name, Email: event.Actor.EmailAddress}
+	changes := []change{}
+	for _, c := range event.Changes {
+		if c.Type == "DELETE" {
+			continue
+		}
+		changes = append(changes, change{
+			ref: c.RefID,
+			revision: api.GitSourceRevision{
+				Commit:    c.ToHash,
+				Author:    user,
+				Committer: user,
+			},
+		})
+	}
+	return changes, nil
+}
+
+// parseUser parses a raw Git user in the "Name <email>" form.
+func parseUser(raw string) api.SourceControlUser {
+	address, err := mail.ParseAddress(raw)
+	if err != nil {
+		return api.SourceControlUser{Name: raw}
+	}
+	return api.SourceControlUser{Name: address.Name, Email: address.Address}
+}
+
+func verifyRequest(req *http.Request) error {
+	if method := req.Method; method != "POST" {
+		return fmt.Errorf("Unsupported HTTP method %s", method)
+	}
+	// Bitbucket Server sends the charset along with the media type
+	contentType := req.Header.Get("Content-Type")
+	if mediaType, _, err := mime.ParseMediaType(contentType); err != nil || mediaType != "application/json" {
+		return fmt.Errorf("Unsupported Content-Type %s", contentType)
+	}
+	if len(req.Header.Get("X-Event-Key")) == 0 {
+		return errors.New("Missing X-Event-Key")
+	}
+	return nil
+}
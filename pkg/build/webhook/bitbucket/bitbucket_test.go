@@ -0,0 +1,219 @@
+package bitbucket
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+type okBuildConfigGetter struct{}
+
+func (c *okBuildConfigGetter) Get(namespace, name string) (*api.BuildConfig, error) {
+	return &api.BuildConfig{
+		Spec: api.BuildConfigSpec{
+			Triggers: []api.BuildTriggerPolicy{
+				{
+					Type: api.BitbucketWebHookBuildTriggerType,
+					BitbucketWebHook: &api.WebHookTrigger{
+						Secret: "secret101",
+					},
+				},
+			},
+			BuildSpec: api.BuildSpec{
+				Source: api.BuildSource{
+					Git: &api.GitBuildSource{
+						URI: "git://bitbucket.org/my/repo.git",
+					},
+				},
+				Strategy: mockBuildStrategy,
+			},
+		},
+	}, nil
+}
+
+var mockBuildStrategy = api.BuildStrategy{
+	SourceStrategy: &api.SourceBuildStrategy{
+		From: kapi.ObjectReference{
+			Kind: "DockerImage",
+			Name: "repository/image",
+		},
+	},
+}
+
+type okBuildConfigInstantiator struct{}
+
+func (*okBuildConfigInstantiator) Instantiate(namespace string, request *api.BuildRequest) (*api.Build, error) {
+	return &api.Build{}, nil
+}
+
+func TestWrongSecret(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/wrongsecret/bitbucket", nil)
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), webhook.ErrSecretMismatch.Error()) {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestMissingEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+	defer server.Close()
+
+	client := &http.Client{}
+	req, _ := http.NewRequest("POST", server.URL+"/build100/secret101/bitbucket", nil)
+	req.Header.Add("Content-Type", "application/json")
+	resp, _ := client.Do(req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusBadRequest ||
+		!strings.Contains(string(body), "Missing X-Event-Key") {
+		t.Errorf("Expected BadRequest, got %s: %s!", resp.Status, string(body))
+	}
+}
+
+func TestWrongBitbucketEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+	defer server.Close()
+
+	postFile("pullrequest:created", "pushevent-cloud.json", server.URL+"/build100/secret101/bitbucket", http.StatusBadRequest, t)
+}
+
+func TestJsonPingEvent(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+	defer server.Close()
+
+	post("diagnostics:ping", []byte(`{"test":true}`), server.URL+"/build100/secret101/bitbucket", http.StatusOK, t)
+}
+
+func TestJsonPushEvents(t *testing.T) {
+	server := httptest.NewServer(webhook.NewController(&okBuildConfigGetter{}, &okBuildConfigInstantiator{},
+		map[string]webhook.Plugin{"bitbucket": New()}))
+	defer server.Close()
+
+	postFile("repo:push", "pushevent-cloud.json", server.URL+"/build100/secret101/bitbucket", http.StatusOK, t)
+	postFile("repo:refs_changed", "pushevent-server.json", server.URL+"/build100/secret101/bitbucket", http.StatusOK, t)
+	post("repo:push", []byte{}, server.URL+"/build100/secret101/bitbucket", http.StatusBadRequest, t)
+}
+
+func postFile(event, filename, url string, expStatusCode int, t *testing.T) {
+	data, err := ioutil.ReadFile("fixtures/" + filename)
+	if err != nil {
+		t.Errorf("Failed to open %s: %v", filename, err)
+	}
+
+	post(event, data, url, expStatusCode, t)
+}
+
+func post(event string, data []byte, url string, expStatusCode int, t *testing.T) {
+	client := &http.Client{}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(data))
+	if err != nil {
+		t.Errorf("Error creating POST request: %v!", err)
+	}
+
+	req.Header.Add("Content-Type", "application/json; charset=UTF-8")
+	req.Header.Add("X-Event-Key", event)
+	resp, err := client.Do(req)
+
+	if err != nil {
+		t.Errorf("Failed posting webhook to: %s!", url)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != expStatusCode {
+		t.Errorf("Wrong response code, expecting %d, got %s: %s!",
+			expStatusCode, resp.Status, string(body))
+	}
+}
+
+func setup(t *testing.T, filename, event string) (*api.BuildConfig, *http.Request) {
+	buildCfg := &api.BuildConfig{
+		Spec: api.BuildConfigSpec{
+			Triggers: []api.BuildTriggerPolicy{
+				{
+					Type: api.BitbucketWebHookBuildTriggerType,
+					BitbucketWebHook: &api.WebHookTrigger{
+						Secret: "secret101",
+					},
+				},
+			},
+			BuildSpec: api.BuildSpec{
+				Source: api.BuildSource{
+					Git: &api.GitBuildSource{
+						URI: "git://bitbucket.org/my/repo.git",
+					},
+				},
+				Strategy: mockBuildStrategy,
+			},
+		},
+	}
+	data, err := ioutil.ReadFile("fixtures/" + filename)
+	if err != nil {
+		t.Fatalf("Failed to open %s: %v", filename, err)
+	}
+	req, _ := http.NewRequest("POST", "http://origin.com", bytes.NewReader(data))
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("X-Event-Key", event)
+	return buildCfg, req
+}
+
+func TestExtractPushEvents(t *testing.T) {
+	tests := []struct {
+		filename        string
+		event           string
+		ref             string
+		proceed         bool
+		expectedMessage string
+	}{
+		{filename: "pushevent-cloud.json", event: "repo:push", proceed: true, expectedMessage: "Random act of kindness\n"},
+		{filename: "pushevent-server.json", event: "repo:refs_changed", proceed: true},
+		// tags and deleted branches are not built
+		{filename: "pushevent-cloud.json", event: "repo:push", ref: "v1.0"},
+		{filename: "pushevent-server.json", event: "repo:refs_changed", ref: "removed"},
+		{filename: "pushevent-cloud.json", event: "repo:push", ref: "adfj32qrafdavckeaewra"},
+	}
+	for i, test := range tests {
+		buildCfg, req := setup(t, test.filename, test.event)
+		buildCfg.Spec.Source.Git.Ref = test.ref
+
+		revision, proceed, err := New().Extract(buildCfg, "secret101", "", req)
+		if err != nil {
+			t.Errorf("%d: Error while extracting build info: %s", i, err)
+			continue
+		}
+		if proceed != test.proceed {
+			t.Errorf("%d: Expected proceed to be %t", i, test.proceed)
+		}
+		if !test.proceed {
+			continue
+		}
+		if revision == nil {
+			t.Errorf("%d: Expecting the revision to not be nil", i)
+			continue
+		}
+		if revision.Git.Commit != "2602ace61490de0513dfbd7c7de949356cf9bd17" {
+			t.Errorf("%d: Expecting the revision to contain the commit id from the push event, got %s", i, revision.Git.Commit)
+		}
+		if revision.Git.Author.Name != "Jon Doe" || revision.Git.Author.Email != "jondoe@email.com" {
+			t.Errorf("%d: Expecting the revision to contain the author, got %#v", i, revision.Git.Author)
+		}
+		if revision.Git.Message != test.expectedMessage {
+			t.Errorf("%d: Expecting the message %q, got %q", i, test.expectedMessage, revision.Git.Message)
+		}
+	}
+}
@@ -0,0 +1,4 @@
+// Package bitbucket contains webhook.Plugin implementation of Bitbucket Cloud
+// and Bitbucket Server webhooks according to
+// https://confluence.atlassian.com/bitbucket/manage-webhooks-735643732.html
+package bitbucket
@@ -0,0 +1,3 @@
+// Package gitlab contains webhook.Plugin implementation of GitLab webhooks
+// according to http://doc.gitlab.com/ce/web_hooks/web_hooks.html
+package gitlab
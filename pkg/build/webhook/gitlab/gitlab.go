@@ -0,0 +1,113 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/openshift/origin/pkg/build/api"
+	"github.com/openshift/origin/pkg/build/webhook"
+)
+
+// WebHook used for processing gitlab webhook requests.
+type WebHook struct{}
+
+// New returns gitlab webhook plugin.
+func New() *WebHook {
+	return &WebHook{}
+}
+
+const pushEventName = "Push Hook"
+
+type commitAuthor struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+type commit struct {
+	ID      string       `json:"id,omitempty"`
+	Message string       `json:"message,omitempty"`
+	Author  commitAuthor `json:"author,omitempty"`
+}
+
+type pushEvent struct {
+	ObjectKind  string   `json:"object_kind,omitempty"`
+	Ref         string   `json:"ref,omitempty"`
+	After       string   `json:"after,omitempty"`
+	CheckoutSHA string   `json:"checkout_sha,omitempty"`
+	Commits     []commit `json:"commits,omitempty"`
+}
+
+// Extract services webhooks from GitLab
+func (p *WebHook) Extract(buildCfg *api.BuildConfig, secret, path string, req *http.Request) (revision *api.SourceRevision, proceed bool, err error) {
+	trigger, ok := webhook.FindTriggerPolicy(api.GitLabWebHookBuildTriggerType, buildCfg)
+	if !ok {
+		err = webhook.ErrHookNotEnabled
+		return
+	}
+	glog.V(4).Infof("Checking if the provided secret for BuildConfig %s/%s matches", buildCfg.Namespace, buildCfg.Name)
+	if trigger.GitLabWebHook.Secret != secret {
+		err = webhook.ErrSecretMismatch
+		return
+	}
+	glog.V(4).Infof("Verifying build request for BuildConfig %s/%s", buildCfg.Namespace, buildCfg.Name)
+	if err = verifyRequest(req); err != nil {
+		return
+	}
+	if method := req.Header.Get("X-Gitlab-Event"); method != pushEventName {
+		err = fmt.Errorf("Unknown X-Gitlab-Event %s", method)
+		return
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return
+	}
+	var event pushEvent
+	if err = json.Unmarshal(body, &event); err != nil {
+		return
+	}
+	// a push deleting the branch has no commit to build
+	if len(event.CheckoutSHA) == 0 {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  No commit to check out for '%s'", buildCfg.Namespace, buildCfg.Name, event.Ref)
+		return
+	}
+	proceed = webhook.GitRefMatches(event.Ref, buildCfg.Spec.Source.Git.Ref)
+	if !proceed {
+		glog.V(2).Infof("Skipping build for BuildConfig %s/%s.  Branch reference from '%s' does not match configuration", buildCfg.Namespace, buildCfg.Name, event.Ref)
+	}
+
+	revision = &api.SourceRevision{
+		Git: &api.GitSourceRevision{
+			Commit: event.CheckoutSHA,
+		},
+	}
+	// GitLab only reports the author of the pushed commits, the committer is
+	// assumed to be the same.
+	for _, c := range event.Commits {
+		if c.ID != event.CheckoutSHA {
+			continue
+		}
+		author := api.SourceControlUser{Name: c.Author.Name, Email: c.Author.Email}
+		revision.Git.Author = author
+		revision.Git.Committer = author
+		revision.Git.Message = c.Message
+	}
+
+	return
+}
+
+func verifyRequest(req *http.Request) error {
+	if method := req.Method; method != "POST" {
+		return fmt.Errorf("Unsupported HTTP method %s", method)
+	}
+	if contentType := req.Header.Get("Content-Type"); contentType != "application/json" {
+		return fmt.Errorf("Unsupported Content-Type %s", contentType)
+	}
+	if len(req.Header.Get("X-Gitlab-Event")) == 0 {
+		return errors.New("Missing X-Gitlab-Event")
+	}
+	return nil
+}
@@ -23,6 +23,7 @@ type Interface interface {
 	ImageStreamMappingsNamespacer
 	ImageStreamTagsNamespacer
 	ImageStreamImagesNamespacer
+	ImageStreamImportsNamespacer
 	DeploymentConfigsNamespacer
 	DeploymentLogsNamespacer
 	RoutesNamespacer
@@ -94,6 +95,11 @@ func (c *Client) ImageStreamImages(namespace string) ImageStreamImageInterface {
 	return newImageStreamImages(c, namespace)
 }
 
+// ImageStreamImports provides a REST client for ImageStreamImport
+func (c *Client) ImageStreamImports(namespace string) ImageStreamImportInterface {
+	return newImageStreamImports(c, namespace)
+}
+
 // DeploymentConfigs provides a REST client for DeploymentConfig
 func (c *Client) DeploymentConfigs(namespace string) DeploymentConfigInterface {
 	return newDeploymentConfigs(c, namespace)
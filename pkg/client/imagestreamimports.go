@@ -0,0 +1,36 @@
+package client
+
+import (
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// ImageStreamImportsNamespacer has methods to work with ImageStreamImport resources in a namespace
+type ImageStreamImportsNamespacer interface {
+	ImageStreamImports(namespace string) ImageStreamImportInterface
+}
+
+// ImageStreamImportInterface exposes methods on ImageStreamImport resources.
+type ImageStreamImportInterface interface {
+	Create(isi *imageapi.ImageStreamImport) (*imageapi.ImageStreamImport, error)
+}
+
+// imageStreamImports implements ImageStreamImportsNamespacer interface
+type imageStreamImports struct {
+	r  *Client
+	ns string
+}
+
+// newImageStreamImports returns an imageStreamImports
+func newImageStreamImports(c *Client, namespace string) *imageStreamImports {
+	return &imageStreamImports{
+		r:  c,
+		ns: namespace,
+	}
+}
+
+// Create imports the images described by isi and returns the result of the import.
+func (c *imageStreamImports) Create(isi *imageapi.ImageStreamImport) (result *imageapi.ImageStreamImport, err error) {
+	result = &imageapi.ImageStreamImport{}
+	err = c.r.Post().Namespace(c.ns).Resource("imageStreamImports").Body(isi).Do().Into(result)
+	return
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/watch"
@@ -22,6 +23,7 @@ type ImageStreamInterface interface {
 	Delete(name string) error
 	Watch(label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error)
 	UpdateStatus(stream *imageapi.ImageStream) (*imageapi.ImageStream, error)
+	Secrets(name string) (*kapi.SecretList, error)
 }
 
 // ImageStreamNamespaceGetter exposes methods to get ImageStreams by Namespace
@@ -108,3 +110,10 @@ func (c *imageStreams) UpdateStatus(stream *imageapi.ImageStream) (result *image
 	err = c.r.Put().Namespace(c.ns).Resource("imageStreams").Name(stream.Name).SubResource("status").Body(stream).Do().Into(result)
 	return
 }
+
+// Secrets returns the secrets which may be used to pull the images of the image stream, and an error, if it occurs.
+func (c *imageStreams) Secrets(name string) (result *kapi.SecretList, err error) {
+	result = &kapi.SecretList{}
+	err = c.r.Get().Namespace(c.ns).Resource("imageStreams").Name(name).SubResource("secrets").Do().Into(result)
+	return
+}
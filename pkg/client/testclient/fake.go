@@ -160,6 +160,11 @@ func (c *Fake) ImageStreamImages(namespace string) client.ImageStreamImageInterf
 	return &FakeImageStreamImages{Fake: c, Namespace: namespace}
 }
 
+// ImageStreamImports provides a fake REST client for ImageStreamImports
+func (c *Fake) ImageStreamImports(namespace string) client.ImageStreamImportInterface {
+	return &FakeImageStreamImports{Fake: c, Namespace: namespace}
+}
+
 // DeploymentConfigs provides a fake REST client for DeploymentConfigs
 func (c *Fake) DeploymentConfigs(namespace string) client.DeploymentConfigInterface {
 	return &FakeDeploymentConfigs{Fake: c, Namespace: namespace}
@@ -0,0 +1,27 @@
+package testclient
+
+import (
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+
+	"github.com/openshift/origin/pkg/client"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// FakeImageStreamImports implements ImageStreamImportInterface. Meant to
+// be embedded into a struct to get a default implementation. This makes faking
+// out just the methods you want to test easier.
+type FakeImageStreamImports struct {
+	Fake      *Fake
+	Namespace string
+}
+
+var _ client.ImageStreamImportInterface = &FakeImageStreamImports{}
+
+func (c *FakeImageStreamImports) Create(inObj *imageapi.ImageStreamImport) (*imageapi.ImageStreamImport, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewCreateAction("imagestreamimports", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*imageapi.ImageStreamImport), err
+}
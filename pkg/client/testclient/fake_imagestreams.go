@@ -1,6 +1,7 @@
 package testclient
 
 import (
+	kapi "k8s.io/kubernetes/pkg/api"
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
@@ -79,3 +80,19 @@ func (c *FakeImageStreams) UpdateStatus(inObj *imageapi.ImageStream) (result *im
 
 	return obj.(*imageapi.ImageStream), err
 }
+
+func (c *FakeImageStreams) Secrets(name string) (*kapi.SecretList, error) {
+	action := ktestclient.GetActionImpl{}
+	action.Verb = "get"
+	action.Namespace = c.Namespace
+	action.Resource = "imagestreams"
+	action.Subresource = "secrets"
+	action.Name = name
+
+	obj, err := c.Fake.Invokes(action, &kapi.SecretList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*kapi.SecretList), err
+}
@@ -52,6 +52,15 @@ func (c *FakeRoutes) Update(inObj *routeapi.Route) (*routeapi.Route, error) {
 	return obj.(*routeapi.Route), err
 }
 
+func (c *FakeRoutes) UpdateStatus(inObj *routeapi.Route) (*routeapi.Route, error) {
+	obj, err := c.Fake.Invokes(ktestclient.NewUpdateSubresourceAction("routes", "status", c.Namespace, inObj), inObj)
+	if obj == nil {
+		return nil, err
+	}
+
+	return obj.(*routeapi.Route), err
+}
+
 func (c *FakeRoutes) Delete(name string) error {
 	_, err := c.Fake.Invokes(ktestclient.NewDeleteAction("routes", c.Namespace, name), &routeapi.Route{})
 	return err
@@ -29,6 +29,7 @@ func NewCommandPrune(name, fullName string, f *clientcmd.Factory, out io.Writer)
 	cmds.AddCommand(NewCmdPruneBuilds(f, fullName, PruneBuildsRecommendedName, out))
 	cmds.AddCommand(NewCmdPruneDeployments(f, fullName, PruneDeploymentsRecommendedName, out))
 	cmds.AddCommand(NewCmdPruneImages(f, fullName, PruneImagesRecommendedName, out))
+	cmds.AddCommand(NewCmdPruneRegistry(f, fullName, PruneRegistryRecommendedName, out))
 	cmds.AddCommand(groups.NewCmdPrune(groups.PruneRecommendedName, fullName+" "+groups.PruneRecommendedName, f, out))
 	return cmds
 }
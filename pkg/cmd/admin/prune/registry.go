@@ -0,0 +1,246 @@
+package prune
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/docker/distribution/registry/api/errcode"
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	"k8s.io/kubernetes/pkg/fields"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/labels"
+
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	"github.com/openshift/origin/pkg/dockerregistry"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+const (
+	registryLongDesc = `%s %s - removes the content of the integrated registry which is not referenced by any image
+
+The registry removes the blobs which are not referenced by any image known to the server, along with the
+repository links to them and the manifest data of deleted images. It keeps serving requests while it
+collects, and keeps the content written during the last hour, which may belong to images being pushed.
+Run '%s images' first to remove the images which are no longer used.`
+
+	// PruneRegistryRecommendedName is the recommended command name
+	PruneRegistryRecommendedName = "registry"
+
+	// registryDialTimeout is how long to wait for the registry to accept a
+	// connection and complete a TLS handshake.
+	registryDialTimeout = 10 * time.Second
+)
+
+// PruneRegistryOptions holds all the required options for prune registry
+type PruneRegistryOptions struct {
+	Out io.Writer
+
+	Confirm bool
+
+	CABundle            string
+	RegistryUrlOverride string
+
+	RegistryClient *http.Client
+	RegistryURL    string
+}
+
+// NewCmdPruneRegistry implements the OpenShift cli prune registry command
+func NewCmdPruneRegistry(f *clientcmd.Factory, parentName, name string, out io.Writer) *cobra.Command {
+	opts := &PruneRegistryOptions{
+		Confirm: false,
+	}
+
+	cmd := &cobra.Command{
+		Use:   name,
+		Short: "Remove unreferenced content from the integrated registry",
+		Long:  fmt.Sprintf(registryLongDesc, parentName, name, parentName),
+
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := opts.Complete(f, args, out); err != nil {
+				cmdutil.CheckErr(err)
+			}
+
+			if err := opts.Validate(); err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(cmd, err.Error()))
+			}
+
+			if err := opts.RunPruneRegistry(); err != nil {
+				cmdutil.CheckErr(err)
+			}
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Confirm, "confirm", opts.Confirm, "Specify that the content should be removed. Defaults to false, displaying what would be removed but not actually removing anything.")
+	cmd.Flags().StringVar(&opts.CABundle, "certificate-authority", opts.CABundle, "The path to a certificate authority bundle to use when communicating with the managed Docker registries. Defaults to the certificate authority data from the current user's config file.")
+	cmd.Flags().StringVar(&opts.RegistryUrlOverride, "registry-url", opts.RegistryUrlOverride, "The address to use when contacting the registry, instead of using the default value. This is useful if you can't resolve or reach the registry (e.g.; the default is a cluster-internal URL) but you do have an alternative route that works.")
+
+	return cmd
+}
+
+// Complete the options for prune registry
+func (o *PruneRegistryOptions) Complete(f *clientcmd.Factory, args []string, out io.Writer) error {
+	if len(args) > 0 {
+		return errors.New("no arguments are allowed to this command")
+	}
+
+	o.Out = out
+
+	osClient, _, registryClient, err := getClients(f, o.CABundle)
+	if err != nil {
+		return err
+	}
+	o.RegistryClient = registryClient
+
+	o.RegistryURL = o.RegistryUrlOverride
+	if len(o.RegistryURL) > 0 {
+		return nil
+	}
+
+	// all the images pushed to the integrated registry have its address
+	images, err := osClient.Images().List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return err
+	}
+	for _, image := range images.Items {
+		if image.Annotations[imageapi.ManagedByOpenShiftAnnotation] != "true" {
+			continue
+		}
+		ref, err := imageapi.ParseDockerImageReference(image.DockerImageReference)
+		if err == nil && len(ref.Registry) > 0 {
+			o.RegistryURL = ref.Registry
+			return nil
+		}
+	}
+	return errors.New("unable to determine the address of the registry, specify it with --registry-url")
+}
+
+// Validate the options for prune registry
+func (o *PruneRegistryOptions) Validate() error {
+	if o.RegistryClient == nil {
+		return errors.New("a registry client needs to be specified")
+	}
+	if len(o.RegistryURL) == 0 {
+		return errors.New("a registry URL needs to be specified")
+	}
+	if o.Out == nil {
+		return errors.New("a writer needs to be specified")
+	}
+	return nil
+}
+
+// RunPruneRegistry runs the prune registry cli command
+func (o *PruneRegistryOptions) RunPruneRegistry() error {
+	if !o.Confirm {
+		fmt.Fprintln(os.Stderr, "Dry run enabled - no modifications will be made. Add --confirm to remove content")
+	}
+
+	report, err := collectRegistryGarbage(o.RegistryClient, o.RegistryURL, !o.Confirm)
+	if err != nil {
+		return fmt.Errorf("error collecting garbage in the registry: %v", err)
+	}
+
+	w := tabwriter.NewWriter(o.Out, 10, 4, 3, ' ', 0)
+	defer w.Flush()
+
+	if len(report.Manifests) > 0 {
+		fmt.Fprintln(w, "\nDeleting registry repository manifest data ...")
+		fmt.Fprintln(w, "REPO\tIMAGE")
+		for _, manifest := range report.Manifests {
+			fmt.Fprintln(w, strings.Replace(manifest, "@", "\t", 1))
+		}
+	}
+	if len(report.LayerLinks) > 0 {
+		fmt.Fprintln(w, "\nDeleting registry repository layer links ...")
+		fmt.Fprintln(w, "REPO\tLAYER")
+		for _, link := range report.LayerLinks {
+			fmt.Fprintln(w, strings.Replace(link, "@", "\t", 1))
+		}
+	}
+	if len(report.Blobs) > 0 {
+		fmt.Fprintln(w, "\nDeleting registry blobs ...")
+		fmt.Fprintln(w, "BLOB")
+		for _, blob := range report.Blobs {
+			fmt.Fprintln(w, blob)
+		}
+	}
+	fmt.Fprintf(w, "\nUnreferenced blobs: %d (%d bytes)\n", len(report.Blobs), report.Size)
+
+	for _, message := range report.Errors {
+		fmt.Fprintf(os.Stderr, "error: %s\n", message)
+	}
+	if len(report.Errors) > 0 {
+		return fmt.Errorf("the registry was unable to remove some content")
+	}
+	return nil
+}
+
+// collectRegistryGarbage asks the registry at registryURL to collect garbage,
+// and returns its report. The request is sent over https, unless the registry
+// does not complete a TLS handshake, in which case it is sent over http. The
+// request is never retried, since the collection may have started.
+func collectRegistryGarbage(registryClient *http.Client, registryURL string, dryRun bool) (*dockerregistry.GarbageCollectionReport, error) {
+	proto := "https"
+	secure, err := speaksTLS(registryURL)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to the registry: %v", err)
+	}
+	if !secure {
+		glog.V(4).Infof("The registry at %s does not accept TLS connections, using http", registryURL)
+		proto = "http"
+	}
+
+	url := fmt.Sprintf("%s://%s/admin/garbagecollect?dryRun=%t", proto, registryURL, dryRun)
+	glog.V(4).Infof("Sending %s", url)
+	resp, err := registryClient.Post(url, "application/json", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		glog.V(1).Infof("Unexpected status code in response: %d", resp.StatusCode)
+		var response errcode.Errors
+		json.NewDecoder(resp.Body).Decode(&response)
+		return nil, &response
+	}
+
+	report := &dockerregistry.GarbageCollectionReport{}
+	if err := json.NewDecoder(resp.Body).Decode(report); err != nil {
+		return nil, fmt.Errorf("error reading the report: %v", err)
+	}
+	return report, nil
+}
+
+// speaksTLS returns true if the registry at registryURL completes a TLS
+// handshake. The certificate of the registry is not verified here, it is
+// verified by the request sent to the registry. An error is returned if the
+// registry can't be reached at all.
+func speaksTLS(registryURL string) (bool, error) {
+	address := registryURL
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, "443")
+	}
+	conn, err := net.DialTimeout("tcp", address, registryDialTimeout)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+
+	tlsConn := tls.Client(conn, &tls.Config{InsecureSkipVerify: true})
+	tlsConn.SetDeadline(time.Now().Add(registryDialTimeout))
+	if err := tlsConn.Handshake(); err != nil {
+		glog.V(4).Infof("TLS handshake with %s failed: %v", address, err)
+		return false, nil
+	}
+	return true, nil
+}
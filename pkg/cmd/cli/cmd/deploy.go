@@ -41,6 +41,8 @@ type DeployOptions struct {
 	retryDeploy          bool
 	cancelDeploy         bool
 	enableTriggers       bool
+	pauseDeploy          bool
+	resumeDeploy         bool
 }
 
 const (
@@ -67,6 +69,10 @@ never successfully complete - in which case you can use the '--latest' flag to f
 When rolling back to a previous deployment, a new deployment will be created with an identical copy
 of your config at the latest position.
 
+While you make several changes to a deployment config, you can pause it with the '--pause' flag
+to prevent its triggers from starting a deployment for each change. Resuming the config with the
+'--resume' flag starts a single deployment with all the changes made in the meantime.
+
 If no options are given, shows information about the latest deployment.`
 
 	deployExample = `  # Display the latest deployment for the 'database' deployment config
@@ -80,7 +86,11 @@ If no options are given, shows information about the latest deployment.`
   $ %[1]s deploy frontend --retry
 
   # Cancel the in-progress deployment based on 'frontend'
-  $ %[1]s deploy frontend --cancel`
+  $ %[1]s deploy frontend --cancel
+
+  # Pause the triggers of 'frontend' while changing it, then deploy the changes at once
+  $ %[1]s deploy frontend --pause
+  $ %[1]s deploy frontend --resume`
 )
 
 // NewCmdDeploy creates a new `deploy` command.
@@ -90,7 +100,7 @@ func NewCmdDeploy(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.C
 	}
 
 	cmd := &cobra.Command{
-		Use:        "deploy DEPLOYMENTCONFIG [--latest|--retry|--cancel|--enable-triggers]",
+		Use:        "deploy DEPLOYMENTCONFIG [--latest|--retry|--cancel|--enable-triggers|--pause|--resume]",
 		Short:      "View, start, cancel, or retry a deployment",
 		Long:       deployLong,
 		Example:    fmt.Sprintf(deployExample, fullName),
@@ -114,6 +124,8 @@ func NewCmdDeploy(fullName string, f *clientcmd.Factory, out io.Writer) *cobra.C
 	cmd.Flags().BoolVar(&options.retryDeploy, "retry", false, "Retry the latest failed deployment.")
 	cmd.Flags().BoolVar(&options.cancelDeploy, "cancel", false, "Cancel the in-progress deployment.")
 	cmd.Flags().BoolVar(&options.enableTriggers, "enable-triggers", false, "Enables all image triggers for the deployment config.")
+	cmd.Flags().BoolVar(&options.pauseDeploy, "pause", false, "Pause the deployment config; triggers will not start new deployments.")
+	cmd.Flags().BoolVar(&options.resumeDeploy, "resume", false, "Resume a paused deployment config, deploying the changes made while it was paused.")
 
 	return cmd
 }
@@ -162,8 +174,14 @@ func (o DeployOptions) Validate() error {
 	if o.enableTriggers {
 		numOptions++
 	}
+	if o.pauseDeploy {
+		numOptions++
+	}
+	if o.resumeDeploy {
+		numOptions++
+	}
 	if numOptions > 1 {
-		return errors.New("only one of --latest, --retry, --cancel, or --enable-triggers is allowed.")
+		return errors.New("only one of --latest, --retry, --cancel, --enable-triggers, --pause, or --resume is allowed.")
 	}
 	return nil
 }
@@ -192,6 +210,10 @@ func (o DeployOptions) RunDeploy() error {
 		err = o.cancel(config, o.out)
 	case o.enableTriggers:
 		err = o.reenableTriggers(config, o.out)
+	case o.pauseDeploy:
+		err = SetPaused(o.osClient, config.Namespace, config.Name, true, o.out)
+	case o.resumeDeploy:
+		err = SetPaused(o.osClient, config.Namespace, config.Name, false, o.out)
 	default:
 		describer := describe.NewLatestDeploymentsDescriber(o.osClient, o.kubeClient, -1)
 		desc, err := describer.Describe(config.Namespace, config.Name)
@@ -207,6 +229,9 @@ func (o DeployOptions) RunDeploy() error {
 // deploy launches a new deployment unless there's already a deployment
 // process in progress for config.
 func (o DeployOptions) deploy(config *deployapi.DeploymentConfig, out io.Writer) error {
+	if config.Spec.Paused {
+		return fmt.Errorf("%s is paused.\nYou can resume it, deploying all its changes, using the --resume option.", config.Name)
+	}
 	deploymentName := deployutil.LatestDeploymentNameForConfig(config)
 	deployment, err := o.kubeClient.ReplicationControllers(config.Namespace).Get(deploymentName)
 	if err == nil {
@@ -349,3 +374,42 @@ func (o DeployOptions) reenableTriggers(config *deployapi.DeploymentConfig, out
 	fmt.Fprintf(out, "Enabled image triggers: %s\n", strings.Join(enabled, ","))
 	return nil
 }
+
+// SetPaused pauses or resumes the deployment config name in namespace and
+// writes the outcome to out. While a config is paused, its triggers don't
+// start new deployments; once it is resumed, they start a single deployment
+// for all the changes made in the meantime. The latest copy of the config is
+// updated, retrying on conflicts.
+func SetPaused(oc client.Interface, namespace, name string, paused bool, out io.Writer) error {
+	changed := false
+	err := kclient.RetryOnConflict(kclient.DefaultRetry, func() error {
+		config, err := oc.DeploymentConfigs(namespace).Get(name)
+		if err != nil {
+			return err
+		}
+		if config.Spec.Paused == paused {
+			return nil
+		}
+		config.Spec.Paused = paused
+		if _, err := oc.DeploymentConfigs(namespace).Update(config); err != nil {
+			return err
+		}
+		changed = true
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case paused && changed:
+		fmt.Fprintf(out, "Paused %s; triggers will not start new deployments until it is resumed\n", name)
+	case paused:
+		fmt.Fprintf(out, "%s is already paused\n", name)
+	case changed:
+		fmt.Fprintf(out, "Resumed %s\n", name)
+	default:
+		fmt.Fprintf(out, "%s is not paused\n", name)
+	}
+	return nil
+}
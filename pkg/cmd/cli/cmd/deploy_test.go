@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"reflect"
@@ -317,3 +318,67 @@ func TestDeploy_reenableTriggers(t *testing.T) {
 		}
 	}
 }
+
+// TestCmdDeploy_latestPausedRejection ensures that attempts to start a new
+// deployment for a paused config are rejected.
+func TestCmdDeploy_latestPausedRejection(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Paused = true
+	kubeClient := ktc.NewSimpleFake(deploymentFor(config, deployapi.DeploymentStatusComplete))
+	o := &DeployOptions{osClient: &tc.Fake{}, kubeClient: kubeClient}
+
+	err := o.deploy(config, ioutil.Discard)
+	if err == nil {
+		t.Fatalf("expected an error starting a deployment for a paused config")
+	}
+	if len(kubeClient.Actions()) != 0 {
+		t.Errorf("unexpected actions: %v", kubeClient.Actions())
+	}
+}
+
+func TestDeploy_pauseAndResume(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	var updated *deployapi.DeploymentConfig
+	conflicts := 1
+
+	osClient := &tc.Fake{}
+	osClient.AddReactor("get", "deploymentconfigs", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		copied := *config
+		return true, &copied, nil
+	})
+	osClient.AddReactor("update", "deploymentconfigs", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		// The config is changed by someone else before the first update.
+		if conflicts > 0 {
+			conflicts--
+			return true, nil, kerrors.NewConflict("DeploymentConfig", config.Name, errors.New("changed"))
+		}
+		updated = action.(ktc.UpdateAction).GetObject().(*deployapi.DeploymentConfig)
+		config = updated
+		return true, updated, nil
+	})
+
+	if err := SetPaused(osClient, config.Namespace, config.Name, true, ioutil.Discard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated == nil || !updated.Spec.Paused {
+		t.Fatalf("expected a paused config, got %#v", updated)
+	}
+
+	updated = nil
+	if err := SetPaused(osClient, config.Namespace, config.Name, true, ioutil.Discard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated != nil {
+		t.Fatalf("unexpected update of an already paused config")
+	}
+
+	if err := SetPaused(osClient, config.Namespace, config.Name, false, ioutil.Discard); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated == nil || updated.Spec.Paused {
+		t.Fatalf("expected a resumed config, got %#v", updated)
+	}
+	if e, a := 1, updated.Status.LatestVersion; e != a {
+		t.Errorf("expected resuming to leave the deployment to the triggers, got latestVersion=%d", a)
+	}
+}
@@ -3,10 +3,14 @@ package cmd
 import (
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/golang/glog"
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/fields"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/labels"
@@ -25,7 +29,8 @@ const (
 Import tag and image information from an external Docker image repository
 
 Only image streams that have a value set for spec.dockerImageRepository and/or
-spec.Tags may have tag and image information imported.`
+spec.Tags may have tag and image information imported. The images are imported
+by the server before the command returns.`
 
 	importImageExample = `  $ %[1]s import-image mystream`
 )
@@ -70,6 +75,7 @@ func RunImportImage(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, arg
 	confirm := cmdutil.GetFlagBool(cmd, "confirm")
 
 	imageStreamClient := osClient.ImageStreams(namespace)
+	changed := false
 	stream, err := imageStreamClient.Get(streamName)
 	if err != nil {
 		if len(from) == 0 || !errors.IsNotFound(err) {
@@ -92,28 +98,72 @@ func RunImportImage(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, arg
 					return fmt.Errorf("the image stream has a different import spec %q, pass --confirm to update", stream.Spec.DockerImageRepository)
 				}
 				stream.Spec.DockerImageRepository = from
+				changed = true
 			}
 		}
 	}
 
-	if stream.Annotations != nil {
-		delete(stream.Annotations, imageapi.DockerImageRepositoryCheckAnnotation)
-	}
-
 	if stream.CreationTimestamp.IsZero() {
 		stream, err = imageStreamClient.Create(stream)
-	} else {
+	} else if changed {
 		stream, err = imageStreamClient.Update(stream)
 	}
 	if err != nil {
 		return err
 	}
 
-	resourceVersion := stream.ResourceVersion
+	isi := importRequestForStream(stream)
+	if isi.Spec.Repository == nil && len(isi.Spec.Images) == 0 {
+		return fmt.Errorf("image stream has not defined anything to import")
+	}
+	result, err := osClient.ImageStreamImports(namespace).Create(isi)
+	if err != nil {
+		if !errors.IsNotFound(err) && !errors.IsForbidden(err) {
+			return err
+		}
+		// the server can't import images itself, so let the import controller
+		// import the stream
+		glog.V(4).Infof("the server can't import images, waiting for the import controller: %v", err)
+		return importWithController(osClient, out, cmd, stream)
+	}
+
+	failures := importFailures(result)
+	if len(failures) == 0 {
+		fmt.Fprint(cmd.Out(), "The import completed successfully.", "\n\n")
+	}
+	if status := result.Status.Repository; status != nil && len(status.AdditionalTags) > 0 {
+		fmt.Fprintf(cmd.Out(), "Only the first %d tags of the repository were imported, the remaining tags will be imported in the background: %s\n\n", len(status.Images), strings.Join(status.AdditionalTags, ", "))
+	}
+
+	d := describe.ImageStreamDescriber{Interface: osClient}
+	info, err := d.Describe(namespace, stream.Name)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, info)
+
+	if len(failures) > 0 {
+		return fmt.Errorf("unable to import some images:\n%s", strings.Join(failures, "\n"))
+	}
+	return nil
+}
+
+// importWithController asks the import controller to import stream and waits
+// for the import to complete. It is used with servers which can't import images
+// themselves.
+func importWithController(osClient client.Interface, out io.Writer, cmd *cobra.Command, stream *imageapi.ImageStream) error {
+	imageStreamClient := osClient.ImageStreams(stream.Namespace)
+	if stream.Annotations != nil {
+		delete(stream.Annotations, imageapi.DockerImageRepositoryCheckAnnotation)
+	}
+	stream, err := imageStreamClient.Update(stream)
+	if err != nil {
+		return err
+	}
 
 	fmt.Fprintln(cmd.Out(), "Waiting for the import to complete, CTRL+C to stop waiting.")
 
-	updatedStream, err := waitForImport(imageStreamClient, stream.Name, resourceVersion)
+	updatedStream, err := waitForImport(imageStreamClient, stream.Name, stream.ResourceVersion)
 	if err != nil {
 		if _, ok := err.(importError); ok {
 			return err
@@ -128,7 +178,6 @@ func RunImportImage(f *clientcmd.Factory, out io.Writer, cmd *cobra.Command, arg
 	if err != nil {
 		return err
 	}
-
 	fmt.Fprintln(out, info)
 	return nil
 }
@@ -180,3 +229,65 @@ func waitForImport(imageStreamClient client.ImageStreamInterface, name, resource
 		}
 	}
 }
+
+// importRequestForStream returns a request to import the Docker repository and
+// the tags of stream which track Docker images.
+func importRequestForStream(stream *imageapi.ImageStream) *imageapi.ImageStreamImport {
+	isi := &imageapi.ImageStreamImport{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      stream.Name,
+			Namespace: stream.Namespace,
+		},
+		Spec: imageapi.ImageStreamImportSpec{Import: true},
+	}
+	insecure := stream.Annotations[imageapi.InsecureRepositoryAnnotation] == "true"
+
+	if len(stream.Spec.DockerImageRepository) > 0 {
+		isi.Spec.Repository = &imageapi.RepositoryImportSpec{
+			From:         kapi.ObjectReference{Kind: "DockerImage", Name: stream.Spec.DockerImageRepository},
+			ImportPolicy: imageapi.TagImportPolicy{Insecure: insecure},
+		}
+	}
+
+	tags := []string{}
+	for tag := range stream.Spec.Tags {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	for _, tag := range tags {
+		tagRef := stream.Spec.Tags[tag]
+		if tagRef.From == nil || tagRef.From.Kind != "DockerImage" || tagRef.Reference {
+			continue
+		}
+		policy := tagRef.ImportPolicy
+		policy.Insecure = policy.Insecure || insecure
+		isi.Spec.Images = append(isi.Spec.Images, imageapi.ImageImportSpec{
+			From:         kapi.ObjectReference{Kind: "DockerImage", Name: tagRef.From.Name},
+			To:           &kapi.LocalObjectReference{Name: tag},
+			ImportPolicy: policy,
+		})
+	}
+	return isi
+}
+
+// importFailures returns a description of each image in the result of an
+// import which could not be imported.
+func importFailures(isi *imageapi.ImageStreamImport) []string {
+	failures := []string{}
+	if status := isi.Status.Repository; status != nil {
+		if status.Status.Status != unversioned.StatusSuccess {
+			failures = append(failures, fmt.Sprintf("  %s: %s", isi.Spec.Repository.From.Name, status.Status.Message))
+		}
+		for _, image := range status.Images {
+			if image.Status.Status != unversioned.StatusSuccess {
+				failures = append(failures, fmt.Sprintf("  %s:%s: %s", isi.Spec.Repository.From.Name, image.Tag, image.Status.Message))
+			}
+		}
+	}
+	for i, image := range isi.Status.Images {
+		if image.Status.Status != unversioned.StatusSuccess && i < len(isi.Spec.Images) {
+			failures = append(failures, fmt.Sprintf("  %s: %s", isi.Spec.Images[i].From.Name, image.Status.Message))
+		}
+	}
+	return failures
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func TestImportRequestForStream(t *testing.T) {
+	stream := &imageapi.ImageStream{
+		ObjectMeta: api.ObjectMeta{
+			Name:        "ruby",
+			Namespace:   "test",
+			Annotations: map[string]string{imageapi.InsecureRepositoryAnnotation: "true"},
+		},
+		Spec: imageapi.ImageStreamSpec{
+			DockerImageRepository: "openshift/ruby-20-centos7",
+			Tags: map[string]imageapi.TagReference{
+				"v2":        {From: &api.ObjectReference{Kind: "DockerImage", Name: "openshift/ruby-22-centos7:latest"}, ImportPolicy: imageapi.TagImportPolicy{Scheduled: true}},
+				"v1":        {From: &api.ObjectReference{Kind: "DockerImage", Name: "openshift/ruby-20-centos7:latest"}},
+				"reference": {From: &api.ObjectReference{Kind: "DockerImage", Name: "openshift/ruby-20-centos7:latest"}, Reference: true},
+				"tracking":  {From: &api.ObjectReference{Kind: "ImageStreamTag", Name: "ruby:v1"}},
+			},
+		},
+	}
+
+	isi := importRequestForStream(stream)
+	if isi.Name != "ruby" || isi.Namespace != "test" || !isi.Spec.Import {
+		t.Errorf("unexpected request: %#v", isi)
+	}
+	expectedRepository := &imageapi.RepositoryImportSpec{
+		From:         api.ObjectReference{Kind: "DockerImage", Name: "openshift/ruby-20-centos7"},
+		ImportPolicy: imageapi.TagImportPolicy{Insecure: true},
+	}
+	if !reflect.DeepEqual(expectedRepository, isi.Spec.Repository) {
+		t.Errorf("unexpected repository: %#v", isi.Spec.Repository)
+	}
+	expectedImages := []imageapi.ImageImportSpec{
+		{
+			From:         api.ObjectReference{Kind: "DockerImage", Name: "openshift/ruby-20-centos7:latest"},
+			To:           &api.LocalObjectReference{Name: "v1"},
+			ImportPolicy: imageapi.TagImportPolicy{Insecure: true},
+		},
+		{
+			From:         api.ObjectReference{Kind: "DockerImage", Name: "openshift/ruby-22-centos7:latest"},
+			To:           &api.LocalObjectReference{Name: "v2"},
+			ImportPolicy: imageapi.TagImportPolicy{Insecure: true, Scheduled: true},
+		},
+	}
+	if !reflect.DeepEqual(expectedImages, isi.Spec.Images) {
+		t.Errorf("unexpected images: %#v", isi.Spec.Images)
+	}
+}
+
+func TestImportFailures(t *testing.T) {
+	isi := &imageapi.ImageStreamImport{
+		Spec: imageapi.ImageStreamImportSpec{
+			Repository: &imageapi.RepositoryImportSpec{From: api.ObjectReference{Kind: "DockerImage", Name: "openshift/ruby-20-centos7"}},
+			Images: []imageapi.ImageImportSpec{
+				{From: api.ObjectReference{Kind: "DockerImage", Name: "openshift/ruby-22-centos7:latest"}},
+				{From: api.ObjectReference{Kind: "DockerImage", Name: "openshift/ruby-22-centos7:missing"}},
+			},
+		},
+		Status: imageapi.ImageStreamImportStatus{
+			Repository: &imageapi.RepositoryImportStatus{
+				Status: unversioned.Status{Status: unversioned.StatusSuccess},
+				Images: []imageapi.ImageImportStatus{
+					{Status: unversioned.Status{Status: unversioned.StatusSuccess}, Tag: "latest"},
+					{Status: unversioned.Status{Status: unversioned.StatusFailure, Message: "internal error"}, Tag: "broken"},
+				},
+			},
+			Images: []imageapi.ImageImportStatus{
+				{Status: unversioned.Status{Status: unversioned.StatusSuccess}},
+				{Status: unversioned.Status{Status: unversioned.StatusFailure, Message: "not found"}},
+			},
+		},
+	}
+
+	expected := []string{
+		"  openshift/ruby-20-centos7:broken: internal error",
+		"  openshift/ruby-22-centos7:missing: not found",
+	}
+	if failures := importFailures(isi); !reflect.DeepEqual(expected, failures) {
+		t.Errorf("unexpected failures: %#v", failures)
+	}
+}
@@ -3,7 +3,6 @@ package cmd
 import (
 	"fmt"
 	"io"
-	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -308,30 +307,25 @@ func (o *RollbackOptions) findResource(targetName string) (runtime.Object, error
 // is <=0, the last completed deployment which is older than the config's
 // version will be returned.
 func (o *RollbackOptions) findTargetDeployment(config *deployapi.DeploymentConfig, desiredVersion int) (*kapi.ReplicationController, error) {
-	// Find deployments for the config sorted by version descending.
+	// Find deployments for the config.
 	deployments, err := o.kc.ReplicationControllers(config.Namespace).List(deployutil.ConfigSelector(config.Name), fields.Everything())
 	if err != nil {
 		return nil, err
 	}
-	sort.Sort(deployutil.ByLatestVersionDesc(deployments.Items))
 
 	// Find the target deployment for rollback. If a version was specified,
 	// use the version for a search. Otherwise, use the last completed
 	// deployment.
 	var target *kapi.ReplicationController
-	for _, deployment := range deployments.Items {
-		version := deployutil.DeploymentVersionFor(&deployment)
-		if desiredVersion > 0 {
-			if version == desiredVersion {
-				target = &deployment
-				break
-			}
-		} else {
-			if version < config.Status.LatestVersion && deployutil.DeploymentStatusFor(&deployment) == deployapi.DeploymentStatusComplete {
-				target = &deployment
+	if desiredVersion > 0 {
+		for i := range deployments.Items {
+			if deployutil.DeploymentVersionFor(&deployments.Items[i]) == desiredVersion {
+				target = &deployments.Items[i]
 				break
 			}
 		}
+	} else {
+		target = deployutil.RollbackTargetDeployment(config, deployments)
 	}
 	if target == nil {
 		return nil, fmt.Errorf("couldn't find deployment for rollback")
@@ -135,13 +135,23 @@ func (d *DeploymentConfigDescriber) Describe(namespace, name string) (string, er
 		}
 
 		printTriggers(deploymentConfig.Spec.Triggers, out)
+		if deploymentConfig.Spec.Paused {
+			formatString(out, "Paused", "yes; triggers will not start new deployments")
+		}
+		if limit := deploymentConfig.Spec.RevisionHistoryLimit; limit != nil {
+			formatString(out, "Revision History Limit", strconv.Itoa(*limit))
+		}
 
 		formatString(out, "Strategy", deploymentConfig.Spec.Strategy.Type)
 		printStrategy(deploymentConfig.Spec.Strategy, out)
+		if len(deploymentConfig.Status.LiveDeployment) > 0 {
+			formatString(out, "Live", fmt.Sprintf("%s (%s)", deploymentConfig.Status.LiveDeployment, deploymentConfig.Status.LiveColor))
+		}
 		printDeploymentConfigSpec(deploymentConfig.Spec, out)
 		if deploymentConfig.Status.Details != nil && len(deploymentConfig.Status.Details.Message) > 0 {
 			fmt.Fprintf(out, "Warning:\t%s\n", deploymentConfig.Status.Details.Message)
 		}
+		printDeploymentConfigStatus(deploymentConfig.Status, out)
 		deploymentName := deployutil.LatestDeploymentNameForConfig(deploymentConfig)
 		deployment, err := d.client.getDeployment(namespace, deploymentName)
 		if err != nil {
@@ -174,15 +184,33 @@ func (d *DeploymentConfigDescriber) Describe(namespace, name string) (string, er
 	})
 }
 
+func printDeploymentConfigStatus(status deployapi.DeploymentConfigStatus, w *tabwriter.Writer) {
+	if status.ObservedGeneration == 0 {
+		return
+	}
+	fmt.Fprintf(w, "Replicas:\t%d updated, %d ready, %d available\n", status.UpdatedReplicas, status.ReadyReplicas, status.AvailableReplicas)
+	if len(status.Conditions) == 0 {
+		return
+	}
+	fmt.Fprint(w, "Conditions:\n  Type\tStatus\tReason\tMessage\n")
+	for _, condition := range status.Conditions {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", condition.Type, condition.Status, condition.Reason, condition.Message)
+	}
+}
+
 func printStrategy(strategy deployapi.DeploymentStrategy, w *tabwriter.Writer) {
 	switch strategy.Type {
 	case deployapi.DeploymentStrategyTypeRecreate:
 		if strategy.RecreateParams != nil {
 			pre := strategy.RecreateParams.Pre
+			mid := strategy.RecreateParams.Mid
 			post := strategy.RecreateParams.Post
 			if pre != nil {
 				printHook("Pre-deployment", pre, w)
 			}
+			if mid != nil {
+				printHook("Mid-deployment", mid, w)
+			}
 			if post != nil {
 				printHook("Post-deployment", post, w)
 			}
@@ -191,6 +219,46 @@ func printStrategy(strategy deployapi.DeploymentStrategy, w *tabwriter.Writer) {
 		if strategy.RollingParams != nil {
 			pre := strategy.RollingParams.Pre
 			post := strategy.RollingParams.Post
+			if deadline := strategy.RollingParams.ProgressDeadlineSeconds; deadline != nil {
+				fmt.Fprintf(w, "\t  Progress Deadline:\t%ds\n", *deadline)
+				fmt.Fprintf(w, "\t  Auto Rollback:\t%t\n", strategy.RollingParams.AutoRollback)
+			}
+			if pre != nil {
+				printHook("Pre-deployment", pre, w)
+			}
+			if post != nil {
+				printHook("Post-deployment", post, w)
+			}
+		}
+	case deployapi.DeploymentStrategyTypeCanary:
+		if strategy.CanaryParams != nil {
+			pre := strategy.CanaryParams.Pre
+			post := strategy.CanaryParams.Post
+			if pre != nil {
+				printHook("Pre-deployment", pre, w)
+			}
+			for i, step := range strategy.CanaryParams.Steps {
+				fmt.Fprintf(w, "\t  Step %d:\t%s replicas", i+1, step.Replicas.String())
+				if step.PauseSeconds > 0 {
+					fmt.Fprintf(w, ", pause %ds", step.PauseSeconds)
+				}
+				fmt.Fprintf(w, "\n")
+				if step.Verify != nil {
+					printHook(fmt.Sprintf("Step %d verify", i+1), step.Verify, w)
+				}
+			}
+			if post != nil {
+				printHook("Post-deployment", post, w)
+			}
+		}
+	case deployapi.DeploymentStrategyTypeBlueGreen:
+		if strategy.BlueGreenParams != nil {
+			pre := strategy.BlueGreenParams.Pre
+			post := strategy.BlueGreenParams.Post
+			fmt.Fprintf(w, "\t  Service:\t%s\n", strategy.BlueGreenParams.ServiceName)
+			if keepWarm := strategy.BlueGreenParams.KeepWarmSeconds; keepWarm > 0 {
+				fmt.Fprintf(w, "\t  Keep Previous Warm:\t%ds\n", keepWarm)
+			}
 			if pre != nil {
 				printHook("Pre-deployment", pre, w)
 			}
@@ -218,6 +286,16 @@ func printHook(prefix string, hook *deployapi.LifecycleHook, w io.Writer) {
 		fmt.Fprintf(w, "\t    Command:\t%v\n", strings.Join(hook.ExecNewPod.Command, " "))
 		fmt.Fprintf(w, "\t    Env:\t%s\n", formatLabels(convertEnv(hook.ExecNewPod.Env)))
 	}
+	if len(hook.TagImages) > 0 {
+		fmt.Fprintf(w, "\t  %s hook (tag images, failure policy: %s):\n", prefix, hook.FailurePolicy)
+		for _, image := range hook.TagImages {
+			to := image.To.Name
+			if len(image.To.Namespace) > 0 {
+				to = image.To.Namespace + "/" + to
+			}
+			fmt.Fprintf(w, "\t    Tag:\tcontainer %s to %s %s\n", image.ContainerName, image.To.Kind, to)
+		}
+	}
 }
 
 func printTriggers(triggers []deployapi.DeploymentTriggerPolicy, w *tabwriter.Writer) {
@@ -237,6 +315,8 @@ func printTriggers(triggers []deployapi.DeploymentTriggerPolicy, w *tabwriter.Wr
 				name, tag, _ := imageapi.SplitImageStreamTag(t.ImageChangeParams.From.Name)
 				labels = append(labels, fmt.Sprintf("Image(%s@%s, auto=%v)", name, tag, t.ImageChangeParams.Automatic))
 			}
+		case deployapi.DeploymentTriggerOnSecretChange:
+			labels = append(labels, "Secret")
 		}
 	}
 
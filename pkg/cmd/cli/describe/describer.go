@@ -30,6 +30,7 @@ import (
 	"github.com/openshift/origin/pkg/client"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 	projectapi "github.com/openshift/origin/pkg/project/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
 	templateapi "github.com/openshift/origin/pkg/template/api"
 )
 
@@ -134,7 +135,13 @@ func (d *BuildDescriber) Describe(namespace, name string) (string, error) {
 		// Create the time object with second-level precision so we don't get
 		// output like "duration: 1.2724395728934s"
 		formatString(out, "Duration", describeBuildDuration(build))
-		formatString(out, "Build Pod", buildutil.GetBuildPodName(build))
+		if build.Spec.Strategy.JenkinsPipelineStrategy != nil {
+			if uri, ok := build.Annotations[buildapi.BuildJenkinsBuildURIAnnotation]; ok {
+				formatString(out, "Jenkins Build", uri)
+			}
+		} else {
+			formatString(out, "Build Pod", buildutil.GetBuildPodName(build))
+		}
 		describeBuildSpec(build.Spec, out)
 		status := bold(build.Status.Phase)
 		if build.Status.Message != "" {
@@ -207,6 +214,16 @@ func describeBuildSpec(p buildapi.BuildSpec, out *tabwriter.Writer) {
 			formatString(out, "Message", rev.Message)
 		}
 	}
+	for _, image := range p.Source.Images {
+		formatString(out, "Image Source", fmt.Sprintf("copies %d paths from %s %s", len(image.Paths), image.From.Kind, image.From.Name))
+	}
+	for _, s := range p.Source.Secrets {
+		dest := s.DestinationDir
+		if len(dest) == 0 {
+			dest = "."
+		}
+		formatString(out, "Build Secret", fmt.Sprintf("%s -> %s", s.Secret.Name, dest))
+	}
 	if p.Source.Binary != nil {
 		if len(p.Source.Binary.AsFile) > 0 {
 			formatString(out, "Binary", fmt.Sprintf("provided as file %q on build", p.Source.Binary.AsFile))
@@ -222,6 +239,8 @@ func describeBuildSpec(p buildapi.BuildSpec, out *tabwriter.Writer) {
 		describeSourceStrategy(p.Strategy.SourceStrategy, out)
 	case p.Strategy.CustomStrategy != nil:
 		describeCustomStrategy(p.Strategy.CustomStrategy, out)
+	case p.Strategy.JenkinsPipelineStrategy != nil:
+		describeJenkinsPipelineStrategy(p.Strategy.JenkinsPipelineStrategy, out)
 	}
 
 	if p.Output.To != nil {
@@ -294,6 +313,21 @@ func describeDockerStrategy(s *buildapi.DockerBuildStrategy, out *tabwriter.Writ
 	if s.ForcePull {
 		formatString(out, "Force Pull", "true")
 	}
+	if s.BuildCache != nil {
+		formatString(out, "Build Cache", fmt.Sprintf("%s (claim %s)", strings.Join(s.BuildCache.Paths, ", "), s.BuildCache.PersistentVolumeClaim.Name))
+	}
+}
+
+func describeJenkinsPipelineStrategy(s *buildapi.JenkinsPipelineBuildStrategy, out *tabwriter.Writer) {
+	if len(s.JenkinsfilePath) != 0 {
+		formatString(out, "Jenkinsfile path", s.JenkinsfilePath)
+	}
+	if len(s.Jenkinsfile) != 0 {
+		fmt.Fprintf(out, "Jenkinsfile contents:\n")
+		for _, line := range strings.Split(s.Jenkinsfile, "\n") {
+			fmt.Fprintf(out, "  %s\n", line)
+		}
+	}
 }
 
 func describeCustomStrategy(s *buildapi.CustomBuildStrategy, out *tabwriter.Writer) {
@@ -342,7 +376,7 @@ func describeBuildTriggers(triggers []buildapi.BuildTriggerPolicy, w *tabwriter.
 
 	for _, t := range triggers {
 		switch t.Type {
-		case buildapi.GitHubWebHookBuildTriggerType, buildapi.GenericWebHookBuildTriggerType:
+		case buildapi.GitHubWebHookBuildTriggerType, buildapi.GenericWebHookBuildTriggerType, buildapi.GitLabWebHookBuildTriggerType, buildapi.BitbucketWebHookBuildTriggerType:
 			continue
 		case buildapi.ConfigChangeBuildTriggerType:
 			labels = append(labels, "Config")
@@ -383,6 +417,18 @@ func (d *BuildConfigDescriber) Describe(namespace, name string) (string, error)
 		} else {
 			formatString(out, "Latest Version", strconv.Itoa(buildConfig.Status.LastVersion))
 		}
+		if len(buildConfig.Spec.RunPolicy) > 0 {
+			formatString(out, "Run Policy", buildConfig.Spec.RunPolicy)
+		}
+		if buildConfig.Spec.SuccessfulBuildsHistoryLimit != nil {
+			formatString(out, "Successful Builds History Limit", *buildConfig.Spec.SuccessfulBuildsHistoryLimit)
+		}
+		if buildConfig.Spec.FailedBuildsHistoryLimit != nil {
+			formatString(out, "Failed Builds History Limit", *buildConfig.Spec.FailedBuildsHistoryLimit)
+		}
+		if status := buildConfig.Spec.CommitStatus; status != nil {
+			formatString(out, "Commit Status", fmt.Sprintf("%s (secret %s)", status.Type, status.Secret.Name))
+		}
 		describeBuildSpec(buildConfig.Spec.BuildSpec, out)
 		d.DescribeTriggers(buildConfig, out)
 		if len(buildList.Items) == 0 {
@@ -559,6 +605,9 @@ func (d *RouteDescriber) Describe(namespace, name string) (string, error) {
 	return tabbedString(func(out *tabwriter.Writer) error {
 		formatMeta(out, route.ObjectMeta)
 		formatString(out, "Host", route.Spec.Host)
+		for _, ingress := range route.Status.Ingress {
+			describeRouteIngress(out, ingress)
+		}
 		formatString(out, "Path", route.Spec.Path)
 		formatString(out, "Service", route.Spec.To.Name)
 
@@ -574,6 +623,32 @@ func (d *RouteDescriber) Describe(namespace, name string) (string, error) {
 	})
 }
 
+// describeRouteIngress writes whether the router of ingress admitted the route.
+func describeRouteIngress(out *tabwriter.Writer, ingress routeapi.RouteIngress) {
+	host := ""
+	if len(ingress.Host) > 0 {
+		host = fmt.Sprintf(" as %s", ingress.Host)
+	}
+	for _, condition := range ingress.Conditions {
+		if condition.Type != routeapi.RouteAdmitted {
+			continue
+		}
+		switch condition.Status {
+		case kapi.ConditionTrue:
+			fmt.Fprintf(out, "\t  exposed on router %s%s %s ago\n", ingress.RouterName, host, formatRelativeTime(condition.LastTransitionTime.Time))
+		case kapi.ConditionFalse:
+			fmt.Fprintf(out, "\t  rejected by router %s: %s (%s ago)\n", ingress.RouterName, condition.Reason, formatRelativeTime(condition.LastTransitionTime.Time))
+			if len(condition.Message) > 0 {
+				fmt.Fprintf(out, "\t    %s\n", condition.Message)
+			}
+		default:
+			fmt.Fprintf(out, "\t  pending on router %s%s\n", ingress.RouterName, host)
+		}
+		return
+	}
+	fmt.Fprintf(out, "\t  pending on router %s%s\n", ingress.RouterName, host)
+}
+
 // ProjectDescriber generates information about a Project
 type ProjectDescriber struct {
 	osClient   client.Interface
@@ -11,6 +11,7 @@ import (
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
 	"k8s.io/kubernetes/pkg/kubectl"
 	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
 
 	authorizationapi "github.com/openshift/origin/pkg/authorization/api"
 	buildapi "github.com/openshift/origin/pkg/build/api"
@@ -22,6 +23,7 @@ import (
 	imageapi "github.com/openshift/origin/pkg/image/api"
 	oauthapi "github.com/openshift/origin/pkg/oauth/api"
 	projectapi "github.com/openshift/origin/pkg/project/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
 	sdnapi "github.com/openshift/origin/pkg/sdn/api"
 )
 
@@ -57,6 +59,7 @@ var DescriberCoverageExceptions = []reflect.Type{
 	reflect.TypeOf(&authorizationapi.ResourceAccessReview{}),
 	reflect.TypeOf(&authorizationapi.LocalSubjectAccessReview{}),
 	reflect.TypeOf(&authorizationapi.LocalResourceAccessReview{}),
+	reflect.TypeOf(&imageapi.ImageStreamImport{}),
 }
 
 // MissingDescriberCoverageExceptions is the list of types that were missing describer methods when I started
@@ -362,3 +365,45 @@ func mkPod(status kapi.PodPhase, exitCode int) *kapi.Pod {
 		},
 	}
 }
+
+func TestRouteDescriberIngress(t *testing.T) {
+	fake := &testclient.Fake{}
+	fake.AddReactor("get", "routes", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &routeapi.Route{
+			ObjectMeta: kapi.ObjectMeta{Name: "bar", Namespace: "foo"},
+			Spec:       routeapi.RouteSpec{Host: "www.example.com"},
+			Status: routeapi.RouteStatus{Ingress: []routeapi.RouteIngress{
+				{
+					Host:       "www.example.com",
+					RouterName: "public",
+					Conditions: []routeapi.RouteIngressCondition{{Type: routeapi.RouteAdmitted, Status: kapi.ConditionTrue, LastTransitionTime: unversioned.Now()}},
+				},
+				{
+					Host:       "www.example.com",
+					RouterName: "other",
+					Conditions: []routeapi.RouteIngressCondition{{
+						Type:               routeapi.RouteAdmitted,
+						Status:             kapi.ConditionFalse,
+						Reason:             "HostAlreadyClaimed",
+						Message:            "route ns/old already exposes www.example.com and is older",
+						LastTransitionTime: unversioned.Now(),
+					}},
+				},
+			}},
+		}, nil
+	})
+	d := &RouteDescriber{fake}
+	out, err := d.Describe("foo", "bar")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, expected := range []string{
+		"exposed on router public as www.example.com",
+		"rejected by router other: HostAlreadyClaimed",
+		"route ns/old already exposes www.example.com and is older",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected the description to contain %q:\n%s", expected, out)
+		}
+	}
+}
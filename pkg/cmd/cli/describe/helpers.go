@@ -137,6 +137,10 @@ func webhookURL(c *buildapi.BuildConfig, cli client.BuildConfigsNamespacer) map[
 			whTrigger = trigger.GitHubWebHook.Secret
 		case buildapi.GenericWebHookBuildTriggerType:
 			whTrigger = trigger.GenericWebHook.Secret
+		case buildapi.GitLabWebHookBuildTriggerType:
+			whTrigger = trigger.GitLabWebHook.Secret
+		case buildapi.BitbucketWebHookBuildTriggerType:
+			whTrigger = trigger.BitbucketWebHook.Secret
 		}
 		if len(whTrigger) == 0 {
 			continue
@@ -170,6 +174,7 @@ func formatImageStreamTags(out *tabwriter.Writer, stream *imageapi.ImageStream)
 	}
 	sort.Strings(sortedTags)
 	for _, tag := range sortedTags {
+		importCondition := imageapi.GetTagCondition(stream, tag, imageapi.ImportSuccess)
 		tagRef, ok := stream.Spec.Tags[tag]
 		specTag := ""
 		if ok {
@@ -186,6 +191,9 @@ func formatImageStreamTags(out *tabwriter.Writer, stream *imageapi.ImageStream)
 					specTag = namePair
 				case "DockerImage":
 					specTag = tagRef.From.Name
+					if tagRef.ImportPolicy.Scheduled {
+						specTag += " (scheduled)"
+					}
 				default:
 					specTag = fmt.Sprintf("<unknown %s> %s", tagRef.From.Kind, namePair)
 				}
@@ -193,7 +201,7 @@ func formatImageStreamTags(out *tabwriter.Writer, stream *imageapi.ImageStream)
 		} else {
 			specTag = "<pushed>"
 		}
-		if taglist, ok := stream.Status.Tags[tag]; ok {
+		if taglist, ok := stream.Status.Tags[tag]; ok && len(taglist.Items) > 0 {
 			for _, event := range taglist.Items {
 				d := timeNowFn().Sub(event.Created.Time)
 				image := event.Image
@@ -219,5 +227,9 @@ func formatImageStreamTags(out *tabwriter.Writer, stream *imageapi.ImageStream)
 		} else {
 			fmt.Fprintf(out, "%s\t%s\t\t<not available>\t<not available>\n", tag, specTag)
 		}
+		if importCondition != nil && importCondition.Status == api.ConditionFalse {
+			d := timeNowFn().Sub(importCondition.LastTransitionTime.Time)
+			fmt.Fprintf(out, "\t! import failed %s ago: %s\t\t\t\n", units.HumanDuration(d), importCondition.Message)
+		}
 	}
 }
@@ -2,6 +2,7 @@ package describe
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 	"text/tabwriter"
 	"time"
@@ -29,6 +30,13 @@ func TestFormatImageStreamTags(t *testing.T) {
 						Name:      "latest@sha256:e52c6534db85036dabac5e71ff14e720db94def2d90f986f3548425ea27b3719",
 					},
 				},
+				"spec3": {
+					From: &kapi.ObjectReference{
+						Kind: "DockerImage",
+						Name: "mysql:missing",
+					},
+					ImportPolicy: imageapi.TagImportPolicy{Scheduled: true},
+				},
 			},
 		},
 		Status: imageapi.ImageStreamStatus{
@@ -65,6 +73,17 @@ func TestFormatImageStreamTags(t *testing.T) {
 						},
 					},
 				},
+				"spec3": {
+					Conditions: []imageapi.TagEventCondition{
+						{
+							Type:               imageapi.ImportSuccess,
+							Status:             kapi.ConditionFalse,
+							LastTransitionTime: unversioned.Date(2015, 3, 24, 9, 38, 0, 0, time.UTC),
+							Reason:             "NotFound",
+							Message:            "tag missing not found",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -78,4 +97,9 @@ func TestFormatImageStreamTags(t *testing.T) {
 	out.Flush()
 	actual := string(buf.String())
 	t.Logf("\n%s", actual)
+	for _, expected := range []string{"mysql:missing (scheduled)", "! import failed", "tag missing not found"} {
+		if !strings.Contains(actual, expected) {
+			t.Errorf("expected the output to contain %q", expected)
+		}
+	}
 }
@@ -9,6 +9,7 @@ import (
 	"text/tabwriter"
 	"time"
 
+	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	kctl "k8s.io/kubernetes/pkg/kubectl"
 	"k8s.io/kubernetes/pkg/labels"
@@ -432,16 +433,33 @@ func printRoute(route *routeapi.Route, w io.Writer, withNamespace, wide, showAll
 		tlsTerm = string(route.Spec.TLS.Termination)
 		insecurePolicy = string(route.Spec.TLS.InsecureEdgeTerminationPolicy)
 	}
+	host := route.Spec.Host
+	if rejected := rejectedIngresses(route); rejected > 0 {
+		host = fmt.Sprintf("%s ... %d rejected", host, rejected)
+	}
 	if withNamespace {
 		if _, err := fmt.Fprintf(w, "%s\t", route.Namespace); err != nil {
 			return err
 		}
 	}
 	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
-		route.Name, route.Spec.Host, route.Spec.Path, route.Spec.To.Name, labels.Set(route.Labels), insecurePolicy, tlsTerm)
+		route.Name, host, route.Spec.Path, route.Spec.To.Name, labels.Set(route.Labels), insecurePolicy, tlsTerm)
 	return err
 }
 
+// rejectedIngresses returns the number of routers which rejected the route.
+func rejectedIngresses(route *routeapi.Route) int {
+	rejected := 0
+	for _, ingress := range route.Status.Ingress {
+		for _, condition := range ingress.Conditions {
+			if condition.Type == routeapi.RouteAdmitted && condition.Status == kapi.ConditionFalse {
+				rejected++
+			}
+		}
+	}
+	return rejected
+}
+
 func printRouteList(routeList *routeapi.RouteList, w io.Writer, withNamespace, wide, showAll bool, columnLabels []string) error {
 	for _, route := range routeList.Items {
 		if err := printRoute(&route, w, withNamespace, wide, showAll, columnLabels); err != nil {
@@ -17,6 +17,7 @@ import (
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 	projectapi "github.com/openshift/origin/pkg/project/api"
+	routeapi "github.com/openshift/origin/pkg/route/api"
 )
 
 // PrinterCoverageExceptions is the list of API types that do NOT have corresponding printers
@@ -40,6 +41,7 @@ var PrinterCoverageExceptions = []reflect.Type{
 	reflect.TypeOf(&buildapi.BinaryBuildRequestOptions{}),
 	reflect.TypeOf(&buildapi.BuildRequest{}),
 	reflect.TypeOf(&buildapi.BuildLogOptions{}),
+	reflect.TypeOf(&imageapi.ImageStreamImport{}),
 }
 
 // MissingPrinterCoverageExceptions is the list of types that were missing printer methods when I started
@@ -125,6 +127,24 @@ func TestPrintImageStream(t *testing.T) {
 
 }
 
+func TestPrintRoute(t *testing.T) {
+	buf := bytes.NewBuffer([]byte{})
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "route"},
+		Spec:       routeapi.RouteSpec{Host: "www.example.com"},
+		Status: routeapi.RouteStatus{Ingress: []routeapi.RouteIngress{
+			{RouterName: "public", Conditions: []routeapi.RouteIngressCondition{{Type: routeapi.RouteAdmitted, Status: kapi.ConditionTrue}}},
+			{RouterName: "other", Conditions: []routeapi.RouteIngressCondition{{Type: routeapi.RouteAdmitted, Status: kapi.ConditionFalse}}},
+		}},
+	}
+	if err := printRoute(route, buf, false, false, false, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := buf.String(); !strings.Contains(got, "www.example.com ... 1 rejected") {
+		t.Errorf("unexpected output:\n%s\nexpected to contain the rejections", got)
+	}
+}
+
 func mockStreams() []*imageapi.ImageStream {
 	return []*imageapi.ImageStream{
 		{
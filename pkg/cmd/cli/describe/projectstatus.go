@@ -463,6 +463,12 @@ func describeBuildInPipeline(build *buildapi.BuildConfig, baseImage graphview.Im
 			return fmt.Sprintf("bc/%s custom build ", build.Name)
 		}
 		return fmt.Sprintf("bc/%s custom build of %s", build.Name, source)
+	case build.Spec.Strategy.JenkinsPipelineStrategy != nil:
+		source, ok := describeSourceInPipeline(&build.Spec.Source)
+		if !ok {
+			return fmt.Sprintf("bc/%s jenkins pipeline", build.Name)
+		}
+		return fmt.Sprintf("bc/%s jenkins pipeline of %s", build.Name, source)
 	default:
 		return fmt.Sprintf("bc/%s unrecognized build", build.Name)
 	}
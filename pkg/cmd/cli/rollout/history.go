@@ -0,0 +1,219 @@
+package rollout
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/api/latest"
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+const HistoryRecommendedName = "history"
+
+const (
+	historyLong = `
+List the revisions of a deployment config
+
+Each deployment of a deployment config is a revision of the config. The history lists the status of
+each revision along with what caused it: a change to the config, a new image, or a manual deployment.
+Pass '--revision' to show the pod template of a single revision.`
+
+	historyExample = `  # List the revisions of the 'frontend' deployment config
+  $ %[1]s frontend
+
+  # Show the details of revision 3 of 'frontend'
+  $ %[1]s frontend --revision=3`
+)
+
+// HistoryOptions contains all the necessary state to list the revisions of
+// a deployment config.
+type HistoryOptions struct {
+	Namespace string
+	Name      string
+	Revision  int
+
+	out   io.Writer
+	oc    client.Interface
+	kc    kclient.Interface
+	codec runtime.Codec
+}
+
+// NewCmdRolloutHistory creates the `rollout history` command.
+func NewCmdRolloutHistory(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	opts := &HistoryOptions{}
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s DEPLOYMENTCONFIG", name),
+		Short:   "List the revisions of a deployment config",
+		Long:    historyLong,
+		Example: fmt.Sprintf(historyExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := opts.Complete(f, args, out); err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(cmd, "%v", err))
+			}
+			if err := opts.Validate(); err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(cmd, "%v", err))
+			}
+			cmdutil.CheckErr(opts.Run())
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.Revision, "revision", 0, "Show the details of this revision instead of listing all the revisions.")
+
+	return cmd
+}
+
+// Complete completes all the required options for listing revisions.
+func (o *HistoryOptions) Complete(f *clientcmd.Factory, args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return errors.New("a deployment config name is required")
+	}
+	o.Name = args[0]
+
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	o.Namespace = namespace
+
+	o.oc, o.kc, err = f.Clients()
+	if err != nil {
+		return err
+	}
+	o.codec = latest.Codec
+	o.out = out
+	return nil
+}
+
+// Validate validates all the required options for listing revisions.
+func (o *HistoryOptions) Validate() error {
+	if len(o.Name) == 0 {
+		return errors.New("a deployment config name is required")
+	}
+	if o.Revision < 0 {
+		return errors.New("the revision must be >= 0")
+	}
+	return nil
+}
+
+// Run lists the revisions of the deployment config, or shows a single
+// revision.
+func (o *HistoryOptions) Run() error {
+	config, err := o.oc.DeploymentConfigs(o.Namespace).Get(o.Name)
+	if err != nil {
+		return err
+	}
+	deployments, err := o.kc.ReplicationControllers(o.Namespace).List(deployutil.ConfigSelector(config.Name), fields.Everything())
+	if err != nil {
+		return err
+	}
+	if len(deployments.Items) == 0 {
+		fmt.Fprintf(o.out, "No revisions found for %s\n", config.Name)
+		return nil
+	}
+	sort.Sort(deployutil.ByLatestVersionAsc(deployments.Items))
+
+	if o.Revision > 0 {
+		for i := range deployments.Items {
+			if deployutil.DeploymentVersionFor(&deployments.Items[i]) == o.Revision {
+				return printRevision(o.out, &deployments.Items[i], o.codec)
+			}
+		}
+		return fmt.Errorf("couldn't find revision %d of %s", o.Revision, config.Name)
+	}
+	return printHistory(o.out, deployments.Items, o.codec)
+}
+
+// printHistory prints the revision, status and cause of each deployment.
+func printHistory(out io.Writer, deployments []kapi.ReplicationController, codec runtime.Codec) error {
+	w := tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+	defer w.Flush()
+	fmt.Fprintln(w, "REVISION\tSTATUS\tCAUSE")
+	for i := range deployments {
+		deployment := &deployments[i]
+		fmt.Fprintf(w, "%d\t%s\t%s\n", deployutil.DeploymentVersionFor(deployment), deployutil.DeploymentStatusFor(deployment), causeFor(deployment, codec))
+	}
+	return nil
+}
+
+// printRevision prints the details of a single deployment.
+func printRevision(out io.Writer, deployment *kapi.ReplicationController, codec runtime.Codec) error {
+	w := tabwriter.NewWriter(out, 10, 4, 3, ' ', 0)
+	defer w.Flush()
+	fmt.Fprintf(w, "Revision:\t%d\n", deployutil.DeploymentVersionFor(deployment))
+	fmt.Fprintf(w, "Deployment:\t%s\n", deployment.Name)
+	fmt.Fprintf(w, "Status:\t%s\n", deployutil.DeploymentStatusFor(deployment))
+	if reason := deployutil.DeploymentStatusReasonFor(deployment); len(reason) > 0 {
+		fmt.Fprintf(w, "Status Reason:\t%s\n", reason)
+	}
+	fmt.Fprintf(w, "Cause:\t%s\n", causeFor(deployment, codec))
+	if config, err := deployutil.DecodeDeploymentConfig(deployment, codec); err == nil {
+		fmt.Fprintf(w, "Strategy:\t%s\n", config.Spec.Strategy.Type)
+	}
+	if deployment.Spec.Template != nil {
+		fmt.Fprint(w, "Containers:\n  NAME\tIMAGE\n")
+		for _, container := range deployment.Spec.Template.Spec.Containers {
+			fmt.Fprintf(w, "  %s\t%s\n", container.Name, container.Image)
+		}
+	}
+	return nil
+}
+
+// causeFor describes what caused deployment from the details of the config
+// it was made from.
+func causeFor(deployment *kapi.ReplicationController, codec runtime.Codec) string {
+	config, err := deployutil.DecodeDeploymentConfig(deployment, codec)
+	if err != nil || config.Status.Details == nil {
+		return "<unknown>"
+	}
+	return formatDetails(config.Status.Details)
+}
+
+// formatDetails describes the causes in details, preferring the message of a
+// manual deployment.
+func formatDetails(details *deployapi.DeploymentDetails) string {
+	if len(details.Message) > 0 {
+		return details.Message
+	}
+	causes := []string{}
+	for _, cause := range details.Causes {
+		switch cause.Type {
+		case deployapi.DeploymentTriggerOnConfigChange:
+			causes = append(causes, "config change")
+		case deployapi.DeploymentTriggerOnImageChange:
+			if cause.ImageTrigger != nil && len(cause.ImageTrigger.From.Name) > 0 {
+				causes = append(causes, fmt.Sprintf("image change (%s)", cause.ImageTrigger.From.Name))
+			} else {
+				causes = append(causes, "image change")
+			}
+		case deployapi.DeploymentTriggerOnSecretChange:
+			if cause.SecretTrigger != nil && len(cause.SecretTrigger.From.Name) > 0 {
+				causes = append(causes, fmt.Sprintf("secret change (%s)", cause.SecretTrigger.From.Name))
+			} else {
+				causes = append(causes, "secret change")
+			}
+		case deployapi.DeploymentTriggerManual:
+			causes = append(causes, "manual change")
+		default:
+			causes = append(causes, strings.ToLower(string(cause.Type)))
+		}
+	}
+	if len(causes) == 0 {
+		return "<unknown>"
+	}
+	return strings.Join(causes, ", ")
+}
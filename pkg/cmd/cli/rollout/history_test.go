@@ -0,0 +1,131 @@
+package rollout
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktc "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	api "github.com/openshift/origin/pkg/api/latest"
+	tc "github.com/openshift/origin/pkg/client/testclient"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+func historyDeployment(version int, status deployapi.DeploymentStatus, details *deployapi.DeploymentDetails) kapi.ReplicationController {
+	config := deploytest.OkDeploymentConfig(version)
+	config.Status.Details = details
+	deployment, _ := deployutil.MakeDeployment(config, api.Codec)
+	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(status)
+	return *deployment
+}
+
+func TestHistoryRun(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(2)
+	deployments := &kapi.ReplicationControllerList{Items: []kapi.ReplicationController{
+		historyDeployment(2, deployapi.DeploymentStatusComplete, &deployapi.DeploymentDetails{Message: "manual rollout"}),
+		historyDeployment(1, deployapi.DeploymentStatusFailed, &deployapi.DeploymentDetails{
+			Causes: []*deployapi.DeploymentCause{{Type: deployapi.DeploymentTriggerOnConfigChange}},
+		}),
+	}}
+
+	oc := &tc.Fake{}
+	oc.AddReactor("get", "deploymentconfigs", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		return true, config, nil
+	})
+	kc := &ktc.Fake{}
+	kc.AddReactor("list", "replicationcontrollers", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		return true, deployments, nil
+	})
+
+	tests := []struct {
+		name          string
+		revision      int
+		expected      []string
+		expectedError bool
+	}{
+		{
+			name:     "list",
+			expected: []string{"REVISION   STATUS     CAUSE\n1          Failed     config change\n2          Complete   manual rollout\n"},
+		},
+		{
+			name:     "revision",
+			revision: 1,
+			expected: []string{"Revision:", "config-1", "Failed", "config change", "Recreate"},
+		},
+		{
+			name:          "missing revision",
+			revision:      3,
+			expectedError: true,
+		},
+	}
+
+	for _, test := range tests {
+		out := &bytes.Buffer{}
+		o := &HistoryOptions{Namespace: config.Namespace, Name: config.Name, Revision: test.revision, out: out, oc: oc, kc: kc, codec: api.Codec}
+		err := o.Run()
+		if test.expectedError {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		for _, s := range test.expected {
+			if !strings.Contains(out.String(), s) {
+				t.Errorf("%s: expected %q in the output:\n%s", test.name, s, out.String())
+			}
+		}
+	}
+
+}
+
+func TestHistoryRunNoRevisions(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(0)
+	oc := &tc.Fake{}
+	oc.AddReactor("get", "deploymentconfigs", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		return true, config, nil
+	})
+	kc := &ktc.Fake{}
+	kc.AddReactor("list", "replicationcontrollers", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &kapi.ReplicationControllerList{}, nil
+	})
+
+	out := &bytes.Buffer{}
+	o := &HistoryOptions{Namespace: config.Namespace, Name: config.Name, out: out, oc: oc, kc: kc, codec: api.Codec}
+	if err := o.Run(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := "No revisions found for config\n", out.String(); e != a {
+		t.Errorf("expected %q, got %q", e, a)
+	}
+}
+
+func TestHistoryValidate(t *testing.T) {
+	tests := []struct {
+		options       HistoryOptions
+		expectedError bool
+	}{
+		{options: HistoryOptions{Name: "config"}},
+		{options: HistoryOptions{Name: "config", Revision: 2}},
+		{options: HistoryOptions{}, expectedError: true},
+		{options: HistoryOptions{Name: "config", Revision: -1}, expectedError: true},
+	}
+
+	for _, test := range tests {
+		err := test.options.Validate()
+		if test.expectedError && err == nil {
+			t.Errorf("%#v: expected an error", test.options)
+		}
+		if !test.expectedError && err != nil {
+			t.Errorf("%#v: unexpected error: %v", test.options, err)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package rollout
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/cli/cmd"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const (
+	PauseRecommendedName  = "pause"
+	ResumeRecommendedName = "resume"
+)
+
+const (
+	pauseLong = `
+Pause a deployment config
+
+While a config is paused, its triggers don't start new deployments, so several changes can be made to
+the config without a deployment for each of them. Resume the config to deploy all the changes at once.`
+
+	pauseExample = `  # Pause the 'frontend' deployment config while changing it
+  $ %[1]s frontend`
+
+	resumeLong = `
+Resume a paused deployment config
+
+The triggers of the config start a single deployment with all the changes made while it was paused.`
+
+	resumeExample = `  # Resume the 'frontend' deployment config
+  $ %[1]s frontend`
+)
+
+// PauseOptions contains all the necessary state to pause or resume a
+// deployment config.
+type PauseOptions struct {
+	Namespace string
+	Name      string
+	// Paused is the desired state of the config.
+	Paused bool
+
+	out io.Writer
+	oc  client.Interface
+}
+
+// NewCmdRolloutPause creates the `rollout pause` command.
+func NewCmdRolloutPause(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	return newCmdPause(name, "Pause a deployment config", pauseLong, fmt.Sprintf(pauseExample, fullName), true, f, out)
+}
+
+// NewCmdRolloutResume creates the `rollout resume` command.
+func NewCmdRolloutResume(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	return newCmdPause(name, "Resume a paused deployment config", resumeLong, fmt.Sprintf(resumeExample, fullName), false, f, out)
+}
+
+func newCmdPause(name, short, long, example string, paused bool, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	opts := &PauseOptions{Paused: paused}
+	return &cobra.Command{
+		Use:     fmt.Sprintf("%s DEPLOYMENTCONFIG", name),
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := opts.Complete(f, args, out); err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(cmd, "%v", err))
+			}
+			cmdutil.CheckErr(opts.Run())
+		},
+	}
+}
+
+// Complete completes all the required options for pausing or resuming a
+// deployment config.
+func (o *PauseOptions) Complete(f *clientcmd.Factory, args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return errors.New("a deployment config name is required")
+	}
+	o.Name = args[0]
+
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	o.Namespace = namespace
+
+	o.oc, _, err = f.Clients()
+	if err != nil {
+		return err
+	}
+	o.out = out
+	return nil
+}
+
+// Run pauses or resumes the deployment config.
+func (o *PauseOptions) Run() error {
+	return cmd.SetPaused(o.oc, o.Namespace, o.Name, o.Paused, o.out)
+}
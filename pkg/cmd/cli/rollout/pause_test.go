@@ -0,0 +1,77 @@
+package rollout
+
+import (
+	"bytes"
+	"testing"
+
+	ktc "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	tc "github.com/openshift/origin/pkg/client/testclient"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+)
+
+func TestPauseRun(t *testing.T) {
+	tests := []struct {
+		name           string
+		paused         bool
+		desired        bool
+		expectedUpdate bool
+		expectedOutput string
+	}{
+		{
+			name:           "pause",
+			desired:        true,
+			expectedUpdate: true,
+			expectedOutput: "Paused config; triggers will not start new deployments until it is resumed\n",
+		},
+		{
+			name:           "already paused",
+			paused:         true,
+			desired:        true,
+			expectedOutput: "config is already paused\n",
+		},
+		{
+			name:           "resume",
+			paused:         true,
+			expectedUpdate: true,
+			expectedOutput: "Resumed config\n",
+		},
+		{
+			name:           "not paused",
+			expectedOutput: "config is not paused\n",
+		},
+	}
+
+	for _, test := range tests {
+		config := deploytest.OkDeploymentConfig(1)
+		config.Spec.Paused = test.paused
+		var updated *deployapi.DeploymentConfig
+
+		oc := &tc.Fake{}
+		oc.AddReactor("get", "deploymentconfigs", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+			return true, config, nil
+		})
+		oc.AddReactor("update", "deploymentconfigs", func(action ktc.Action) (handled bool, ret runtime.Object, err error) {
+			updated = action.(ktc.UpdateAction).GetObject().(*deployapi.DeploymentConfig)
+			return true, updated, nil
+		})
+
+		out := &bytes.Buffer{}
+		o := &PauseOptions{Namespace: config.Namespace, Name: config.Name, Paused: test.desired, out: out, oc: oc}
+		if err := o.Run(); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if test.expectedUpdate != (updated != nil) {
+			t.Errorf("%s: expected update %t, got %#v", test.name, test.expectedUpdate, updated)
+		}
+		if updated != nil && updated.Spec.Paused != test.desired {
+			t.Errorf("%s: expected paused to be %t", test.name, test.desired)
+		}
+		if e, a := test.expectedOutput, out.String(); e != a {
+			t.Errorf("%s: expected %q, got %q", test.name, e, a)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package rollout
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "github.com/openshift/origin/pkg/cmd/util"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const RolloutRecommendedName = "rollout"
+
+const (
+	rolloutLong = `
+Manage the rollout of a deployment config
+
+A deployment config rolls out its pod template as a series of deployments, each of which is a
+revision of the config. These commands wait for a rollout to finish, list the revisions of a config,
+roll a config back to one of its revisions, and pause and resume its triggers.`
+)
+
+// NewCmdRollout creates the `rollout` command and its subcommands.
+func NewCmdRollout(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	// Parent command to which all subcommands are added.
+	cmds := &cobra.Command{
+		Use:   name,
+		Short: "Manage the rollout of a deployment config",
+		Long:  rolloutLong,
+		Run:   cmdutil.DefaultSubCommandRun(out),
+	}
+
+	cmds.AddCommand(NewCmdRolloutHistory(HistoryRecommendedName, fullName+" "+HistoryRecommendedName, f, out))
+	cmds.AddCommand(NewCmdRolloutStatus(StatusRecommendedName, fullName+" "+StatusRecommendedName, f, out))
+	cmds.AddCommand(NewCmdRolloutUndo(UndoRecommendedName, fullName+" "+UndoRecommendedName, f, out))
+	cmds.AddCommand(NewCmdRolloutPause(PauseRecommendedName, fullName+" "+PauseRecommendedName, f, out))
+	cmds.AddCommand(NewCmdRolloutResume(ResumeRecommendedName, fullName+" "+ResumeRecommendedName, f, out))
+
+	return cmds
+}
@@ -0,0 +1,220 @@
+package rollout
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/wait"
+
+	"github.com/openshift/origin/pkg/api/latest"
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+const StatusRecommendedName = "status"
+
+const (
+	statusLong = `
+Watch the rollout of the latest deployment of a deployment config
+
+The command waits until the latest deployment of the config finishes, printing its progress along the
+way, and exits with a non-zero status if the deployment fails or is cancelled. A rollout is only
+considered finished once the latest changes to the config have been seen by the server and, if the
+config has a config change trigger, a deployment was started for them, so the command can be run
+straight after changing a config. Pass '--watch=false' to print the current
+status without waiting.`
+
+	statusExample = `  # Wait for the rollout of the 'frontend' deployment config to finish
+  $ %[1]s frontend
+
+  # Give up if the rollout hasn't finished within 10 minutes
+  $ %[1]s frontend --timeout=10m`
+)
+
+// StatusOptions contains all the necessary state to watch a rollout.
+type StatusOptions struct {
+	Namespace string
+	Name      string
+	Watch     bool
+	Timeout   time.Duration
+
+	out   io.Writer
+	oc    client.Interface
+	kc    kclient.Interface
+	codec runtime.Codec
+	// interval is how often the rollout is checked.
+	interval time.Duration
+}
+
+// NewCmdRolloutStatus creates the `rollout status` command.
+func NewCmdRolloutStatus(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	opts := &StatusOptions{interval: time.Second}
+	cmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s DEPLOYMENTCONFIG", name),
+		Short:   "Watch the rollout of a deployment config",
+		Long:    statusLong,
+		Example: fmt.Sprintf(statusExample, fullName),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := opts.Complete(f, args, out); err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(cmd, "%v", err))
+			}
+			if err := opts.Validate(); err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(cmd, "%v", err))
+			}
+			cmdutil.CheckErr(opts.Run())
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Watch, "watch", "w", true, "Wait for the rollout to finish.")
+	cmd.Flags().DurationVar(&opts.Timeout, "timeout", 0, "How long to wait for the rollout to finish before giving up. Zero means wait forever.")
+
+	return cmd
+}
+
+// Complete completes all the required options for watching a rollout.
+func (o *StatusOptions) Complete(f *clientcmd.Factory, args []string, out io.Writer) error {
+	if len(args) != 1 {
+		return errors.New("a deployment config name is required")
+	}
+	o.Name = args[0]
+
+	namespace, _, err := f.DefaultNamespace()
+	if err != nil {
+		return err
+	}
+	o.Namespace = namespace
+
+	o.oc, o.kc, err = f.Clients()
+	if err != nil {
+		return err
+	}
+	o.codec = latest.Codec
+	o.out = out
+	return nil
+}
+
+// Validate validates all the required options for watching a rollout.
+func (o *StatusOptions) Validate() error {
+	if len(o.Name) == 0 {
+		return errors.New("a deployment config name is required")
+	}
+	if o.Timeout < 0 {
+		return errors.New("the timeout must be >= 0")
+	}
+	return nil
+}
+
+// Run prints the status of the rollout until it finishes, and returns an
+// error if it fails.
+func (o *StatusOptions) Run() error {
+	lastMessage := ""
+	check := func() (bool, error) {
+		message, done, err := o.check()
+		if err != nil {
+			return false, err
+		}
+		if message != lastMessage {
+			fmt.Fprintln(o.out, message)
+			lastMessage = message
+		}
+		return done, nil
+	}
+
+	if !o.Watch {
+		_, err := check()
+		return err
+	}
+	err := wait.PollImmediate(o.interval, o.Timeout, check)
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("timed out waiting for the rollout of %s to finish", o.Name)
+	}
+	return err
+}
+
+// check returns the current status of the rollout.
+func (o *StatusOptions) check() (string, bool, error) {
+	config, err := o.oc.DeploymentConfigs(o.Namespace).Get(o.Name)
+	if err != nil {
+		return "", false, err
+	}
+	deployments, err := o.kc.ReplicationControllers(o.Namespace).List(deployutil.ConfigSelector(config.Name), fields.Everything())
+	if err != nil {
+		return "", false, err
+	}
+	pods, err := o.kc.Pods(o.Namespace).List(deployutil.ConfigPodSelector(config.Name), fields.Everything())
+	if err != nil {
+		return "", false, err
+	}
+	return rolloutStatus(config, deployments, pods, o.codec)
+}
+
+// rolloutStatus describes the rollout of the latest deployment of config,
+// and returns true once it has finished. An error is returned if the
+// deployment failed. The status is calculated from the deployments and pods
+// of config, rather than taken from the status of config, so that a rollout
+// which was just started isn't mistaken for the previous one.
+func rolloutStatus(config *deployapi.DeploymentConfig, deployments *kapi.ReplicationControllerList, pods *kapi.PodList, codec runtime.Codec) (string, bool, error) {
+	if config.Status.ObservedGeneration < config.Generation {
+		return fmt.Sprintf("Waiting for the latest changes to %s to be observed...", config.Name), false, nil
+	}
+	if config.Status.LatestVersion == 0 {
+		return fmt.Sprintf("Waiting for %s to be deployed...", config.Name), false, nil
+	}
+	// The generation is observed by the deployment config controller, which
+	// may happen before the config change trigger has bumped the latest
+	// version for a template change, so wait for that too.
+	pending, err := templateChangePending(config, deployments, codec)
+	if err != nil {
+		return "", false, err
+	}
+	if pending {
+		return fmt.Sprintf("Waiting for the latest changes to %s to be deployed...", config.Name), false, nil
+	}
+
+	status := deployutil.CalculateStatus(config, deployments, pods, unversioned.Now())
+	progressing := deployutil.GetCondition(status, deployapi.DeploymentProgressing)
+	if progressing == nil {
+		return fmt.Sprintf("Waiting for %s to be deployed...", config.Name), false, nil
+	}
+	switch progressing.Reason {
+	case deployapi.DeploymentComplete:
+		return fmt.Sprintf("Rollout of %s #%d complete", config.Name, config.Status.LatestVersion), true, nil
+	case deployapi.DeploymentFailed, deployapi.DeploymentCancelled:
+		return "", true, fmt.Errorf("rollout of %s #%d failed: %s", config.Name, config.Status.LatestVersion, progressing.Message)
+	case deployapi.NewDeploymentRequested:
+		return fmt.Sprintf("Waiting for rollout of %s #%d to start...", config.Name, config.Status.LatestVersion), false, nil
+	default:
+		return fmt.Sprintf("Waiting for rollout of %s #%d to finish: %d of %d updated replicas created, %d available...",
+			config.Name, config.Status.LatestVersion, status.UpdatedReplicas, config.Spec.Replicas, status.AvailableReplicas), false, nil
+	}
+}
+
+// templateChangePending returns true if config has a config change trigger
+// and its template differs from the one of its latest deployment, meaning
+// the trigger hasn't created a new version for the change yet.
+func templateChangePending(config *deployapi.DeploymentConfig, deployments *kapi.ReplicationControllerList, codec runtime.Codec) (bool, error) {
+	if !deployutil.HasChangeTrigger(config) || config.Spec.Paused {
+		return false, nil
+	}
+	latestExists, latest := deployutil.LatestDeploymentInfo(config, deployments)
+	if !latestExists {
+		return false, nil
+	}
+	deployedConfig, err := deployutil.DecodeDeploymentConfig(latest, codec)
+	if err != nil {
+		return false, fmt.Errorf("couldn't decode the config of deployment %s: %v", latest.Name, err)
+	}
+	return !kapi.Semantic.DeepEqual(config.Spec.Template, deployedConfig.Spec.Template), nil
+}
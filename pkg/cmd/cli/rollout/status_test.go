@@ -0,0 +1,160 @@
+package rollout
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	api "github.com/openshift/origin/pkg/api/latest"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+func TestRolloutStatus(t *testing.T) {
+	tests := []struct {
+		name               string
+		generation         int64
+		observedGeneration int64
+		version            int
+		// status is the status of the latest deployment, if it exists
+		status deployapi.DeploymentStatus
+		// templateChanged is true if the template was changed after the
+		// latest deployment was created
+		templateChanged bool
+		expectDone      bool
+		expectedError   bool
+	}{
+		{
+			name:               "changes not observed",
+			generation:         2,
+			observedGeneration: 1,
+			version:            1,
+			status:             deployapi.DeploymentStatusComplete,
+		},
+		{
+			name:               "changes observed but not deployed",
+			generation:         2,
+			observedGeneration: 2,
+			version:            1,
+			status:             deployapi.DeploymentStatusComplete,
+			templateChanged:    true,
+		},
+		{
+			name:               "not deployed",
+			generation:         1,
+			observedGeneration: 1,
+			version:            0,
+		},
+		{
+			name:               "not created",
+			generation:         1,
+			observedGeneration: 1,
+			version:            1,
+		},
+		{
+			name:               "running",
+			generation:         1,
+			observedGeneration: 1,
+			version:            1,
+			status:             deployapi.DeploymentStatusRunning,
+		},
+		{
+			name:               "complete",
+			generation:         1,
+			observedGeneration: 1,
+			version:            1,
+			status:             deployapi.DeploymentStatusComplete,
+			expectDone:         true,
+		},
+		{
+			name:               "failed",
+			generation:         1,
+			observedGeneration: 1,
+			version:            1,
+			status:             deployapi.DeploymentStatusFailed,
+			expectDone:         true,
+			expectedError:      true,
+		},
+	}
+
+	for _, test := range tests {
+		config := deploytest.OkDeploymentConfig(test.version)
+		config.Generation = test.generation
+		config.Status.ObservedGeneration = test.observedGeneration
+		config.Spec.Triggers = append(config.Spec.Triggers, deploytest.OkConfigChangeTrigger())
+
+		deployments := &kapi.ReplicationControllerList{}
+		if len(test.status) > 0 {
+			deployment, _ := deployutil.MakeDeployment(config, api.Codec)
+			deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(test.status)
+			deployments.Items = append(deployments.Items, *deployment)
+			// Use the defaulted template, like the one of a config read
+			// from the server.
+			deployedConfig, _ := deployutil.DecodeDeploymentConfig(deployment, api.Codec)
+			config.Spec.Template = deployedConfig.Spec.Template
+		}
+		if test.templateChanged {
+			config.Spec.Template.Spec.Containers[0].Image = "changed"
+		}
+
+		message, done, err := rolloutStatus(config, deployments, &kapi.PodList{}, api.Codec)
+		if test.expectedError {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+		} else if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		} else if len(message) == 0 {
+			t.Errorf("%s: expected a message", test.name)
+		}
+		if done != test.expectDone {
+			t.Errorf("%s: expected done to be %t, got %t", test.name, test.expectDone, done)
+		}
+	}
+}
+
+func TestFormatDetails(t *testing.T) {
+	tests := []struct {
+		details  *deployapi.DeploymentDetails
+		expected string
+	}{
+		{
+			details:  &deployapi.DeploymentDetails{Message: "manual rollout"},
+			expected: "manual rollout",
+		},
+		{
+			details: &deployapi.DeploymentDetails{
+				Causes: []*deployapi.DeploymentCause{
+					{Type: deployapi.DeploymentTriggerOnConfigChange},
+					{
+						Type:         deployapi.DeploymentTriggerOnImageChange,
+						ImageTrigger: &deployapi.DeploymentCauseImageTrigger{From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "ruby:latest"}},
+					},
+				},
+			},
+			expected: "config change, image change (ruby:latest)",
+		},
+		{
+			details: &deployapi.DeploymentDetails{
+				Causes: []*deployapi.DeploymentCause{
+					{
+						Type:          deployapi.DeploymentTriggerOnSecretChange,
+						SecretTrigger: &deployapi.DeploymentCauseSecretTrigger{From: kapi.ObjectReference{Kind: "Secret", Name: "db-credentials"}},
+					},
+				},
+			},
+			expected: "secret change (db-credentials)",
+		},
+		{
+			details:  &deployapi.DeploymentDetails{},
+			expected: "<unknown>",
+		},
+	}
+
+	for _, test := range tests {
+		if e, a := test.expected, formatDetails(test.details); e != a {
+			t.Errorf("expected %q, got %q", e, a)
+		}
+	}
+}
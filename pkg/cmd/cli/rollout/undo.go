@@ -0,0 +1,75 @@
+package rollout
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
+
+	"github.com/openshift/origin/pkg/cmd/cli/cmd"
+	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
+)
+
+const UndoRecommendedName = "undo"
+
+const (
+	undoLong = `
+Roll a deployment config back to a previous revision
+
+The pod template of the config is rolled back to the template of the revision, which starts a new
+deployment. By default the config is rolled back to the last revision which completed before the
+latest one; use '--to-revision' to pick a revision from the history of the config. As with the
+rollback command, any image triggers of the config are disabled by the rollback.`
+
+	undoExample = `  # Roll the 'frontend' deployment config back to its last complete revision
+  $ %[1]s frontend
+
+  # Roll 'frontend' back to revision 3
+  $ %[1]s frontend --to-revision=3`
+)
+
+// NewCmdRolloutUndo creates the `rollout undo` command.
+func NewCmdRolloutUndo(name, fullName string, f *clientcmd.Factory, out io.Writer) *cobra.Command {
+	opts := &cmd.RollbackOptions{}
+	command := &cobra.Command{
+		Use:     fmt.Sprintf("%s DEPLOYMENTCONFIG", name),
+		Short:   "Roll a deployment config back to a previous revision",
+		Long:    undoLong,
+		Example: fmt.Sprintf(undoExample, fullName),
+		Run: func(command *cobra.Command, args []string) {
+			rollbackArgs, err := undoArgs(args)
+			if err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(command, "%v", err))
+			}
+			if err := opts.Complete(f, rollbackArgs, out); err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(command, "%v", err))
+			}
+			if err := opts.Validate(); err != nil {
+				cmdutil.CheckErr(cmdutil.UsageError(command, "%v", err))
+			}
+			cmdutil.CheckErr(opts.Run())
+		},
+	}
+
+	command.Flags().IntVar(&opts.DesiredVersion, "to-revision", 0, "The revision to roll back to. Zero means the last revision which completed before the latest one.")
+	command.Flags().BoolVarP(&opts.DryRun, "dry-run", "d", false, "Instead of performing the rollback, describe what the rollback will look like in human-readable form")
+
+	return command
+}
+
+// undoArgs returns the arguments of the rollback for the arguments of undo.
+// Only deployment configs can be undone, so the name is not allowed to be
+// mistaken for a deployment.
+func undoArgs(args []string) ([]string, error) {
+	if len(args) != 1 || len(args[0]) == 0 {
+		return nil, errors.New("a deployment config name is required")
+	}
+	if strings.Contains(args[0], "/") {
+		return nil, fmt.Errorf("%q is not a deployment config name", args[0])
+	}
+	return []string{"dc/" + args[0]}, nil
+}
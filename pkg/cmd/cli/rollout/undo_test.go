@@ -0,0 +1,51 @@
+package rollout
+
+import (
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+func TestUndoArgs(t *testing.T) {
+	tests := []struct {
+		args          []string
+		expected      []string
+		expectedError bool
+	}{
+		{args: []string{"frontend"}, expected: []string{"dc/frontend"}},
+		{args: []string{}, expectedError: true},
+		{args: []string{""}, expectedError: true},
+		{args: []string{"frontend", "backend"}, expectedError: true},
+		{args: []string{"rc/frontend-1"}, expectedError: true},
+	}
+
+	for _, test := range tests {
+		args, err := undoArgs(test.args)
+		if test.expectedError {
+			if err == nil {
+				t.Errorf("%v: expected an error", test.args)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: unexpected error: %v", test.args, err)
+			continue
+		}
+		if !reflect.DeepEqual(test.expected, args) {
+			t.Errorf("%v: expected %v, got %v", test.args, test.expected, args)
+		}
+	}
+}
+
+func TestUndoFlags(t *testing.T) {
+	cmd := NewCmdRolloutUndo(UndoRecommendedName, "oc rollout undo", nil, ioutil.Discard)
+	if err := cmd.Flags().Parse([]string{"--to-revision=3", "--dry-run"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if revision, err := cmd.Flags().GetInt("to-revision"); err != nil || revision != 3 {
+		t.Errorf("expected revision 3, got %d (%v)", revision, err)
+	}
+	if dryRun, err := cmd.Flags().GetBool("dry-run"); err != nil || !dryRun {
+		t.Errorf("expected a dry run, got %t (%v)", dryRun, err)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/docker/distribution/reference"
 	"github.com/docker/distribution/registry/auth"
 	"github.com/docker/distribution/registry/handlers"
+	storagedriverfactory "github.com/docker/distribution/registry/storage/driver/factory"
 	_ "github.com/docker/distribution/registry/storage/driver/filesystem"
 	_ "github.com/docker/distribution/registry/storage/driver/s3"
 	"github.com/docker/distribution/uuid"
@@ -71,6 +72,34 @@ func Execute(configFile io.Reader) {
 		pruneAccessRecords,
 	)
 
+	// the garbage collector reads the modification times of content directly from the storage
+	driver, err := storagedriverfactory.Create(config.Storage.Type(), config.Storage.Parameters())
+	if err != nil {
+		log.Fatalf("Error creating storage driver: %s", err)
+	}
+
+	app.RegisterRoute(
+		// POST /admin/garbagecollect
+		adminRouter.Path("/garbagecollect").Methods("POST"),
+		// handler
+		server.NewGarbageCollectDispatcher(driver, server.DefaultGarbageCollectionGracePeriod),
+		// repo name not required in url
+		handlers.NameNotRequired,
+		// custom access records
+		pruneAccessRecords,
+	)
+
+	app.RegisterRoute(
+		// GET /metrics
+		app.NewRoute().Path("/metrics").Methods("GET"),
+		// handler
+		server.MetricsHandler,
+		// repo name not required in url
+		handlers.NameNotRequired,
+		// custom access records
+		server.MetricsAccessRecords,
+	)
+
 	app.RegisterHealthChecks()
 	handler := alive("/", app)
 	// TODO: temporarily keep for backwards compatibility; remove in the future
@@ -13,10 +13,13 @@ import (
 	"k8s.io/kubernetes/pkg/kubectl"
 
 	"github.com/openshift/origin/pkg/api/latest"
+	osclient "github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/cmd/util"
 	"github.com/openshift/origin/pkg/cmd/util/clientcmd"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	"github.com/openshift/origin/pkg/deploy/strategy"
+	"github.com/openshift/origin/pkg/deploy/strategy/bluegreen"
+	"github.com/openshift/origin/pkg/deploy/strategy/canary"
 	"github.com/openshift/origin/pkg/deploy/strategy/recreate"
 	"github.com/openshift/origin/pkg/deploy/strategy/rolling"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
@@ -47,7 +50,7 @@ func NewCommandDeployer(name string) *cobra.Command {
 		Short: "Run the deployer",
 		Long:  deployerLong,
 		Run: func(c *cobra.Command, args []string) {
-			_, kClient, err := cfg.Config.Clients()
+			osClient, kClient, err := cfg.Config.Clients()
 			if err != nil {
 				glog.Fatal(err)
 			}
@@ -60,7 +63,7 @@ func NewCommandDeployer(name string) *cobra.Command {
 				glog.Fatal("namespace is required")
 			}
 
-			deployer := NewDeployer(kClient)
+			deployer := NewDeployer(osClient, kClient)
 			if err = deployer.Deploy(cfg.Namespace, cfg.DeploymentName); err != nil {
 				glog.Fatal(err)
 			}
@@ -77,8 +80,9 @@ func NewCommandDeployer(name string) *cobra.Command {
 	return cmd
 }
 
-// NewDeployer makes a new Deployer from a kube client.
-func NewDeployer(client kclient.Interface) *Deployer {
+// NewDeployer makes a new Deployer from an OpenShift client and a kube
+// client.
+func NewDeployer(osClient osclient.Interface, client kclient.Interface) *Deployer {
 	scaler, _ := kubectl.ScalerFor("ReplicationController", client)
 	return &Deployer{
 		getDeployment: func(namespace, name string) (*kapi.ReplicationController, error) {
@@ -91,10 +95,14 @@ func NewDeployer(client kclient.Interface) *Deployer {
 		strategyFor: func(config *deployapi.DeploymentConfig) (strategy.DeploymentStrategy, error) {
 			switch config.Spec.Strategy.Type {
 			case deployapi.DeploymentStrategyTypeRecreate:
-				return recreate.NewRecreateDeploymentStrategy(client, latest.Codec), nil
+				return recreate.NewRecreateDeploymentStrategy(client, osClient, latest.Codec), nil
 			case deployapi.DeploymentStrategyTypeRolling:
-				recreate := recreate.NewRecreateDeploymentStrategy(client, latest.Codec)
-				return rolling.NewRollingDeploymentStrategy(config.Namespace, client, latest.Codec, recreate), nil
+				recreate := recreate.NewRecreateDeploymentStrategy(client, osClient, latest.Codec)
+				return rolling.NewRollingDeploymentStrategy(config.Namespace, client, osClient, latest.Codec, recreate), nil
+			case deployapi.DeploymentStrategyTypeCanary:
+				return canary.NewCanaryDeploymentStrategy(client, osClient, latest.Codec), nil
+			case deployapi.DeploymentStrategyTypeBlueGreen:
+				return bluegreen.NewBlueGreenDeploymentStrategy(client, osClient, latest.Codec), nil
 			default:
 				return nil, fmt.Errorf("unsupported strategy type: %s", config.Spec.Strategy.Type)
 			}
@@ -167,13 +167,14 @@ func (o *F5RouterOptions) Run() error {
 		return err
 	}
 
-	plugin := controller.NewUniqueHost(f5Plugin, o.RouteSelectionFunc())
-
 	oc, kc, err := o.Config.Clients()
 	if err != nil {
 		return err
 	}
 
+	statusPlugin := controller.NewStatusAdmitter(f5Plugin, oc, o.RouterName)
+	plugin := controller.NewUniqueHost(statusPlugin, o.RouteSelectionFunc(), statusPlugin)
+
 	factory := o.RouterSelection.NewFactory(oc, kc)
 	controller := factory.Create(plugin)
 	controller.Run()
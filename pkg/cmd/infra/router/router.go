@@ -24,6 +24,8 @@ import (
 // RouterSelection controls what routes and resources on the server are considered
 // part of this router.
 type RouterSelection struct {
+	RouterName string
+
 	ResyncInterval time.Duration
 
 	HostnameTemplate string
@@ -46,6 +48,7 @@ type RouterSelection struct {
 
 // Bind sets the appropriate labels
 func (o *RouterSelection) Bind(flag *pflag.FlagSet) {
+	flag.StringVar(&o.RouterName, "name", cmdutil.Env("ROUTER_SERVICE_NAME", "public"), "The name the router will identify itself with in the route status")
 	flag.DurationVar(&o.ResyncInterval, "resync-interval", 10*time.Minute, "The interval at which the route list should be fully refreshed")
 	flag.StringVar(&o.HostnameTemplate, "hostname-template", cmdutil.Env("ROUTER_SUBDOMAIN", ""), "If specified, a template that should be used to generate the hostname for a route without spec.host (e.g. '${name}-${namespace}.myapps.mycompany.com')")
 	flag.BoolVar(&o.OverrideHostname, "override-hostname", false, "Override the spec.host value for a route with --hostname-template")
@@ -85,6 +88,9 @@ func (o *RouterSelection) RouteSelectionFunc() controller.RouteHostFunc {
 // Complete converts string representations of field and label selectors to their parsed equivalent, or
 // returns an error.
 func (o *RouterSelection) Complete() error {
+	if len(o.RouterName) == 0 {
+		return fmt.Errorf("--name must be specified")
+	}
 	if len(o.HostnameTemplate) == 0 && o.OverrideHostname {
 		return fmt.Errorf("--override-hostname requires that --hostname-template be specified")
 	}
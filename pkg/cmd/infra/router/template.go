@@ -154,13 +154,14 @@ func (o *TemplateRouterOptions) Run() error {
 		return err
 	}
 
-	plugin := controller.NewUniqueHost(templatePlugin, o.RouteSelectionFunc())
-
 	oc, kc, err := o.Config.Clients()
 	if err != nil {
 		return err
 	}
 
+	statusPlugin := controller.NewStatusAdmitter(templatePlugin, oc, o.RouterName)
+	plugin := controller.NewUniqueHost(statusPlugin, o.RouteSelectionFunc(), statusPlugin)
+
 	factory := o.RouterSelection.NewFactory(oc, kc)
 	controller := factory.Create(plugin)
 	controller.Run()
@@ -234,6 +234,10 @@ func GetMasterFileReferences(config *MasterConfig) []*string {
 
 	refs = append(refs, &config.PolicyConfig.BootstrapPolicyFile)
 
+	if config.BuildLogArchiveConfig != nil {
+		refs = append(refs, &config.BuildLogArchiveConfig.Directory)
+	}
+
 	return refs
 }
 
@@ -230,6 +230,9 @@ type MasterConfig struct {
 	// ImageConfig holds options that describe how to build image names for system components
 	ImageConfig ImageConfig
 
+	// ImagePolicyConfig controls limits and behavior for importing images
+	ImagePolicyConfig ImagePolicyConfig
+
 	// PolicyConfig holds information about where to locate critical pieces of bootstrapping policy
 	PolicyConfig PolicyConfig
 
@@ -241,6 +244,9 @@ type MasterConfig struct {
 
 	// NetworkConfig to be passed to the compiled in network plugin
 	NetworkConfig MasterNetworkConfig
+
+	// BuildLogArchiveConfig, if present, keeps the logs of completed builds after their build pods are deleted
+	BuildLogArchiveConfig *BuildLogArchiveConfig
 }
 
 type ProjectConfig struct {
@@ -264,6 +270,22 @@ type RoutingConfig struct {
 	Subdomain string
 }
 
+type ImagePolicyConfig struct {
+	// ScheduledImageImportMinimumIntervalSeconds is the interval in seconds at which image stream tags with a scheduled import policy are
+	// checked against their upstream images
+	ScheduledImageImportMinimumIntervalSeconds int
+	// DisableScheduledImport stops image stream tags with a scheduled import policy from being checked against their upstream images
+	DisableScheduledImport bool
+	// MaxImagesBulkImportedPerRepository is the maximum number of tags of a Docker repository imported by a single
+	// image stream import request
+	MaxImagesBulkImportedPerRepository int
+}
+
+type BuildLogArchiveConfig struct {
+	// Directory is where the logs of completed builds are stored, typically a persistent volume mounted on the master
+	Directory string
+}
+
 type SecurityAllocator struct {
 	// UIDAllocatorRange defines the total set of Unix user IDs (UIDs) that will be allocated to projects automatically, and the size of the
 	// block each namespace gets. For example, 1000-1999/10 will allocate ten UIDs per namespace, and will be able to allocate up to 100 blocks
@@ -29,6 +29,12 @@ func init() {
 			if len(obj.RoutingConfig.Subdomain) == 0 {
 				obj.RoutingConfig.Subdomain = "router.default.svc.cluster.local"
 			}
+			if obj.ImagePolicyConfig.ScheduledImageImportMinimumIntervalSeconds == 0 {
+				obj.ImagePolicyConfig.ScheduledImageImportMinimumIntervalSeconds = 15 * 60
+			}
+			if obj.ImagePolicyConfig.MaxImagesBulkImportedPerRepository == 0 {
+				obj.ImagePolicyConfig.MaxImagesBulkImportedPerRepository = 5
+			}
 
 			// Populate the new NetworkConfig.ServiceNetworkCIDR field from the KubernetesMasterConfig.ServicesSubnet field if needed
 			if len(obj.NetworkConfig.ServiceNetworkCIDR) == 0 {
@@ -178,6 +178,9 @@ type MasterConfig struct {
 	// ImageConfig holds options that describe how to build image names for system components
 	ImageConfig ImageConfig `json:"imageConfig"`
 
+	// ImagePolicyConfig controls limits and behavior for importing images
+	ImagePolicyConfig ImagePolicyConfig `json:"imagePolicyConfig"`
+
 	// PolicyConfig holds information about where to locate critical pieces of bootstrapping policy
 	PolicyConfig PolicyConfig `json:"policyConfig"`
 
@@ -189,6 +192,9 @@ type MasterConfig struct {
 
 	// NetworkConfig to be passed to the compiled in network plugin
 	NetworkConfig MasterNetworkConfig `json:"networkConfig"`
+
+	// BuildLogArchiveConfig, if present, keeps the logs of completed builds after their build pods are deleted
+	BuildLogArchiveConfig *BuildLogArchiveConfig `json:"buildLogArchiveConfig"`
 }
 
 type ProjectConfig struct {
@@ -207,6 +213,11 @@ type ProjectConfig struct {
 	SecurityAllocator *SecurityAllocator `json:"securityAllocator"`
 }
 
+type BuildLogArchiveConfig struct {
+	// Directory is where the logs of completed builds are stored, typically a persistent volume mounted on the master
+	Directory string `json:"directory"`
+}
+
 type SecurityAllocator struct {
 	// UIDAllocatorRange defines the total set of Unix user IDs (UIDs) that will be allocated to projects automatically, and the size of the
 	// block each namespace gets. For example, 1000-1999/10 will allocate ten UIDs per namespace, and will be able to allocate up to 100 blocks
@@ -245,6 +256,17 @@ type RoutingConfig struct {
 	Subdomain string `json:"subdomain"`
 }
 
+type ImagePolicyConfig struct {
+	// ScheduledImageImportMinimumIntervalSeconds is the interval in seconds at which image stream tags with a scheduled import policy are
+	// checked against their upstream images. Defaults to 15 minutes.
+	ScheduledImageImportMinimumIntervalSeconds int `json:"scheduledImageImportMinimumIntervalSeconds"`
+	// DisableScheduledImport stops image stream tags with a scheduled import policy from being checked against their upstream images
+	DisableScheduledImport bool `json:"disableScheduledImport"`
+	// MaxImagesBulkImportedPerRepository is the maximum number of tags of a Docker repository imported by a single
+	// image stream import request. Defaults to 5.
+	MaxImagesBulkImportedPerRepository int `json:"maxImagesBulkImportedPerRepository"`
+}
+
 // MasterNetworkConfig to be passed to the compiled in network plugin
 type MasterNetworkConfig struct {
 	NetworkPluginName  string `json:"networkPluginName"`
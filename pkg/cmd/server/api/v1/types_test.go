@@ -79,6 +79,8 @@ assetConfig:
     maxRequestsInFlight: 0
     namedCertificates: null
     requestTimeoutSeconds: 0
+buildLogArchiveConfig:
+  directory: ""
 controllerLeaseTTL: 0
 controllers: ""
 corsAllowedOrigins: null
@@ -117,6 +119,10 @@ etcdStorageConfig:
 imageConfig:
   format: ""
   latest: false
+imagePolicyConfig:
+  disableScheduledImport: false
+  maxImagesBulkImportedPerRepository: 0
+  scheduledImageImportMinimumIntervalSeconds: 0
 kind: MasterConfig
 kubeletClientInfo:
   ca: ""
@@ -351,7 +357,8 @@ func TestMasterConfig(t *testing.T) {
 		AssetConfig: &internal.AssetConfig{
 			Extensions: []internal.AssetExtensionsConfig{{}},
 		},
-		DNSConfig: &internal.DNSConfig{},
+		DNSConfig:             &internal.DNSConfig{},
+		BuildLogArchiveConfig: &internal.BuildLogArchiveConfig{},
 	}
 	serializedConfig, err := writeYAML(config)
 	if err != nil {
@@ -110,6 +110,10 @@ func ValidateMasterConfig(config *api.MasterConfig) ValidationResults {
 		}
 	}
 
+	if config.BuildLogArchiveConfig != nil && len(config.BuildLogArchiveConfig.Directory) == 0 {
+		validationResults.AddErrors(fielderrors.NewFieldRequired("buildLogArchiveConfig.directory"))
+	}
+
 	if config.EtcdConfig != nil {
 		etcdConfigErrs := ValidateEtcdConfig(config.EtcdConfig).Prefix("etcdConfig")
 		validationResults.Append(etcdConfigErrs)
@@ -129,6 +133,13 @@ func ValidateMasterConfig(config *api.MasterConfig) ValidationResults {
 
 	validationResults.AddErrors(ValidateImageConfig(config.ImageConfig).Prefix("imageConfig")...)
 
+	if !config.ImagePolicyConfig.DisableScheduledImport && config.ImagePolicyConfig.ScheduledImageImportMinimumIntervalSeconds <= 0 {
+		validationResults.AddErrors(fielderrors.NewFieldInvalid("imagePolicyConfig.scheduledImageImportMinimumIntervalSeconds", config.ImagePolicyConfig.ScheduledImageImportMinimumIntervalSeconds, "must be a positive integer"))
+	}
+	if config.ImagePolicyConfig.MaxImagesBulkImportedPerRepository <= 0 {
+		validationResults.AddErrors(fielderrors.NewFieldInvalid("imagePolicyConfig.maxImagesBulkImportedPerRepository", config.ImagePolicyConfig.MaxImagesBulkImportedPerRepository, "must be a positive integer"))
+	}
+
 	validationResults.AddErrors(ValidateKubeletConnectionInfo(config.KubeletClientInfo).Prefix("kubeletClientInfo")...)
 
 	builtInKubernetes := config.KubernetesMasterConfig != nil
@@ -98,6 +98,27 @@ func init() {
 					Verbs:     sets.NewString("update"),
 					Resources: sets.NewString("builds"),
 				},
+				// BuildController.HistoryPruner (BuildHistoryPruner)
+				{
+					Verbs:     sets.NewString("delete"),
+					Resources: sets.NewString("builds"),
+				},
+				// BuildController.HistoryPruner (BuildHistoryPruner)
+				// BuildController.StatusNotifier (commitstatus.Notifier)
+				{
+					Verbs:     sets.NewString("get"),
+					Resources: sets.NewString("buildconfigs"),
+				},
+				// BuildController.StatusNotifier (commitstatus.Notifier)
+				{
+					Verbs:     sets.NewString("get"),
+					Resources: sets.NewString("secrets"),
+				},
+				// BuildController.PipelineClient (jenkins.Client)
+				{
+					Verbs:     sets.NewString("get"),
+					Resources: sets.NewString("services"),
+				},
 				// BuildController.ImageStreamClient (ControllerClient)
 				{
 					Verbs:     sets.NewString("get"),
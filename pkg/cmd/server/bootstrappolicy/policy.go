@@ -89,6 +89,11 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 					Verbs:     sets.NewString("get", "create"),
 					Resources: sets.NewString(authorizationapi.NodeStatsResource),
 				},
+				// Allow read access to the metrics of the integrated registry
+				{
+					Verbs:     sets.NewString("get"),
+					Resources: sets.NewString(authorizationapi.RegistryMetricsResource),
+				},
 				{
 					Verbs:           sets.NewString("get"),
 					NonResourceURLs: sets.NewString(authorizationapi.NonResourceAll),
@@ -102,7 +107,7 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 			Rules: []authorizationapi.PolicyRule{
 				{
 					Verbs:     sets.NewString("get", "list", "watch", "create", "update", "patch", "delete"),
-					Resources: sets.NewString(authorizationapi.OpenshiftExposedGroupName, authorizationapi.PermissionGrantingGroupName, authorizationapi.KubeExposedGroupName, "projects", "secrets", "pods/attach", "pods/proxy", "pods/exec", "pods/portforward", authorizationapi.DockerBuildResource, authorizationapi.SourceBuildResource, authorizationapi.CustomBuildResource, "deploymentconfigs/scale"),
+					Resources: sets.NewString(authorizationapi.OpenshiftExposedGroupName, authorizationapi.PermissionGrantingGroupName, authorizationapi.KubeExposedGroupName, "projects", "secrets", "pods/attach", "pods/proxy", "pods/exec", "pods/portforward", authorizationapi.DockerBuildResource, authorizationapi.SourceBuildResource, authorizationapi.CustomBuildResource, authorizationapi.JenkinsPipelineBuildResource, "deploymentconfigs/scale"),
 				},
 				{
 					APIGroups: []string{authorizationapi.APIGroupExtensions},
@@ -127,7 +132,7 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 			Rules: []authorizationapi.PolicyRule{
 				{
 					Verbs:     sets.NewString("get", "list", "watch", "create", "update", "patch", "delete"),
-					Resources: sets.NewString(authorizationapi.OpenshiftExposedGroupName, authorizationapi.KubeExposedGroupName, "secrets", "pods/attach", "pods/proxy", "pods/exec", "pods/portforward", authorizationapi.DockerBuildResource, authorizationapi.SourceBuildResource, authorizationapi.CustomBuildResource, "deploymentconfigs/scale"),
+					Resources: sets.NewString(authorizationapi.OpenshiftExposedGroupName, authorizationapi.KubeExposedGroupName, "secrets", "pods/attach", "pods/proxy", "pods/exec", "pods/portforward", authorizationapi.DockerBuildResource, authorizationapi.SourceBuildResource, authorizationapi.CustomBuildResource, authorizationapi.JenkinsPipelineBuildResource, "deploymentconfigs/scale"),
 				},
 				{
 					APIGroups: []string{authorizationapi.APIGroupExtensions},
@@ -290,6 +295,16 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 					Verbs:     sets.NewString("get"),
 					Resources: sets.NewString("pods/log"),
 				},
+				{
+					// HookExecutor.tagImages
+					Verbs:     sets.NewString("get", "create", "update"),
+					Resources: sets.NewString("imagestreams"),
+				},
+				{
+					// BlueGreenDeploymentStrategy.services
+					Verbs:     sets.NewString("get", "update"),
+					Resources: sets.NewString("services"),
+				},
 			},
 		},
 		{
@@ -324,6 +339,11 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 					Verbs:     sets.NewString("list", "watch"),
 					Resources: sets.NewString("routes", "endpoints"),
 				},
+				// routers write the status of the routes they admit or reject
+				{
+					Verbs:     sets.NewString("update"),
+					Resources: sets.NewString("routes/status"),
+				},
 			},
 		},
 		{
@@ -332,17 +352,22 @@ func GetBootstrapClusterRoles() []authorizationapi.ClusterRole {
 			},
 			Rules: []authorizationapi.PolicyRule{
 				{
-					Verbs:     sets.NewString("get", "delete"),
+					Verbs:     sets.NewString("get", "list", "delete"),
 					Resources: sets.NewString("images"),
 				},
 				{
 					Verbs:     sets.NewString("get"),
-					Resources: sets.NewString("imagestreamimages", "imagestreamtags", "imagestreams"),
+					Resources: sets.NewString("imagestreamimages", "imagestreamtags", "imagestreams", "imagestreams/secrets"),
 				},
 				{
 					Verbs:     sets.NewString("update"),
 					Resources: sets.NewString("imagestreams"),
 				},
+				{
+					// used to enforce the image storage quota of projects
+					Verbs:     sets.NewString("list"),
+					Resources: sets.NewString("imagestreams", "resourcequotas"),
+				},
 				{
 					Verbs:     sets.NewString("create"),
 					Resources: sets.NewString("imagestreammappings"),
@@ -33,8 +33,10 @@ import (
 	buildconfigetcd "github.com/openshift/origin/pkg/build/registry/buildconfig/etcd"
 	buildlogregistry "github.com/openshift/origin/pkg/build/registry/buildlog"
 	"github.com/openshift/origin/pkg/build/webhook"
+	"github.com/openshift/origin/pkg/build/webhook/bitbucket"
 	"github.com/openshift/origin/pkg/build/webhook/generic"
 	"github.com/openshift/origin/pkg/build/webhook/github"
+	"github.com/openshift/origin/pkg/build/webhook/gitlab"
 	"github.com/openshift/origin/pkg/cmd/server/crypto"
 	cmdutil "github.com/openshift/origin/pkg/cmd/util"
 	deployconfiggenerator "github.com/openshift/origin/pkg/deploy/generator"
@@ -42,11 +44,14 @@ import (
 	deployconfigetcd "github.com/openshift/origin/pkg/deploy/registry/deployconfig/etcd"
 	deploylogregistry "github.com/openshift/origin/pkg/deploy/registry/deploylog"
 	deployrollback "github.com/openshift/origin/pkg/deploy/registry/rollback"
+	"github.com/openshift/origin/pkg/dockerregistry"
 	"github.com/openshift/origin/pkg/image/registry/image"
 	imageetcd "github.com/openshift/origin/pkg/image/registry/image/etcd"
+	"github.com/openshift/origin/pkg/image/registry/imagesecret"
 	"github.com/openshift/origin/pkg/image/registry/imagestream"
 	imagestreametcd "github.com/openshift/origin/pkg/image/registry/imagestream/etcd"
 	"github.com/openshift/origin/pkg/image/registry/imagestreamimage"
+	"github.com/openshift/origin/pkg/image/registry/imagestreamimport"
 	"github.com/openshift/origin/pkg/image/registry/imagestreammapping"
 	"github.com/openshift/origin/pkg/image/registry/imagestreamtag"
 	accesstokenetcd "github.com/openshift/origin/pkg/oauth/registry/oauthaccesstoken/etcd"
@@ -379,6 +384,8 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 	imageStreamTagRegistry := imagestreamtag.NewRegistry(imageStreamTagStorage)
 	imageStreamImageStorage := imagestreamimage.NewREST(imageRegistry, imageStreamRegistry)
 	imageStreamImageRegistry := imagestreamimage.NewRegistry(imageStreamImageStorage)
+	imageStreamSecretsStorage := imagesecret.NewREST(c.ImageStreamSecretClient())
+	imageStreamImportStorage := imagestreamimport.NewREST(imageStreamRegistry, imageStreamMappingStorage, dockerregistry.NewClient(10*time.Second), c.Options.ImagePolicyConfig.MaxImagesBulkImportedPerRepository)
 
 	buildGenerator := &buildgenerator.BuildGenerator{
 		Client: buildgenerator.Client{
@@ -394,15 +401,16 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 		Secrets:         c.KubeClient(),
 	}
 
+	configClient, kclient := c.DeploymentConfigClients()
 	// TODO: with sharding, this needs to be changed
 	deployConfigGenerator := &deployconfiggenerator.DeploymentConfigGenerator{
 		Client: deployconfiggenerator.Client{
 			DCFn:   deployConfigRegistry.GetDeploymentConfig,
 			ISFn:   imageStreamRegistry.GetImageStream,
 			LISFn2: imageStreamRegistry.ListImageStreams,
+			SFn:    clientSecretInterface{kclient}.GetSecret,
 		},
 	}
-	configClient, kclient := c.DeploymentConfigClients()
 	deployRollback := &deployrollback.RollbackGenerator{}
 	deployRollbackClient := deployrollback.Client{
 		DCFn: deployConfigRegistry.GetDeploymentConfig,
@@ -424,18 +432,22 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 		buildConfigRegistry,
 		buildclient.NewOSClientBuildConfigInstantiatorClient(bcClient),
 		map[string]webhook.Plugin{
-			"generic": generic.New(),
-			"github":  github.New(),
+			"generic":   generic.New(),
+			"github":    github.New(),
+			"gitlab":    gitlab.New(),
+			"bitbucket": bitbucket.New(),
 		},
 	)
 
 	storage := map[string]rest.Storage{
-		"images":              imageStorage,
-		"imageStreams":        imageStreamStorage,
-		"imageStreams/status": imageStreamStatusStorage,
-		"imageStreamImages":   imageStreamImageStorage,
-		"imageStreamMappings": imageStreamMappingStorage,
-		"imageStreamTags":     imageStreamTagStorage,
+		"images":               imageStorage,
+		"imageStreams":         imageStreamStorage,
+		"imageStreams/status":  imageStreamStatusStorage,
+		"imageStreams/secrets": imageStreamSecretsStorage,
+		"imageStreamImages":    imageStreamImageStorage,
+		"imageStreamImports":   imageStreamImportStorage,
+		"imageStreamMappings":  imageStreamMappingStorage,
+		"imageStreamTags":      imageStreamTagStorage,
 
 		"deploymentConfigs":         deployConfigStorage.DeploymentConfig,
 		"deploymentConfigs/scale":   deployConfigStorage.Scale,
@@ -489,7 +501,7 @@ func (c *MasterConfig) GetRestStorage() map[string]rest.Storage {
 		storage["builds/clone"] = buildclone.NewStorage(buildGenerator)
 		storage["buildConfigs/instantiate"] = buildconfiginstantiate.NewStorage(buildGenerator)
 		storage["buildConfigs/instantiatebinary"] = buildconfiginstantiate.NewBinaryStorage(buildGenerator, buildStorage, c.BuildLogClient(), kubeletClient)
-		storage["builds/log"] = buildlogregistry.NewREST(buildStorage, buildStorage, c.BuildLogClient(), kubeletClient)
+		storage["builds/log"] = buildlogregistry.NewREST(buildStorage, buildStorage, c.BuildLogClient(), kubeletClient, c.BuildLogArchive(), c.BuildPipelineLogs())
 		storage["builds/details"] = buildDetailsStorage
 	}
 
@@ -640,3 +652,14 @@ type clientDeploymentInterface struct {
 func (c clientDeploymentInterface) GetDeployment(ctx kapi.Context, name string) (*kapi.ReplicationController, error) {
 	return c.KubeClient.ReplicationControllers(kapi.NamespaceValue(ctx)).Get(name)
 }
+
+// clientSecretInterface is a struct implementing the secret getter of the
+// deployment config generator using a client
+type clientSecretInterface struct {
+	KubeClient kclient.Interface
+}
+
+// GetSecret returns the secret with the provided context and name
+func (c clientSecretInterface) GetSecret(ctx kapi.Context, name string) (*kapi.Secret, error) {
+	return c.KubeClient.Secrets(kapi.NamespaceValue(ctx)).Get(name)
+}
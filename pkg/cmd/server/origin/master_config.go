@@ -44,6 +44,9 @@ import (
 	policybindingregistry "github.com/openshift/origin/pkg/authorization/registry/policybinding"
 	policybindingetcd "github.com/openshift/origin/pkg/authorization/registry/policybinding/etcd"
 	"github.com/openshift/origin/pkg/authorization/rulevalidation"
+	"github.com/openshift/origin/pkg/build/jenkins"
+	"github.com/openshift/origin/pkg/build/logarchive"
+	buildlogregistry "github.com/openshift/origin/pkg/build/registry/buildlog"
 	osclient "github.com/openshift/origin/pkg/client"
 	configapi "github.com/openshift/origin/pkg/cmd/server/api"
 	"github.com/openshift/origin/pkg/cmd/server/bootstrappolicy"
@@ -385,6 +388,28 @@ func (c *MasterConfig) BuildLogClient() *kclient.Client {
 	return c.PrivilegedLoopbackKubernetesClient
 }
 
+// BuildPipelineLogs returns the locator of the logs of Jenkins Pipeline
+// builds, which finds Jenkins through its service in the namespace of the build
+func (c *MasterConfig) BuildPipelineLogs() buildlogregistry.PipelineLogLocator {
+	return &jenkins.Client{
+		ResolveURL: jenkins.NewServiceURLResolver(c.PrivilegedLoopbackKubernetesClient, jenkins.DefaultServiceName),
+	}
+}
+
+// BuildLogArchive returns the archive holding the logs of completed builds,
+// or nil if build logs are not archived
+func (c *MasterConfig) BuildLogArchive() logarchive.Archive {
+	if c.Options.BuildLogArchiveConfig == nil {
+		return nil
+	}
+	return logarchive.NewDirectoryArchive(c.Options.BuildLogArchiveConfig.Directory)
+}
+
+// ImageStreamSecretClient returns the client capable of retrieving secrets for an image secret wrapper
+func (c *MasterConfig) ImageStreamSecretClient() *kclient.Client {
+	return c.PrivilegedLoopbackKubernetesClient
+}
+
 // BuildConfigWebHookClient returns the webhook client object
 func (c *MasterConfig) BuildConfigWebHookClient() *osclient.Client {
 	return c.PrivilegedLoopbackOpenShiftClient
@@ -424,6 +449,16 @@ func (c *MasterConfig) ImageImportControllerClient() *osclient.Client {
 	return c.PrivilegedLoopbackOpenShiftClient
 }
 
+// ScheduledImageImportControllerClient returns the scheduled image import controller client object
+func (c *MasterConfig) ScheduledImageImportControllerClient() *osclient.Client {
+	return c.PrivilegedLoopbackOpenShiftClient
+}
+
+// ImageStorageQuotaControllerClients returns the image storage quota controller client objects
+func (c *MasterConfig) ImageStorageQuotaControllerClients() (*osclient.Client, *kclient.Client) {
+	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient
+}
+
 // DeploymentConfigScaleClient returns the client used by the Scale subresource registry
 func (c *MasterConfig) DeploymentConfigScaleClient() *kclient.Client {
 	return c.PrivilegedLoopbackKubernetesClient
@@ -463,6 +498,11 @@ func (c *MasterConfig) DeploymentImageChangeTriggerControllerClient() *osclient.
 	return c.PrivilegedLoopbackOpenShiftClient
 }
 
+// DeploymentSecretChangeTriggerControllerClients returns the deploymentConfig secret change controller client objects
+func (c *MasterConfig) DeploymentSecretChangeTriggerControllerClients() (*osclient.Client, *kclient.Client) {
+	return c.PrivilegedLoopbackOpenShiftClient, c.PrivilegedLoopbackKubernetesClient
+}
+
 // DeploymentLogClient returns the deployment log client object
 func (c *MasterConfig) DeploymentLogClient() *kclient.Client {
 	return c.PrivilegedLoopbackKubernetesClient
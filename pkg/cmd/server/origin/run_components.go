@@ -27,8 +27,10 @@ import (
 	deploycontroller "github.com/openshift/origin/pkg/deploy/controller/deployment"
 	deployconfigcontroller "github.com/openshift/origin/pkg/deploy/controller/deploymentconfig"
 	imagechangecontroller "github.com/openshift/origin/pkg/deploy/controller/imagechange"
+	secretchangecontroller "github.com/openshift/origin/pkg/deploy/controller/secretchange"
 	"github.com/openshift/origin/pkg/dns"
 	imagecontroller "github.com/openshift/origin/pkg/image/controller"
+	imagequota "github.com/openshift/origin/pkg/image/quota"
 	projectcache "github.com/openshift/origin/pkg/project/cache"
 	projectcontroller "github.com/openshift/origin/pkg/project/controller"
 	securitycontroller "github.com/openshift/origin/pkg/security/controller"
@@ -217,6 +219,8 @@ func (c *MasterConfig) RunBuildController() {
 			// TODO: this will be set to --storage-version (the internal schema we use)
 			Codec: interfaces.Codec,
 		},
+		PublicURL:  c.Options.MasterPublicURL,
+		LogArchive: c.BuildLogArchive(),
 	}
 
 	controller := factory.Create()
@@ -232,6 +236,8 @@ func (c *MasterConfig) RunBuildPodController() {
 		OSClient:     osclient,
 		KubeClient:   kclient,
 		BuildUpdater: buildclient.NewOSClientBuildClient(osclient),
+		PublicURL:    c.Options.MasterPublicURL,
+		LogArchive:   c.BuildLogArchive(),
 	}
 	controller := factory.Create()
 	controller.Run()
@@ -285,8 +291,9 @@ func (c *MasterConfig) RunDeploymentController() {
 
 // RunDeployerPodController starts the deployer pod controller process.
 func (c *MasterConfig) RunDeployerPodController() {
-	_, kclient := c.DeployerPodControllerClients()
+	osclient, kclient := c.DeployerPodControllerClients()
 	factory := deployerpodcontroller.DeployerPodControllerFactory{
+		Client:     osclient,
 		KubeClient: kclient,
 	}
 
@@ -326,6 +333,14 @@ func (c *MasterConfig) RunDeploymentImageChangeTriggerController() {
 	controller.Run()
 }
 
+// RunDeploymentSecretChangeTriggerController starts the secret change trigger controller process.
+func (c *MasterConfig) RunDeploymentSecretChangeTriggerController() {
+	oClient, kClient := c.DeploymentSecretChangeTriggerControllerClients()
+	factory := secretchangecontroller.SecretChangeControllerFactory{Client: oClient, KubeClient: kClient}
+	controller := factory.Create()
+	controller.Run()
+}
+
 // RunSDNController runs openshift-sdn if the said network plugin is provided
 func (c *MasterConfig) RunSDNController() {
 	oClient, kClient := c.SDNControllerClients()
@@ -352,6 +367,27 @@ func (c *MasterConfig) RunImageImportController() {
 	controller.Run()
 }
 
+// RunScheduledImageImportController starts the controller which periodically re-imports image stream tags
+// with a scheduled import policy.
+func (c *MasterConfig) RunScheduledImageImportController() {
+	if c.Options.ImagePolicyConfig.DisableScheduledImport {
+		glog.V(3).Infof("Scheduled image import is disabled - image stream tags will only be imported once")
+		return
+	}
+	interval := time.Duration(c.Options.ImagePolicyConfig.ScheduledImageImportMinimumIntervalSeconds) * time.Second
+	controller := imagecontroller.NewScheduledImportController(interval, c.ScheduledImageImportControllerClient())
+	go controller.RunUntil(util.NeverStop)
+}
+
+// RunImageStorageQuotaController starts the controller which records the storage used by the images of each
+// project on the quotas which limit it.
+func (c *MasterConfig) RunImageStorageQuotaController() {
+	osclient, kclient := c.ImageStorageQuotaControllerClients()
+	// the usage is recomputed from every image of the projects, so it is synced less often than other quotas
+	controller := imagequota.NewStorageQuotaController(time.Minute, osclient, kclient)
+	go controller.RunUntil(util.NeverStop)
+}
+
 // RunSecurityAllocationController starts the security allocation controller process.
 func (c *MasterConfig) RunSecurityAllocationController() {
 	alloc := c.Options.ProjectConfig.SecurityAllocator
@@ -574,7 +574,10 @@ func startControllers(oc *origin.MasterConfig, kc *kubernetes.MasterConfig) erro
 	oc.RunDeploymentConfigController()
 	oc.RunDeploymentConfigChangeController()
 	oc.RunDeploymentImageChangeTriggerController()
+	oc.RunDeploymentSecretChangeTriggerController()
 	oc.RunImageImportController()
+	oc.RunScheduledImageImportController()
+	oc.RunImageStorageQuotaController()
 	oc.RunOriginNamespaceController()
 	oc.RunSDNController()
 
@@ -3,6 +3,7 @@ package test
 import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/resource"
+	kutil "k8s.io/kubernetes/pkg/util"
 
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	imageapi "github.com/openshift/origin/pkg/image/api"
@@ -85,6 +86,32 @@ func OkRollingStrategy() deployapi.DeploymentStrategy {
 	}
 }
 
+func OkCanaryStrategy() deployapi.DeploymentStrategy {
+	timeout := int64(20)
+	return deployapi.DeploymentStrategy{
+		Type: deployapi.DeploymentStrategyTypeCanary,
+		CanaryParams: &deployapi.CanaryDeploymentStrategyParams{
+			Steps: []deployapi.CanaryStep{
+				{Replicas: kutil.NewIntOrStringFromInt(1)},
+				{Replicas: kutil.NewIntOrStringFromString("50%")},
+			},
+			TimeoutSeconds: &timeout,
+		},
+	}
+}
+
+func OkBlueGreenStrategy() deployapi.DeploymentStrategy {
+	timeout := int64(20)
+	return deployapi.DeploymentStrategy{
+		Type: deployapi.DeploymentStrategyTypeBlueGreen,
+		BlueGreenParams: &deployapi.BlueGreenDeploymentStrategyParams{
+			ServiceName:     "service1",
+			TimeoutSeconds:  &timeout,
+			KeepWarmSeconds: 60,
+		},
+	}
+}
+
 func OkSelector() map[string]string {
 	return map[string]string{"a": "b"}
 }
@@ -34,6 +34,10 @@ type DeploymentStrategy struct {
 	RecreateParams *RecreateDeploymentStrategyParams
 	// RollingParams are the input to the Rolling deployment strategy.
 	RollingParams *RollingDeploymentStrategyParams
+	// CanaryParams are the input to the Canary deployment strategy.
+	CanaryParams *CanaryDeploymentStrategyParams
+	// BlueGreenParams are the input to the BlueGreen deployment strategy.
+	BlueGreenParams *BlueGreenDeploymentStrategyParams
 	// Resources contains resource requirements to execute the deployment
 	Resources kapi.ResourceRequirements
 	// Labels is a set of key, value pairs added to custom deployer and lifecycle pre/post hook pods.
@@ -52,6 +56,13 @@ const (
 	DeploymentStrategyTypeCustom DeploymentStrategyType = "Custom"
 	// DeploymentStrategyTypeRolling uses the Kubernetes RollingUpdater.
 	DeploymentStrategyTypeRolling DeploymentStrategyType = "Rolling"
+	// DeploymentStrategyTypeCanary scales the new deployment up through a
+	// series of steps, verifying each step before moving on to the next.
+	DeploymentStrategyTypeCanary DeploymentStrategyType = "Canary"
+	// DeploymentStrategyTypeBlueGreen scales the new deployment up alongside
+	// the previous deployment and switches a service over to it once it's
+	// ready.
+	DeploymentStrategyTypeBlueGreen DeploymentStrategyType = "BlueGreen"
 )
 
 // CustomDeploymentStrategyParams are the input to the Custom deployment strategy.
@@ -70,6 +81,10 @@ type RecreateDeploymentStrategyParams struct {
 	// Pre is a lifecycle hook which is executed before the strategy manipulates
 	// the deployment. All LifecycleHookFailurePolicy values are supported.
 	Pre *LifecycleHook
+	// Mid is a lifecycle hook which is executed while the deployment is scaled
+	// down to zero before the first new pod is created. All
+	// LifecycleHookFailurePolicy values are supported.
+	Mid *LifecycleHook
 	// Post is a lifecycle hook which is executed after the strategy has
 	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
 	// is NOT supported.
@@ -82,6 +97,9 @@ type LifecycleHook struct {
 	FailurePolicy LifecycleHookFailurePolicy
 	// ExecNewPod specifies the options for a lifecycle hook backed by a pod.
 	ExecNewPod *ExecNewPodHook
+	// TagImages specifies the images of the deployment which are tagged into
+	// image streams by the hook. Tagging is performed by the deployer.
+	TagImages []TagImageHook
 }
 
 // LifecycleHookFailurePolicy describes possibles actions to take if a hook fails.
@@ -96,6 +114,17 @@ const (
 	LifecycleHookFailurePolicyIgnore LifecycleHookFailurePolicy = "Ignore"
 )
 
+// TagImageHook is a hook implementation which tags the image of a container
+// of the deployment into an image stream tag.
+type TagImageHook struct {
+	// ContainerName is the name of a container in the deployment template
+	// whose image is tagged.
+	ContainerName string
+	// To is the ImageStreamTag the image is tagged into. The namespace of the
+	// deployment is used if no namespace is specified.
+	To kapi.ObjectReference
+}
+
 // ExecNewPodHook is a hook implementation which runs a command in a new pod
 // based on the specified container which is assumed to be part of the
 // deployment template.
@@ -124,6 +153,16 @@ type RollingDeploymentStrategyParams struct {
 	// TimeoutSeconds is the time to wait for updates before giving up. If the
 	// value is nil, a default will be used.
 	TimeoutSeconds *int64
+	// ProgressDeadlineSeconds is the maximum time the deployment may go
+	// without making progress before it is considered failed. Progress is
+	// made when more pods of the new deployment are ready than ever before
+	// without any of their containers restarting, so crash looping pods
+	// never count as progress. If the value is nil, there is no deadline.
+	ProgressDeadlineSeconds *int64
+	// AutoRollback rolls the deployment config back to the last complete
+	// deployment when the deployment fails because its progress deadline was
+	// exceeded.
+	AutoRollback bool
 	// The maximum number of pods that can be unavailable during the update.
 	// Value can be an absolute number (ex: 5) or a percentage of total pods at the start of update (ex: 10%).
 	// Absolute number is calculated from percentage by rounding up.
@@ -160,6 +199,43 @@ type RollingDeploymentStrategyParams struct {
 	Post *LifecycleHook
 }
 
+// CanaryDeploymentStrategyParams are the input to the Canary deployment
+// strategy.
+type CanaryDeploymentStrategyParams struct {
+	// Steps are the steps the new deployment is scaled up through in order.
+	// The previous deployment is scaled down as the new deployment is scaled
+	// up so the total number of pods stays the same. Once all the steps are
+	// complete, the new deployment is scaled up to the desired replica count.
+	Steps []CanaryStep
+	// TimeoutSeconds is the time to wait for the pods of a step to become
+	// ready before giving up. If the value is nil, a default will be used.
+	TimeoutSeconds *int64
+	// Pre is a lifecycle hook which is executed before the deployment process
+	// begins. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook
+	// Post is a lifecycle hook which is executed after the strategy has
+	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
+	// is NOT supported.
+	Post *LifecycleHook
+}
+
+// CanaryStep is a step of a Canary deployment.
+type CanaryStep struct {
+	// Replicas is the number of replicas of the new deployment for the step.
+	// Value can be an absolute number (ex: 5) or a percentage of the desired
+	// replicas (ex: 10%). Absolute number is calculated from percentage by
+	// rounding up.
+	Replicas kutil.IntOrString
+	// PauseSeconds is the time to wait once the pods of the step are ready
+	// before verifying the step.
+	PauseSeconds int64
+	// Verify is a lifecycle hook which is executed once the pods of the step
+	// are ready. If the hook fails, the deployment is aborted and scaled back
+	// to the previous deployment. All LifecycleHookFailurePolicy values are
+	// supported.
+	Verify *LifecycleHook
+}
+
 const (
 	// DefaultRollingTimeoutSeconds is the default TimeoutSeconds for RollingDeploymentStrategyParams.
 	DefaultRollingTimeoutSeconds int64 = 10 * 60
@@ -169,6 +245,50 @@ const (
 	DefaultRollingUpdatePeriodSeconds int64 = 1
 )
 
+// DefaultCanaryTimeoutSeconds is the default TimeoutSeconds for
+// CanaryDeploymentStrategyParams.
+const DefaultCanaryTimeoutSeconds int64 = 10 * 60
+
+// BlueGreenDeploymentStrategyParams are the input to the BlueGreen deployment
+// strategy.
+type BlueGreenDeploymentStrategyParams struct {
+	// ServiceName is the name of the service which is switched to the new
+	// deployment once all of its pods are ready. The selector of the service
+	// is pinned to the pods of the new deployment, so routes to the service
+	// switch along with it.
+	ServiceName string
+	// TimeoutSeconds is the time to wait for the pods of the new deployment to
+	// become ready before giving up. If the value is nil, a default will be
+	// used.
+	TimeoutSeconds *int64
+	// KeepWarmSeconds is the time the previous deployment is kept at full
+	// scale once the service has been switched, during which cancelling the
+	// deployment switches the service straight back to it.
+	KeepWarmSeconds int64
+	// Pre is a lifecycle hook which is executed before the deployment process
+	// begins. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook
+	// Post is a lifecycle hook which is executed after the strategy has
+	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
+	// is NOT supported.
+	Post *LifecycleHook
+}
+
+// DefaultBlueGreenTimeoutSeconds is the default TimeoutSeconds for
+// BlueGreenDeploymentStrategyParams.
+const DefaultBlueGreenTimeoutSeconds int64 = 10 * 60
+
+// DeploymentColor is the color of a deployment made by the BlueGreen
+// strategy. Consecutive deployments alternate between the two colors.
+type DeploymentColor string
+
+const (
+	// DeploymentColorBlue is the color of the first deployment.
+	DeploymentColorBlue DeploymentColor = "blue"
+	// DeploymentColorGreen is the color of the deployment after a blue one.
+	DeploymentColorGreen DeploymentColor = "green"
+)
+
 // These constants represent keys used for correlating objects related to deployments.
 const (
 	// DeploymentConfigAnnotation is an annotation name used to correlate a deployment with the
@@ -215,9 +335,18 @@ const (
 	// DeploymentCancelledAnnotation indicates that the deployment has been cancelled
 	// The annotation value does not matter and its mere presence indicates cancellation
 	DeploymentCancelledAnnotation = "openshift.io/deployment.cancelled"
+	// DeploymentAutoRolledBackAnnotation is an annotation on a deployment which
+	// exceeded its progress deadline and was automatically rolled back. The
+	// annotation value is the name of the deployment the config was rolled
+	// back to.
+	DeploymentAutoRolledBackAnnotation = "openshift.io/deployment.auto-rolled-back-to"
 	// DeploymentReplicasAnnotation is for internal use only and is for
 	// detecting external modifications to deployment replica counts.
 	DeploymentReplicasAnnotation = "openshift.io/deployment.replicas"
+	// DeploymentColorAnnotation is an annotation on a deployment made by the
+	// BlueGreen strategy. The annotation value is the DeploymentColor of the
+	// deployment.
+	DeploymentColorAnnotation = "openshift.io/deployment.color"
 )
 
 // These constants represent the various reasons for cancelling a deployment
@@ -227,6 +356,7 @@ const (
 	DeploymentCancelledNewerDeploymentExists  = "The deployment was cancelled as a newer deployment was found running"
 	DeploymentFailedUnrelatedDeploymentExists = "The deployment failed as an unrelated pod with the same name as this deployment is already running"
 	DeploymentFailedDeployerPodNoLongerExists = "The deployment failed as the deployer pod no longer exists"
+	DeploymentFailedProgressDeadlineExceeded  = "The deployment failed as it made no progress within its progress deadline"
 )
 
 // MaxDeploymentDurationSeconds represents the maximum duration that a deployment is allowed to run
@@ -273,6 +403,17 @@ type DeploymentConfigSpec struct {
 	// insufficient replicas are detected. Internally, this takes precedence over a
 	// TemplateRef.
 	Template *kapi.PodTemplateSpec
+
+	// RevisionHistoryLimit is the number of old inactive deployments to
+	// retain. Older deployments are deleted along with their deployer and hook
+	// pods. The active deployment and the deployment a rollback would target
+	// are never deleted. No deployments are deleted if this is unset.
+	RevisionHistoryLimit *int
+
+	// Paused indicates that the deployment config is paused. Triggers do not
+	// start new deployments while the config is paused; resuming it starts a
+	// single deployment for all the changes made in the meantime.
+	Paused bool
 }
 
 // DeploymentConfigStatus represents the current deployment state.
@@ -280,9 +421,87 @@ type DeploymentConfigStatus struct {
 	// LatestVersion is used to determine whether the current deployment associated with a DeploymentConfig
 	// is out of sync.
 	LatestVersion int
+	// ObservedGeneration is the most recent generation of the config acted on
+	// by the deploymentconfig controller. Once it matches the generation of
+	// the config, the rest of the status reflects the latest spec.
+	ObservedGeneration int64
 	// Details are the reasons for the update to this deployment config.
 	// This could be based on a change made by the user or caused by an automatic trigger
 	Details *DeploymentDetails
+	// LiveColor is the color of the deployment the service of a BlueGreen
+	// strategy is switched to.
+	LiveColor DeploymentColor
+	// LiveDeployment is the name of the deployment the service of a BlueGreen
+	// strategy is switched to.
+	LiveDeployment string
+	// UpdatedReplicas is the number of pods of the latest deployment.
+	UpdatedReplicas int
+	// ReadyReplicas is the number of ready pods of all the deployments of the
+	// config. It is refreshed when the config or one of its deployer pods
+	// changes and at least every two minutes, not when pods become ready, so
+	// it may lag behind the pods.
+	ReadyReplicas int
+	// AvailableReplicas is the number of ready pods of all the deployments of
+	// the config which aren't being terminated. Like ReadyReplicas, it may be
+	// up to two minutes out of date.
+	AvailableReplicas int
+	// Conditions are the latest available observations of the state of the
+	// config.
+	Conditions []DeploymentCondition
+}
+
+// DeploymentConditionType is a kind of DeploymentCondition.
+type DeploymentConditionType string
+
+const (
+	// DeploymentAvailable means at least as many pods as the replica count of
+	// the config are available.
+	DeploymentAvailable DeploymentConditionType = "Available"
+	// DeploymentProgressing means the latest deployment is running or has
+	// completed. It is false once the latest deployment has failed.
+	DeploymentProgressing DeploymentConditionType = "Progressing"
+)
+
+// These constants are the reasons of DeploymentConditions.
+const (
+	// MinimumReplicasAvailable is the reason of an Available condition which
+	// is true.
+	MinimumReplicasAvailable = "MinimumReplicasAvailable"
+	// MinimumReplicasUnavailable is the reason of an Available condition which
+	// is false.
+	MinimumReplicasUnavailable = "MinimumReplicasUnavailable"
+	// NewDeploymentRequested is the reason of a Progressing condition while
+	// the latest deployment hasn't been created yet.
+	NewDeploymentRequested = "NewDeploymentRequested"
+	// DeploymentRunning is the reason of a Progressing condition while the
+	// latest deployment is running.
+	DeploymentRunning = "DeploymentRunning"
+	// DeploymentComplete is the reason of a Progressing condition once the
+	// latest deployment has completed.
+	DeploymentComplete = "DeploymentComplete"
+	// DeploymentFailed is the reason of a Progressing condition once the
+	// latest deployment has failed.
+	DeploymentFailed = "DeploymentFailed"
+	// DeploymentCancelled is the reason of a Progressing condition once the
+	// latest deployment has been cancelled.
+	DeploymentCancelled = "DeploymentCancelled"
+)
+
+// DeploymentCondition describes the state of a config at a certain point.
+type DeploymentCondition struct {
+	// Type is the type of the condition.
+	Type DeploymentConditionType
+	// Status of the condition, one of True, False or Unknown.
+	Status kapi.ConditionStatus
+	// LastTransitionTime is the last time the condition changed from one
+	// status to another.
+	LastTransitionTime unversioned.Time
+	// Reason is a brief machine readable reason for the last transition of the
+	// condition.
+	Reason string
+	// Message is a human readable description of the last transition of the
+	// condition.
+	Message string
 }
 
 // DeploymentTriggerPolicy describes a policy for a single trigger that results in a new deployment.
@@ -291,6 +510,8 @@ type DeploymentTriggerPolicy struct {
 	Type DeploymentTriggerType
 	// ImageChangeParams represents the parameters for the ImageChange trigger.
 	ImageChangeParams *DeploymentTriggerImageChangeParams
+	// SecretChangeParams represents the parameters for the SecretChange trigger.
+	SecretChangeParams *DeploymentTriggerSecretChangeParams
 }
 
 // DeploymentTriggerType refers to a specific DeploymentTriggerPolicy implementation.
@@ -305,6 +526,9 @@ const (
 	// DeploymentTriggerOnConfigChange will create new deployments in response to changes to
 	// the ControllerTemplate of a DeploymentConfig.
 	DeploymentTriggerOnConfigChange DeploymentTriggerType = "ConfigChange"
+	// DeploymentTriggerOnSecretChange will create new deployments in response to changes to
+	// the contents of the secrets referenced by the ControllerTemplate of a DeploymentConfig.
+	DeploymentTriggerOnSecretChange DeploymentTriggerType = "SecretChange"
 )
 
 // DeploymentTriggerImageChangeParams represents the parameters to the ImageChange trigger.
@@ -321,6 +545,13 @@ type DeploymentTriggerImageChangeParams struct {
 	LastTriggeredImage string
 }
 
+// DeploymentTriggerSecretChangeParams represents the parameters to the SecretChange trigger.
+type DeploymentTriggerSecretChangeParams struct {
+	// LastTriggeredSecrets maps the name of each secret referenced by the pod template to a
+	// hash of its contents when it was last seen by the trigger.
+	LastTriggeredSecrets map[string]string
+}
+
 // DeploymentDetails captures information about the causes of a deployment.
 type DeploymentDetails struct {
 	// Message is the user specified change message, if this deployment was triggered manually by the user
@@ -335,6 +566,8 @@ type DeploymentCause struct {
 	Type DeploymentTriggerType
 	// ImageTrigger contains the image trigger details, if this trigger was fired based on an image change
 	ImageTrigger *DeploymentCauseImageTrigger
+	// SecretTrigger contains the secret trigger details, if this trigger was fired based on a secret change
+	SecretTrigger *DeploymentCauseSecretTrigger
 }
 
 // DeploymentCauseImageTrigger contains information about a deployment caused by an image trigger
@@ -344,6 +577,12 @@ type DeploymentCauseImageTrigger struct {
 	From kapi.ObjectReference
 }
 
+// DeploymentCauseSecretTrigger contains information about a deployment caused by a secret trigger
+type DeploymentCauseSecretTrigger struct {
+	// From is a reference to the Secret whose contents changed.
+	From kapi.ObjectReference
+}
+
 // DeploymentConfigList is a collection of deployment configs.
 type DeploymentConfigList struct {
 	unversioned.TypeMeta
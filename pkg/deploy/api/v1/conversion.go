@@ -52,6 +52,8 @@ func convert_v1_RollingDeploymentStrategyParams_To_api_RollingDeploymentStrategy
 	out.UpdatePeriodSeconds = in.UpdatePeriodSeconds
 	out.IntervalSeconds = in.IntervalSeconds
 	out.TimeoutSeconds = in.TimeoutSeconds
+	out.ProgressDeadlineSeconds = in.ProgressDeadlineSeconds
+	out.AutoRollback = in.AutoRollback
 	out.UpdatePercent = in.UpdatePercent
 
 	if in.Pre != nil {
@@ -87,6 +89,8 @@ func convert_api_RollingDeploymentStrategyParams_To_v1_RollingDeploymentStrategy
 	out.UpdatePeriodSeconds = in.UpdatePeriodSeconds
 	out.IntervalSeconds = in.IntervalSeconds
 	out.TimeoutSeconds = in.TimeoutSeconds
+	out.ProgressDeadlineSeconds = in.ProgressDeadlineSeconds
+	out.AutoRollback = in.AutoRollback
 	out.UpdatePercent = in.UpdatePercent
 
 	if in.Pre != nil {
@@ -58,6 +58,16 @@ func init() {
 				}
 			}
 		},
+		func(obj *CanaryDeploymentStrategyParams) {
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultCanaryTimeoutSeconds)
+			}
+		},
+		func(obj *BlueGreenDeploymentStrategyParams) {
+			if obj.TimeoutSeconds == nil {
+				obj.TimeoutSeconds = mkintp(deployapi.DefaultBlueGreenTimeoutSeconds)
+			}
+		},
 		func(obj *DeploymentTriggerImageChangeParams) {
 			if len(obj.From.Kind) == 0 {
 				obj.From.Kind = "ImageStreamTag"
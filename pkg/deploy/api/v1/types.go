@@ -34,6 +34,10 @@ type DeploymentStrategy struct {
 	RecreateParams *RecreateDeploymentStrategyParams `json:"recreateParams,omitempty" description:"input to the Recreate deployment strategy"`
 	// RollingParams are the input to the Rolling deployment strategy.
 	RollingParams *RollingDeploymentStrategyParams `json:"rollingParams,omitempty" description:"input to the Rolling deployment strategy"`
+	// CanaryParams are the input to the Canary deployment strategy.
+	CanaryParams *CanaryDeploymentStrategyParams `json:"canaryParams,omitempty" description:"input to the Canary deployment strategy"`
+	// BlueGreenParams are the input to the BlueGreen deployment strategy.
+	BlueGreenParams *BlueGreenDeploymentStrategyParams `json:"blueGreenParams,omitempty" description:"input to the BlueGreen deployment strategy"`
 	// Resources contains resource requirements to execute the deployment
 	Resources kapi.ResourceRequirements `json:"resources,omitempty" description:"resource requirements to execute the deployment"`
 	// Labels is a set of key, value pairs added to custom deployer and lifecycle pre/post hook pods.
@@ -52,6 +56,13 @@ const (
 	DeploymentStrategyTypeCustom DeploymentStrategyType = "Custom"
 	// DeploymentStrategyTypeRolling uses the Kubernetes RollingUpdater.
 	DeploymentStrategyTypeRolling DeploymentStrategyType = "Rolling"
+	// DeploymentStrategyTypeCanary scales the new deployment up through a
+	// series of steps, verifying each step before moving on to the next.
+	DeploymentStrategyTypeCanary DeploymentStrategyType = "Canary"
+	// DeploymentStrategyTypeBlueGreen scales the new deployment up alongside
+	// the previous deployment and switches a service over to it once it's
+	// ready.
+	DeploymentStrategyTypeBlueGreen DeploymentStrategyType = "BlueGreen"
 )
 
 // CustomDeploymentStrategyParams are the input to the Custom deployment strategy.
@@ -70,6 +81,10 @@ type RecreateDeploymentStrategyParams struct {
 	// Pre is a lifecycle hook which is executed before the strategy manipulates
 	// the deployment. All LifecycleHookFailurePolicy values are supported.
 	Pre *LifecycleHook `json:"pre,omitempty" description:"a hook executed before the strategy starts the deployment"`
+	// Mid is a lifecycle hook which is executed while the deployment is scaled
+	// down to zero before the first new pod is created. All
+	// LifecycleHookFailurePolicy values are supported.
+	Mid *LifecycleHook `json:"mid,omitempty" description:"a hook executed after the old deployment is scaled down and before the new deployment is scaled up"`
 	// Post is a lifecycle hook which is executed after the strategy has
 	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
 	// is NOT supported.
@@ -82,6 +97,9 @@ type LifecycleHook struct {
 	FailurePolicy LifecycleHookFailurePolicy `json:"failurePolicy" description:"what action to take if the hook fails"`
 	// ExecNewPod specifies the options for a lifecycle hook backed by a pod.
 	ExecNewPod *ExecNewPodHook `json:"execNewPod,omitempty" description:"options for an ExecNewPodHook"`
+	// TagImages specifies the images of the deployment which are tagged into
+	// image streams by the hook. Tagging is performed by the deployer.
+	TagImages []TagImageHook `json:"tagImages,omitempty" description:"tag the images of containers of the deployment onto image stream tags"`
 }
 
 // LifecycleHookFailurePolicy describes possibles actions to take if a hook fails.
@@ -96,6 +114,17 @@ const (
 	LifecycleHookFailurePolicyIgnore LifecycleHookFailurePolicy = "Ignore"
 )
 
+// TagImageHook is a hook implementation which tags the image of a container
+// of the deployment into an image stream tag.
+type TagImageHook struct {
+	// ContainerName is the name of a container in the deployment template
+	// whose image is tagged.
+	ContainerName string `json:"containerName" description:"the name of a container in the deployment whose image will be tagged"`
+	// To is the ImageStreamTag the image is tagged into. The namespace of the
+	// deployment is used if no namespace is specified.
+	To kapi.ObjectReference `json:"to" description:"the image stream tag to set the image of"`
+}
+
 // ExecNewPodHook is a hook implementation which runs a command in a new pod
 // based on the specified container which is assumed to be part of the
 // deployment template.
@@ -125,6 +154,16 @@ type RollingDeploymentStrategyParams struct {
 	// TimeoutSeconds is the time to wait for updates before giving up. If the
 	// value is nil, a default will be used.
 	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" description:"the time to wait for updates before giving up"`
+	// ProgressDeadlineSeconds is the maximum time the deployment may go
+	// without making progress before it is considered failed. Progress is
+	// made when more pods of the new deployment are ready than ever before
+	// without any of their containers restarting, so crash looping pods
+	// never count as progress. If the value is nil, there is no deadline.
+	ProgressDeadlineSeconds *int64 `json:"progressDeadlineSeconds,omitempty" description:"the maximum time the deployment may go without making progress before it is considered failed"`
+	// AutoRollback rolls the deployment config back to the last complete
+	// deployment when the deployment fails because its progress deadline was
+	// exceeded.
+	AutoRollback bool `json:"autoRollback,omitempty" description:"roll the deployment config back to the last complete deployment when the progress deadline is exceeded"`
 	// MaxUnavailable is the maximum number of pods that can be unavailable
 	// during the update. Value can be an absolute number (ex: 5) or a
 	// percentage of total pods at the start of update (ex: 10%). Absolute
@@ -165,6 +204,68 @@ type RollingDeploymentStrategyParams struct {
 	Post *LifecycleHook `json:"post,omitempty" description:"a hook executed after the strategy finishes the deployment"`
 }
 
+// CanaryDeploymentStrategyParams are the input to the Canary deployment
+// strategy.
+type CanaryDeploymentStrategyParams struct {
+	// Steps are the steps the new deployment is scaled up through in order.
+	// The previous deployment is scaled down as the new deployment is scaled
+	// up so the total number of pods stays the same. Once all the steps are
+	// complete, the new deployment is scaled up to the desired replica count.
+	Steps []CanaryStep `json:"steps" description:"the steps the new deployment is scaled up through in order"`
+	// TimeoutSeconds is the time to wait for the pods of a step to become
+	// ready before giving up. If the value is nil, a default will be used.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" description:"the time to wait for the pods of a step to become ready before giving up"`
+	// Pre is a lifecycle hook which is executed before the deployment process
+	// begins. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook `json:"pre,omitempty" description:"a hook executed before the strategy starts the deployment"`
+	// Post is a lifecycle hook which is executed after the strategy has
+	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
+	// is NOT supported.
+	Post *LifecycleHook `json:"post,omitempty" description:"a hook executed after the strategy finishes the deployment"`
+}
+
+// CanaryStep is a step of a Canary deployment.
+type CanaryStep struct {
+	// Replicas is the number of replicas of the new deployment for the step.
+	// Value can be an absolute number (ex: 5) or a percentage of the desired
+	// replicas (ex: 10%). Absolute number is calculated from percentage by
+	// rounding up.
+	Replicas kutil.IntOrString `json:"replicas" description:"the number of replicas of the new deployment for the step; value can be an absolute number or a percentage of the desired replicas"`
+	// PauseSeconds is the time to wait once the pods of the step are ready
+	// before verifying the step.
+	PauseSeconds int64 `json:"pauseSeconds,omitempty" description:"the time to wait once the pods of the step are ready before verifying the step"`
+	// Verify is a lifecycle hook which is executed once the pods of the step
+	// are ready. If the hook fails, the deployment is aborted and scaled back
+	// to the previous deployment. All LifecycleHookFailurePolicy values are
+	// supported.
+	Verify *LifecycleHook `json:"verify,omitempty" description:"a hook executed once the pods of the step are ready; the deployment is scaled back if it fails"`
+}
+
+// BlueGreenDeploymentStrategyParams are the input to the BlueGreen deployment
+// strategy.
+type BlueGreenDeploymentStrategyParams struct {
+	// ServiceName is the name of the service which is switched to the new
+	// deployment once all of its pods are ready. The selector of the service
+	// is pinned to the pods of the new deployment, so routes to the service
+	// switch along with it.
+	ServiceName string `json:"serviceName" description:"the name of the service switched to the new deployment once all of its pods are ready"`
+	// TimeoutSeconds is the time to wait for the pods of the new deployment to
+	// become ready before giving up. If the value is nil, a default will be
+	// used.
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" description:"the time to wait for the pods of the new deployment to become ready before giving up"`
+	// KeepWarmSeconds is the time the previous deployment is kept at full
+	// scale once the service has been switched, during which cancelling the
+	// deployment switches the service straight back to it.
+	KeepWarmSeconds int64 `json:"keepWarmSeconds,omitempty" description:"the time the previous deployment is kept at full scale once the service has been switched"`
+	// Pre is a lifecycle hook which is executed before the deployment process
+	// begins. All LifecycleHookFailurePolicy values are supported.
+	Pre *LifecycleHook `json:"pre,omitempty" description:"a hook executed before the strategy starts the deployment"`
+	// Post is a lifecycle hook which is executed after the strategy has
+	// finished all deployment logic. The LifecycleHookFailurePolicyAbort policy
+	// is NOT supported.
+	Post *LifecycleHook `json:"post,omitempty" description:"a hook executed after the strategy finishes the deployment"`
+}
+
 // These constants represent keys used for correlating objects related to deployments.
 const (
 	// DeploymentConfigAnnotation is an annotation name used to correlate a deployment with the
@@ -251,6 +352,17 @@ type DeploymentConfigSpec struct {
 	// TemplateRef.
 	// Must be set before converting to a v1beta1 or v1beta2 API object.
 	Template *kapi.PodTemplateSpec `json:"template,omitempty" description:"describes the pod that will be created if insufficient replicas are detected; takes precedence over a template reference"`
+
+	// RevisionHistoryLimit is the number of old inactive deployments to
+	// retain. Older deployments are deleted along with their deployer and hook
+	// pods. The active deployment and the deployment a rollback would target
+	// are never deleted. No deployments are deleted if this is unset.
+	RevisionHistoryLimit *int `json:"revisionHistoryLimit,omitempty" description:"the number of old inactive deployments to retain; older deployments are deleted, no deployments are deleted if unset"`
+
+	// Paused indicates that the deployment config is paused. Triggers do not
+	// start new deployments while the config is paused; resuming it starts a
+	// single deployment for all the changes made in the meantime.
+	Paused bool `json:"paused,omitempty" description:"indicates that the deployment config is paused; no new deployments are triggered while paused"`
 }
 
 // DeploymentConfigStatus represents the current deployment state.
@@ -258,9 +370,62 @@ type DeploymentConfigStatus struct {
 	// LatestVersion is used to determine whether the current deployment associated with a DeploymentConfig
 	// is out of sync.
 	LatestVersion int `json:"latestVersion,omitempty" description:"used to determine whether the current deployment is out of sync"`
+	// ObservedGeneration is the most recent generation of the config acted on
+	// by the deploymentconfig controller. Once it matches the generation of
+	// the config, the rest of the status reflects the latest spec.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" description:"the most recent generation of the config acted on by the deploymentconfig controller"`
 	// Details are the reasons for the update to this deployment config.
 	// This could be based on a change made by the user or caused by an automatic trigger
 	Details *DeploymentDetails `json:"details,omitempty" description:"reasons for the last update to the config"`
+	// LiveColor is the color of the deployment the service of a BlueGreen
+	// strategy is switched to.
+	LiveColor string `json:"liveColor,omitempty" description:"the color of the deployment the service of a BlueGreen strategy is switched to"`
+	// LiveDeployment is the name of the deployment the service of a BlueGreen
+	// strategy is switched to.
+	LiveDeployment string `json:"liveDeployment,omitempty" description:"the name of the deployment the service of a BlueGreen strategy is switched to"`
+	// UpdatedReplicas is the number of pods of the latest deployment.
+	UpdatedReplicas int `json:"updatedReplicas,omitempty" description:"the number of pods of the latest deployment"`
+	// ReadyReplicas is the number of ready pods of all the deployments of the
+	// config. It is refreshed when the config or one of its deployer pods
+	// changes and at least every two minutes, not when pods become ready, so
+	// it may lag behind the pods.
+	ReadyReplicas int `json:"readyReplicas,omitempty" description:"the number of ready pods of all the deployments of the config, refreshed at least every two minutes"`
+	// AvailableReplicas is the number of ready pods of all the deployments of
+	// the config which aren't being terminated. Like ReadyReplicas, it may be
+	// up to two minutes out of date.
+	AvailableReplicas int `json:"availableReplicas,omitempty" description:"the number of ready pods of all the deployments of the config which aren't being terminated, refreshed at least every two minutes"`
+	// Conditions are the latest available observations of the state of the
+	// config.
+	Conditions []DeploymentCondition `json:"conditions,omitempty" description:"the latest available observations of the state of the config"`
+}
+
+// DeploymentConditionType is a kind of DeploymentCondition.
+type DeploymentConditionType string
+
+const (
+	// DeploymentAvailable means at least as many pods as the replica count of
+	// the config are available.
+	DeploymentAvailable DeploymentConditionType = "Available"
+	// DeploymentProgressing means the latest deployment is running or has
+	// completed. It is false once the latest deployment has failed.
+	DeploymentProgressing DeploymentConditionType = "Progressing"
+)
+
+// DeploymentCondition describes the state of a config at a certain point.
+type DeploymentCondition struct {
+	// Type is the type of the condition.
+	Type DeploymentConditionType `json:"type" description:"type of the condition, currently Available or Progressing"`
+	// Status of the condition, one of True, False or Unknown.
+	Status kapi.ConditionStatus `json:"status" description:"status of the condition, one of True, False or Unknown"`
+	// LastTransitionTime is the last time the condition changed from one
+	// status to another.
+	LastTransitionTime unversioned.Time `json:"lastTransitionTime,omitempty" description:"the last time the condition changed from one status to another"`
+	// Reason is a brief machine readable reason for the last transition of the
+	// condition.
+	Reason string `json:"reason,omitempty" description:"a brief machine readable reason for the last transition of the condition"`
+	// Message is a human readable description of the last transition of the
+	// condition.
+	Message string `json:"message,omitempty" description:"a human readable description of the last transition of the condition"`
 }
 
 // DeploymentTriggerPolicy describes a policy for a single trigger that results in a new deployment.
@@ -269,6 +434,8 @@ type DeploymentTriggerPolicy struct {
 	Type DeploymentTriggerType `json:"type,omitempty" description:"the type of the trigger"`
 	// ImageChangeParams represents the parameters for the ImageChange trigger.
 	ImageChangeParams *DeploymentTriggerImageChangeParams `json:"imageChangeParams,omitempty" description:"input to the ImageChange trigger"`
+	// SecretChangeParams represents the parameters for the SecretChange trigger.
+	SecretChangeParams *DeploymentTriggerSecretChangeParams `json:"secretChangeParams,omitempty" description:"input to the SecretChange trigger"`
 }
 
 // DeploymentTriggerType refers to a specific DeploymentTriggerPolicy implementation.
@@ -281,6 +448,9 @@ const (
 	// DeploymentTriggerOnConfigChange will create new deployments in response to changes to
 	// the ControllerTemplate of a DeploymentConfig.
 	DeploymentTriggerOnConfigChange DeploymentTriggerType = "ConfigChange"
+	// DeploymentTriggerOnSecretChange will create new deployments in response to changes to
+	// the contents of the secrets referenced by the ControllerTemplate of a DeploymentConfig.
+	DeploymentTriggerOnSecretChange DeploymentTriggerType = "SecretChange"
 )
 
 // DeploymentTriggerImageChangeParams represents the parameters to the ImageChange trigger.
@@ -297,6 +467,13 @@ type DeploymentTriggerImageChangeParams struct {
 	LastTriggeredImage string `json:"lastTriggeredImage,omitempty" description:"the last image to be triggered"`
 }
 
+// DeploymentTriggerSecretChangeParams represents the parameters to the SecretChange trigger.
+type DeploymentTriggerSecretChangeParams struct {
+	// LastTriggeredSecrets maps the name of each secret referenced by the pod template to a
+	// hash of its contents when it was last seen by the trigger.
+	LastTriggeredSecrets map[string]string `json:"lastTriggeredSecrets,omitempty" description:"hashes of the contents of the secrets referenced by the pod template when they were last seen by the trigger, keyed by secret name"`
+}
+
 // DeploymentDetails captures information about the causes of a deployment.
 type DeploymentDetails struct {
 	// Message is the user specified change message, if this deployment was triggered manually by the user
@@ -311,6 +488,8 @@ type DeploymentCause struct {
 	Type DeploymentTriggerType `json:"type" description:"the type of trigger that resulted in a new deployment"`
 	// ImageTrigger contains the image trigger details, if this trigger was fired based on an image change
 	ImageTrigger *DeploymentCauseImageTrigger `json:"imageTrigger,omitempty" description:"image trigger details (if applicable)"`
+	// SecretTrigger contains the secret trigger details, if this trigger was fired based on a secret change
+	SecretTrigger *DeploymentCauseSecretTrigger `json:"secretTrigger,omitempty" description:"secret trigger details (if applicable)"`
 }
 
 // DeploymentCauseImageTrigger represents details about the cause of a deployment originating
@@ -321,6 +500,13 @@ type DeploymentCauseImageTrigger struct {
 	From kapi.ObjectReference `json:"from" description:"a reference the changed object which triggered a deployment"`
 }
 
+// DeploymentCauseSecretTrigger represents details about the cause of a deployment originating
+// from a secret change trigger
+type DeploymentCauseSecretTrigger struct {
+	// From is a reference to the Secret whose contents changed.
+	From kapi.ObjectReference `json:"from" description:"a reference to the Secret whose contents changed"`
+}
+
 // DeploymentConfigList is a collection of deployment configs.
 type DeploymentConfigList struct {
 	unversioned.TypeMeta `json:",inline"`
@@ -51,6 +51,8 @@ func convert_v1beta3_RollingDeploymentStrategyParams_To_api_RollingDeploymentStr
 	out.UpdatePeriodSeconds = in.UpdatePeriodSeconds
 	out.IntervalSeconds = in.IntervalSeconds
 	out.TimeoutSeconds = in.TimeoutSeconds
+	out.ProgressDeadlineSeconds = in.ProgressDeadlineSeconds
+	out.AutoRollback = in.AutoRollback
 	out.UpdatePercent = in.UpdatePercent
 
 	if in.Pre != nil {
@@ -86,6 +88,8 @@ func convert_api_RollingDeploymentStrategyParams_To_v1beta3_RollingDeploymentStr
 	out.UpdatePeriodSeconds = in.UpdatePeriodSeconds
 	out.IntervalSeconds = in.IntervalSeconds
 	out.TimeoutSeconds = in.TimeoutSeconds
+	out.ProgressDeadlineSeconds = in.ProgressDeadlineSeconds
+	out.AutoRollback = in.AutoRollback
 	out.UpdatePercent = in.UpdatePercent
 
 	if in.Pre != nil {
@@ -22,8 +22,15 @@ func ValidateDeploymentConfig(config *deployapi.DeploymentConfig) fielderrors.Va
 	allErrs = append(allErrs, validation.ValidateObjectMeta(&config.ObjectMeta, true, validation.NameIsDNSSubdomain).Prefix("metadata")...)
 
 	// TODO: Refactor to validate spec and status separately
+	secretChangeTriggers := 0
 	for i := range config.Spec.Triggers {
 		allErrs = append(allErrs, validateTrigger(&config.Spec.Triggers[i]).PrefixIndex(i).Prefix("spec.triggers")...)
+		if config.Spec.Triggers[i].Type == deployapi.DeploymentTriggerOnSecretChange {
+			secretChangeTriggers++
+			if secretChangeTriggers > 1 {
+				allErrs = append(allErrs, fielderrors.NewFieldInvalid(fmt.Sprintf("spec.triggers[%d].type", i), config.Spec.Triggers[i].Type, "only one SecretChange trigger is allowed"))
+			}
+		}
 	}
 	allErrs = append(allErrs, validateDeploymentStrategy(&config.Spec.Strategy).Prefix("spec.strategy")...)
 	if config.Spec.Template == nil {
@@ -37,6 +44,9 @@ func ValidateDeploymentConfig(config *deployapi.DeploymentConfig) fielderrors.Va
 	if config.Spec.Replicas < 0 {
 		allErrs = append(allErrs, fielderrors.NewFieldInvalid("spec.replicas", config.Spec.Replicas, "replicas cannot be negative"))
 	}
+	if config.Spec.RevisionHistoryLimit != nil && *config.Spec.RevisionHistoryLimit < 0 {
+		allErrs = append(allErrs, fielderrors.NewFieldInvalid("spec.revisionHistoryLimit", *config.Spec.RevisionHistoryLimit, "revisionHistoryLimit cannot be negative"))
+	}
 	if config.Spec.Selector == nil || len(config.Spec.Selector) == 0 {
 		allErrs = append(allErrs, fielderrors.NewFieldInvalid("spec.selector", config.Spec.Selector, "selector cannot be empty"))
 	}
@@ -91,6 +101,18 @@ func validateDeploymentStrategy(strategy *deployapi.DeploymentStrategy) fielderr
 		} else {
 			errs = append(errs, validateRollingParams(strategy.RollingParams).Prefix("rollingParams")...)
 		}
+	case deployapi.DeploymentStrategyTypeCanary:
+		if strategy.CanaryParams == nil {
+			errs = append(errs, fielderrors.NewFieldRequired("canaryParams"))
+		} else {
+			errs = append(errs, validateCanaryParams(strategy.CanaryParams).Prefix("canaryParams")...)
+		}
+	case deployapi.DeploymentStrategyTypeBlueGreen:
+		if strategy.BlueGreenParams == nil {
+			errs = append(errs, fielderrors.NewFieldRequired("blueGreenParams"))
+		} else {
+			errs = append(errs, validateBlueGreenParams(strategy.BlueGreenParams).Prefix("blueGreenParams")...)
+		}
 	case deployapi.DeploymentStrategyTypeCustom:
 		if strategy.CustomParams == nil {
 			errs = append(errs, fielderrors.NewFieldRequired("customParams"))
@@ -124,6 +146,81 @@ func validateCustomParams(params *deployapi.CustomDeploymentStrategyParams) fiel
 func validateRecreateParams(params *deployapi.RecreateDeploymentStrategyParams) fielderrors.ValidationErrorList {
 	errs := fielderrors.ValidationErrorList{}
 
+	if params.Pre != nil {
+		errs = append(errs, validateLifecycleHook(params.Pre).Prefix("pre")...)
+	}
+	if params.Mid != nil {
+		errs = append(errs, validateLifecycleHook(params.Mid).Prefix("mid")...)
+	}
+	if params.Post != nil {
+		errs = append(errs, validateLifecycleHook(params.Post).Prefix("post")...)
+	}
+
+	return errs
+}
+
+func validateCanaryParams(params *deployapi.CanaryDeploymentStrategyParams) fielderrors.ValidationErrorList {
+	errs := fielderrors.ValidationErrorList{}
+
+	if len(params.Steps) == 0 {
+		errs = append(errs, fielderrors.NewFieldRequired("steps"))
+	}
+	for i := range params.Steps {
+		errs = append(errs, validateCanaryStep(&params.Steps[i]).PrefixIndex(i).Prefix("steps")...)
+	}
+
+	if params.TimeoutSeconds != nil && *params.TimeoutSeconds < 1 {
+		errs = append(errs, fielderrors.NewFieldInvalid("timeoutSeconds", *params.TimeoutSeconds, "must be >0"))
+	}
+
+	if params.Pre != nil {
+		errs = append(errs, validateLifecycleHook(params.Pre).Prefix("pre")...)
+	}
+	if params.Post != nil {
+		errs = append(errs, validateLifecycleHook(params.Post).Prefix("post")...)
+	}
+
+	return errs
+}
+
+func validateCanaryStep(step *deployapi.CanaryStep) fielderrors.ValidationErrorList {
+	errs := fielderrors.ValidationErrorList{}
+
+	replicaErrs := ValidatePositiveIntOrPercent(step.Replicas, "replicas")
+	replicaErrs = append(replicaErrs, IsNotMoreThan100Percent(step.Replicas, "replicas")...)
+	if len(replicaErrs) == 0 && getIntOrPercentValue(step.Replicas) == 0 {
+		replicaErrs = append(replicaErrs, fielderrors.NewFieldInvalid("replicas", step.Replicas, "must be >0"))
+	}
+	errs = append(errs, replicaErrs...)
+
+	if step.PauseSeconds < 0 {
+		errs = append(errs, fielderrors.NewFieldInvalid("pauseSeconds", step.PauseSeconds, isNegativeErrorMsg))
+	}
+
+	if step.Verify != nil {
+		errs = append(errs, validateLifecycleHook(step.Verify).Prefix("verify")...)
+	}
+
+	return errs
+}
+
+func validateBlueGreenParams(params *deployapi.BlueGreenDeploymentStrategyParams) fielderrors.ValidationErrorList {
+	errs := fielderrors.ValidationErrorList{}
+
+	if len(params.ServiceName) == 0 {
+		errs = append(errs, fielderrors.NewFieldRequired("serviceName"))
+	} else if ok, msg := validation.ValidateServiceName(params.ServiceName, false); !ok {
+		errs = append(errs, fielderrors.NewFieldInvalid("serviceName", params.ServiceName, msg))
+	}
+
+	if params.TimeoutSeconds != nil && *params.TimeoutSeconds < 1 {
+		errs = append(errs, fielderrors.NewFieldInvalid("timeoutSeconds", *params.TimeoutSeconds, "must be >0"))
+	}
+
+	if params.KeepWarmSeconds < 0 {
+		errs = append(errs, fielderrors.NewFieldInvalid("keepWarmSeconds", params.KeepWarmSeconds, isNegativeErrorMsg))
+	}
+
 	if params.Pre != nil {
 		errs = append(errs, validateLifecycleHook(params.Pre).Prefix("pre")...)
 	}
@@ -141,10 +238,41 @@ func validateLifecycleHook(hook *deployapi.LifecycleHook) fielderrors.Validation
 		errs = append(errs, fielderrors.NewFieldRequired("failurePolicy"))
 	}
 
-	if hook.ExecNewPod == nil {
+	switch {
+	case hook.ExecNewPod == nil && len(hook.TagImages) == 0:
 		errs = append(errs, fielderrors.NewFieldRequired("execNewPod"))
-	} else {
+	case hook.ExecNewPod != nil && len(hook.TagImages) > 0:
+		errs = append(errs, fielderrors.NewFieldInvalid("tagImages", hook.TagImages, "only one of execNewPod or tagImages may be specified"))
+	case hook.ExecNewPod != nil:
 		errs = append(errs, validateExecNewPod(hook.ExecNewPod).Prefix("execNewPod")...)
+	default:
+		for i := range hook.TagImages {
+			errs = append(errs, validateTagImage(&hook.TagImages[i]).PrefixIndex(i).Prefix("tagImages")...)
+		}
+	}
+
+	return errs
+}
+
+func validateTagImage(image *deployapi.TagImageHook) fielderrors.ValidationErrorList {
+	errs := fielderrors.ValidationErrorList{}
+
+	if len(image.ContainerName) == 0 {
+		errs = append(errs, fielderrors.NewFieldRequired("containerName"))
+	}
+
+	if len(image.To.Name) == 0 {
+		errs = append(errs, fielderrors.NewFieldRequired("to"))
+	} else {
+		if image.To.Kind != "ImageStreamTag" {
+			errs = append(errs, fielderrors.NewFieldInvalid("to.kind", image.To.Kind, "kind must be an ImageStreamTag"))
+		}
+		if err := validateImageStreamTagName(image.To.Name); err != nil {
+			errs = append(errs, fielderrors.NewFieldInvalid("to.name", image.To.Name, err.Error()))
+		}
+		if len(image.To.Namespace) != 0 && !kvalidation.IsDNS1123Subdomain(image.To.Namespace) {
+			errs = append(errs, fielderrors.NewFieldInvalid("to.namespace", image.To.Namespace, "namespace must be a valid subdomain"))
+		}
 	}
 
 	return errs
@@ -213,6 +341,14 @@ func validateRollingParams(params *deployapi.RollingDeploymentStrategyParams) fi
 		errs = append(errs, fielderrors.NewFieldInvalid("timeoutSeconds", *params.TimeoutSeconds, "must be >0"))
 	}
 
+	if params.ProgressDeadlineSeconds != nil && *params.ProgressDeadlineSeconds < 1 {
+		errs = append(errs, fielderrors.NewFieldInvalid("progressDeadlineSeconds", *params.ProgressDeadlineSeconds, "must be >0"))
+	}
+
+	if params.AutoRollback && params.ProgressDeadlineSeconds == nil {
+		errs = append(errs, fielderrors.NewFieldRequired("progressDeadlineSeconds"))
+	}
+
 	if params.UpdatePercent != nil {
 		p := *params.UpdatePercent
 		if p == 0 || p < -100 || p > 100 {
@@ -256,6 +392,17 @@ func validateTrigger(trigger *deployapi.DeploymentTriggerPolicy) fielderrors.Val
 		}
 	}
 
+	if trigger.SecretChangeParams != nil {
+		if trigger.Type != deployapi.DeploymentTriggerOnSecretChange {
+			errs = append(errs, fielderrors.NewFieldInvalid("secretChangeParams", "", "secretChangeParams are only valid for a SecretChange trigger"))
+		}
+		for name := range trigger.SecretChangeParams.LastTriggeredSecrets {
+			if ok, msg := validation.ValidateSecretName(name, false); !ok {
+				errs = append(errs, fielderrors.NewFieldInvalid("secretChangeParams.lastTriggeredSecrets", name, msg))
+			}
+		}
+	}
+
 	return errs
 }
 
@@ -62,6 +62,42 @@ func rollingConfigMax(maxSurge, maxUnavailable kutil.IntOrString) api.Deployment
 	}
 }
 
+func rollingConfigProgress(progressDeadline *int64, autoRollback bool) api.DeploymentConfig {
+	config := rollingConfig(1, 1, 1)
+	config.Spec.Strategy.RollingParams.ProgressDeadlineSeconds = progressDeadline
+	config.Spec.Strategy.RollingParams.AutoRollback = autoRollback
+	return config
+}
+
+func canaryConfig(steps ...api.CanaryStep) api.DeploymentConfig {
+	strategy := test.OkCanaryStrategy()
+	strategy.CanaryParams.Steps = steps
+	return api.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Spec: api.DeploymentConfigSpec{
+			Triggers: manualTrigger(),
+			Strategy: strategy,
+			Template: test.OkPodTemplate(),
+			Selector: test.OkSelector(),
+		},
+	}
+}
+
+func blueGreenConfig(serviceName string, keepWarmSeconds int64) api.DeploymentConfig {
+	strategy := test.OkBlueGreenStrategy()
+	strategy.BlueGreenParams.ServiceName = serviceName
+	strategy.BlueGreenParams.KeepWarmSeconds = keepWarmSeconds
+	return api.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+		Spec: api.DeploymentConfigSpec{
+			Triggers: manualTrigger(),
+			Strategy: strategy,
+			Template: test.OkPodTemplate(),
+			Selector: test.OkSelector(),
+		},
+	}
+}
+
 func TestValidateDeploymentConfigOK(t *testing.T) {
 	errs := ValidateDeploymentConfig(&api.DeploymentConfig{
 		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
@@ -79,7 +115,34 @@ func TestValidateDeploymentConfigOK(t *testing.T) {
 	}
 }
 
+func TestValidateDeploymentConfigBlueGreenOK(t *testing.T) {
+	config := blueGreenConfig("frontend", 300)
+	if errs := ValidateDeploymentConfig(&config); len(errs) > 0 {
+		t.Errorf("Unxpected non-empty error list: %#v", errs)
+	}
+}
+
+func TestValidateDeploymentConfigCanaryOK(t *testing.T) {
+	config := canaryConfig(
+		api.CanaryStep{Replicas: kutil.NewIntOrStringFromInt(1), PauseSeconds: 30},
+		api.CanaryStep{
+			Replicas: kutil.NewIntOrStringFromString("50%"),
+			Verify: &api.LifecycleHook{
+				FailurePolicy: api.LifecycleHookFailurePolicyAbort,
+				ExecNewPod: &api.ExecNewPodHook{
+					Command:       []string{"verify"},
+					ContainerName: "container1",
+				},
+			},
+		},
+	)
+	if errs := ValidateDeploymentConfig(&config); len(errs) > 0 {
+		t.Errorf("Unxpected non-empty error list: %#v", errs)
+	}
+}
+
 func TestValidateDeploymentConfigMissingFields(t *testing.T) {
+	negativeLimit := -1
 	errorCases := map[string]struct {
 		DeploymentConfig api.DeploymentConfig
 		ErrorType        fielderrors.ValidationErrorType
@@ -138,6 +201,63 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			fielderrors.ValidationErrorTypeRequired,
 			"spec.triggers[0].type",
 		},
+		"more than one SecretChange trigger": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Triggers: []api.DeploymentTriggerPolicy{
+						{Type: api.DeploymentTriggerOnSecretChange},
+						{Type: api.DeploymentTriggerOnSecretChange},
+					},
+					Selector: test.OkSelector(),
+					Strategy: test.OkStrategy(),
+					Template: test.OkPodTemplate(),
+				},
+			},
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.triggers[1].type",
+		},
+		"secretChangeParams on another trigger": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Triggers: []api.DeploymentTriggerPolicy{
+						{
+							Type:               api.DeploymentTriggerOnConfigChange,
+							SecretChangeParams: &api.DeploymentTriggerSecretChangeParams{},
+						},
+					},
+					Selector: test.OkSelector(),
+					Strategy: test.OkStrategy(),
+					Template: test.OkPodTemplate(),
+				},
+			},
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.triggers[0].secretChangeParams",
+		},
+		"invalid Trigger secretChangeParams.lastTriggeredSecrets": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Triggers: []api.DeploymentTriggerPolicy{
+						{
+							Type: api.DeploymentTriggerOnSecretChange,
+							SecretChangeParams: &api.DeploymentTriggerSecretChangeParams{
+								LastTriggeredSecrets: map[string]string{"Not_Valid": "hash"},
+							},
+						},
+					},
+					Selector: test.OkSelector(),
+					Strategy: test.OkStrategy(),
+					Template: test.OkPodTemplate(),
+				},
+			},
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.triggers[0].secretChangeParams.lastTriggeredSecrets",
+		},
 		"missing Trigger imageChangeParams.from": {
 			api.DeploymentConfig{
 				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
@@ -371,6 +491,182 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			fielderrors.ValidationErrorTypeInvalid,
 			"spec.strategy.recreateParams.pre.execNewPod.volumes[1]",
 		},
+		"missing spec.strategy.recreateParams.mid.execNewPod": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Mid: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyRetry,
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			fielderrors.ValidationErrorTypeRequired,
+			"spec.strategy.recreateParams.mid.execNewPod",
+		},
+		"invalid spec.strategy.recreateParams.post.tagImages": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Post: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyRetry,
+								ExecNewPod: &api.ExecNewPodHook{
+									ContainerName: "container",
+									Command:       []string{"cmd"},
+								},
+								TagImages: []api.TagImageHook{
+									{ContainerName: "container", To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:deployed"}},
+								},
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.strategy.recreateParams.post.tagImages",
+		},
+		"missing spec.strategy.recreateParams.post.tagImages.containerName": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Post: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyRetry,
+								TagImages: []api.TagImageHook{
+									{To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:deployed"}},
+								},
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			fielderrors.ValidationErrorTypeRequired,
+			"spec.strategy.recreateParams.post.tagImages[0].containerName",
+		},
+		"missing spec.strategy.recreateParams.post.tagImages.to": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Post: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyRetry,
+								TagImages: []api.TagImageHook{
+									{ContainerName: "container"},
+								},
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			fielderrors.ValidationErrorTypeRequired,
+			"spec.strategy.recreateParams.post.tagImages[0].to",
+		},
+		"invalid spec.strategy.recreateParams.post.tagImages.to.kind": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Post: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyRetry,
+								TagImages: []api.TagImageHook{
+									{ContainerName: "container", To: kapi.ObjectReference{Kind: "DockerImage", Name: "app:deployed"}},
+								},
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.strategy.recreateParams.post.tagImages[0].to.kind",
+		},
+		"invalid spec.strategy.recreateParams.post.tagImages.to.name": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Post: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyRetry,
+								TagImages: []api.TagImageHook{
+									{ContainerName: "container", To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app"}},
+								},
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.strategy.recreateParams.post.tagImages[0].to.name",
+		},
+		"valid spec.strategy.recreateParams.post.tagImages": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas: 1,
+					Strategy: api.DeploymentStrategy{
+						Type: api.DeploymentStrategyTypeRecreate,
+						RecreateParams: &api.RecreateDeploymentStrategyParams{
+							Post: &api.LifecycleHook{
+								FailurePolicy: api.LifecycleHookFailurePolicyIgnore,
+								TagImages: []api.TagImageHook{
+									{ContainerName: "container", To: kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "other", Name: "app:deployed"}},
+								},
+							},
+						},
+					},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			"",
+			"",
+		},
+		"invalid spec.revisionHistoryLimit": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Replicas:             1,
+					RevisionHistoryLimit: &negativeLimit,
+					Strategy:             test.OkStrategy(),
+					Template:             test.OkPodTemplate(),
+					Selector:             test.OkSelector(),
+				},
+			},
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.revisionHistoryLimit",
+		},
 		"invalid spec.strategy.rollingParams.intervalSeconds": {
 			rollingConfig(-20, 1, 1),
 			fielderrors.ValidationErrorTypeInvalid,
@@ -386,6 +682,88 @@ func TestValidateDeploymentConfigMissingFields(t *testing.T) {
 			fielderrors.ValidationErrorTypeInvalid,
 			"spec.strategy.rollingParams.timeoutSeconds",
 		},
+		"invalid spec.strategy.rollingParams.progressDeadlineSeconds": {
+			rollingConfigProgress(mkint64p(0), false),
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.strategy.rollingParams.progressDeadlineSeconds",
+		},
+		"missing spec.strategy.rollingParams.progressDeadlineSeconds with autoRollback": {
+			rollingConfigProgress(nil, true),
+			fielderrors.ValidationErrorTypeRequired,
+			"spec.strategy.rollingParams.progressDeadlineSeconds",
+		},
+		"missing spec.strategy.canaryParams": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Strategy: api.DeploymentStrategy{Type: api.DeploymentStrategyTypeCanary},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			fielderrors.ValidationErrorTypeRequired,
+			"spec.strategy.canaryParams",
+		},
+		"missing spec.strategy.canaryParams.steps": {
+			canaryConfig(),
+			fielderrors.ValidationErrorTypeRequired,
+			"spec.strategy.canaryParams.steps",
+		},
+		"zero spec.strategy.canaryParams.steps[0].replicas": {
+			canaryConfig(api.CanaryStep{Replicas: kutil.NewIntOrStringFromInt(0)}),
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.strategy.canaryParams.steps[0].replicas",
+		},
+		"invalid spec.strategy.canaryParams.steps[1].replicas": {
+			canaryConfig(api.CanaryStep{Replicas: kutil.NewIntOrStringFromInt(1)}, api.CanaryStep{Replicas: kutil.NewIntOrStringFromString("150%")}),
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.strategy.canaryParams.steps[1].replicas",
+		},
+		"invalid spec.strategy.canaryParams.steps[0].pauseSeconds": {
+			canaryConfig(api.CanaryStep{Replicas: kutil.NewIntOrStringFromInt(1), PauseSeconds: -1}),
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.strategy.canaryParams.steps[0].pauseSeconds",
+		},
+		"missing spec.strategy.canaryParams.steps[0].verify.failurePolicy": {
+			canaryConfig(api.CanaryStep{
+				Replicas: kutil.NewIntOrStringFromInt(1),
+				Verify: &api.LifecycleHook{
+					ExecNewPod: &api.ExecNewPodHook{
+						Command:       []string{"cmd"},
+						ContainerName: "container",
+					},
+				},
+			}),
+			fielderrors.ValidationErrorTypeRequired,
+			"spec.strategy.canaryParams.steps[0].verify.failurePolicy",
+		},
+		"missing spec.strategy.blueGreenParams": {
+			api.DeploymentConfig{
+				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
+				Spec: api.DeploymentConfigSpec{
+					Strategy: api.DeploymentStrategy{Type: api.DeploymentStrategyTypeBlueGreen},
+					Template: test.OkPodTemplate(),
+					Selector: test.OkSelector(),
+				},
+			},
+			fielderrors.ValidationErrorTypeRequired,
+			"spec.strategy.blueGreenParams",
+		},
+		"missing spec.strategy.blueGreenParams.serviceName": {
+			blueGreenConfig("", 0),
+			fielderrors.ValidationErrorTypeRequired,
+			"spec.strategy.blueGreenParams.serviceName",
+		},
+		"invalid spec.strategy.blueGreenParams.serviceName": {
+			blueGreenConfig("Not_A_Service", 0),
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.strategy.blueGreenParams.serviceName",
+		},
+		"invalid spec.strategy.blueGreenParams.keepWarmSeconds": {
+			blueGreenConfig("frontend", -1),
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.strategy.blueGreenParams.keepWarmSeconds",
+		},
 		"missing spec.strategy.rollingParams.pre.failurePolicy": {
 			api.DeploymentConfig{
 				ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "bar"},
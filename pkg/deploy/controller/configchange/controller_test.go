@@ -85,6 +85,40 @@ func TestHandle_newConfigTriggers(t *testing.T) {
 	}
 }
 
+// TestHandle_pausedConfig ensures that a pod template change to a paused
+// config with a config change trigger doesn't result in a version bump.
+func TestHandle_pausedConfig(t *testing.T) {
+	controller := &DeploymentConfigChangeController{
+		decodeConfig: func(deployment *kapi.ReplicationController) (*deployapi.DeploymentConfig, error) {
+			return deployutil.DecodeDeploymentConfig(deployment, api.Codec)
+		},
+		changeStrategy: &changeStrategyImpl{
+			generateDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+				t.Fatalf("unexpected generation of deploymentConfig")
+				return nil, nil
+			},
+			updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+				t.Fatalf("unexpected update of deploymentConfig")
+				return config, nil
+			},
+			getDeploymentFunc: func(namespace, name string) (*kapi.ReplicationController, error) {
+				t.Fatalf("unexpected retrieval of deployment")
+				return nil, nil
+			},
+		},
+	}
+
+	for _, version := range []int{0, 1} {
+		config := deployapitest.OkDeploymentConfig(version)
+		config.Spec.Triggers = []deployapi.DeploymentTriggerPolicy{deployapitest.OkConfigChangeTrigger()}
+		config.Spec.Template.Labels["newkey"] = "value"
+		config.Spec.Paused = true
+		if err := controller.Handle(config); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+}
+
 // TestHandle_changeWithTemplateDiff ensures that a pod template change to a
 // config with a config change trigger results in a version bump and cause
 // update.
@@ -7,13 +7,17 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	kutil "k8s.io/kubernetes/pkg/util"
 
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 )
 
 // DeployerPodController keeps a deployment's status in sync with the deployer pod
-// handling the deployment.
+// handling the deployment, and keeps the status of the deployment's config in
+// sync with the deployment.
 //
 // Use the DeployerPodControllerFactory to create this controller.
 type DeployerPodController struct {
@@ -23,6 +27,11 @@ type DeployerPodController struct {
 	deployerPodsFor func(namespace, name string) (*kapi.PodList, error)
 	// deletePod deletes a pod.
 	deletePod func(namespace, name string) error
+	// configClient provides access to deployment configs.
+	configClient configClient
+	// podsForConfig returns all the pods of the deployments of the named
+	// config.
+	podsForConfig func(namespace, name string) (*kapi.PodList, error)
 }
 
 // transientError is an error which will be retried indefinitely.
@@ -109,11 +118,51 @@ func (c *DeployerPodController) Handle(pod *kapi.Pod) error {
 			return fmt.Errorf("couldn't update Deployment %s to status %s: %v", deployutil.LabelForDeployment(deployment), nextStatus, err)
 		}
 		glog.V(4).Infof("Updated Deployment %s status from %s to %s", deployutil.LabelForDeployment(deployment), currentStatus, nextStatus)
+
+		if err := c.updateConfigStatus(deployment); err != nil {
+			// The config status is brought up to date the next time the config
+			// is handled, so don't retry the deployment.
+			kutil.HandleError(fmt.Errorf("couldn't update the status of the config of %s: %v", deployutil.LabelForDeployment(deployment), err))
+		}
 	}
 
 	return nil
 }
 
+// updateConfigStatus recalculates the status of the config of deployment.
+// The observed generation is left alone since the config itself hasn't been
+// handled.
+func (c *DeployerPodController) updateConfigStatus(deployment *kapi.ReplicationController) error {
+	configName := deployutil.DeploymentConfigNameFor(deployment)
+	if len(configName) == 0 {
+		return nil
+	}
+	return kclient.RetryOnConflict(kclient.DefaultRetry, func() error {
+		config, err := c.configClient.getDeploymentConfig(deployment.Namespace, configName)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		deployments, err := c.deploymentClient.listDeploymentsForConfig(deployment.Namespace, configName)
+		if err != nil {
+			return err
+		}
+		pods, err := c.podsForConfig(deployment.Namespace, configName)
+		if err != nil {
+			return err
+		}
+		status := deployutil.CalculateStatus(config, deployments, pods, unversioned.Now())
+		if kapi.Semantic.DeepEqual(status, config.Status) {
+			return nil
+		}
+		config.Status = status
+		_, err = c.configClient.updateDeploymentConfig(config.Namespace, config)
+		return err
+	})
+}
+
 // deploymentClient abstracts access to deployments.
 type deploymentClient interface {
 	getDeployment(namespace, name string) (*kapi.ReplicationController, error)
@@ -141,3 +190,23 @@ func (i *deploymentClientImpl) updateDeployment(namespace string, deployment *ka
 func (i *deploymentClientImpl) listDeploymentsForConfig(namespace, configName string) (*kapi.ReplicationControllerList, error) {
 	return i.listDeploymentsForConfigFunc(namespace, configName)
 }
+
+// configClient abstracts access to deployment configs.
+type configClient interface {
+	getDeploymentConfig(namespace, name string) (*deployapi.DeploymentConfig, error)
+	updateDeploymentConfig(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error)
+}
+
+// configClientImpl is a pluggable configClient.
+type configClientImpl struct {
+	getDeploymentConfigFunc    func(namespace, name string) (*deployapi.DeploymentConfig, error)
+	updateDeploymentConfigFunc func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error)
+}
+
+func (i *configClientImpl) getDeploymentConfig(namespace, name string) (*deployapi.DeploymentConfig, error) {
+	return i.getDeploymentConfigFunc(namespace, name)
+}
+
+func (i *configClientImpl) updateDeploymentConfig(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+	return i.updateDeploymentConfigFunc(namespace, config)
+}
@@ -91,6 +91,7 @@ func TestHandle_runningPod(t *testing.T) {
 	var updatedDeployment *kapi.ReplicationController
 
 	controller := &DeployerPodController{
+		configClient: missingConfigClient(),
 		deploymentClient: &deploymentClientImpl{
 			getDeploymentFunc: func(namespace, name string) (*kapi.ReplicationController, error) {
 				return deployment, nil
@@ -125,6 +126,7 @@ func TestHandle_podTerminatedOk(t *testing.T) {
 	var updatedDeployment *kapi.ReplicationController
 
 	controller := &DeployerPodController{
+		configClient: missingConfigClient(),
 		deploymentClient: &deploymentClientImpl{
 			getDeploymentFunc: func(namespace, name string) (*kapi.ReplicationController, error) {
 				return deployment, nil
@@ -162,6 +164,7 @@ func TestHandle_podTerminatedFailNoContainerStatus(t *testing.T) {
 	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusRunning)
 
 	controller := &DeployerPodController{
+		configClient: missingConfigClient(),
 		deploymentClient: &deploymentClientImpl{
 			getDeploymentFunc: func(namespace, name string) (*kapi.ReplicationController, error) {
 				return deployment, nil
@@ -218,6 +221,7 @@ func TestHandle_cleanupDesiredReplicasAnnotation(t *testing.T) {
 		deployment.Annotations[deployapi.DesiredReplicasAnnotation] = "1"
 
 		controller := &DeployerPodController{
+			configClient: missingConfigClient(),
 			deploymentClient: &deploymentClientImpl{
 				getDeploymentFunc: func(namespace, name string) (*kapi.ReplicationController, error) {
 					return deployment, nil
@@ -248,6 +252,74 @@ func TestHandle_cleanupDesiredReplicasAnnotation(t *testing.T) {
 	}
 }
 
+// TestHandle_updatesConfigStatus ensures that the status of the config is
+// recalculated when the status of its deployment changes.
+func TestHandle_updatesConfigStatus(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(1)
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusRunning)
+	deployment.Status.Replicas = 1
+	var updatedConfig *deployapi.DeploymentConfig
+
+	controller := &DeployerPodController{
+		deploymentClient: &deploymentClientImpl{
+			getDeploymentFunc: func(namespace, name string) (*kapi.ReplicationController, error) {
+				return deployment, nil
+			},
+			updateDeploymentFunc: func(namespace string, deployment *kapi.ReplicationController) (*kapi.ReplicationController, error) {
+				return deployment, nil
+			},
+			listDeploymentsForConfigFunc: func(namespace, configName string) (*kapi.ReplicationControllerList, error) {
+				return &kapi.ReplicationControllerList{Items: []kapi.ReplicationController{*deployment}}, nil
+			},
+		},
+		configClient: &configClientImpl{
+			getDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+				return config, nil
+			},
+			updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+				updatedConfig = config
+				return config, nil
+			},
+		},
+		podsForConfig: func(namespace, name string) (*kapi.PodList, error) {
+			pod := kapi.Pod{}
+			pod.Status.Conditions = []kapi.PodCondition{{Type: kapi.PodReady, Status: kapi.ConditionTrue}}
+			return &kapi.PodList{Items: []kapi.Pod{pod}}, nil
+		},
+	}
+
+	if err := controller.Handle(succeededPod(deployment)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if updatedConfig == nil {
+		t.Fatalf("expected config update")
+	}
+	if e, a := 1, updatedConfig.Status.UpdatedReplicas; e != a {
+		t.Errorf("expected %d updated replicas, got %d", e, a)
+	}
+	if e, a := 1, updatedConfig.Status.ReadyReplicas; e != a {
+		t.Errorf("expected %d ready replicas, got %d", e, a)
+	}
+	condition := deployutil.GetCondition(updatedConfig.Status, deployapi.DeploymentProgressing)
+	if condition == nil || condition.Reason != deployapi.DeploymentComplete {
+		t.Errorf("expected a %s progressing condition, got %#v", deployapi.DeploymentComplete, condition)
+	}
+}
+
+// missingConfigClient returns a configClient for which no config exists.
+func missingConfigClient() configClient {
+	return &configClientImpl{
+		getDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+			return nil, kerrors.NewNotFound("DeploymentConfig", name)
+		},
+		updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+			return nil, kerrors.NewNotFound("DeploymentConfig", config.Name)
+		},
+	}
+}
+
 func okPod(deployment *kapi.ReplicationController) *kapi.Pod {
 	return &kapi.Pod{
 		ObjectMeta: kapi.ObjectMeta{
@@ -12,13 +12,17 @@ import (
 	kutil "k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/watch"
 
+	osclient "github.com/openshift/origin/pkg/client"
 	controller "github.com/openshift/origin/pkg/controller"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 )
 
 // DeployerPodControllerFactory can create a DeployerPodController which
 // handles processing deployer pods.
 type DeployerPodControllerFactory struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
 	// KubeClient is a Kubernetes client.
 	KubeClient kclient.Interface
 }
@@ -78,6 +82,17 @@ func (factory *DeployerPodControllerFactory) Create() controller.RunnableControl
 		deletePod: func(namespace, name string) error {
 			return factory.KubeClient.Pods(namespace).Delete(name, kapi.NewDeleteOptions(0))
 		},
+		configClient: &configClientImpl{
+			getDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+				return factory.Client.DeploymentConfigs(namespace).Get(name)
+			},
+			updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+				return factory.Client.DeploymentConfigs(namespace).Update(config)
+			},
+		},
+		podsForConfig: func(namespace, name string) (*kapi.PodList, error) {
+			return factory.KubeClient.Pods(namespace).List(deployutil.ConfigPodSelector(name), fields.Everything())
+		},
 	}
 
 	return &controller.RetryController{
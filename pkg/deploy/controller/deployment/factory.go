@@ -124,9 +124,9 @@ func (factory *DeploymentControllerFactory) Create() controller.RunnableControll
 
 // makeContainer creates containers in the following way:
 //
-//   1. For the Recreate and Rolling strategies, strategy, use the factory's
-//      DeployerImage as the container image, and the factory's Environment
-//      as the container environment.
+//   1. For the Recreate, Rolling, Canary and BlueGreen strategies, use the
+//      factory's DeployerImage as the container image, and the factory's
+//      Environment as the container environment.
 //   2. For all Custom strategy, use the strategy's image for the container
 //      image, and use the combination of the factory's Environment and the
 //      strategy's environment as the container environment.
@@ -141,7 +141,7 @@ func (factory *DeploymentControllerFactory) makeContainer(strategy *deployapi.De
 
 	// Every strategy type should be handled here.
 	switch strategy.Type {
-	case deployapi.DeploymentStrategyTypeRecreate, deployapi.DeploymentStrategyTypeRolling:
+	case deployapi.DeploymentStrategyTypeRecreate, deployapi.DeploymentStrategyTypeRolling, deployapi.DeploymentStrategyTypeCanary, deployapi.DeploymentStrategyTypeBlueGreen:
 		// Use the factory-configured image.
 		return &kapi.Container{
 			Image: factory.DeployerImage,
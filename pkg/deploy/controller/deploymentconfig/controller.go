@@ -8,13 +8,16 @@ import (
 
 	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/record"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/runtime"
+	kutilerrors "k8s.io/kubernetes/pkg/util/errors"
 
 	osclient "github.com/openshift/origin/pkg/client"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	"github.com/openshift/origin/pkg/deploy/strategy/bluegreen"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
 )
 
@@ -32,6 +35,20 @@ import (
 // If a new version is observed for which no deployment exists, any running
 // deployments will be cancelled. The controller will not attempt to scale
 // running deployments.
+//
+// For configs using the BlueGreen strategy, the service of the strategy is
+// kept switched to the active deployment, so cancelling a deployment which
+// already switched the service switches it back.
+//
+// Once the config has been handled, its status is brought up to date with its
+// deployments and their pods, and its generation is recorded as observed.
+//
+// If the latest deployment failed because its progress deadline was exceeded
+// and the config asks for it, the config is rolled back to the last complete
+// deployment.
+//
+// Once the latest deployment has finished, the oldest inactive deployments
+// beyond the revision history limit of the config are deleted.
 type DeploymentConfigController struct {
 	// kubeClient provides acceess to Kube resources.
 	kubeClient kclient.Interface
@@ -116,9 +133,26 @@ func (c *DeploymentConfigController) Handle(config *deployapi.DeploymentConfig)
 		// If the latest deployment is still running, try again later. We don't
 		// want to compete with the deployer.
 		if !deployutil.IsTerminatedDeployment(latestDeployment) {
-			return nil
+			return c.updateStatus(config, existingDeployments)
 		}
-		return c.reconcileDeployments(existingDeployments, config)
+		// Switch the service back before scaling down a cancelled deployment
+		// which already switched it.
+		if isBlueGreen(config) {
+			if err := c.switchBlueGreenService(existingDeployments, config); err != nil {
+				return err
+			}
+		}
+		if err := c.reconcileDeployments(existingDeployments, config); err != nil {
+			return err
+		}
+		if shouldAutoRollback(config, latestDeployment, existingDeployments) {
+			if err := c.autoRollback(existingDeployments, config, latestDeployment); err != nil {
+				return err
+			}
+		} else if err := c.cleanupOldDeployments(existingDeployments, config); err != nil {
+			return err
+		}
+		return c.updateStatus(config, existingDeployments)
 	}
 	// No deployments are running and the latest deployment doesn't exist, so
 	// create the new deployment.
@@ -137,7 +171,8 @@ func (c *DeploymentConfigController) Handle(config *deployapi.DeploymentConfig)
 		return fmt.Errorf("couldn't create deployment for deployment config %s: %v", deployutil.LabelForDeploymentConfig(config), err)
 	}
 	c.recorder.Eventf(config, "DeploymentCreated", "Created new deployment %q for version %d", created.Name, config.Status.LatestVersion)
-	return nil
+	existingDeployments.Items = append(existingDeployments.Items, *created)
+	return c.updateStatus(config, existingDeployments)
 }
 
 // reconcileDeployments reconciles existing deployment replica counts which
@@ -250,3 +285,198 @@ func (c *DeploymentConfigController) reconcileDeployments(existingDeployments *k
 	}
 	return nil
 }
+
+// isBlueGreen returns true if config uses the BlueGreen strategy.
+func isBlueGreen(config *deployapi.DeploymentConfig) bool {
+	return config.Spec.Strategy.Type == deployapi.DeploymentStrategyTypeBlueGreen && config.Spec.Strategy.BlueGreenParams != nil
+}
+
+// switchBlueGreenService switches the service of the BlueGreen strategy of
+// config to the active deployment if it isn't already.
+func (c *DeploymentConfigController) switchBlueGreenService(existingDeployments *kapi.ReplicationControllerList, config *deployapi.DeploymentConfig) error {
+	params := config.Spec.Strategy.BlueGreenParams
+	activeDeployment := deployutil.ActiveDeployment(config, existingDeployments)
+	if activeDeployment == nil {
+		return nil
+	}
+
+	switched, err := bluegreen.SwitchService(c.kubeClient, config.Namespace, params.ServiceName, activeDeployment)
+	if err != nil {
+		c.recorder.Eventf(config, "DeploymentServiceSwitchFailed", "Failed to switch service %q to active deployment %q: %s", params.ServiceName, activeDeployment.Name, err)
+		return fmt.Errorf("couldn't switch service %s/%s to deployment %s: %v", config.Namespace, params.ServiceName, deployutil.LabelForDeployment(activeDeployment), err)
+	}
+	if switched {
+		c.recorder.Eventf(config, "DeploymentServiceSwitched", "Switched service %q to active deployment %q", params.ServiceName, activeDeployment.Name)
+	}
+	return nil
+}
+
+// updateStatus brings the status of config up to date with its deployments
+// and their pods, and records the generation of config as observed. The
+// config is only updated if its status changed.
+func (c *DeploymentConfigController) updateStatus(config *deployapi.DeploymentConfig, existingDeployments *kapi.ReplicationControllerList) error {
+	pods, err := c.kubeClient.Pods(config.Namespace).List(deployutil.ConfigPodSelector(config.Name), fields.Everything())
+	if err != nil {
+		return fmt.Errorf("couldn't list pods of deployment config %s: %v", deployutil.LabelForDeploymentConfig(config), err)
+	}
+
+	status := deployutil.CalculateStatus(config, existingDeployments, pods, unversioned.Now())
+	status.ObservedGeneration = config.Generation
+	if kapi.Semantic.DeepEqual(status, config.Status) {
+		return nil
+	}
+	// The config may have been updated while it was handled, so update the
+	// latest copy.
+	return kclient.RetryOnConflict(kclient.DefaultRetry, func() error {
+		latest, err := c.osClient.DeploymentConfigs(config.Namespace).Get(config.Name)
+		if err != nil {
+			return err
+		}
+		latest.Status = deployutil.CalculateStatus(latest, existingDeployments, pods, unversioned.Now())
+		latest.Status.ObservedGeneration = config.Generation
+		_, err = c.osClient.DeploymentConfigs(config.Namespace).Update(latest)
+		return err
+	})
+}
+
+// shouldAutoRollback returns true if config should be rolled back because its
+// latest deployment failed to make progress within its progress deadline. A
+// deployment is only rolled back once, and the deployment following a rolled
+// back deployment, which is the one the rollback produced, is not rolled back
+// at all, so that a rollback which fails as well doesn't start a loop of
+// rollbacks.
+func shouldAutoRollback(config *deployapi.DeploymentConfig, latestDeployment *kapi.ReplicationController, existingDeployments *kapi.ReplicationControllerList) bool {
+	params := config.Spec.Strategy.RollingParams
+	if params == nil || !params.AutoRollback {
+		return false
+	}
+	if deployutil.DeploymentStatusFor(latestDeployment) != deployapi.DeploymentStatusFailed ||
+		latestDeployment.Annotations[deployapi.DeploymentStatusReasonAnnotation] != deployapi.DeploymentFailedProgressDeadlineExceeded {
+		return false
+	}
+	if isAutoRolledBack(latestDeployment) {
+		return false
+	}
+	previousVersion := deployutil.DeploymentVersionFor(latestDeployment) - 1
+	for i := range existingDeployments.Items {
+		deployment := &existingDeployments.Items[i]
+		if deployutil.DeploymentVersionFor(deployment) == previousVersion && isAutoRolledBack(deployment) {
+			return false
+		}
+	}
+	return true
+}
+
+// isAutoRolledBack returns true if deployment was automatically rolled back.
+func isAutoRolledBack(deployment *kapi.ReplicationController) bool {
+	_, rolledBack := deployment.Annotations[deployapi.DeploymentAutoRolledBackAnnotation]
+	return rolledBack
+}
+
+// autoRollback rolls the template of config back to the last complete
+// deployment, the same way a rollback without a version does. Image change
+// triggers are disabled by the rollback so the image which failed to deploy
+// isn't immediately deployed again. The rollback is recorded on the failed
+// latest deployment before the config is rolled back, so that a failure to
+// record it cannot lead to a second rollback. The record is removed again if
+// the rollback fails, for the next sync to retry it.
+func (c *DeploymentConfigController) autoRollback(existingDeployments *kapi.ReplicationControllerList, config *deployapi.DeploymentConfig, latestDeployment *kapi.ReplicationController) error {
+	target := deployutil.RollbackTargetDeployment(config, existingDeployments)
+	if target == nil {
+		c.recorder.Eventf(config, "DeploymentRollbackSkipped", "No complete deployment to roll back to after version %d exceeded its progress deadline", config.Status.LatestVersion)
+		return nil
+	}
+	if err := c.recordAutoRollback(latestDeployment, target.Name); err != nil {
+		return fmt.Errorf("couldn't record the rollback of deployment %s: %v", deployutil.LabelForDeployment(latestDeployment), err)
+	}
+
+	rollback := &deployapi.DeploymentConfigRollback{
+		Spec: deployapi.DeploymentConfigRollbackSpec{
+			From: kapi.ObjectReference{
+				Name: target.Name,
+			},
+			IncludeTemplate: true,
+		},
+	}
+	rolledBack, err := c.osClient.DeploymentConfigs(config.Namespace).Rollback(rollback)
+	if err == nil {
+		_, err = c.osClient.DeploymentConfigs(config.Namespace).Update(rolledBack)
+	}
+	if err != nil {
+		c.recorder.Eventf(config, "DeploymentRollbackFailed", "Failed to roll back to deployment %q after version %d exceeded its progress deadline: %s", target.Name, config.Status.LatestVersion, err)
+		if clearErr := c.recordAutoRollback(latestDeployment, ""); clearErr != nil {
+			glog.V(2).Infof("Couldn't clear the rollback record of deployment %s, it won't be rolled back again: %v", deployutil.LabelForDeployment(latestDeployment), clearErr)
+		}
+		return fmt.Errorf("couldn't roll back deployment config %s to deployment %s: %v", deployutil.LabelForDeploymentConfig(config), deployutil.LabelForDeployment(target), err)
+	}
+	c.recorder.Eventf(config, "DeploymentRolledBack", "Rolled back to deployment %q after version %d exceeded its progress deadline", target.Name, config.Status.LatestVersion)
+	return nil
+}
+
+// recordAutoRollback records on deployment that it was rolled back to the
+// deployment named target, or removes the record if target is empty. The
+// deployment may have been scaled while reconciling, so the latest copy is
+// updated.
+func (c *DeploymentConfigController) recordAutoRollback(deployment *kapi.ReplicationController, target string) error {
+	return kclient.RetryOnConflict(kclient.DefaultRetry, func() error {
+		latest, err := c.kubeClient.ReplicationControllers(deployment.Namespace).Get(deployment.Name)
+		if err != nil {
+			return err
+		}
+		if latest.Annotations == nil {
+			latest.Annotations = make(map[string]string)
+		}
+		if len(target) == 0 {
+			delete(latest.Annotations, deployapi.DeploymentAutoRolledBackAnnotation)
+		} else {
+			latest.Annotations[deployapi.DeploymentAutoRolledBackAnnotation] = target
+		}
+		_, err = c.kubeClient.ReplicationControllers(latest.Namespace).Update(latest)
+		return err
+	})
+}
+
+// cleanupOldDeployments deletes the oldest inactive deployments of config
+// beyond its revision history limit, along with their deployer and hook pods.
+// Deployments which still have replicas, the active deployment and the
+// deployment a rollback would target are never deleted.
+func (c *DeploymentConfigController) cleanupOldDeployments(existingDeployments *kapi.ReplicationControllerList, config *deployapi.DeploymentConfig) error {
+	if config.Spec.RevisionHistoryLimit == nil {
+		return nil
+	}
+	prunableDeployments := deployutil.DeploymentsForCleanup(config, existingDeployments)
+	limit := *config.Spec.RevisionHistoryLimit
+	if len(prunableDeployments) <= limit {
+		return nil
+	}
+
+	errs := []error{}
+	for _, deployment := range prunableDeployments[limit:] {
+		if err := c.deleteDeployment(&deployment); err != nil {
+			c.recorder.Eventf(config, "DeploymentCleanupFailed", "Failed to delete old deployment %q: %s", deployment.Name, err)
+			errs = append(errs, err)
+			continue
+		}
+		c.recorder.Eventf(config, "DeploymentCleanedUp", "Deleted old deployment %q beyond the revision history limit of %d", deployment.Name, limit)
+	}
+	return kutilerrors.NewAggregate(errs)
+}
+
+// deleteDeployment deletes the deployer and hook pods of deployment and then
+// deployment itself.
+func (c *DeploymentConfigController) deleteDeployment(deployment *kapi.ReplicationController) error {
+	pods, err := c.kubeClient.Pods(deployment.Namespace).List(deployutil.DeployerPodSelector(deployment.Name), fields.Everything())
+	if err != nil {
+		return fmt.Errorf("couldn't list deployer and hook pods of deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+	}
+	for _, pod := range pods.Items {
+		if err := c.kubeClient.Pods(pod.Namespace).Delete(pod.Name, nil); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("couldn't delete pod %s/%s of deployment %s: %v", pod.Namespace, pod.Name, deployutil.LabelForDeployment(deployment), err)
+		}
+	}
+	if err := c.kubeClient.ReplicationControllers(deployment.Namespace).Delete(deployment.Name); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("couldn't delete deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+	}
+	glog.V(4).Infof("Deleted old deployment %s", deployutil.LabelForDeployment(deployment))
+	return nil
+}
@@ -1,14 +1,19 @@
 package deploymentconfig
 
 import (
+	"fmt"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
 	"testing"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/record"
 	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
 	kutil "k8s.io/kubernetes/pkg/util"
 
@@ -631,3 +636,653 @@ func TestHandleScenarios(t *testing.T) {
 func newint(i int) *int {
 	return &i
 }
+
+func newint64(i int64) *int64 {
+	return &i
+}
+
+func TestHandle_cleanupOldDeployments(t *testing.T) {
+	mkdeployment := func(version, replicas int, status deployapi.DeploymentStatus) kapi.ReplicationController {
+		deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(version), kapi.Codec)
+		deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(status)
+		deployment.Annotations[deployapi.DeploymentReplicasAnnotation] = strconv.Itoa(replicas)
+		deployment.Spec.Replicas = replicas
+		return *deployment
+	}
+
+	tests := []struct {
+		name string
+		// limit is the revision history limit of the config
+		limit *int
+		// expectedDeleted are the versions of the deployments expected to be
+		// deleted
+		expectedDeleted []int
+	}{
+		{
+			name:            "no limit",
+			limit:           nil,
+			expectedDeleted: []int{},
+		},
+		{
+			name:            "limit larger than history",
+			limit:           newint(5),
+			expectedDeleted: []int{},
+		},
+		{
+			name:            "limit of one",
+			limit:           newint(1),
+			expectedDeleted: []int{1, 3},
+		},
+		{
+			name:            "limit of zero",
+			limit:           newint(0),
+			expectedDeleted: []int{1, 3, 5},
+		},
+	}
+
+	for _, test := range tests {
+		t.Logf("evaluating test: %s", test.name)
+
+		deployments := map[string]kapi.ReplicationController{}
+		for _, deployment := range []kapi.ReplicationController{
+			mkdeployment(1, 0, deployapi.DeploymentStatusComplete),
+			// still has replicas, it is scaled down by this sync
+			mkdeployment(2, 1, deployapi.DeploymentStatusComplete),
+			mkdeployment(3, 0, deployapi.DeploymentStatusComplete),
+			// the rollback target
+			mkdeployment(4, 0, deployapi.DeploymentStatusComplete),
+			mkdeployment(5, 0, deployapi.DeploymentStatusFailed),
+			// the latest and active deployment
+			mkdeployment(6, 1, deployapi.DeploymentStatusComplete),
+		} {
+			deployments[deployment.Name] = deployment
+		}
+
+		deletedPods := []string{}
+		kc := &ktestclient.Fake{}
+		kc.AddReactor("list", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			list := []kapi.ReplicationController{}
+			for _, deployment := range deployments {
+				list = append(list, deployment)
+			}
+			return true, &kapi.ReplicationControllerList{Items: list}, nil
+		})
+		kc.AddReactor("update", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			rc := action.(ktestclient.UpdateAction).GetObject().(*kapi.ReplicationController)
+			deployments[rc.Name] = *rc
+			return true, rc, nil
+		})
+		kc.AddReactor("delete", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			delete(deployments, action.(ktestclient.DeleteAction).GetName())
+			return true, nil, nil
+		})
+		kc.AddReactor("list", "pods", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			selector := action.(ktestclient.ListAction).GetListRestrictions().Labels
+			pods := []kapi.Pod{}
+			for name := range deployments {
+				pod := kapi.Pod{
+					ObjectMeta: kapi.ObjectMeta{
+						Name:   deployutil.DeployerPodNameForDeployment(name),
+						Labels: map[string]string{deployapi.DeployerPodForDeploymentLabel: name},
+					},
+				}
+				if selector.Matches(labels.Set(pod.Labels)) {
+					pods = append(pods, pod)
+				}
+			}
+			return true, &kapi.PodList{Items: pods}, nil
+		})
+		kc.AddReactor("delete", "pods", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			deletedPods = append(deletedPods, action.(ktestclient.DeleteAction).GetName())
+			return true, nil, nil
+		})
+
+		recorder := &record.FakeRecorder{}
+		controller := &DeploymentConfigController{
+			kubeClient: kc,
+			osClient:   &testclient.Fake{},
+			codec:      kapi.Codec,
+			recorder:   recorder,
+		}
+
+		config := deploytest.OkDeploymentConfig(6)
+		config.Spec.RevisionHistoryLimit = test.limit
+		if err := controller.Handle(config); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expectedPods := []string{}
+		for _, version := range test.expectedDeleted {
+			name := deployutil.DeploymentNameForConfigVersion(config.Name, version)
+			if _, exists := deployments[name]; exists {
+				t.Errorf("expected deployment %s to be deleted", name)
+			}
+			expectedPods = append(expectedPods, deployutil.DeployerPodNameForDeployment(name))
+		}
+		if e, a := 6-len(test.expectedDeleted), len(deployments); e != a {
+			t.Errorf("expected %d deployments to remain, got %d", e, a)
+		}
+		sort.Strings(deletedPods)
+		if !reflect.DeepEqual(expectedPods, deletedPods) {
+			t.Errorf("expected pods %v to be deleted, got %v", expectedPods, deletedPods)
+		}
+		if t.Failed() {
+			t.Fatalf("events:\n%s", strings.Join(recorder.Events, "\t\n"))
+		}
+	}
+}
+
+func TestHandle_autoRollback(t *testing.T) {
+	mkdeployment := func(version, replicas int, status deployapi.DeploymentStatus, reason string) kapi.ReplicationController {
+		deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(version), kapi.Codec)
+		deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(status)
+		deployment.Annotations[deployapi.DeploymentReplicasAnnotation] = strconv.Itoa(replicas)
+		if len(reason) > 0 {
+			deployment.Annotations[deployapi.DeploymentStatusReasonAnnotation] = reason
+		}
+		deployment.Spec.Replicas = replicas
+		return *deployment
+	}
+	rolledBack := func(deployment kapi.ReplicationController) kapi.ReplicationController {
+		deployment.Annotations[deployapi.DeploymentAutoRolledBackAnnotation] = "config-0"
+		return deployment
+	}
+
+	tests := []struct {
+		name         string
+		autoRollback bool
+		deployments  []kapi.ReplicationController
+		// expectedTarget is the name of the deployment expected to be rolled
+		// back to, if any
+		expectedTarget string
+	}{
+		{
+			name:         "progress deadline exceeded",
+			autoRollback: true,
+			deployments: []kapi.ReplicationController{
+				mkdeployment(1, 1, deployapi.DeploymentStatusComplete, ""),
+				mkdeployment(2, 0, deployapi.DeploymentStatusFailed, deployapi.DeploymentFailedProgressDeadlineExceeded),
+			},
+			expectedTarget: "config-1",
+		},
+		{
+			name:         "auto rollback disabled",
+			autoRollback: false,
+			deployments: []kapi.ReplicationController{
+				mkdeployment(1, 1, deployapi.DeploymentStatusComplete, ""),
+				mkdeployment(2, 0, deployapi.DeploymentStatusFailed, deployapi.DeploymentFailedProgressDeadlineExceeded),
+			},
+		},
+		{
+			name:         "failed for another reason",
+			autoRollback: true,
+			deployments: []kapi.ReplicationController{
+				mkdeployment(1, 1, deployapi.DeploymentStatusComplete, ""),
+				mkdeployment(2, 0, deployapi.DeploymentStatusFailed, deployapi.DeploymentFailedDeployerPodNoLongerExists),
+			},
+		},
+		{
+			name:         "complete",
+			autoRollback: true,
+			deployments: []kapi.ReplicationController{
+				mkdeployment(1, 0, deployapi.DeploymentStatusComplete, ""),
+				mkdeployment(2, 1, deployapi.DeploymentStatusComplete, ""),
+			},
+		},
+		{
+			name:         "no deployment to roll back to",
+			autoRollback: true,
+			deployments: []kapi.ReplicationController{
+				mkdeployment(1, 0, deployapi.DeploymentStatusFailed, ""),
+				mkdeployment(2, 0, deployapi.DeploymentStatusFailed, deployapi.DeploymentFailedProgressDeadlineExceeded),
+			},
+		},
+		{
+			name:         "already rolled back",
+			autoRollback: true,
+			deployments: []kapi.ReplicationController{
+				mkdeployment(1, 1, deployapi.DeploymentStatusComplete, ""),
+				rolledBack(mkdeployment(2, 0, deployapi.DeploymentStatusFailed, deployapi.DeploymentFailedProgressDeadlineExceeded)),
+			},
+		},
+		{
+			name:         "produced by a rollback",
+			autoRollback: true,
+			deployments: []kapi.ReplicationController{
+				mkdeployment(0, 1, deployapi.DeploymentStatusComplete, ""),
+				rolledBack(mkdeployment(1, 0, deployapi.DeploymentStatusFailed, deployapi.DeploymentFailedProgressDeadlineExceeded)),
+				mkdeployment(2, 0, deployapi.DeploymentStatusFailed, deployapi.DeploymentFailedProgressDeadlineExceeded),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Logf("evaluating test: %s", test.name)
+
+		kc := &ktestclient.Fake{}
+		kc.AddReactor("list", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			return true, &kapi.ReplicationControllerList{Items: test.deployments}, nil
+		})
+		kc.AddReactor("get", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			name := action.(ktestclient.GetAction).GetName()
+			for i := range test.deployments {
+				if test.deployments[i].Name == name {
+					deployment := test.deployments[i]
+					deployment.Annotations = map[string]string{}
+					for k, v := range test.deployments[i].Annotations {
+						deployment.Annotations[k] = v
+					}
+					return true, &deployment, nil
+				}
+			}
+			return true, nil, kerrors.NewNotFound("ReplicationController", name)
+		})
+		var recorded *kapi.ReplicationController
+		kc.AddReactor("update", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			rc := action.(ktestclient.UpdateAction).GetObject().(*kapi.ReplicationController)
+			if _, ok := rc.Annotations[deployapi.DeploymentAutoRolledBackAnnotation]; ok && rc.Name == "config-2" {
+				recorded = rc
+			}
+			return true, rc, nil
+		})
+
+		var rollback *deployapi.DeploymentConfigRollback
+		var updated *deployapi.DeploymentConfig
+		oc := &testclient.Fake{}
+		oc.AddReactor("create", "deploymentconfigrollbacks", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			rollback = action.(ktestclient.CreateAction).GetObject().(*deployapi.DeploymentConfigRollback)
+			if recorded == nil {
+				t.Errorf("expected the rollback to be recorded on the failed deployment before rolling back")
+			}
+			return true, deploytest.OkDeploymentConfig(3), nil
+		})
+		oc.AddReactor("update", "deploymentconfigs", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			config := action.(ktestclient.UpdateAction).GetObject().(*deployapi.DeploymentConfig)
+			// The rolled back config is updated before the status.
+			if updated == nil {
+				updated = config
+			}
+			return true, config, nil
+		})
+
+		recorder := &record.FakeRecorder{}
+		controller := &DeploymentConfigController{
+			kubeClient: kc,
+			osClient:   oc,
+			codec:      kapi.Codec,
+			recorder:   recorder,
+		}
+
+		config := deploytest.OkDeploymentConfig(2)
+		config.Spec.Strategy = deploytest.OkRollingStrategy()
+		config.Spec.Strategy.RollingParams.ProgressDeadlineSeconds = newint64(60)
+		config.Spec.Strategy.RollingParams.AutoRollback = test.autoRollback
+		if err := controller.Handle(config); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(test.expectedTarget) == 0 {
+			if rollback != nil {
+				t.Errorf("unexpected rollback to %s", rollback.Spec.From.Name)
+			}
+			continue
+		}
+		if rollback == nil {
+			t.Fatalf("expected a rollback to %s", test.expectedTarget)
+		}
+		if e, a := test.expectedTarget, rollback.Spec.From.Name; e != a {
+			t.Errorf("expected rollback to %s, got %s", e, a)
+		}
+		if !rollback.Spec.IncludeTemplate {
+			t.Errorf("expected the template to be rolled back")
+		}
+		if updated == nil || updated.Status.LatestVersion != 3 {
+			t.Errorf("expected the rolled back config to be updated, got %#v", updated)
+		}
+		if recorded == nil || recorded.Annotations[deployapi.DeploymentAutoRolledBackAnnotation] != test.expectedTarget {
+			t.Errorf("expected the rollback to %s to be recorded on the failed deployment, got %#v", test.expectedTarget, recorded)
+		}
+		if t.Failed() {
+			t.Fatalf("events:\n%s", strings.Join(recorder.Events, "\t\n"))
+		}
+	}
+}
+
+// TestHandle_autoRollbackFailures ensures that the config is only rolled back
+// once the rollback is recorded on the failed deployment, and that the record
+// is removed when the rollback fails so that the next sync retries it.
+func TestHandle_autoRollbackFailures(t *testing.T) {
+	mkdeployment := func(version, replicas int, status deployapi.DeploymentStatus, reason string) kapi.ReplicationController {
+		deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(version), kapi.Codec)
+		deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(status)
+		deployment.Annotations[deployapi.DeploymentReplicasAnnotation] = strconv.Itoa(replicas)
+		if len(reason) > 0 {
+			deployment.Annotations[deployapi.DeploymentStatusReasonAnnotation] = reason
+		}
+		deployment.Spec.Replicas = replicas
+		return *deployment
+	}
+
+	tests := []struct {
+		name          string
+		recordErr     error
+		rollbackErr   error
+		expectedRolls int
+		// expectedRecord is the rollback recorded on the failed deployment at
+		// the end of the sync
+		expectedRecord string
+	}{
+		{
+			name:      "recording fails",
+			recordErr: kerrors.NewInternalError(fmt.Errorf("etcd down")),
+		},
+		{
+			name:          "rollback fails",
+			rollbackErr:   kerrors.NewInternalError(fmt.Errorf("etcd down")),
+			expectedRolls: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Logf("evaluating test: %s", test.name)
+
+		failed := mkdeployment(2, 0, deployapi.DeploymentStatusFailed, deployapi.DeploymentFailedProgressDeadlineExceeded)
+		deployments := []kapi.ReplicationController{
+			mkdeployment(1, 1, deployapi.DeploymentStatusComplete, ""),
+			failed,
+		}
+		kc := &ktestclient.Fake{}
+		kc.AddReactor("list", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			return true, &kapi.ReplicationControllerList{Items: deployments}, nil
+		})
+		kc.AddReactor("get", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			copied := failed
+			copied.Annotations = map[string]string{}
+			for k, v := range failed.Annotations {
+				copied.Annotations[k] = v
+			}
+			return true, &copied, nil
+		})
+		kc.AddReactor("update", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			rc := action.(ktestclient.UpdateAction).GetObject().(*kapi.ReplicationController)
+			if test.recordErr != nil {
+				return true, nil, test.recordErr
+			}
+			failed = *rc
+			return true, rc, nil
+		})
+
+		rolls := 0
+		oc := &testclient.Fake{}
+		oc.AddReactor("create", "deploymentconfigrollbacks", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			rolls++
+			if test.rollbackErr != nil {
+				return true, nil, test.rollbackErr
+			}
+			return true, deploytest.OkDeploymentConfig(3), nil
+		})
+
+		controller := &DeploymentConfigController{
+			kubeClient: kc,
+			osClient:   oc,
+			codec:      kapi.Codec,
+			recorder:   &record.FakeRecorder{},
+		}
+
+		config := deploytest.OkDeploymentConfig(2)
+		config.Spec.Strategy = deploytest.OkRollingStrategy()
+		config.Spec.Strategy.RollingParams.ProgressDeadlineSeconds = newint64(60)
+		config.Spec.Strategy.RollingParams.AutoRollback = true
+		if err := controller.Handle(config); err == nil {
+			t.Errorf("expected an error")
+		}
+		if rolls != test.expectedRolls {
+			t.Errorf("expected %d rollbacks, got %d", test.expectedRolls, rolls)
+		}
+		if e, a := test.expectedRecord, failed.Annotations[deployapi.DeploymentAutoRolledBackAnnotation]; e != a {
+			t.Errorf("expected the rollback record %q on the failed deployment, got %q", e, a)
+		}
+	}
+}
+
+func TestHandle_blueGreen(t *testing.T) {
+	mkdeployment := func(version, replicas int, status deployapi.DeploymentStatus, color deployapi.DeploymentColor) kapi.ReplicationController {
+		deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(version), kapi.Codec)
+		deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(status)
+		deployment.Annotations[deployapi.DeploymentReplicasAnnotation] = strconv.Itoa(replicas)
+		deployment.Annotations[deployapi.DeploymentColorAnnotation] = string(color)
+		deployment.Spec.Replicas = replicas
+		return *deployment
+	}
+
+	tests := []struct {
+		name        string
+		deployments []kapi.ReplicationController
+		// liveDeployment is the deployment the service selects
+		liveDeployment string
+		// recorded is true if the status of the config was already recorded
+		// by handling it before
+		recorded bool
+		// expectedSwitch is the deployment the service is expected to be
+		// switched to, if any
+		expectedSwitch string
+		// expectedColor is the live color expected to be recorded, if any
+		expectedColor deployapi.DeploymentColor
+	}{
+		{
+			name: "cancelled after switching",
+			deployments: []kapi.ReplicationController{
+				mkdeployment(1, 1, deployapi.DeploymentStatusComplete, deployapi.DeploymentColorBlue),
+				mkdeployment(2, 1, deployapi.DeploymentStatusFailed, deployapi.DeploymentColorGreen),
+			},
+			liveDeployment: "config-2",
+			expectedSwitch: "config-1",
+			expectedColor:  deployapi.DeploymentColorBlue,
+		},
+		{
+			name: "complete",
+			deployments: []kapi.ReplicationController{
+				mkdeployment(1, 0, deployapi.DeploymentStatusComplete, deployapi.DeploymentColorBlue),
+				mkdeployment(2, 1, deployapi.DeploymentStatusComplete, deployapi.DeploymentColorGreen),
+			},
+			liveDeployment: "config-2",
+			expectedColor:  deployapi.DeploymentColorGreen,
+		},
+		{
+			name: "already recorded",
+			deployments: []kapi.ReplicationController{
+				mkdeployment(1, 0, deployapi.DeploymentStatusComplete, deployapi.DeploymentColorBlue),
+				mkdeployment(2, 1, deployapi.DeploymentStatusComplete, deployapi.DeploymentColorGreen),
+			},
+			liveDeployment: "config-2",
+			recorded:       true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Logf("evaluating test: %s", test.name)
+
+		config := deploytest.OkDeploymentConfig(2)
+		config.Spec.Strategy = deploytest.OkBlueGreenStrategy()
+
+		// actions records the order of service switches and scaling.
+		actions := []string{}
+		var switched *kapi.Service
+		kc := &ktestclient.Fake{}
+		kc.AddReactor("list", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			return true, &kapi.ReplicationControllerList{Items: test.deployments}, nil
+		})
+		kc.AddReactor("update", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			rc := action.(ktestclient.UpdateAction).GetObject().(*kapi.ReplicationController)
+			actions = append(actions, "scale "+rc.Name)
+			return true, rc, nil
+		})
+		kc.AddReactor("get", "services", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			return true, &kapi.Service{
+				ObjectMeta: kapi.ObjectMeta{Name: "service1"},
+				Spec: kapi.ServiceSpec{
+					Selector: map[string]string{"a": "b", deployapi.DeploymentLabel: test.liveDeployment},
+				},
+			}, nil
+		})
+		kc.AddReactor("update", "services", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			switched = action.(ktestclient.UpdateAction).GetObject().(*kapi.Service)
+			actions = append(actions, "switch "+switched.Spec.Selector[deployapi.DeploymentLabel])
+			return true, switched, nil
+		})
+
+		var updated *deployapi.DeploymentConfig
+		oc := &testclient.Fake{}
+		oc.AddReactor("get", "deploymentconfigs", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			return true, config, nil
+		})
+		oc.AddReactor("update", "deploymentconfigs", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			updated = action.(ktestclient.UpdateAction).GetObject().(*deployapi.DeploymentConfig)
+			return true, updated, nil
+		})
+
+		recorder := &record.FakeRecorder{}
+		controller := &DeploymentConfigController{
+			kubeClient: kc,
+			osClient:   oc,
+			codec:      kapi.Codec,
+			recorder:   recorder,
+		}
+
+		if test.recorded {
+			if err := controller.Handle(config); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if updated == nil {
+				t.Fatalf("expected the live color to be recorded")
+			}
+			config, updated = updated, nil
+		}
+		if err := controller.Handle(config); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(test.expectedSwitch) == 0 {
+			if switched != nil {
+				t.Errorf("unexpected switch of the service to %s", switched.Spec.Selector[deployapi.DeploymentLabel])
+			}
+		} else {
+			// The service must be switched back before the deployment it
+			// selected is scaled down.
+			expectedActions := []string{"switch " + test.expectedSwitch, "scale config-2"}
+			if !reflect.DeepEqual(expectedActions, actions) {
+				t.Errorf("expected actions %v, got %v", expectedActions, actions)
+			}
+			if e, a := "b", switched.Spec.Selector["a"]; e != a {
+				t.Errorf("expected the rest of the selector to be kept, got %v", switched.Spec.Selector)
+			}
+		}
+
+		if len(test.expectedColor) == 0 {
+			if updated != nil {
+				t.Errorf("unexpected config update: %#v", updated.Status)
+			}
+		} else {
+			if updated == nil {
+				t.Fatalf("expected the live color to be recorded")
+			}
+			if e, a := test.expectedColor, updated.Status.LiveColor; e != a {
+				t.Errorf("expected live color %s, got %s", e, a)
+			}
+		}
+		if t.Failed() {
+			t.Fatalf("events:\n%s", strings.Join(recorder.Events, "\t\n"))
+		}
+	}
+}
+
+func TestHandle_updateStatus(t *testing.T) {
+	deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codec)
+	deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(deployapi.DeploymentStatusRunning)
+	deployment.Status.Replicas = 2
+
+	mkpod := func(name string, ready bool, labels map[string]string) kapi.Pod {
+		pod := kapi.Pod{ObjectMeta: kapi.ObjectMeta{Name: name, Labels: map[string]string{deployapi.DeploymentConfigLabel: "config"}}}
+		for k, v := range labels {
+			pod.Labels[k] = v
+		}
+		status := kapi.ConditionFalse
+		if ready {
+			status = kapi.ConditionTrue
+		}
+		pod.Status.Conditions = []kapi.PodCondition{{Type: kapi.PodReady, Status: status}}
+		return pod
+	}
+	terminating := mkpod("terminating", true, nil)
+	now := unversioned.Now()
+	terminating.DeletionTimestamp = &now
+	pods := []kapi.Pod{
+		mkpod("ready", true, nil),
+		terminating,
+		mkpod("unready", false, nil),
+		mkpod("deployer", true, map[string]string{deployapi.DeployerPodForDeploymentLabel: deployment.Name}),
+	}
+
+	config := deploytest.OkDeploymentConfig(1)
+	config.Generation = 3
+
+	kc := &ktestclient.Fake{}
+	kc.AddReactor("list", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &kapi.ReplicationControllerList{Items: []kapi.ReplicationController{*deployment}}, nil
+	})
+	kc.AddReactor("list", "pods", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &kapi.PodList{Items: pods}, nil
+	})
+
+	updates := 0
+	oc := &testclient.Fake{}
+	oc.AddReactor("get", "deploymentconfigs", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, config, nil
+	})
+	oc.AddReactor("update", "deploymentconfigs", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		updates++
+		config = action.(ktestclient.UpdateAction).GetObject().(*deployapi.DeploymentConfig)
+		return true, config, nil
+	})
+
+	controller := &DeploymentConfigController{
+		kubeClient: kc,
+		osClient:   oc,
+		codec:      kapi.Codec,
+		recorder:   &record.FakeRecorder{},
+	}
+
+	if err := controller.Handle(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := 1, updates; e != a {
+		t.Fatalf("expected %d config update, got %d", e, a)
+	}
+	status := config.Status
+	if e, a := int64(3), status.ObservedGeneration; e != a {
+		t.Errorf("expected observed generation %d, got %d", e, a)
+	}
+	if e, a := 2, status.UpdatedReplicas; e != a {
+		t.Errorf("expected %d updated replicas, got %d", e, a)
+	}
+	if e, a := 2, status.ReadyReplicas; e != a {
+		t.Errorf("expected %d ready replicas, got %d", e, a)
+	}
+	if e, a := 1, status.AvailableReplicas; e != a {
+		t.Errorf("expected %d available replicas, got %d", e, a)
+	}
+	if c := deployutil.GetCondition(status, deployapi.DeploymentProgressing); c == nil || c.Reason != deployapi.DeploymentRunning {
+		t.Errorf("expected a %s progressing condition, got %#v", deployapi.DeploymentRunning, c)
+	}
+	if c := deployutil.GetCondition(status, deployapi.DeploymentAvailable); c == nil || c.Status != kapi.ConditionTrue {
+		t.Errorf("expected the config to be available, got %#v", c)
+	}
+
+	// Handling the updated config again doesn't change its status.
+	if err := controller.Handle(config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e, a := 1, updates; e != a {
+		t.Errorf("expected no further config updates, got %d updates", a)
+	}
+}
@@ -0,0 +1,180 @@
+package secretchange
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// SecretChangeController increments the version of a DeploymentConfig which
+// has a secret change trigger when the contents of a secret mounted by its
+// pod template change.
+//
+// The trigger records a hash of the contents of each secret it has seen. The
+// hashes are first recorded by the deployment config generator when it
+// creates a new version, so changes made before the controller sees the
+// secret still start a deployment. A secret which had no recorded hash, such
+// as one created after the deployment, only has its hash recorded without
+// starting a new deployment.
+//
+// Use the SecretChangeControllerFactory to create this controller.
+type SecretChangeController struct {
+	deploymentConfigClient deploymentConfigClient
+}
+
+// fatalError is an error which can't be retried.
+type fatalError string
+
+func (e fatalError) Error() string {
+	return fmt.Sprintf("fatal error handling Secret: %s", string(e))
+}
+
+// Handle processes secret change triggers associated with secret.
+func (c *SecretChangeController) Handle(secret *kapi.Secret) error {
+	configs, err := c.deploymentConfigClient.listDeploymentConfigs(secret.Namespace)
+	if err != nil {
+		return fmt.Errorf("couldn't get list of DeploymentConfig while handling Secret %s: %v", labelForSecret(secret), err)
+	}
+
+	hash := deployutil.HashSecretData(secret)
+	anyFailed := false
+	for _, config := range configs {
+		if config.Spec.Paused {
+			glog.V(5).Infof("Ignoring DeploymentConfig %s; the config is paused", deployutil.LabelForDeploymentConfig(config))
+			continue
+		}
+		if !triggerMatchesSecret(config, secret.Name, hash) {
+			continue
+		}
+		if err := c.update(config, secret, hash); err != nil {
+			anyFailed = true
+			glog.V(2).Infof("Couldn't update DeploymentConfig %s for Secret %s: %v", deployutil.LabelForDeploymentConfig(config), labelForSecret(secret), err)
+		}
+	}
+
+	if anyFailed {
+		return fatalError(fmt.Sprintf("couldn't update some DeploymentConfig for trigger on Secret %s", labelForSecret(secret)))
+	}
+	return nil
+}
+
+// triggerMatchesSecret returns true if config has a secret change trigger,
+// its pod template mounts the named secret, and the recorded hash of the
+// secret isn't hash.
+func triggerMatchesSecret(config *deployapi.DeploymentConfig, name, hash string) bool {
+	trigger := deployutil.SecretChangeTriggerFor(config)
+	if trigger == nil {
+		return false
+	}
+	mounted := false
+	for _, secretName := range deployutil.SecretNamesForTemplate(config.Spec.Template) {
+		if secretName == name {
+			mounted = true
+			break
+		}
+	}
+	if !mounted {
+		return false
+	}
+	return trigger.SecretChangeParams == nil || trigger.SecretChangeParams.LastTriggeredSecrets[name] != hash
+}
+
+// update records hash as the hash of secret in the secret change trigger of
+// the latest copy of config. If a different hash was already recorded and
+// config has been deployed, a new deployment is started. Secrets which are no
+// longer mounted by the pod template are forgotten.
+func (c *SecretChangeController) update(config *deployapi.DeploymentConfig, secret *kapi.Secret, hash string) error {
+	return kclient.RetryOnConflict(kclient.DefaultRetry, func() error {
+		latest, err := c.deploymentConfigClient.getDeploymentConfig(config.Namespace, config.Name)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if latest.Spec.Paused || !triggerMatchesSecret(latest, secret.Name, hash) {
+			return nil
+		}
+
+		trigger := deployutil.SecretChangeTriggerFor(latest)
+		if trigger.SecretChangeParams == nil {
+			trigger.SecretChangeParams = &deployapi.DeploymentTriggerSecretChangeParams{}
+		}
+		previous, seen := trigger.SecretChangeParams.LastTriggeredSecrets[secret.Name]
+		recorded := map[string]string{}
+		for _, name := range deployutil.SecretNamesForTemplate(latest.Spec.Template) {
+			if existing, ok := trigger.SecretChangeParams.LastTriggeredSecrets[name]; ok {
+				recorded[name] = existing
+			}
+		}
+		recorded[secret.Name] = hash
+		trigger.SecretChangeParams.LastTriggeredSecrets = recorded
+
+		deploy := seen && previous != hash && latest.Status.LatestVersion > 0
+		if deploy {
+			latest.Status.LatestVersion++
+			latest.Status.Details = &deployapi.DeploymentDetails{
+				Causes: []*deployapi.DeploymentCause{
+					{
+						Type: deployapi.DeploymentTriggerOnSecretChange,
+						SecretTrigger: &deployapi.DeploymentCauseSecretTrigger{
+							From: kapi.ObjectReference{
+								Kind:            "Secret",
+								Namespace:       secret.Namespace,
+								Name:            secret.Name,
+								ResourceVersion: secret.ResourceVersion,
+							},
+						},
+					},
+				},
+			}
+		}
+
+		if _, err := c.deploymentConfigClient.updateDeploymentConfig(latest.Namespace, latest); err != nil {
+			return err
+		}
+		if deploy {
+			glog.V(4).Infof("Updated DeploymentConfig %s to version %d for changes to Secret %s", deployutil.LabelForDeploymentConfig(latest), latest.Status.LatestVersion, labelForSecret(secret))
+		} else {
+			glog.V(4).Infof("Recorded the contents of Secret %s for DeploymentConfig %s", labelForSecret(secret), deployutil.LabelForDeploymentConfig(latest))
+		}
+		return nil
+	})
+}
+
+func labelForSecret(secret *kapi.Secret) string {
+	return fmt.Sprintf("%s/%s", secret.Namespace, secret.Name)
+}
+
+// deploymentConfigClient abstracts access to DeploymentConfigs.
+type deploymentConfigClient interface {
+	listDeploymentConfigs(namespace string) ([]*deployapi.DeploymentConfig, error)
+	getDeploymentConfig(namespace, name string) (*deployapi.DeploymentConfig, error)
+	updateDeploymentConfig(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error)
+}
+
+// deploymentConfigClientImpl is a pluggable deploymentConfigClient.
+type deploymentConfigClientImpl struct {
+	listDeploymentConfigsFunc  func(namespace string) ([]*deployapi.DeploymentConfig, error)
+	getDeploymentConfigFunc    func(namespace, name string) (*deployapi.DeploymentConfig, error)
+	updateDeploymentConfigFunc func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error)
+}
+
+func (i *deploymentConfigClientImpl) listDeploymentConfigs(namespace string) ([]*deployapi.DeploymentConfig, error) {
+	return i.listDeploymentConfigsFunc(namespace)
+}
+
+func (i *deploymentConfigClientImpl) getDeploymentConfig(namespace, name string) (*deployapi.DeploymentConfig, error) {
+	return i.getDeploymentConfigFunc(namespace, name)
+}
+
+func (i *deploymentConfigClientImpl) updateDeploymentConfig(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+	return i.updateDeploymentConfigFunc(namespace, config)
+}
@@ -0,0 +1,173 @@
+package secretchange
+
+import (
+	"flag"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployapitest "github.com/openshift/origin/pkg/deploy/api/test"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+func init() {
+	flag.Set("v", "5")
+}
+
+// TestHandle_secretChangeScenarios ensures that a secret change either
+// records the contents of the secret, starts a new deployment, or is ignored
+// depending on the state of the config.
+func TestHandle_secretChangeScenarios(t *testing.T) {
+	secret := makeSecret("secret1", "new")
+
+	tests := []struct {
+		name string
+		// recorded is the hash recorded for secret1, if any
+		recorded       map[string]string
+		version        int
+		paused         bool
+		mounted        bool
+		trigger        bool
+		expectUpdate   bool
+		expectDeployed bool
+	}{
+		{
+			name:         "first seen",
+			version:      1,
+			mounted:      true,
+			trigger:      true,
+			expectUpdate: true,
+		},
+		{
+			name:           "changed",
+			recorded:       map[string]string{"secret1": "old", "stale": "old"},
+			version:        1,
+			mounted:        true,
+			trigger:        true,
+			expectUpdate:   true,
+			expectDeployed: true,
+		},
+		{
+			name:         "changed before the first deployment",
+			recorded:     map[string]string{"secret1": "old"},
+			version:      0,
+			mounted:      true,
+			trigger:      true,
+			expectUpdate: true,
+		},
+		{
+			name:     "unchanged",
+			recorded: map[string]string{"secret1": deployutil.HashSecretData(secret)},
+			version:  1,
+			mounted:  true,
+			trigger:  true,
+		},
+		{
+			name:     "paused",
+			recorded: map[string]string{"secret1": "old"},
+			version:  1,
+			paused:   true,
+			mounted:  true,
+			trigger:  true,
+		},
+		{
+			name:     "not mounted",
+			recorded: map[string]string{"secret1": "old"},
+			version:  1,
+			trigger:  true,
+		},
+		{
+			name:    "no trigger",
+			version: 1,
+			mounted: true,
+		},
+	}
+
+	for _, test := range tests {
+		config := deployapitest.OkDeploymentConfig(test.version)
+		config.Namespace = secret.Namespace
+		config.Spec.Paused = test.paused
+		if test.mounted {
+			config.Spec.Template.Spec.Volumes = append(config.Spec.Template.Spec.Volumes, kapi.Volume{
+				Name:         "secret-volume",
+				VolumeSource: kapi.VolumeSource{Secret: &kapi.SecretVolumeSource{SecretName: "secret1"}},
+			})
+		}
+		if test.trigger {
+			trigger := deployapi.DeploymentTriggerPolicy{Type: deployapi.DeploymentTriggerOnSecretChange}
+			if test.recorded != nil {
+				trigger.SecretChangeParams = &deployapi.DeploymentTriggerSecretChangeParams{LastTriggeredSecrets: test.recorded}
+			}
+			config.Spec.Triggers = append(config.Spec.Triggers, trigger)
+		}
+
+		var updated *deployapi.DeploymentConfig
+		controller := &SecretChangeController{
+			deploymentConfigClient: &deploymentConfigClientImpl{
+				listDeploymentConfigsFunc: func(namespace string) ([]*deployapi.DeploymentConfig, error) {
+					return []*deployapi.DeploymentConfig{config}, nil
+				},
+				getDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+					return config, nil
+				},
+				updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+					updated = config
+					return config, nil
+				},
+			},
+		}
+
+		if err := controller.Handle(secret); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if !test.expectUpdate {
+			if updated != nil {
+				t.Errorf("%s: unexpected update", test.name)
+			}
+			continue
+		}
+		if updated == nil {
+			t.Errorf("%s: expected an update", test.name)
+			continue
+		}
+
+		recorded := deployutil.SecretChangeTriggerFor(updated).SecretChangeParams.LastTriggeredSecrets
+		if len(recorded) != 1 || recorded["secret1"] != deployutil.HashSecretData(secret) {
+			t.Errorf("%s: expected only the new hash of secret1 to be recorded, got %v", test.name, recorded)
+		}
+
+		if !test.expectDeployed {
+			if updated.Status.LatestVersion != test.version {
+				t.Errorf("%s: expected version %d, got %d", test.name, test.version, updated.Status.LatestVersion)
+			}
+			continue
+		}
+		if e, a := test.version+1, updated.Status.LatestVersion; e != a {
+			t.Errorf("%s: expected version %d, got %d", test.name, e, a)
+		}
+		if updated.Status.Details == nil || len(updated.Status.Details.Causes) != 1 {
+			t.Errorf("%s: expected a single cause, got %#v", test.name, updated.Status.Details)
+			continue
+		}
+		cause := updated.Status.Details.Causes[0]
+		if cause.Type != deployapi.DeploymentTriggerOnSecretChange || cause.SecretTrigger == nil {
+			t.Errorf("%s: expected a secret change cause, got %#v", test.name, cause)
+			continue
+		}
+		if e, a := "secret1", cause.SecretTrigger.From.Name; e != a {
+			t.Errorf("%s: expected cause for secret %s, got %s", test.name, e, a)
+		}
+	}
+}
+
+func makeSecret(name, value string) *kapi.Secret {
+	return &kapi.Secret{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Data: map[string][]byte{"key": []byte(value)},
+	}
+}
@@ -0,0 +1,98 @@
+package secretchange
+
+import (
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/cache"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+	kutil "k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/watch"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	controller "github.com/openshift/origin/pkg/controller"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// SecretChangeControllerFactory can create a SecretChangeController which
+// watches all Secret changes.
+type SecretChangeControllerFactory struct {
+	// Client is an OpenShift client.
+	Client osclient.Interface
+	// KubeClient is a Kubernetes client.
+	KubeClient kclient.Interface
+}
+
+// Create creates a SecretChangeController.
+func (factory *SecretChangeControllerFactory) Create() controller.RunnableController {
+	secretLW := &deployutil.ListWatcherImpl{
+		ListFunc: func() (runtime.Object, error) {
+			return factory.KubeClient.Secrets(kapi.NamespaceAll).List(labels.Everything(), fields.Everything())
+		},
+		WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+			return factory.KubeClient.Secrets(kapi.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
+		},
+	}
+	queue := cache.NewFIFO(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(secretLW, &kapi.Secret{}, queue, 2*time.Minute).Run()
+
+	deploymentConfigLW := &deployutil.ListWatcherImpl{
+		ListFunc: func() (runtime.Object, error) {
+			return factory.Client.DeploymentConfigs(kapi.NamespaceAll).List(labels.Everything(), fields.Everything())
+		},
+		WatchFunc: func(resourceVersion string) (watch.Interface, error) {
+			return factory.Client.DeploymentConfigs(kapi.NamespaceAll).Watch(labels.Everything(), fields.Everything(), resourceVersion)
+		},
+	}
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	cache.NewReflector(deploymentConfigLW, &deployapi.DeploymentConfig{}, store, 2*time.Minute).Run()
+
+	changeController := &SecretChangeController{
+		deploymentConfigClient: &deploymentConfigClientImpl{
+			listDeploymentConfigsFunc: func(namespace string) ([]*deployapi.DeploymentConfig, error) {
+				configs := []*deployapi.DeploymentConfig{}
+				objs := store.List()
+				for _, obj := range objs {
+					config := obj.(*deployapi.DeploymentConfig)
+					if config.Namespace == namespace {
+						configs = append(configs, config)
+					}
+				}
+				return configs, nil
+			},
+			getDeploymentConfigFunc: func(namespace, name string) (*deployapi.DeploymentConfig, error) {
+				return factory.Client.DeploymentConfigs(namespace).Get(name)
+			},
+			updateDeploymentConfigFunc: func(namespace string, config *deployapi.DeploymentConfig) (*deployapi.DeploymentConfig, error) {
+				return factory.Client.DeploymentConfigs(namespace).Update(config)
+			},
+		},
+	}
+
+	return &controller.RetryController{
+		Queue: queue,
+		RetryManager: controller.NewQueueRetryManager(
+			queue,
+			cache.MetaNamespaceKeyFunc,
+			func(obj interface{}, err error, retries controller.Retry) bool {
+				kutil.HandleError(err)
+				if _, isFatal := err.(fatalError); isFatal {
+					return false
+				}
+				if retries.Count > 0 {
+					return false
+				}
+				return true
+			},
+			kutil.NewTokenBucketRateLimiter(1, 10),
+		),
+		Handle: func(obj interface{}) error {
+			secret := obj.(*kapi.Secret)
+			return changeController.Handle(secret)
+		},
+	}
+}
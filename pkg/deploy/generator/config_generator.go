@@ -110,11 +110,41 @@ func (g *DeploymentConfigGenerator) Generate(ctx kapi.Context, name string) (*de
 			Causes: causes,
 		}
 		config.Status.LatestVersion++
+		if err := g.recordSecrets(ctx, config); err != nil {
+			return nil, err
+		}
 	}
 
 	return config, nil
 }
 
+// recordSecrets records the hashes of the secrets mounted by the pod template
+// of config in its secret change trigger, so that changes made to them after
+// the new version was generated start another deployment. Secrets which don't
+// exist yet are recorded by the trigger when they are created.
+func (g *DeploymentConfigGenerator) recordSecrets(ctx kapi.Context, config *deployapi.DeploymentConfig) error {
+	trigger := deployutil.SecretChangeTriggerFor(config)
+	if trigger == nil {
+		return nil
+	}
+	recorded := map[string]string{}
+	for _, name := range deployutil.SecretNamesForTemplate(config.Spec.Template) {
+		secret, err := g.Client.GetSecret(ctx, name)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		recorded[name] = deployutil.HashSecretData(secret)
+	}
+	if trigger.SecretChangeParams == nil {
+		trigger.SecretChangeParams = &deployapi.DeploymentTriggerSecretChangeParams{}
+	}
+	trigger.SecretChangeParams.LastTriggeredSecrets = recorded
+	return nil
+}
+
 func (g *DeploymentConfigGenerator) findImageStream(config *deployapi.DeploymentConfig, params *deployapi.DeploymentTriggerImageChangeParams) (*imageapi.ImageStream, error) {
 	if len(params.From.Name) > 0 {
 		namespace := params.From.Namespace
@@ -133,6 +163,7 @@ func (g *DeploymentConfigGenerator) findImageStream(config *deployapi.Deployment
 type GeneratorClient interface {
 	GetDeploymentConfig(ctx kapi.Context, name string) (*deployapi.DeploymentConfig, error)
 	GetImageStream(ctx kapi.Context, name string) (*imageapi.ImageStream, error)
+	GetSecret(ctx kapi.Context, name string) (*kapi.Secret, error)
 	// LEGACY: used, to scan all repositories for a DockerImageReference.  Will be removed
 	// when we drop support for reference by DockerImageReference.
 	ListImageStreams(ctx kapi.Context) (*imageapi.ImageStreamList, error)
@@ -143,6 +174,7 @@ type Client struct {
 	ISFn   func(ctx kapi.Context, name string) (*imageapi.ImageStream, error)
 	LISFn  func(ctx kapi.Context) (*imageapi.ImageStreamList, error)
 	LISFn2 func(ctx kapi.Context, label labels.Selector) (*imageapi.ImageStreamList, error)
+	SFn    func(ctx kapi.Context, name string) (*kapi.Secret, error)
 }
 
 func (c Client) GetDeploymentConfig(ctx kapi.Context, name string) (*deployapi.DeploymentConfig, error) {
@@ -151,6 +183,9 @@ func (c Client) GetDeploymentConfig(ctx kapi.Context, name string) (*deployapi.D
 func (c Client) GetImageStream(ctx kapi.Context, name string) (*imageapi.ImageStream, error) {
 	return c.ISFn(ctx, name)
 }
+func (c Client) GetSecret(ctx kapi.Context, name string) (*kapi.Secret, error) {
+	return c.SFn(ctx, name)
+}
 func (c Client) ListImageStreams(ctx kapi.Context) (*imageapi.ImageStreamList, error) {
 	if c.LISFn2 != nil {
 		return c.LISFn2(ctx, labels.Everything())
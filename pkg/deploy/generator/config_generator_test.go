@@ -10,6 +10,7 @@ import (
 
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 )
 
@@ -105,6 +106,50 @@ func TestGenerate_fromZeroConfigWithoutTagChange(t *testing.T) {
 	}
 }
 
+func TestGenerate_recordsSecrets(t *testing.T) {
+	secret := &kapi.Secret{
+		ObjectMeta: kapi.ObjectMeta{Name: "db-credentials"},
+		Data:       map[string][]byte{"password": []byte("secret")},
+	}
+	generator := &DeploymentConfigGenerator{
+		Client: Client{
+			DCFn: func(ctx kapi.Context, id string) (*deployapi.DeploymentConfig, error) {
+				config := deploytest.OkDeploymentConfig(0)
+				config.Spec.Triggers = []deployapi.DeploymentTriggerPolicy{{Type: deployapi.DeploymentTriggerOnSecretChange}}
+				for _, name := range []string{"db-credentials", "missing"} {
+					config.Spec.Template.Spec.Volumes = append(config.Spec.Template.Spec.Volumes, kapi.Volume{
+						Name:         name,
+						VolumeSource: kapi.VolumeSource{Secret: &kapi.SecretVolumeSource{SecretName: name}},
+					})
+				}
+				return config, nil
+			},
+			SFn: func(ctx kapi.Context, name string) (*kapi.Secret, error) {
+				if name != secret.Name {
+					return nil, kerrors.NewNotFound("Secret", name)
+				}
+				return secret, nil
+			},
+		},
+	}
+
+	config, err := generator.Generate(kapi.NewDefaultContext(), "deploy1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.Status.LatestVersion != 1 {
+		t.Fatalf("Expected config LatestVersion=1, got %d", config.Status.LatestVersion)
+	}
+	params := config.Spec.Triggers[0].SecretChangeParams
+	if params == nil {
+		t.Fatalf("Expected the secrets to be recorded")
+	}
+	expected := map[string]string{"db-credentials": deployutil.HashSecretData(secret)}
+	if !kapi.Semantic.DeepEqual(params.LastTriggeredSecrets, expected) {
+		t.Errorf("Expected recorded secrets %v, got %v", expected, params.LastTriggeredSecrets)
+	}
+}
+
 func TestGenerate_fromConfigWithUpdatedImageRef(t *testing.T) {
 	newRepoName := "registry:8080/openshift/test-image@sha256:00000000000000000000000000000002"
 	streamName := "test-image-stream"
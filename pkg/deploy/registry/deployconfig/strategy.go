@@ -39,13 +39,21 @@ func (strategy) AllowUnconditionalUpdate() bool {
 
 // PrepareForCreate clears fields that are not allowed to be set by end users on creation.
 func (strategy) PrepareForCreate(obj runtime.Object) {
-	_ = obj.(*api.DeploymentConfig)
+	config := obj.(*api.DeploymentConfig)
+	config.Generation = 1
 	// TODO: need to ensure status.latestVersion is not set out of order
 }
 
 // PrepareForUpdate clears fields that are not allowed to be set by end users on update.
 func (strategy) PrepareForUpdate(obj, old runtime.Object) {
-	_ = obj.(*api.DeploymentConfig)
+	newConfig := obj.(*api.DeploymentConfig)
+	oldConfig := old.(*api.DeploymentConfig)
+	// Any change to the spec is a new generation, updates to the status alone
+	// are not.
+	newConfig.Generation = oldConfig.Generation
+	if !kapi.Semantic.DeepEqual(newConfig.Spec, oldConfig.Spec) {
+		newConfig.Generation = oldConfig.Generation + 1
+	}
 	// TODO: need to ensure status.latestVersion is not set out of order
 }
 
@@ -47,3 +47,36 @@ func TestDeploymentConfigStrategy(t *testing.T) {
 		t.Errorf("Expected error validating")
 	}
 }
+
+func TestDeploymentConfigStrategyGeneration(t *testing.T) {
+	config := &deployapi.DeploymentConfig{
+		ObjectMeta: kapi.ObjectMeta{Name: "foo", Namespace: "default"},
+		Spec:       deploytest.OkDeploymentConfigSpec(),
+	}
+	Strategy.PrepareForCreate(config)
+	if e, a := int64(1), config.Generation; e != a {
+		t.Fatalf("expected generation %d on create, got %d", e, a)
+	}
+
+	statusUpdate := *config
+	statusUpdate.Status.LatestVersion = 1
+	Strategy.PrepareForUpdate(&statusUpdate, config)
+	if e, a := int64(1), statusUpdate.Generation; e != a {
+		t.Errorf("expected generation %d after a status update, got %d", e, a)
+	}
+
+	specUpdate := *config
+	specUpdate.Spec = deploytest.OkDeploymentConfigSpec()
+	specUpdate.Spec.Replicas = 3
+	Strategy.PrepareForUpdate(&specUpdate, config)
+	if e, a := int64(2), specUpdate.Generation; e != a {
+		t.Errorf("expected generation %d after a spec update, got %d", e, a)
+	}
+
+	// Clients can't set the generation themselves.
+	specUpdate.Generation = 10
+	Strategy.PrepareForUpdate(&specUpdate, config)
+	if e, a := int64(2), specUpdate.Generation; e != a {
+		t.Errorf("expected generation %d, got %d", e, a)
+	}
+}
@@ -0,0 +1,220 @@
+package bluegreen
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util"
+	kutilerrors "k8s.io/kubernetes/pkg/util/errors"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	strat "github.com/openshift/origin/pkg/deploy/strategy"
+	stratsupport "github.com/openshift/origin/pkg/deploy/strategy/support"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// BlueGreenDeploymentStrategy is a Strategy which scales a new deployment up
+// to the desired replica count alongside the previous deployment, and once
+// all of its pods are ready, switches a service over to it. Deployments
+// alternate between the blue and green colors.
+//
+// The previous deployment is kept at full scale for a while after the switch
+// so that cancelling the deployment switches the service straight back to
+// pods which are already running. If the new pods don't become ready, the new
+// deployment is scaled back to zero and the service is never switched.
+type BlueGreenDeploymentStrategy struct {
+	// replicationControllers is used to record the color of deployments.
+	replicationControllers kclient.ReplicationControllersNamespacer
+	// services is used to switch the service of the config.
+	services kclient.ServicesNamespacer
+	// scaler is used to scale replication controllers.
+	scaler kubectl.Scaler
+	// codec is used to decode DeploymentConfigs contained in deployments.
+	codec runtime.Codec
+	// hookExecutor can execute a lifecycle hook.
+	hookExecutor hookExecutor
+	// getUpdateAcceptor returns an UpdateAcceptor to verify the pods of the
+	// new deployment.
+	getUpdateAcceptor func(timeout time.Duration) strat.UpdateAcceptor
+	// sleep keeps the previous deployment warm.
+	sleep func(d time.Duration)
+	// retryTimeout is how long to wait for the replica count update to succeed
+	// before giving up.
+	retryTimeout time.Duration
+	// retryPeriod is how often to try updating the replica count.
+	retryPeriod time.Duration
+}
+
+// AcceptorInterval is how often the UpdateAcceptor should check for
+// readiness.
+const AcceptorInterval = 1 * time.Second
+
+// NewBlueGreenDeploymentStrategy makes a BlueGreenDeploymentStrategy backed
+// by a real HookExecutor and client.
+func NewBlueGreenDeploymentStrategy(client kclient.Interface, osClient osclient.Interface, codec runtime.Codec) *BlueGreenDeploymentStrategy {
+	scaler, _ := kubectl.ScalerFor("ReplicationController", client)
+	return &BlueGreenDeploymentStrategy{
+		replicationControllers: client,
+		services:               client,
+		scaler:                 scaler,
+		codec:                  codec,
+		hookExecutor:           stratsupport.NewHookExecutor(client, osClient, os.Stdout, codec),
+		getUpdateAcceptor: func(timeout time.Duration) strat.UpdateAcceptor {
+			return stratsupport.NewAcceptNewlyObservedReadyPods(client, timeout, AcceptorInterval)
+		},
+		sleep:        time.Sleep,
+		retryTimeout: 120 * time.Second,
+		retryPeriod:  1 * time.Second,
+	}
+}
+
+// Deploy scales to up to desiredReplicas, switches the service of its config
+// to it once its pods are ready, and scales from down to zero once it has
+// been kept warm.
+func (s *BlueGreenDeploymentStrategy) Deploy(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
+	config, err := deployutil.DecodeDeploymentConfig(to, s.codec)
+	if err != nil {
+		return fmt.Errorf("couldn't decode config from deployment %s: %v", to.Name, err)
+	}
+
+	params := config.Spec.Strategy.BlueGreenParams
+	if params == nil {
+		return fmt.Errorf("deployment %s has no blue/green parameters", deployutil.LabelForDeployment(to))
+	}
+	timeout := time.Duration(deployapi.DefaultBlueGreenTimeoutSeconds) * time.Second
+	if params.TimeoutSeconds != nil {
+		timeout = time.Duration(*params.TimeoutSeconds) * time.Second
+	}
+
+	// Execute any pre-hook.
+	if params.Pre != nil {
+		if err := s.hookExecutor.Execute(params.Pre, to, "prehook"); err != nil {
+			return fmt.Errorf("Pre hook failed: %s", err)
+		}
+		glog.Infof("Pre hook finished")
+	}
+
+	color := deployutil.NextDeploymentColor(from)
+	glog.Infof("Scaling %s deployment %s to %d alongside the previous deployment", color, deployutil.LabelForDeployment(to), desiredReplicas)
+	if err := s.scaleAndWait(to, desiredReplicas); err != nil {
+		return s.abort(to, fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(to), desiredReplicas, err))
+	}
+	if err := s.getUpdateAcceptor(timeout).Accept(to); err != nil {
+		return s.abort(to, fmt.Errorf("update acceptor rejected %s: %v", deployutil.LabelForDeployment(to), err))
+	}
+
+	// Record the color before switching so the deployment is never live
+	// without one.
+	if err := s.setColor(to, color); err != nil {
+		return s.abort(to, fmt.Errorf("couldn't record the color of %s: %v", deployutil.LabelForDeployment(to), err))
+	}
+	if _, err := SwitchService(s.services, to.Namespace, params.ServiceName, to); err != nil {
+		return s.abort(to, fmt.Errorf("couldn't switch service %s to %s: %v", params.ServiceName, deployutil.LabelForDeployment(to), err))
+	}
+	glog.Infof("Switched service %s to %s deployment %s", params.ServiceName, color, deployutil.LabelForDeployment(to))
+
+	if from != nil {
+		if params.KeepWarmSeconds > 0 && from.Spec.Replicas > 0 {
+			glog.Infof("Keeping previous deployment %s warm for %d seconds; cancel the deployment to switch back to it", deployutil.LabelForDeployment(from), params.KeepWarmSeconds)
+			s.sleep(time.Duration(params.KeepWarmSeconds) * time.Second)
+		}
+		if err := s.scaleAndWait(from, 0); err != nil {
+			return fmt.Errorf("couldn't scale %s to 0: %v", deployutil.LabelForDeployment(from), err)
+		}
+	}
+
+	// Execute any post-hook. Errors are logged and ignored.
+	if params.Post != nil {
+		if err := s.hookExecutor.Execute(params.Post, to, "posthook"); err != nil {
+			util.HandleError(fmt.Errorf("post hook failed: %s", err))
+		} else {
+			glog.Infof("Post hook finished")
+		}
+	}
+
+	glog.Infof("Deployment %s successfully made active", to.Name)
+	return nil
+}
+
+// abort scales to back to zero, and returns err along with any error scaling
+// back. The previous deployment is left alone since it was never scaled down.
+func (s *BlueGreenDeploymentStrategy) abort(to *kapi.ReplicationController, err error) error {
+	glog.Infof("Aborting deployment %s: %v", deployutil.LabelForDeployment(to), err)
+	errs := []error{err}
+	if scaleErr := s.scaleAndWait(to, 0); scaleErr != nil {
+		errs = append(errs, fmt.Errorf("couldn't scale %s back to 0: %v", deployutil.LabelForDeployment(to), scaleErr))
+	}
+	return kutilerrors.NewAggregate(errs)
+}
+
+// setColor records color in the annotations of deployment.
+func (s *BlueGreenDeploymentStrategy) setColor(deployment *kapi.ReplicationController, color deployapi.DeploymentColor) error {
+	return kclient.RetryOnConflict(kclient.DefaultRetry, func() error {
+		rcs := s.replicationControllers.ReplicationControllers(deployment.Namespace)
+		current, err := rcs.Get(deployment.Name)
+		if err != nil {
+			return err
+		}
+		if current.Annotations == nil {
+			current.Annotations = map[string]string{}
+		}
+		current.Annotations[deployapi.DeploymentColorAnnotation] = string(color)
+		_, err = rcs.Update(current)
+		return err
+	})
+}
+
+func (s *BlueGreenDeploymentStrategy) scaleAndWait(deployment *kapi.ReplicationController, replicas int) error {
+	retry := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+	wait := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+	return s.scaler.Scale(deployment.Namespace, deployment.Name, uint(replicas), &kubectl.ScalePrecondition{Size: -1, ResourceVersion: ""}, retry, wait)
+}
+
+// SwitchService pins the selector of the named service to the pods of
+// deployment, and returns true if the service had to be switched. The rest of
+// the selector is left as it is.
+func SwitchService(services kclient.ServicesNamespacer, namespace, name string, deployment *kapi.ReplicationController) (bool, error) {
+	switched := false
+	err := kclient.RetryOnConflict(kclient.DefaultRetry, func() error {
+		service, err := services.Services(namespace).Get(name)
+		if err != nil {
+			return err
+		}
+		if service.Spec.Selector[deployapi.DeploymentLabel] == deployment.Name {
+			return nil
+		}
+		if service.Spec.Selector == nil {
+			service.Spec.Selector = map[string]string{}
+		}
+		service.Spec.Selector[deployapi.DeploymentLabel] = deployment.Name
+		if _, err := services.Services(namespace).Update(service); err != nil {
+			return err
+		}
+		switched = true
+		return nil
+	})
+	return switched, err
+}
+
+// hookExecutor knows how to execute a deployment lifecycle hook.
+type hookExecutor interface {
+	Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+}
+
+// hookExecutorImpl is a pluggable hookExecutor.
+type hookExecutorImpl struct {
+	executeFunc func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+}
+
+// Execute executes the provided lifecycle hook
+func (i *hookExecutorImpl) Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+	return i.executeFunc(hook, deployment, label)
+}
@@ -0,0 +1,192 @@
+package bluegreen
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	api "github.com/openshift/origin/pkg/api/latest"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	scalertest "github.com/openshift/origin/pkg/deploy/scaler/test"
+	strat "github.com/openshift/origin/pkg/deploy/strategy"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+func TestBlueGreen_deploySwitchesService(t *testing.T) {
+	from, to := blueGreenDeployments()
+	from.Spec.Replicas = 2
+	from.Annotations[deployapi.DeploymentColorAnnotation] = string(deployapi.DeploymentColorBlue)
+
+	client := ktestclient.NewSimpleFake(service(), to)
+	scaler := &scalertest.FakeScaler{}
+	pauses := []time.Duration{}
+	strategy := newStrategy(client, scaler, nil)
+	strategy.sleep = func(d time.Duration) {
+		pauses = append(pauses, d)
+	}
+
+	if err := strategy.Deploy(from, to, 2); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+
+	expectedEvents := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 2},
+		{Name: from.Name, Size: 0},
+	}
+	if !reflect.DeepEqual(expectedEvents, scaler.Events) {
+		t.Errorf("expected scale events %v, got %v", expectedEvents, scaler.Events)
+	}
+	if e, a := []time.Duration{60 * time.Second}, pauses; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected pauses %v, got %v", e, a)
+	}
+
+	updatedTo := updated(client, "replicationcontrollers").(*kapi.ReplicationController)
+	if e, a := deployapi.DeploymentColorGreen, deployutil.DeploymentColorFor(updatedTo); e != a {
+		t.Errorf("expected color %s, got %s", e, a)
+	}
+	updatedService := updated(client, "services").(*kapi.Service)
+	expectedSelector := map[string]string{"name": "frontend", deployapi.DeploymentLabel: to.Name}
+	if !reflect.DeepEqual(expectedSelector, updatedService.Spec.Selector) {
+		t.Errorf("expected selector %v, got %v", expectedSelector, updatedService.Spec.Selector)
+	}
+}
+
+func TestBlueGreen_deployInitial(t *testing.T) {
+	_, to := blueGreenDeployments()
+
+	client := ktestclient.NewSimpleFake(service(), to)
+	scaler := &scalertest.FakeScaler{}
+	strategy := newStrategy(client, scaler, nil)
+	strategy.sleep = func(d time.Duration) {
+		t.Errorf("unexpected pause of %v without a previous deployment", d)
+	}
+
+	if err := strategy.Deploy(nil, to, 3); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+
+	expectedEvents := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 3},
+	}
+	if !reflect.DeepEqual(expectedEvents, scaler.Events) {
+		t.Errorf("expected scale events %v, got %v", expectedEvents, scaler.Events)
+	}
+	updatedTo := updated(client, "replicationcontrollers").(*kapi.ReplicationController)
+	if e, a := deployapi.DeploymentColorBlue, deployutil.DeploymentColorFor(updatedTo); e != a {
+		t.Errorf("expected color %s, got %s", e, a)
+	}
+}
+
+func TestBlueGreen_acceptorFailureAborts(t *testing.T) {
+	from, to := blueGreenDeployments()
+	from.Spec.Replicas = 2
+
+	client := ktestclient.NewSimpleFake(service(), to)
+	scaler := &scalertest.FakeScaler{}
+	strategy := newStrategy(client, scaler, fmt.Errorf("pods not ready"))
+
+	if err := strategy.Deploy(from, to, 2); err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+
+	// The previous deployment was never scaled down.
+	expectedEvents := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 2},
+		{Name: to.Name, Size: 0},
+	}
+	if !reflect.DeepEqual(expectedEvents, scaler.Events) {
+		t.Errorf("expected scale events %v, got %v", expectedEvents, scaler.Events)
+	}
+	if obj := updated(client, "services"); obj != nil {
+		t.Errorf("expected the service not to be switched, got %#v", obj)
+	}
+}
+
+func TestBlueGreen_missingServiceAborts(t *testing.T) {
+	from, to := blueGreenDeployments()
+	from.Spec.Replicas = 2
+
+	client := ktestclient.NewSimpleFake(to)
+	scaler := &scalertest.FakeScaler{}
+	strategy := newStrategy(client, scaler, nil)
+
+	err := strategy.Deploy(from, to, 2)
+	if err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+	t.Logf("got expected error: %v", err)
+
+	expectedEvents := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 2},
+		{Name: to.Name, Size: 0},
+	}
+	if !reflect.DeepEqual(expectedEvents, scaler.Events) {
+		t.Errorf("expected scale events %v, got %v", expectedEvents, scaler.Events)
+	}
+}
+
+func newStrategy(client *ktestclient.Fake, scaler *scalertest.FakeScaler, acceptErr error) *BlueGreenDeploymentStrategy {
+	return &BlueGreenDeploymentStrategy{
+		replicationControllers: client,
+		services:               client,
+		scaler:                 scaler,
+		codec:                  api.Codec,
+		getUpdateAcceptor: func(timeout time.Duration) strat.UpdateAcceptor {
+			return &testAcceptor{
+				acceptFn: func(deployment *kapi.ReplicationController) error {
+					return acceptErr
+				},
+			}
+		},
+		sleep:        func(d time.Duration) {},
+		retryTimeout: 1 * time.Second,
+		retryPeriod:  1 * time.Millisecond,
+	}
+}
+
+// blueGreenDeployments returns the first and second deployments of a config
+// using the BlueGreen strategy.
+func blueGreenDeployments() (*kapi.ReplicationController, *kapi.ReplicationController) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Strategy = deploytest.OkBlueGreenStrategy()
+	config.Spec.Strategy.BlueGreenParams.ServiceName = "frontend"
+	from, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	config.Status.LatestVersion++
+	to, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	return from, to
+}
+
+func service() *kapi.Service {
+	return &kapi.Service{
+		ObjectMeta: kapi.ObjectMeta{Name: "frontend", Namespace: kapi.NamespaceDefault},
+		Spec: kapi.ServiceSpec{
+			Selector: map[string]string{"name": "frontend"},
+		},
+	}
+}
+
+// updated returns the last object of resource updated through client, if
+// any.
+func updated(client *ktestclient.Fake, resource string) runtime.Object {
+	var obj runtime.Object
+	for _, action := range client.Actions() {
+		if update, ok := action.(ktestclient.UpdateAction); ok && action.GetResource() == resource {
+			obj = update.GetObject()
+		}
+	}
+	return obj
+}
+
+type testAcceptor struct {
+	acceptFn func(*kapi.ReplicationController) error
+}
+
+func (t *testAcceptor) Accept(deployment *kapi.ReplicationController) error {
+	return t.acceptFn(deployment)
+}
@@ -0,0 +1,267 @@
+package canary
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util"
+	kutilerrors "k8s.io/kubernetes/pkg/util/errors"
+
+	osclient "github.com/openshift/origin/pkg/client"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	strat "github.com/openshift/origin/pkg/deploy/strategy"
+	stratsupport "github.com/openshift/origin/pkg/deploy/strategy/support"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+// CanaryDeploymentStrategy is a Strategy which scales a new deployment up
+// through the steps of the config, scaling the previous deployment down as
+// the new deployment is scaled up. Each step waits for the new pods to become
+// ready, then optionally pauses and runs a verification hook before moving on
+// to the next step.
+//
+// If a step fails, the deployment is aborted: the new deployment is scaled
+// back to zero and the previous deployment is scaled back to its original
+// replica count.
+type CanaryDeploymentStrategy struct {
+	// getReplicationController knows how to get a replication controller.
+	getReplicationController func(namespace, name string) (*kapi.ReplicationController, error)
+	// scaler is used to scale replication controllers.
+	scaler kubectl.Scaler
+	// codec is used to decode DeploymentConfigs contained in deployments.
+	codec runtime.Codec
+	// hookExecutor can execute a lifecycle hook.
+	hookExecutor hookExecutor
+	// getUpdateAcceptor returns an UpdateAcceptor to verify the pods of each
+	// step.
+	getUpdateAcceptor func(timeout time.Duration) strat.UpdateAcceptor
+	// sleep pauses between steps.
+	sleep func(d time.Duration)
+	// retryTimeout is how long to wait for the replica count update to succeed
+	// before giving up.
+	retryTimeout time.Duration
+	// retryPeriod is how often to try updating the replica count.
+	retryPeriod time.Duration
+}
+
+// AcceptorInterval is how often the UpdateAcceptor should check for
+// readiness.
+const AcceptorInterval = 1 * time.Second
+
+// NewCanaryDeploymentStrategy makes a CanaryDeploymentStrategy backed by a
+// real HookExecutor and client.
+func NewCanaryDeploymentStrategy(client kclient.Interface, osClient osclient.Interface, codec runtime.Codec) *CanaryDeploymentStrategy {
+	scaler, _ := kubectl.ScalerFor("ReplicationController", client)
+	return &CanaryDeploymentStrategy{
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return client.ReplicationControllers(namespace).Get(name)
+		},
+		scaler:       scaler,
+		codec:        codec,
+		hookExecutor: stratsupport.NewHookExecutor(client, osClient, os.Stdout, codec),
+		getUpdateAcceptor: func(timeout time.Duration) strat.UpdateAcceptor {
+			return stratsupport.NewAcceptNewlyObservedReadyPods(client, timeout, AcceptorInterval)
+		},
+		sleep:        time.Sleep,
+		retryTimeout: 120 * time.Second,
+		retryPeriod:  1 * time.Second,
+	}
+}
+
+// Deploy scales to up through the steps of its config and then to
+// desiredReplicas, scaling from down by the same amount at each step.
+func (s *CanaryDeploymentStrategy) Deploy(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int) error {
+	config, err := deployutil.DecodeDeploymentConfig(to, s.codec)
+	if err != nil {
+		return fmt.Errorf("couldn't decode config from deployment %s: %v", to.Name, err)
+	}
+
+	params := config.Spec.Strategy.CanaryParams
+	if params == nil {
+		return fmt.Errorf("deployment %s has no canary parameters", deployutil.LabelForDeployment(to))
+	}
+	timeout := time.Duration(deployapi.DefaultCanaryTimeoutSeconds) * time.Second
+	if params.TimeoutSeconds != nil {
+		timeout = time.Duration(*params.TimeoutSeconds) * time.Second
+	}
+	updateAcceptor := s.getUpdateAcceptor(timeout)
+
+	// Execute any pre-hook.
+	if params.Pre != nil {
+		if err := s.hookExecutor.Execute(params.Pre, to, "prehook"); err != nil {
+			return fmt.Errorf("Pre hook failed: %s", err)
+		}
+		glog.Infof("Pre hook finished")
+	}
+
+	c := &canary{
+		strategy:        s,
+		from:            from,
+		to:              to,
+		desiredReplicas: desiredReplicas,
+		updateAcceptor:  updateAcceptor,
+	}
+	if from != nil {
+		c.fromReplicas = from.Spec.Replicas
+		c.fromCurrentReplicas = from.Spec.Replicas
+	}
+
+	for i, step := range params.Steps {
+		replicas := stepReplicas(step.Replicas, desiredReplicas)
+		glog.Infof("Starting step %d of %d with %d replicas of %s", i+1, len(params.Steps), replicas, deployutil.LabelForDeployment(to))
+		if err := c.scaleTo(replicas); err != nil {
+			return c.abort(fmt.Errorf("step %d failed: %v", i+1, err))
+		}
+
+		if step.PauseSeconds > 0 {
+			glog.Infof("Pausing for %d seconds before verifying step %d", step.PauseSeconds, i+1)
+			s.sleep(time.Duration(step.PauseSeconds) * time.Second)
+		}
+
+		if step.Verify != nil {
+			if err := s.hookExecutor.Execute(step.Verify, c.to, fmt.Sprintf("verifyhook-%d", i+1)); err != nil {
+				return c.abort(fmt.Errorf("Verify hook of step %d failed: %s", i+1, err))
+			}
+			glog.Infof("Verify hook of step %d finished", i+1)
+		}
+	}
+
+	// Complete the scale up.
+	if err := c.scaleTo(desiredReplicas); err != nil {
+		return c.abort(err)
+	}
+
+	// Execute any post-hook. Errors are logged and ignored.
+	if params.Post != nil {
+		if err := s.hookExecutor.Execute(params.Post, c.to, "posthook"); err != nil {
+			util.HandleError(fmt.Errorf("post hook failed: %s", err))
+		} else {
+			glog.Infof("Post hook finished")
+		}
+	}
+
+	glog.Infof("Deployment %s successfully made active", to.Name)
+	return nil
+}
+
+// canary tracks the replica counts of a deployment in progress.
+type canary struct {
+	strategy *CanaryDeploymentStrategy
+	// from is the previous deployment, if any.
+	from *kapi.ReplicationController
+	// fromReplicas is the original replica count of from.
+	fromReplicas int
+	// fromCurrentReplicas is the replica count from has been scaled to.
+	fromCurrentReplicas int
+	// to is the new deployment.
+	to *kapi.ReplicationController
+	// toReplicas is the replica count to has been scaled to.
+	toReplicas int
+	// desiredReplicas is the replica count of to once the deployment is
+	// complete.
+	desiredReplicas int
+	// updateAcceptor verifies the pods of to are ready after each scale up.
+	updateAcceptor strat.UpdateAcceptor
+}
+
+// scaleTo scales to up to replicas and waits for its pods to become ready,
+// then scales from down so the total replica count stays the same. Neither
+// deployment is scaled the other way.
+func (c *canary) scaleTo(replicas int) error {
+	if replicas > c.toReplicas {
+		updated, err := c.strategy.scaleAndWait(c.to, replicas)
+		if err != nil {
+			return fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(c.to), replicas, err)
+		}
+		c.to, c.toReplicas = updated, replicas
+		if err := c.updateAcceptor.Accept(c.to); err != nil {
+			return fmt.Errorf("update acceptor rejected %s: %v", deployutil.LabelForDeployment(c.to), err)
+		}
+	}
+
+	if c.from == nil {
+		return nil
+	}
+	remaining := c.desiredReplicas - c.toReplicas
+	if remaining < 0 {
+		remaining = 0
+	}
+	if remaining >= c.fromCurrentReplicas {
+		return nil
+	}
+	updated, err := c.strategy.scaleAndWait(c.from, remaining)
+	if err != nil {
+		return fmt.Errorf("couldn't scale %s to %d: %v", deployutil.LabelForDeployment(c.from), remaining, err)
+	}
+	c.from, c.fromCurrentReplicas = updated, remaining
+	return nil
+}
+
+// abort scales to back to zero and from back to its original replica count,
+// and returns err along with any errors scaling back.
+func (c *canary) abort(err error) error {
+	glog.Infof("Aborting deployment %s: %v", deployutil.LabelForDeployment(c.to), err)
+	errs := []error{err}
+	if _, scaleErr := c.strategy.scaleAndWait(c.to, 0); scaleErr != nil {
+		errs = append(errs, fmt.Errorf("couldn't scale %s back to 0: %v", deployutil.LabelForDeployment(c.to), scaleErr))
+	}
+	if c.from != nil {
+		if _, scaleErr := c.strategy.scaleAndWait(c.from, c.fromReplicas); scaleErr != nil {
+			errs = append(errs, fmt.Errorf("couldn't scale %s back to %d: %v", deployutil.LabelForDeployment(c.from), c.fromReplicas, scaleErr))
+		}
+	}
+	return kutilerrors.NewAggregate(errs)
+}
+
+func (s *CanaryDeploymentStrategy) scaleAndWait(deployment *kapi.ReplicationController, replicas int) (*kapi.ReplicationController, error) {
+	retry := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+	wait := kubectl.NewRetryParams(s.retryPeriod, s.retryTimeout)
+	if err := s.scaler.Scale(deployment.Namespace, deployment.Name, uint(replicas), &kubectl.ScalePrecondition{Size: -1, ResourceVersion: ""}, retry, wait); err != nil {
+		return nil, err
+	}
+	updatedDeployment, err := s.getReplicationController(deployment.Namespace, deployment.Name)
+	if err != nil {
+		return nil, err
+	}
+	return updatedDeployment, nil
+}
+
+// stepReplicas returns the replica count of a step with the given replicas
+// for a deployment with desiredReplicas. Percentages are rounded up, and the
+// result is never more than desiredReplicas.
+func stepReplicas(replicas util.IntOrString, desiredReplicas int) int {
+	count := replicas.IntVal
+	if replicas.Kind == util.IntstrString {
+		percent, _ := strconv.Atoi(strings.TrimSuffix(replicas.StrVal, "%"))
+		count = int(math.Ceil(float64(desiredReplicas) * float64(percent) / 100))
+	}
+	if count > desiredReplicas {
+		count = desiredReplicas
+	}
+	return count
+}
+
+// hookExecutor knows how to execute a deployment lifecycle hook.
+type hookExecutor interface {
+	Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+}
+
+// hookExecutorImpl is a pluggable hookExecutor.
+type hookExecutorImpl struct {
+	executeFunc func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error
+}
+
+// Execute executes the provided lifecycle hook
+func (i *hookExecutorImpl) Execute(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+	return i.executeFunc(hook, deployment, label)
+}
@@ -0,0 +1,272 @@
+package canary
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kutil "k8s.io/kubernetes/pkg/util"
+
+	api "github.com/openshift/origin/pkg/api/latest"
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+	scalertest "github.com/openshift/origin/pkg/deploy/scaler/test"
+	strat "github.com/openshift/origin/pkg/deploy/strategy"
+	deployutil "github.com/openshift/origin/pkg/deploy/util"
+)
+
+func TestCanary_deploySteps(t *testing.T) {
+	from, to := canaryDeployments(canaryParams(
+		deployapi.CanaryStep{Replicas: kutil.NewIntOrStringFromInt(1), PauseSeconds: 30},
+		deployapi.CanaryStep{Replicas: kutil.NewIntOrStringFromString("50%"), Verify: verifyHook()},
+	))
+	from.Spec.Replicas = 4
+
+	scaler := &scalertest.FakeScaler{}
+	hooks := []string{}
+	pauses := []time.Duration{}
+	accepted := 0
+	strategy := &CanaryDeploymentStrategy{
+		codec:        api.Codec,
+		retryTimeout: 1 * time.Second,
+		retryPeriod:  1 * time.Millisecond,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			if name == from.Name {
+				return from, nil
+			}
+			return to, nil
+		},
+		scaler: scaler,
+		hookExecutor: &hookExecutorImpl{
+			executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+				hooks = append(hooks, label)
+				return nil
+			},
+		},
+		getUpdateAcceptor: func(timeout time.Duration) strat.UpdateAcceptor {
+			return &testAcceptor{
+				acceptFn: func(deployment *kapi.ReplicationController) error {
+					accepted++
+					return nil
+				},
+			}
+		},
+		sleep: func(d time.Duration) {
+			pauses = append(pauses, d)
+		},
+	}
+
+	if err := strategy.Deploy(from, to, 4); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+
+	expectedEvents := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 1},
+		{Name: from.Name, Size: 3},
+		{Name: to.Name, Size: 2},
+		{Name: from.Name, Size: 2},
+		{Name: to.Name, Size: 4},
+		{Name: from.Name, Size: 0},
+	}
+	if !reflect.DeepEqual(expectedEvents, scaler.Events) {
+		t.Errorf("expected scale events %v, got %v", expectedEvents, scaler.Events)
+	}
+	if e, a := 3, accepted; e != a {
+		t.Errorf("expected %d acceptance checks, got %d", e, a)
+	}
+	if e, a := []time.Duration{30 * time.Second}, pauses; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected pauses %v, got %v", e, a)
+	}
+	if e, a := []string{"verifyhook-2"}, hooks; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected hooks %v, got %v", e, a)
+	}
+}
+
+func TestCanary_deployInitial(t *testing.T) {
+	_, to := canaryDeployments(canaryParams(
+		deployapi.CanaryStep{Replicas: kutil.NewIntOrStringFromInt(1)},
+		deployapi.CanaryStep{Replicas: kutil.NewIntOrStringFromInt(5)},
+	))
+
+	scaler := &scalertest.FakeScaler{}
+	strategy := &CanaryDeploymentStrategy{
+		codec:        api.Codec,
+		retryTimeout: 1 * time.Second,
+		retryPeriod:  1 * time.Millisecond,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return to, nil
+		},
+		scaler:            scaler,
+		getUpdateAcceptor: getUpdateAcceptor(nil),
+	}
+
+	if err := strategy.Deploy(nil, to, 3); err != nil {
+		t.Fatalf("unexpected deploy error: %v", err)
+	}
+
+	// The second step is capped at the desired replica count, which leaves
+	// nothing to do for the final scale up.
+	expectedEvents := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 1},
+		{Name: to.Name, Size: 3},
+	}
+	if !reflect.DeepEqual(expectedEvents, scaler.Events) {
+		t.Errorf("expected scale events %v, got %v", expectedEvents, scaler.Events)
+	}
+}
+
+func TestCanary_verifyHookFailureAborts(t *testing.T) {
+	params := canaryParams(
+		deployapi.CanaryStep{Replicas: kutil.NewIntOrStringFromInt(1), Verify: verifyHook()},
+		deployapi.CanaryStep{Replicas: kutil.NewIntOrStringFromString("50%")},
+	)
+	params.Post = &deployapi.LifecycleHook{
+		FailurePolicy: deployapi.LifecycleHookFailurePolicyIgnore,
+		ExecNewPod:    &deployapi.ExecNewPodHook{},
+	}
+	from, to := canaryDeployments(params)
+	from.Spec.Replicas = 2
+
+	scaler := &scalertest.FakeScaler{}
+	hooks := []string{}
+	strategy := &CanaryDeploymentStrategy{
+		codec:        api.Codec,
+		retryTimeout: 1 * time.Second,
+		retryPeriod:  1 * time.Millisecond,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			if name == from.Name {
+				return from, nil
+			}
+			return to, nil
+		},
+		scaler: scaler,
+		hookExecutor: &hookExecutorImpl{
+			executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+				hooks = append(hooks, label)
+				return fmt.Errorf("verification failed")
+			},
+		},
+		getUpdateAcceptor: getUpdateAcceptor(nil),
+	}
+
+	err := strategy.Deploy(from, to, 2)
+	if err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+	t.Logf("got expected error: %v", err)
+
+	expectedEvents := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 1},
+		{Name: from.Name, Size: 1},
+		// scale back
+		{Name: to.Name, Size: 0},
+		{Name: from.Name, Size: 2},
+	}
+	if !reflect.DeepEqual(expectedEvents, scaler.Events) {
+		t.Errorf("expected scale events %v, got %v", expectedEvents, scaler.Events)
+	}
+	if e, a := []string{"verifyhook-1"}, hooks; !reflect.DeepEqual(e, a) {
+		t.Errorf("expected hooks %v, got %v", e, a)
+	}
+}
+
+func TestCanary_acceptorFailureAborts(t *testing.T) {
+	from, to := canaryDeployments(canaryParams(
+		deployapi.CanaryStep{Replicas: kutil.NewIntOrStringFromInt(1)},
+	))
+	from.Spec.Replicas = 2
+
+	scaler := &scalertest.FakeScaler{}
+	strategy := &CanaryDeploymentStrategy{
+		codec:        api.Codec,
+		retryTimeout: 1 * time.Second,
+		retryPeriod:  1 * time.Millisecond,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			if name == from.Name {
+				return from, nil
+			}
+			return to, nil
+		},
+		scaler:            scaler,
+		getUpdateAcceptor: getUpdateAcceptor(fmt.Errorf("pods not ready")),
+	}
+
+	if err := strategy.Deploy(from, to, 2); err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+
+	expectedEvents := []scalertest.ScaleEvent{
+		{Name: to.Name, Size: 1},
+		// scale back
+		{Name: to.Name, Size: 0},
+		{Name: from.Name, Size: 2},
+	}
+	if !reflect.DeepEqual(expectedEvents, scaler.Events) {
+		t.Errorf("expected scale events %v, got %v", expectedEvents, scaler.Events)
+	}
+}
+
+func TestStepReplicas(t *testing.T) {
+	tests := []struct {
+		replicas kutil.IntOrString
+		desired  int
+		expected int
+	}{
+		{kutil.NewIntOrStringFromInt(1), 10, 1},
+		{kutil.NewIntOrStringFromInt(20), 10, 10},
+		{kutil.NewIntOrStringFromString("10%"), 10, 1},
+		{kutil.NewIntOrStringFromString("25%"), 10, 3},
+		{kutil.NewIntOrStringFromString("100%"), 10, 10},
+		{kutil.NewIntOrStringFromString("50%"), 0, 0},
+	}
+	for _, test := range tests {
+		if e, a := test.expected, stepReplicas(test.replicas, test.desired); e != a {
+			t.Errorf("expected %v of %d to be %d replicas, got %d", test.replicas, test.desired, e, a)
+		}
+	}
+}
+
+func canaryParams(steps ...deployapi.CanaryStep) *deployapi.CanaryDeploymentStrategyParams {
+	params := deploytest.OkCanaryStrategy().CanaryParams
+	params.Steps = steps
+	return params
+}
+
+// canaryDeployments returns the first and second deployments of a config
+// with params.
+func canaryDeployments(params *deployapi.CanaryDeploymentStrategyParams) (*kapi.ReplicationController, *kapi.ReplicationController) {
+	config := deploytest.OkDeploymentConfig(1)
+	config.Spec.Strategy = deploytest.OkCanaryStrategy()
+	config.Spec.Strategy.CanaryParams = params
+	from, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	config.Status.LatestVersion++
+	to, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	return from, to
+}
+
+func verifyHook() *deployapi.LifecycleHook {
+	return &deployapi.LifecycleHook{
+		FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+		ExecNewPod:    &deployapi.ExecNewPodHook{},
+	}
+}
+
+func getUpdateAcceptor(err error) func(timeout time.Duration) strat.UpdateAcceptor {
+	return func(timeout time.Duration) strat.UpdateAcceptor {
+		return &testAcceptor{
+			acceptFn: func(deployment *kapi.ReplicationController) error {
+				return err
+			},
+		}
+	}
+}
+
+type testAcceptor struct {
+	acceptFn func(*kapi.ReplicationController) error
+}
+
+func (t *testAcceptor) Accept(deployment *kapi.ReplicationController) error {
+	return t.acceptFn(deployment)
+}
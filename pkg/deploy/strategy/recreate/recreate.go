@@ -13,6 +13,7 @@ import (
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util"
 
+	osclient "github.com/openshift/origin/pkg/client"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	strat "github.com/openshift/origin/pkg/deploy/strategy"
 	stratsupport "github.com/openshift/origin/pkg/deploy/strategy/support"
@@ -43,7 +44,7 @@ type RecreateDeploymentStrategy struct {
 
 // NewRecreateDeploymentStrategy makes a RecreateDeploymentStrategy backed by
 // a real HookExecutor and client.
-func NewRecreateDeploymentStrategy(client kclient.Interface, codec runtime.Codec) *RecreateDeploymentStrategy {
+func NewRecreateDeploymentStrategy(client kclient.Interface, osClient osclient.Interface, codec runtime.Codec) *RecreateDeploymentStrategy {
 	scaler, _ := kubectl.ScalerFor("ReplicationController", client)
 	return &RecreateDeploymentStrategy{
 		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
@@ -51,7 +52,7 @@ func NewRecreateDeploymentStrategy(client kclient.Interface, codec runtime.Codec
 		},
 		scaler:       scaler,
 		codec:        codec,
-		hookExecutor: stratsupport.NewHookExecutor(client, os.Stdout, codec),
+		hookExecutor: stratsupport.NewHookExecutor(client, osClient, os.Stdout, codec),
 		retryTimeout: 120 * time.Second,
 		retryPeriod:  1 * time.Second,
 	}
@@ -97,6 +98,15 @@ func (s *RecreateDeploymentStrategy) DeployWithAcceptor(from *kapi.ReplicationCo
 		}
 	}
 
+	// Execute any mid-hook while no replicas of either deployment are running.
+	if params != nil && params.Mid != nil {
+		if err := s.hookExecutor.Execute(params.Mid, to, "midhook"); err != nil {
+			return fmt.Errorf("Mid hook failed: %s", err)
+		} else {
+			glog.Infof("Mid hook finished")
+		}
+	}
+
 	// Scale up the to deployment.
 	if desiredReplicas > 0 {
 		// If an UpdateAcceptor is provided, scale up to 1 and validate the replica,
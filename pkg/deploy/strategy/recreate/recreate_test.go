@@ -104,6 +104,92 @@ func TestRecreate_deploymentPreHookFail(t *testing.T) {
 	}
 }
 
+func TestRecreate_deploymentMidHookSuccess(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(2)
+	config.Spec.Strategy.RecreateParams = &deployapi.RecreateDeploymentStrategyParams{
+		Mid: &deployapi.LifecycleHook{
+			FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+			ExecNewPod:    &deployapi.ExecNewPodHook{},
+		},
+	}
+	from, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codec)
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	scaler := &scalertest.FakeScaler{}
+
+	hookExecuted := false
+	strategy := &RecreateDeploymentStrategy{
+		codec:        api.Codec,
+		retryTimeout: 1 * time.Second,
+		retryPeriod:  1 * time.Millisecond,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return deployment, nil
+		},
+		hookExecutor: &hookExecutorImpl{
+			executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+				if e, a := 1, len(scaler.Events); e != a {
+					t.Errorf("expected the mid hook to run after %d scale calls, got %d", e, a)
+				}
+				if e, a := "midhook", label; e != a {
+					t.Errorf("expected label %s, got %s", e, a)
+				}
+				hookExecuted = true
+				return nil
+			},
+		},
+		scaler: scaler,
+	}
+
+	err := strategy.Deploy(from, deployment, 2)
+	if err != nil {
+		t.Fatalf("unexpected deploy error: %#v", err)
+	}
+	if !hookExecuted {
+		t.Fatalf("expected hook execution")
+	}
+	if e, a := 2, len(scaler.Events); e != a {
+		t.Fatalf("expected %d scale calls, got %d", e, a)
+	}
+}
+
+func TestRecreate_deploymentMidHookFail(t *testing.T) {
+	config := deploytest.OkDeploymentConfig(2)
+	config.Spec.Strategy.RecreateParams = &deployapi.RecreateDeploymentStrategyParams{
+		Mid: &deployapi.LifecycleHook{
+			FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+			ExecNewPod:    &deployapi.ExecNewPodHook{},
+		},
+	}
+	from, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codec)
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+	scaler := &scalertest.FakeScaler{}
+
+	strategy := &RecreateDeploymentStrategy{
+		codec:        api.Codec,
+		retryTimeout: 1 * time.Second,
+		retryPeriod:  1 * time.Millisecond,
+		getReplicationController: func(namespace, name string) (*kapi.ReplicationController, error) {
+			return deployment, nil
+		},
+		hookExecutor: &hookExecutorImpl{
+			executeFunc: func(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController, label string) error {
+				return fmt.Errorf("hook execution failure")
+			},
+		},
+		scaler: scaler,
+	}
+
+	err := strategy.Deploy(from, deployment, 2)
+	if err == nil {
+		t.Fatalf("expected a deploy error")
+	}
+	if e, a := 1, len(scaler.Events); e != a {
+		t.Fatalf("expected only the scale down, got %d scale calls: %v", a, scaler.Events)
+	}
+	if e, a := uint(0), scaler.Events[0].Size; e != a {
+		t.Errorf("expected scale down to %d, got %d", e, a)
+	}
+}
+
 func TestRecreate_deploymentPostHookSuccess(t *testing.T) {
 	config := deploytest.OkDeploymentConfig(1)
 	config.Spec.Strategy.RecreateParams = recreateParams("", deployapi.LifecycleHookFailurePolicyAbort)
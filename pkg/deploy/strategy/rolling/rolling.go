@@ -10,11 +10,14 @@ import (
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/kubectl"
+	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
 	"k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/util/wait"
 
+	osclient "github.com/openshift/origin/pkg/client"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	strat "github.com/openshift/origin/pkg/deploy/strategy"
 	stratsupport "github.com/openshift/origin/pkg/deploy/strategy/support"
@@ -60,6 +63,11 @@ type RollingDeploymentStrategy struct {
 	apiRetryPeriod time.Duration
 	// apiRetryTimeout is how long to retry API calls before giving up.
 	apiRetryTimeout time.Duration
+	// progressCheckPeriod is how often the pods of the deployment are checked
+	// for progress when a progress deadline is set.
+	progressCheckPeriod time.Duration
+	// now returns the current time.
+	now func() time.Time
 }
 
 // acceptingDeploymentStrategy is a DeploymentStrategy which accepts an
@@ -75,19 +83,25 @@ type acceptingDeploymentStrategy interface {
 // readiness.
 const AcceptorInterval = 1 * time.Second
 
+// ProgressCheckPeriod is how often the pods of a deployment should be checked
+// for progress.
+const ProgressCheckPeriod = 5 * time.Second
+
 // NewRollingDeploymentStrategy makes a new RollingDeploymentStrategy.
-func NewRollingDeploymentStrategy(namespace string, client kclient.Interface, codec runtime.Codec, initialStrategy acceptingDeploymentStrategy) *RollingDeploymentStrategy {
+func NewRollingDeploymentStrategy(namespace string, client kclient.Interface, osClient osclient.Interface, codec runtime.Codec, initialStrategy acceptingDeploymentStrategy) *RollingDeploymentStrategy {
 	return &RollingDeploymentStrategy{
-		codec:           codec,
-		initialStrategy: initialStrategy,
-		client:          client,
-		apiRetryPeriod:  DefaultApiRetryPeriod,
-		apiRetryTimeout: DefaultApiRetryTimeout,
+		codec:               codec,
+		initialStrategy:     initialStrategy,
+		client:              client,
+		apiRetryPeriod:      DefaultApiRetryPeriod,
+		apiRetryTimeout:     DefaultApiRetryTimeout,
+		progressCheckPeriod: ProgressCheckPeriod,
+		now:                 time.Now,
 		rollingUpdate: func(config *kubectl.RollingUpdaterConfig) error {
 			updater := kubectl.NewRollingUpdater(namespace, client)
 			return updater.Update(config)
 		},
-		hookExecutor: stratsupport.NewHookExecutor(client, os.Stdout, codec),
+		hookExecutor: stratsupport.NewHookExecutor(client, osClient, os.Stdout, codec),
 		getUpdateAcceptor: func(timeout time.Duration) strat.UpdateAcceptor {
 			return stratsupport.NewAcceptNewlyObservedReadyPods(client, timeout, AcceptorInterval)
 		},
@@ -119,7 +133,9 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 		}
 
 		// Execute the delegate strategy.
-		err := s.initialStrategy.DeployWithAcceptor(from, to, desiredReplicas, updateAcceptor)
+		err := s.deployWithProgressDeadline(to, params, func() error {
+			return s.initialStrategy.DeployWithAcceptor(from, to, desiredReplicas, updateAcceptor)
+		})
 		if err != nil {
 			return err
 		}
@@ -207,7 +223,9 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 		MaxSurge:       params.MaxSurge,
 		MaxUnavailable: params.MaxUnavailable,
 	}
-	err = s.rollingUpdate(rollingConfig)
+	err = s.deployWithProgressDeadline(to, params, func() error {
+		return s.rollingUpdate(rollingConfig)
+	})
 	if err != nil {
 		return err
 	}
@@ -225,6 +243,119 @@ func (s *RollingDeploymentStrategy) Deploy(from *kapi.ReplicationController, to
 	return nil
 }
 
+// deployWithProgressDeadline runs deploy while watching the pods of the
+// deployment for progress. If the pods make no progress within the progress
+// deadline of params, the deployment is annotated with the reason for its
+// failure and an error is returned without waiting for deploy to finish. The
+// deployer process exits on that error, which stops deploy along with it.
+func (s *RollingDeploymentStrategy) deployWithProgressDeadline(deployment *kapi.ReplicationController, params *deployapi.RollingDeploymentStrategyParams, deploy func() error) error {
+	if params.ProgressDeadlineSeconds == nil {
+		return deploy()
+	}
+	deadline := time.Duration(*params.ProgressDeadlineSeconds) * time.Second
+
+	result := make(chan error, 1)
+	go func() {
+		result <- deploy()
+	}()
+
+	ticker := time.NewTicker(s.progressCheckPeriod)
+	defer ticker.Stop()
+
+	tracker := &progressTracker{}
+	lastProgress := s.now()
+	selector := labels.SelectorFromSet(deployment.Spec.Selector)
+	for {
+		select {
+		case err := <-result:
+			return err
+		case <-ticker.C:
+		}
+
+		pods, err := s.client.Pods(deployment.Namespace).List(selector, fields.Everything())
+		if err != nil {
+			// Try again on the next check.
+			glog.Infof("couldn't list pods of deployment %s: %v", deployutil.LabelForDeployment(deployment), err)
+			continue
+		}
+		if tracker.observe(pods.Items) {
+			glog.Infof("Deployment %s progressed to %d ready pods", deployutil.LabelForDeployment(deployment), tracker.ready)
+			lastProgress = s.now()
+			continue
+		}
+		if s.now().Sub(lastProgress) <= deadline {
+			continue
+		}
+
+		if err := s.markProgressDeadlineExceeded(deployment); err != nil {
+			util.HandleError(err)
+		}
+		return fmt.Errorf("deployment %s made no progress within %s: %d of %d pods ready, %d container restarts", deployutil.LabelForDeployment(deployment), deadline, tracker.ready, len(pods.Items), tracker.restarts)
+	}
+}
+
+// markProgressDeadlineExceeded records on deployment that it failed because
+// its progress deadline was exceeded.
+func (s *RollingDeploymentStrategy) markProgressDeadlineExceeded(deployment *kapi.ReplicationController) error {
+	return wait.Poll(s.apiRetryPeriod, s.apiRetryTimeout, func() (done bool, err error) {
+		existing, err := s.client.ReplicationControllers(deployment.Namespace).Get(deployment.Name)
+		if err != nil {
+			msg := fmt.Sprintf("couldn't look up deployment %s: %s", deployutil.LabelForDeployment(deployment), err)
+			if kerrors.IsNotFound(err) {
+				return false, fmt.Errorf("%s", msg)
+			}
+			// Try again.
+			glog.Info(msg)
+			return false, nil
+		}
+		existing.Annotations[deployapi.DeploymentStatusReasonAnnotation] = deployapi.DeploymentFailedProgressDeadlineExceeded
+		if _, err := s.client.ReplicationControllers(existing.Namespace).Update(existing); err != nil {
+			msg := fmt.Sprintf("couldn't record the failure reason of deployment %s: %v", deployutil.LabelForDeployment(existing), err)
+			if kerrors.IsNotFound(err) {
+				return false, fmt.Errorf("%s", msg)
+			}
+			// Try again.
+			glog.Info(msg)
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
+// progressTracker tracks the progress of the pods of a deployment.
+type progressTracker struct {
+	// maxReady is the highest number of ready pods observed.
+	maxReady int
+	// ready is the number of ready pods last observed.
+	ready int
+	// restarts is the total container restart count last observed.
+	restarts int
+}
+
+// observe records the state of pods and returns whether they made progress
+// since the last observation. Progress is made when more pods are ready than
+// ever observed before and no containers restarted since the last
+// observation, so crash looping pods which are briefly ready never count as
+// progress.
+func (t *progressTracker) observe(pods []kapi.Pod) bool {
+	ready, restarts := 0, 0
+	for i := range pods {
+		if kapi.IsPodReady(&pods[i]) {
+			ready++
+		}
+		for _, status := range pods[i].Status.ContainerStatuses {
+			restarts += status.RestartCount
+		}
+	}
+	restarted := restarts > t.restarts
+	t.ready, t.restarts = ready, restarts
+	if ready <= t.maxReady || restarted {
+		return false
+	}
+	t.maxReady = ready
+	return true
+}
+
 // rollingUpdaterWriter is an io.Writer that delegates to glog.
 type rollingUpdaterWriter struct{}
 
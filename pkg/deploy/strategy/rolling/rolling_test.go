@@ -269,6 +269,153 @@ func TestRolling_deployInitialHooks(t *testing.T) {
 	}
 }
 
+func TestRolling_deployRollingProgressDeadlineExceeded(t *testing.T) {
+	latestConfig := deploytest.OkDeploymentConfig(1)
+	latestConfig.Spec.Strategy = deploytest.OkRollingStrategy()
+	latest, _ := deployutil.MakeDeployment(latestConfig, kapi.Codec)
+	config := deploytest.OkDeploymentConfig(2)
+	config.Spec.Strategy = deploytest.OkRollingStrategy()
+	config.Spec.Strategy.RollingParams.ProgressDeadlineSeconds = mkintp(60)
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+
+	deployments := map[string]*kapi.ReplicationController{
+		latest.Name:     latest,
+		deployment.Name: deployment,
+	}
+
+	// Simulate a crash looping pod which is briefly ready after each restart.
+	lists := 0
+	fake := &ktestclient.Fake{}
+	fake.AddReactor("get", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		name := action.(ktestclient.GetAction).GetName()
+		return true, deployments[name], nil
+	})
+	fake.AddReactor("update", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		updated := action.(ktestclient.UpdateAction).GetObject().(*kapi.ReplicationController)
+		deployments[updated.Name] = updated
+		return true, updated, nil
+	})
+	fake.AddReactor("list", "pods", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		lists++
+		return true, progressPods(deployment.Spec.Selector, lists%2, lists/2), nil
+	})
+
+	stop := make(chan struct{})
+	defer close(stop)
+	now := time.Now()
+	strategy := &RollingDeploymentStrategy{
+		codec:  api.Codec,
+		client: fake,
+		rollingUpdate: func(config *kubectl.RollingUpdaterConfig) error {
+			<-stop
+			return nil
+		},
+		getUpdateAcceptor:   getUpdateAcceptor,
+		apiRetryPeriod:      1 * time.Millisecond,
+		apiRetryTimeout:     10 * time.Millisecond,
+		progressCheckPeriod: 1 * time.Millisecond,
+		now: func() time.Time {
+			now = now.Add(30 * time.Second)
+			return now
+		},
+	}
+
+	err := strategy.Deploy(latest, deployment, 2)
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	t.Logf("got expected error: %v", err)
+	if e, a := deployapi.DeploymentFailedProgressDeadlineExceeded, deployments[deployment.Name].Annotations[deployapi.DeploymentStatusReasonAnnotation]; e != a {
+		t.Errorf("expected status reason %q, got %q", e, a)
+	}
+}
+
+func TestRolling_deployRollingProgressMade(t *testing.T) {
+	latestConfig := deploytest.OkDeploymentConfig(1)
+	latestConfig.Spec.Strategy = deploytest.OkRollingStrategy()
+	latest, _ := deployutil.MakeDeployment(latestConfig, kapi.Codec)
+	config := deploytest.OkDeploymentConfig(2)
+	config.Spec.Strategy = deploytest.OkRollingStrategy()
+	config.Spec.Strategy.RollingParams.ProgressDeadlineSeconds = mkintp(60)
+	deployment, _ := deployutil.MakeDeployment(config, kapi.Codec)
+
+	deployments := map[string]*kapi.ReplicationController{
+		latest.Name:     latest,
+		deployment.Name: deployment,
+	}
+
+	// Each pod becomes ready slowly, but without restarting.
+	lists := 0
+	done := make(chan struct{})
+	fake := &ktestclient.Fake{}
+	fake.AddReactor("get", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		name := action.(ktestclient.GetAction).GetName()
+		return true, deployments[name], nil
+	})
+	fake.AddReactor("update", "replicationcontrollers", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		updated := action.(ktestclient.UpdateAction).GetObject().(*kapi.ReplicationController)
+		deployments[updated.Name] = updated
+		return true, updated, nil
+	})
+	fake.AddReactor("list", "pods", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		lists++
+		if lists == 5 {
+			close(done)
+		}
+		return true, progressPods(deployment.Spec.Selector, lists, 0), nil
+	})
+
+	now := time.Now()
+	strategy := &RollingDeploymentStrategy{
+		codec:  api.Codec,
+		client: fake,
+		rollingUpdate: func(config *kubectl.RollingUpdaterConfig) error {
+			<-done
+			return nil
+		},
+		getUpdateAcceptor:   getUpdateAcceptor,
+		apiRetryPeriod:      1 * time.Millisecond,
+		apiRetryTimeout:     10 * time.Millisecond,
+		progressCheckPeriod: 1 * time.Millisecond,
+		now: func() time.Time {
+			now = now.Add(50 * time.Second)
+			return now
+		},
+	}
+
+	err := strategy.Deploy(latest, deployment, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reason, ok := deployments[deployment.Name].Annotations[deployapi.DeploymentStatusReasonAnnotation]; ok {
+		t.Errorf("unexpected status reason %q", reason)
+	}
+}
+
+func TestProgressTracker(t *testing.T) {
+	tracker := &progressTracker{}
+	cases := []struct {
+		ready      int
+		restarts   int
+		progressed bool
+	}{
+		{0, 0, false},
+		{1, 0, true},
+		{1, 0, false},
+		// A pod which restarted isn't progress, even if it's ready.
+		{2, 1, false},
+		{2, 1, true},
+		{1, 2, false},
+		{2, 3, false},
+		{3, 3, true},
+	}
+	for i, tc := range cases {
+		if e, a := tc.progressed, tracker.observe(progressPods(nil, tc.ready, tc.restarts).Items); e != a {
+			t.Errorf("%d: expected progressed %t, got %t", i, e, a)
+		}
+	}
+}
+
 type testStrategy struct {
 	deployFn func(from *kapi.ReplicationController, to *kapi.ReplicationController, desiredReplicas int, updateAcceptor strat.UpdateAcceptor) error
 }
@@ -322,3 +469,23 @@ type testAcceptor struct {
 func (t *testAcceptor) Accept(deployment *kapi.ReplicationController) error {
 	return t.acceptFn(deployment)
 }
+
+// progressPods returns ready pods with the given labels and total container
+// restart count, along with a pod which isn't ready.
+func progressPods(labels map[string]string, ready, restarts int) *kapi.PodList {
+	pods := &kapi.PodList{}
+	for i := 0; i <= ready; i++ {
+		pod := kapi.Pod{
+			ObjectMeta: kapi.ObjectMeta{Name: fmt.Sprintf("pod-%d", i), Labels: labels},
+			Status: kapi.PodStatus{
+				ContainerStatuses: []kapi.ContainerStatus{{Name: "container"}},
+			},
+		}
+		if i < ready {
+			pod.Status.Conditions = []kapi.PodCondition{{Type: kapi.PodReady, Status: kapi.ConditionTrue}}
+		}
+		pods.Items = append(pods.Items, pod)
+	}
+	pods.Items[0].Status.ContainerStatuses[0].RestartCount = restarts
+	return pods
+}
@@ -15,12 +15,15 @@ import (
 	"k8s.io/kubernetes/pkg/fields"
 	"k8s.io/kubernetes/pkg/labels"
 	"k8s.io/kubernetes/pkg/runtime"
+	kutilerrors "k8s.io/kubernetes/pkg/util/errors"
 	"k8s.io/kubernetes/pkg/util/sets"
 	"k8s.io/kubernetes/pkg/util/wait"
 	"k8s.io/kubernetes/pkg/watch"
 
+	"github.com/openshift/origin/pkg/client"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	imageapi "github.com/openshift/origin/pkg/image/api"
 	"github.com/openshift/origin/pkg/util"
 	namer "github.com/openshift/origin/pkg/util/namer"
 )
@@ -35,12 +38,15 @@ type HookExecutor struct {
 	podLogDestination io.Writer
 	// podLogStream provides a reader for a pod's logs.
 	podLogStream func(namespace, name string, opts *kapi.PodLogOptions) (io.ReadCloser, error)
+	// imageStreams provides access to the image streams images are tagged into.
+	imageStreams client.ImageStreamsNamespacer
 	// Codec is used for encoding/decoding.
 	codec runtime.Codec
 }
 
-// NewHookExecutor makes a HookExecutor from a client.
-func NewHookExecutor(client kclient.Interface, podLogDestination io.Writer, codec runtime.Codec) *HookExecutor {
+// NewHookExecutor makes a HookExecutor from a kube client and an OpenShift
+// client.
+func NewHookExecutor(client kclient.Interface, osClient client.Interface, podLogDestination io.Writer, codec runtime.Codec) *HookExecutor {
 	return &HookExecutor{
 		podClient: &HookExecutorPodClientImpl{
 			CreatePodFunc: func(namespace string, pod *kapi.Pod) (*kapi.Pod, error) {
@@ -57,6 +63,7 @@ func NewHookExecutor(client kclient.Interface, podLogDestination io.Writer, code
 			}
 			return req.Stream()
 		},
+		imageStreams:      osClient,
 		podLogDestination: podLogDestination,
 		codec:             codec,
 	}
@@ -69,6 +76,8 @@ func (e *HookExecutor) Execute(hook *deployapi.LifecycleHook, deployment *kapi.R
 	switch {
 	case hook.ExecNewPod != nil:
 		err = e.executeExecNewPod(hook, deployment, label)
+	case len(hook.TagImages) > 0:
+		err = e.tagImages(hook, deployment)
 	}
 
 	if err == nil {
@@ -148,6 +157,87 @@ waitLoop:
 	return nil
 }
 
+// tagImages tags the images of the containers of deployment referenced by a
+// TagImages hook into their image stream tags. The image streams are created
+// if they don't exist. An error is returned if any image could not be tagged.
+func (e *HookExecutor) tagImages(hook *deployapi.LifecycleHook, deployment *kapi.ReplicationController) error {
+	var errs []error
+	for _, action := range hook.TagImages {
+		image, ok := findContainerImage(deployment, action.ContainerName)
+		if !ok {
+			errs = append(errs, fmt.Errorf("no container named '%s' found in deployment template", action.ContainerName))
+			continue
+		}
+		namespace := action.To.Namespace
+		if len(namespace) == 0 {
+			namespace = deployment.Namespace
+		}
+		name, tag, ok := imageapi.SplitImageStreamTag(action.To.Name)
+		if !ok {
+			errs = append(errs, fmt.Errorf("invalid ImageStreamTag: %s", action.To.Name))
+			continue
+		}
+		if err := e.tagImage(namespace, name, tag, image); err != nil {
+			errs = append(errs, fmt.Errorf("couldn't tag %s into %s/%s: %v", image, namespace, action.To.Name, err))
+			continue
+		}
+		glog.V(0).Infof("Tagged %s into %s/%s for deployment %s", image, namespace, action.To.Name, deployutil.LabelForDeployment(deployment))
+	}
+	return kutilerrors.NewAggregate(errs)
+}
+
+// tagImage points the tag of the named image stream at image, creating the
+// image stream if it doesn't exist.
+func (e *HookExecutor) tagImage(namespace, name, tag, image string) error {
+	return kclient.RetryOnConflict(kclient.DefaultRetry, func() error {
+		streams := e.imageStreams.ImageStreams(namespace)
+		stream, err := streams.Get(name)
+		if err != nil {
+			if !kerrors.IsNotFound(err) {
+				return err
+			}
+			stream = &imageapi.ImageStream{
+				ObjectMeta: kapi.ObjectMeta{
+					Name: name,
+				},
+			}
+		}
+
+		if stream.Spec.Tags == nil {
+			stream.Spec.Tags = make(map[string]imageapi.TagReference)
+		}
+		ref := stream.Spec.Tags[tag]
+		ref.From = &kapi.ObjectReference{
+			Kind: "DockerImage",
+			Name: image,
+		}
+		stream.Spec.Tags[tag] = ref
+		// Force a re-import to fetch the metadata of the image.
+		delete(stream.Annotations, imageapi.DockerImageRepositoryCheckAnnotation)
+
+		if stream.CreationTimestamp.IsZero() {
+			_, err = streams.Create(stream)
+		} else {
+			_, err = streams.Update(stream)
+		}
+		return err
+	})
+}
+
+// findContainerImage returns the image of the named container of the
+// deployment template.
+func findContainerImage(deployment *kapi.ReplicationController, containerName string) (string, bool) {
+	if deployment.Spec.Template == nil {
+		return "", false
+	}
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if container.Name == containerName {
+			return container.Image, true
+		}
+	}
+	return "", false
+}
+
 // readPodLogs streams logs from pod to podLogDestination. It signals wg when
 // done.
 func (e *HookExecutor) readPodLogs(pod *kapi.Pod, wg *sync.WaitGroup) {
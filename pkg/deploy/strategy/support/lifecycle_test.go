@@ -12,14 +12,20 @@ import (
 	"time"
 
 	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
 	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	"k8s.io/kubernetes/pkg/client/cache"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
 	kutil "k8s.io/kubernetes/pkg/util"
 	"k8s.io/kubernetes/pkg/util/sets"
 
+	"github.com/openshift/origin/pkg/client/testclient"
 	deployapi "github.com/openshift/origin/pkg/deploy/api"
 	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
 	deployutil "github.com/openshift/origin/pkg/deploy/util"
+	imageapi "github.com/openshift/origin/pkg/image/api"
 	namer "github.com/openshift/origin/pkg/util/namer"
 )
 
@@ -145,6 +151,106 @@ func TestHookExecutor_executeExecNewPodFailed(t *testing.T) {
 	t.Logf("got expected error: %s", err)
 }
 
+func TestHookExecutor_tagImages(t *testing.T) {
+	hook := &deployapi.LifecycleHook{
+		FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+		TagImages: []deployapi.TagImageHook{
+			{ContainerName: "container1", To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "existing:deployed"}},
+			{ContainerName: "container2", To: kapi.ObjectReference{Kind: "ImageStreamTag", Namespace: "other", Name: "new:deployed"}},
+		},
+	}
+
+	deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codec)
+	deployment.Namespace = "test"
+
+	existing := &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:              "existing",
+			Namespace:         "test",
+			CreationTimestamp: unversioned.Now(),
+			Annotations:       map[string]string{imageapi.DockerImageRepositoryCheckAnnotation: "checked"},
+		},
+		Spec: imageapi.ImageStreamSpec{
+			Tags: map[string]imageapi.TagReference{
+				"latest": {From: &kapi.ObjectReference{Kind: "DockerImage", Name: "registry:8080/repo1:old"}},
+			},
+		},
+	}
+	client := &testclient.Fake{}
+	client.AddReactor("get", "imagestreams", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		name := action.(ktestclient.GetAction).GetName()
+		if action.GetNamespace() == existing.Namespace && name == existing.Name {
+			return true, existing, nil
+		}
+		return true, nil, kerrors.NewNotFound("imagestreams", name)
+	})
+	executor := &HookExecutor{
+		imageStreams: client,
+		codec:        kapi.Codec,
+	}
+
+	if err := executor.Execute(hook, deployment, "posthook"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	streams := map[string]*imageapi.ImageStream{}
+	for _, action := range client.Actions() {
+		switch action.GetVerb() {
+		case "update":
+			streams["update "+action.GetNamespace()] = action.(ktestclient.UpdateAction).GetObject().(*imageapi.ImageStream)
+		case "create":
+			streams["create "+action.GetNamespace()] = action.(ktestclient.CreateAction).GetObject().(*imageapi.ImageStream)
+		}
+	}
+	if len(streams) != 2 {
+		t.Fatalf("expected an update and a create, got %v", client.Actions())
+	}
+	updated := streams["update test"]
+	if updated == nil || updated.Name != "existing" {
+		t.Fatalf("expected the existing stream to be updated, got %#v", updated)
+	}
+	if e, a := "registry:8080/repo1:ref1", updated.Spec.Tags["deployed"].From.Name; e != a {
+		t.Errorf("expected deployed to be tagged to %s, got %s", e, a)
+	}
+	if _, ok := updated.Spec.Tags["latest"]; !ok {
+		t.Errorf("expected the existing tags to be kept, got %#v", updated.Spec.Tags)
+	}
+	if _, ok := updated.Annotations[imageapi.DockerImageRepositoryCheckAnnotation]; ok {
+		t.Errorf("expected the import annotation to be removed")
+	}
+	created := streams["create other"]
+	if created == nil || created.Name != "new" {
+		t.Fatalf("expected the new stream to be created, got %#v", created)
+	}
+	if e, a := "registry:8080/repo1:ref2", created.Spec.Tags["deployed"].From.Name; e != a {
+		t.Errorf("expected deployed to be tagged to %s, got %s", e, a)
+	}
+}
+
+func TestHookExecutor_tagImagesInvalidContainerRef(t *testing.T) {
+	hook := &deployapi.LifecycleHook{
+		FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
+		TagImages: []deployapi.TagImageHook{
+			{ContainerName: "undefined", To: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "app:deployed"}},
+		},
+	}
+
+	deployment, _ := deployutil.MakeDeployment(deploytest.OkDeploymentConfig(1), kapi.Codec)
+
+	client := &testclient.Fake{}
+	executor := &HookExecutor{
+		imageStreams: client,
+		codec:        kapi.Codec,
+	}
+
+	if err := executor.Execute(hook, deployment, "posthook"); err == nil {
+		t.Fatalf("expected an error")
+	}
+	if len(client.Actions()) != 0 {
+		t.Errorf("unexpected actions: %v", client.Actions())
+	}
+}
+
 func TestHookExecutor_makeHookPodInvalidContainerRef(t *testing.T) {
 	hook := &deployapi.LifecycleHook{
 		FailurePolicy: deployapi.LifecycleHookFailurePolicyAbort,
@@ -0,0 +1,156 @@
+package util
+
+import (
+	"fmt"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/labels"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+)
+
+// ConfigPodSelector returns a label Selector which can be used to find all
+// the pods of the deployments of the config with name.
+func ConfigPodSelector(name string) labels.Selector {
+	return labels.Set{deployapi.DeploymentConfigLabel: name}.AsSelector()
+}
+
+// CalculateStatus returns the status of config given its deployments and the
+// pods of its deployments. The latest version, details and observed
+// generation are copied from the current status of config; the replica
+// counts, conditions and, for the BlueGreen strategy, the live deployment are
+// calculated. The transition time of a condition is now if its status
+// changed.
+func CalculateStatus(config *deployapi.DeploymentConfig, deployments *api.ReplicationControllerList, pods *api.PodList, now unversioned.Time) deployapi.DeploymentConfigStatus {
+	status := config.Status
+	status.Conditions = nil
+	for _, condition := range config.Status.Conditions {
+		status.Conditions = append(status.Conditions, condition)
+	}
+
+	latestIsDeployed, latest := LatestDeploymentInfo(config, deployments)
+
+	status.UpdatedReplicas = 0
+	if latestIsDeployed {
+		status.UpdatedReplicas = latest.Status.Replicas
+	}
+	status.ReadyReplicas, status.AvailableReplicas = 0, 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if _, isDeployer := pod.Labels[deployapi.DeployerPodForDeploymentLabel]; isDeployer {
+			continue
+		}
+		if !api.IsPodReady(pod) {
+			continue
+		}
+		status.ReadyReplicas++
+		if pod.DeletionTimestamp == nil {
+			status.AvailableReplicas++
+		}
+	}
+
+	if status.AvailableReplicas >= config.Spec.Replicas {
+		setCondition(&status, deployapi.DeploymentCondition{
+			Type:    deployapi.DeploymentAvailable,
+			Status:  api.ConditionTrue,
+			Reason:  deployapi.MinimumReplicasAvailable,
+			Message: fmt.Sprintf("%d of %d replicas available", status.AvailableReplicas, config.Spec.Replicas),
+		}, now)
+	} else {
+		setCondition(&status, deployapi.DeploymentCondition{
+			Type:    deployapi.DeploymentAvailable,
+			Status:  api.ConditionFalse,
+			Reason:  deployapi.MinimumReplicasUnavailable,
+			Message: fmt.Sprintf("%d of %d replicas available", status.AvailableReplicas, config.Spec.Replicas),
+		}, now)
+	}
+
+	if progressing := progressingCondition(config, latestIsDeployed, latest); progressing != nil {
+		setCondition(&status, *progressing, now)
+	}
+
+	if config.Spec.Strategy.Type == deployapi.DeploymentStrategyTypeBlueGreen && latestIsDeployed && IsTerminatedDeployment(latest) {
+		if active := ActiveDeployment(config, deployments); active != nil {
+			status.LiveColor = DeploymentColorFor(active)
+			status.LiveDeployment = active.Name
+		}
+	}
+
+	return status
+}
+
+// progressingCondition returns the Progressing condition of config given its
+// latest deployment, or nil if config hasn't been deployed yet.
+func progressingCondition(config *deployapi.DeploymentConfig, latestIsDeployed bool, latest *api.ReplicationController) *deployapi.DeploymentCondition {
+	if config.Status.LatestVersion == 0 {
+		return nil
+	}
+	if !latestIsDeployed {
+		return &deployapi.DeploymentCondition{
+			Type:    deployapi.DeploymentProgressing,
+			Status:  api.ConditionTrue,
+			Reason:  deployapi.NewDeploymentRequested,
+			Message: fmt.Sprintf("waiting for deployment %q to be created", LatestDeploymentNameForConfig(config)),
+		}
+	}
+	switch DeploymentStatusFor(latest) {
+	case deployapi.DeploymentStatusComplete:
+		return &deployapi.DeploymentCondition{
+			Type:    deployapi.DeploymentProgressing,
+			Status:  api.ConditionTrue,
+			Reason:  deployapi.DeploymentComplete,
+			Message: fmt.Sprintf("deployment %q completed", latest.Name),
+		}
+	case deployapi.DeploymentStatusFailed:
+		reason := deployapi.DeploymentFailed
+		if IsDeploymentCancelled(latest) {
+			reason = deployapi.DeploymentCancelled
+		}
+		message := DeploymentStatusReasonFor(latest)
+		if len(message) == 0 {
+			message = fmt.Sprintf("deployment %q failed", latest.Name)
+		}
+		return &deployapi.DeploymentCondition{
+			Type:    deployapi.DeploymentProgressing,
+			Status:  api.ConditionFalse,
+			Reason:  reason,
+			Message: message,
+		}
+	default:
+		return &deployapi.DeploymentCondition{
+			Type:    deployapi.DeploymentProgressing,
+			Status:  api.ConditionTrue,
+			Reason:  deployapi.DeploymentRunning,
+			Message: fmt.Sprintf("deployment %q is running", latest.Name),
+		}
+	}
+}
+
+// GetCondition returns the condition of status with the given type, or nil if
+// there is none.
+func GetCondition(status deployapi.DeploymentConfigStatus, conditionType deployapi.DeploymentConditionType) *deployapi.DeploymentCondition {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == conditionType {
+			return &status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// setCondition sets condition in status, replacing any condition of the same
+// type. The transition time of the existing condition is kept if the status
+// didn't change.
+func setCondition(status *deployapi.DeploymentConfigStatus, condition deployapi.DeploymentCondition, now unversioned.Time) {
+	existing := GetCondition(*status, condition.Type)
+	if existing == nil {
+		condition.LastTransitionTime = now
+		status.Conditions = append(status.Conditions, condition)
+		return
+	}
+	condition.LastTransitionTime = existing.LastTransitionTime
+	if existing.Status != condition.Status {
+		condition.LastTransitionTime = now
+	}
+	*existing = condition
+}
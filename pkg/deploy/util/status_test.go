@@ -0,0 +1,129 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	api "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+
+	deployapi "github.com/openshift/origin/pkg/deploy/api"
+	deploytest "github.com/openshift/origin/pkg/deploy/api/test"
+)
+
+func TestCalculateStatusProgressing(t *testing.T) {
+	tests := []struct {
+		name      string
+		version   int
+		status    deployapi.DeploymentStatus
+		cancelled bool
+		reason    string
+		// expected is the expected Progressing condition, if any
+		expected *deployapi.DeploymentCondition
+	}{
+		{
+			name:    "not deployed",
+			version: 0,
+		},
+		{
+			name:     "not created",
+			version:  2,
+			expected: &deployapi.DeploymentCondition{Status: api.ConditionTrue, Reason: deployapi.NewDeploymentRequested},
+		},
+		{
+			name:     "running",
+			version:  1,
+			status:   deployapi.DeploymentStatusRunning,
+			expected: &deployapi.DeploymentCondition{Status: api.ConditionTrue, Reason: deployapi.DeploymentRunning},
+		},
+		{
+			name:     "complete",
+			version:  1,
+			status:   deployapi.DeploymentStatusComplete,
+			expected: &deployapi.DeploymentCondition{Status: api.ConditionTrue, Reason: deployapi.DeploymentComplete},
+		},
+		{
+			name:     "failed",
+			version:  1,
+			status:   deployapi.DeploymentStatusFailed,
+			reason:   deployapi.DeploymentFailedProgressDeadlineExceeded,
+			expected: &deployapi.DeploymentCondition{Status: api.ConditionFalse, Reason: deployapi.DeploymentFailed, Message: deployapi.DeploymentFailedProgressDeadlineExceeded},
+		},
+		{
+			name:      "cancelled",
+			version:   1,
+			status:    deployapi.DeploymentStatusFailed,
+			cancelled: true,
+			reason:    deployapi.DeploymentCancelledByUser,
+			expected:  &deployapi.DeploymentCondition{Status: api.ConditionFalse, Reason: deployapi.DeploymentCancelled, Message: deployapi.DeploymentCancelledByUser},
+		},
+	}
+
+	for _, test := range tests {
+		deployment, _ := MakeDeployment(deploytest.OkDeploymentConfig(1), api.Codec)
+		deployment.Annotations[deployapi.DeploymentStatusAnnotation] = string(test.status)
+		if test.cancelled {
+			deployment.Annotations[deployapi.DeploymentCancelledAnnotation] = deployapi.DeploymentCancelledAnnotationValue
+		}
+		if len(test.reason) > 0 {
+			deployment.Annotations[deployapi.DeploymentStatusReasonAnnotation] = test.reason
+		}
+		config := deploytest.OkDeploymentConfig(test.version)
+
+		status := CalculateStatus(config, &api.ReplicationControllerList{Items: []api.ReplicationController{*deployment}}, &api.PodList{}, unversioned.Now())
+		condition := GetCondition(status, deployapi.DeploymentProgressing)
+		if test.expected == nil {
+			if condition != nil {
+				t.Errorf("%s: unexpected progressing condition %#v", test.name, condition)
+			}
+			continue
+		}
+		if condition == nil {
+			t.Errorf("%s: expected a progressing condition", test.name)
+			continue
+		}
+		if condition.Status != test.expected.Status || condition.Reason != test.expected.Reason {
+			t.Errorf("%s: expected a %s progressing condition with reason %s, got %#v", test.name, test.expected.Status, test.expected.Reason, condition)
+		}
+		if len(test.expected.Message) > 0 && condition.Message != test.expected.Message {
+			t.Errorf("%s: expected message %q, got %q", test.name, test.expected.Message, condition.Message)
+		}
+	}
+}
+
+func TestCalculateStatusTransitionTime(t *testing.T) {
+	before := unversioned.NewTime(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+	now := unversioned.NewTime(before.Add(time.Hour))
+
+	config := deploytest.OkDeploymentConfig(0)
+	config.Spec.Replicas = 1
+	config.Status.Conditions = []deployapi.DeploymentCondition{
+		{
+			Type:               deployapi.DeploymentAvailable,
+			Status:             api.ConditionFalse,
+			LastTransitionTime: before,
+			Reason:             deployapi.MinimumReplicasUnavailable,
+		},
+	}
+
+	// The status didn't change, so the transition time is kept.
+	status := CalculateStatus(config, &api.ReplicationControllerList{}, &api.PodList{}, now)
+	condition := GetCondition(status, deployapi.DeploymentAvailable)
+	if condition == nil || !condition.LastTransitionTime.Equal(before) {
+		t.Fatalf("expected the transition time to be kept, got %#v", condition)
+	}
+
+	// A ready pod makes the config available.
+	pod := api.Pod{}
+	pod.Status.Conditions = []api.PodCondition{{Type: api.PodReady, Status: api.ConditionTrue}}
+	status = CalculateStatus(config, &api.ReplicationControllerList{}, &api.PodList{Items: []api.Pod{pod}}, now)
+	condition = GetCondition(status, deployapi.DeploymentAvailable)
+	if condition == nil || condition.Status != api.ConditionTrue || !condition.LastTransitionTime.Equal(now) {
+		t.Fatalf("expected the config to become available now, got %#v", condition)
+	}
+
+	// The conditions of the config itself are left alone.
+	if e, a := api.ConditionFalse, config.Status.Conditions[0].Status; e != a {
+		t.Errorf("expected the config to keep its condition status %s, got %s", e, a)
+	}
+}
@@ -1,6 +1,8 @@
 package util
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"sort"
 	"strconv"
@@ -47,6 +49,51 @@ func ActiveDeployment(config *deployapi.DeploymentConfig, deployments *api.Repli
 	return activeDeployment
 }
 
+// RollbackTargetDeployment returns the latest complete deployment older than
+// the latest version of config, or nil if there is no such deployment. This
+// is the deployment a rollback targets when no version is specified.
+func RollbackTargetDeployment(config *deployapi.DeploymentConfig, deployments *api.ReplicationControllerList) *api.ReplicationController {
+	sort.Sort(ByLatestVersionDesc(deployments.Items))
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		if DeploymentVersionFor(deployment) < config.Status.LatestVersion && DeploymentStatusFor(deployment) == deployapi.DeploymentStatusComplete {
+			return deployment
+		}
+	}
+	return nil
+}
+
+// DeploymentsForCleanup returns the old deployments of config which can be
+// deleted, sorted by version descending. The latest, active and rollback
+// target deployments are excluded, as well as deployments which are still
+// running or have replicas.
+func DeploymentsForCleanup(config *deployapi.DeploymentConfig, deployments *api.ReplicationControllerList) []api.ReplicationController {
+	active := ActiveDeployment(config, deployments)
+	target := RollbackTargetDeployment(config, deployments)
+
+	sort.Sort(ByLatestVersionDesc(deployments.Items))
+	prunable := []api.ReplicationController{}
+	for _, deployment := range deployments.Items {
+		if DeploymentVersionFor(&deployment) >= config.Status.LatestVersion {
+			continue
+		}
+		if active != nil && deployment.Name == active.Name {
+			continue
+		}
+		if target != nil && deployment.Name == target.Name {
+			continue
+		}
+		if !IsTerminatedDeployment(&deployment) {
+			continue
+		}
+		if deployment.Spec.Replicas != 0 || deployment.Status.Replicas != 0 {
+			continue
+		}
+		prunable = append(prunable, deployment)
+	}
+	return prunable
+}
+
 // DeployerPodSuffix is the suffix added to pods created from a deployment
 const DeployerPodSuffix = "deploy"
 
@@ -105,6 +152,56 @@ func HasChangeTrigger(config *deployapi.DeploymentConfig) bool {
 	return false
 }
 
+// SecretChangeTriggerFor returns the secret change trigger of config, or nil
+// if config has none.
+func SecretChangeTriggerFor(config *deployapi.DeploymentConfig) *deployapi.DeploymentTriggerPolicy {
+	for i := range config.Spec.Triggers {
+		if config.Spec.Triggers[i].Type == deployapi.DeploymentTriggerOnSecretChange {
+			return &config.Spec.Triggers[i]
+		}
+	}
+	return nil
+}
+
+// SecretNamesForTemplate returns the sorted names of the secrets mounted by
+// the volumes of template.
+func SecretNamesForTemplate(template *api.PodTemplateSpec) []string {
+	names := []string{}
+	if template == nil {
+		return names
+	}
+	seen := map[string]bool{}
+	for _, volume := range template.Spec.Volumes {
+		if volume.Secret == nil || seen[volume.Secret.SecretName] {
+			continue
+		}
+		seen[volume.Secret.SecretName] = true
+		names = append(names, volume.Secret.SecretName)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// HashSecretData returns a hash of the contents of secret, which changes
+// only when its data changes.
+func HashSecretData(secret *api.Secret) string {
+	keys := []string{}
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	hash := sha256.New()
+	for _, key := range keys {
+		// Separate the keys from the values so that moving bytes between them
+		// changes the hash.
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write(secret.Data[key])
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
 // DecodeDeploymentConfig decodes a DeploymentConfig from controller using codec. An error is returned
 // if the controller doesn't contain an encoded config.
 func DecodeDeploymentConfig(controller *api.ReplicationController, codec runtime.Codec) (*deployapi.DeploymentConfig, error) {
@@ -246,6 +343,20 @@ func DeploymentStatusReasonFor(obj runtime.Object) string {
 	return annotationFor(obj, deployapi.DeploymentStatusReasonAnnotation)
 }
 
+func DeploymentColorFor(obj runtime.Object) deployapi.DeploymentColor {
+	return deployapi.DeploymentColor(annotationFor(obj, deployapi.DeploymentColorAnnotation))
+}
+
+// NextDeploymentColor returns the color of the deployment made by the
+// BlueGreen strategy after previous. The first deployment, with no previous
+// deployment, is blue.
+func NextDeploymentColor(previous *api.ReplicationController) deployapi.DeploymentColor {
+	if previous != nil && DeploymentColorFor(previous) == deployapi.DeploymentColorBlue {
+		return deployapi.DeploymentColorGreen
+	}
+	return deployapi.DeploymentColorBlue
+}
+
 func DeploymentDesiredReplicas(obj runtime.Object) (int, bool) {
 	return intAnnotationFor(obj, deployapi.DesiredReplicasAnnotation)
 }
@@ -0,0 +1,23 @@
+package dockerregistry
+
+// GarbageCollectionReport describes the content of the integrated registry
+// which is not referenced by any image, and which is removed by a garbage
+// collection. It is returned by the /admin/garbagecollect endpoint of the
+// registry.
+type GarbageCollectionReport struct {
+	// DryRun is true if the content was only reported and not removed.
+	DryRun bool `json:"dryRun"`
+	// Blobs are the digests of the blobs removed from the storage.
+	Blobs []string `json:"blobs,omitempty"`
+	// Size is the total size of Blobs in bytes.
+	Size int64 `json:"size"`
+	// LayerLinks are the layer links removed from repositories, as
+	// <repository>@<digest>.
+	LayerLinks []string `json:"layerLinks,omitempty"`
+	// Manifests are the manifest revisions removed from repositories, as
+	// <repository>@<digest>.
+	Manifests []string `json:"manifests,omitempty"`
+	// Errors are the failures to remove content, which do not stop the
+	// collection.
+	Errors []string `json:"errors,omitempty"`
+}
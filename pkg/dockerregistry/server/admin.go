@@ -1,8 +1,11 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync/atomic"
+	"time"
 
 	"github.com/docker/distribution"
 	"github.com/docker/distribution/context"
@@ -77,3 +80,74 @@ func (bh *blobHandler) Delete(w http.ResponseWriter, req *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// errorCodeGarbageCollectionRunning is returned if a garbage collection is
+// requested while another one is running.
+var errorCodeGarbageCollectionRunning = errcode.Register("openshift", errcode.ErrorDescriptor{
+	Value:   "GARBAGECOLLECTIONRUNNING",
+	Message: "a garbage collection is already running",
+	Description: `Returned when a garbage collection is requested while
+	another one is running. The request may be retried later.`,
+	HTTPStatusCode: http.StatusConflict,
+})
+
+// NewGarbageCollectDispatcher returns a dispatcher of garbage collection
+// requests, which remove the content of the storage of driver that is not
+// referenced by any image. Content modified within gracePeriod is kept. Only
+// one collection runs at a time; other requests are rejected meanwhile.
+func NewGarbageCollectDispatcher(driver storagedriver.StorageDriver, gracePeriod time.Duration) func(*handlers.Context, *http.Request) http.Handler {
+	running := new(int32)
+	return func(ctx *handlers.Context, r *http.Request) http.Handler {
+		gcHandler := &garbageCollectHandler{
+			Context:     ctx,
+			driver:      driver,
+			gracePeriod: gracePeriod,
+			running:     running,
+		}
+
+		return gorillahandlers.MethodHandler{
+			"POST": http.HandlerFunc(gcHandler.Collect),
+		}
+	}
+}
+
+// garbageCollectHandler handles garbage collection requests.
+type garbageCollectHandler struct {
+	*handlers.Context
+
+	driver      storagedriver.StorageDriver
+	gracePeriod time.Duration
+	// running is 1 while a collection runs.
+	running *int32
+}
+
+// Collect removes the content which is not referenced by any image and
+// responds with a report of it. If the dryRun parameter is true, the content
+// is only reported. A conflict is returned if a collection is already running.
+func (gh *garbageCollectHandler) Collect(w http.ResponseWriter, req *http.Request) {
+	defer req.Body.Close()
+
+	if !atomic.CompareAndSwapInt32(gh.running, 0, 1) {
+		gh.Errors = append(gh.Errors, errorCodeGarbageCollectionRunning)
+		return
+	}
+	defer atomic.StoreInt32(gh.running, 0)
+
+	registryClient, err := NewRegistryOpenShiftClient()
+	if err != nil {
+		gh.Errors = append(gh.Errors, errcode.ErrorCodeUnknown.WithDetail(err))
+		return
+	}
+
+	dryRun := req.URL.Query().Get("dryRun") == "true"
+	report, err := CollectGarbage(gh, gh.Namespace(), gh.driver, registryClient, gh.gracePeriod, dryRun)
+	if err != nil {
+		gh.Errors = append(gh.Errors, errcode.ErrorCodeUnknown.WithDetail(err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		context.GetLogger(gh).Errorf("Error writing the garbage collection report: %v", err)
+	}
+}
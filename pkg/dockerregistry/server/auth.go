@@ -88,6 +88,7 @@ func (ac *authChallenge) SetHeaders(w http.ResponseWriter) {
 
 // wrapErr wraps errors related to authorization in an authChallenge error that will present a WWW-Authenticate challenge response
 func (ac *AccessController) wrapErr(err error) error {
+	recordAuthFailure(err)
 	switch err {
 	case ErrTokenRequired, ErrTokenInvalid, ErrOpenShiftTokenRequired, ErrOpenShiftAccessDenied:
 		// Challenge for errors that involve tokens or access denied
@@ -183,6 +184,15 @@ func (ac *AccessController) Authorized(ctx context.Context, accessRecords ...reg
 			default:
 				return nil, ac.wrapErr(ErrUnsupportedAction)
 			}
+		case "metrics":
+			switch access.Action {
+			case "get":
+				if err := verifyMetricsAccess(ctx, client); err != nil {
+					return nil, ac.wrapErr(err)
+				}
+			default:
+				return nil, ac.wrapErr(ErrUnsupportedAction)
+			}
 		default:
 			return nil, ac.wrapErr(ErrUnsupportedResource)
 		}
@@ -288,3 +298,25 @@ func verifyPruneAccess(ctx context.Context, client *client.Client) error {
 	}
 	return nil
 }
+
+func verifyMetricsAccess(ctx context.Context, client *client.Client) error {
+	sar := authorizationapi.SubjectAccessReview{
+		Action: authorizationapi.AuthorizationAttributes{
+			Verb:     "get",
+			Resource: authorizationapi.RegistryMetricsResource,
+		},
+	}
+	response, err := client.SubjectAccessReviews().Create(&sar)
+	if err != nil {
+		context.GetLogger(ctx).Errorf("OpenShift client error: %s", err)
+		if kerrors.IsUnauthorized(err) || kerrors.IsForbidden(err) {
+			return ErrOpenShiftAccessDenied
+		}
+		return err
+	}
+	if !response.Allowed {
+		context.GetLogger(ctx).Errorf("OpenShift access denied: %s", response.Reason)
+		return ErrOpenShiftAccessDenied
+	}
+	return nil
+}
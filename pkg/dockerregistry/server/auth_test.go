@@ -261,6 +261,36 @@ func TestAccessController(t *testing.T) {
 				"POST /oapi/v1/subjectaccessreviews",
 			},
 		},
+		"metrics": {
+			access: []auth.Access{{
+				Resource: auth.Resource{
+					Type: "metrics",
+				},
+				Action: "get",
+			}},
+			basicToken: "b3BlbnNoaWZ0OmF3ZXNvbWU=",
+			openshiftResponses: []response{
+				{200, runtime.EncodeOrDie(latest.Codec, &api.SubjectAccessReviewResponse{Allowed: true, Reason: "authorized!"})},
+			},
+			expectedError:     nil,
+			expectedChallenge: false,
+			expectedActions:   []string{"POST /oapi/v1/subjectaccessreviews"},
+		},
+		"metrics denied": {
+			access: []auth.Access{{
+				Resource: auth.Resource{
+					Type: "metrics",
+				},
+				Action: "get",
+			}},
+			basicToken: "b3BlbnNoaWZ0OmF3ZXNvbWU=",
+			openshiftResponses: []response{
+				{200, runtime.EncodeOrDie(latest.Codec, &api.SubjectAccessReviewResponse{Allowed: false, Reason: "no!"})},
+			},
+			expectedError:     ErrOpenShiftAccessDenied,
+			expectedChallenge: true,
+			expectedActions:   []string{"POST /oapi/v1/subjectaccessreviews"},
+		},
 	}
 
 	for k, test := range tests {
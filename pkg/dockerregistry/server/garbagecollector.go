@@ -0,0 +1,375 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/digest"
+	"github.com/docker/distribution/registry/storage"
+	storagedriver "github.com/docker/distribution/registry/storage/driver"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+
+	"github.com/openshift/origin/pkg/client"
+	"github.com/openshift/origin/pkg/dockerregistry"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// DefaultGarbageCollectionGracePeriod is how long content written to the
+// storage is kept before it may be collected. Pushes create their image only
+// after their blobs are stored, so recent blobs may belong to an image which
+// is being pushed.
+const DefaultGarbageCollectionGracePeriod = time.Hour
+
+// storagePathRoot is the root of the layout of the storage of the registry.
+const storagePathRoot = "/docker/registry/v2"
+
+// garbageCollector marks the blobs referenced by the images known to the
+// master, and sweeps the other blobs and the repository links to them from the
+// storage of the registry. Content modified within gracePeriod is never swept.
+type garbageCollector struct {
+	registry    distribution.Namespace
+	driver      storagedriver.StorageDriver
+	images      client.ImagesInterfacer
+	gracePeriod time.Duration
+	// started is when the collection started. Content modified since then is
+	// never swept.
+	started time.Time
+
+	// referenced holds the digests of the blobs which must be kept.
+	referenced map[digest.Digest]bool
+	report     *dockerregistry.GarbageCollectionReport
+}
+
+// CollectGarbage removes the blobs of registry which are not referenced by any
+// image known to the master, along with the repository links and manifest
+// revisions of those images. The registry keeps serving requests while the
+// collection runs. If dryRun is true, the content is only reported.
+func CollectGarbage(ctx context.Context, registry distribution.Namespace, driver storagedriver.StorageDriver, images client.ImagesInterfacer, gracePeriod time.Duration, dryRun bool) (*dockerregistry.GarbageCollectionReport, error) {
+	gc := &garbageCollector{
+		registry:    registry,
+		driver:      driver,
+		images:      images,
+		gracePeriod: gracePeriod,
+		started:     time.Now(),
+		referenced:  make(map[digest.Digest]bool),
+		report:      &dockerregistry.GarbageCollectionReport{DryRun: dryRun},
+	}
+	if err := gc.markImages(); err != nil {
+		return nil, err
+	}
+	if err := gc.sweepRepositories(ctx); err != nil {
+		return nil, err
+	}
+	if err := gc.sweepBlobs(ctx); err != nil {
+		return nil, err
+	}
+	return gc.report, nil
+}
+
+// markImages marks the manifests, layers and configurations of every image.
+func (gc *garbageCollector) markImages() error {
+	images, err := gc.images.Images().List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return fmt.Errorf("unable to list images: %v", err)
+	}
+	for i := range images.Items {
+		blobs, err := imageBlobs(&images.Items[i])
+		if err != nil {
+			return err
+		}
+		for _, dgst := range blobs {
+			gc.referenced[dgst] = true
+		}
+	}
+	return nil
+}
+
+// imageBlobs returns the digests of the blobs referenced by image. An error is
+// returned if the manifest of image cannot be read, since its layers would be
+// unknown.
+func imageBlobs(image *imageapi.Image) ([]digest.Digest, error) {
+	blobs := []digest.Digest{digest.Digest(image.Name)}
+	for _, layer := range image.DockerImageLayers {
+		blobs = append(blobs, digest.Digest(layer.Name))
+	}
+	if len(image.DockerImageManifest) == 0 {
+		return blobs, nil
+	}
+
+	manifest := imageapi.DockerImageManifest{}
+	if err := json.Unmarshal([]byte(image.DockerImageManifest), &manifest); err != nil {
+		return nil, fmt.Errorf("unable to read the manifest of image %s: %v", image.Name, err)
+	}
+	for _, layer := range manifest.FSLayers {
+		blobs = append(blobs, digest.Digest(layer.DockerBlobSum))
+	}
+	return blobs, nil
+}
+
+// sweepRepositories sweeps the manifest revisions and layer links of every
+// repository.
+func (gc *garbageCollector) sweepRepositories(ctx context.Context) error {
+	names, err := gc.repositories(ctx)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := gc.sweepRepository(ctx, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// repositories returns the names of the repositories of the registry.
+func (gc *garbageCollector) repositories(ctx context.Context) ([]string, error) {
+	names := []string{}
+	page := make([]string, 100)
+	last := ""
+	for {
+		n, err := gc.registry.Repositories(ctx, page, last)
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("unable to list repositories: %v", err)
+		}
+		names = append(names, page[:n]...)
+		if err == io.EOF || n == 0 {
+			return names, nil
+		}
+		last = page[n-1]
+	}
+}
+
+// sweepRepository removes the manifest revisions of the repository name whose
+// image does not exist, and the links to layers which are not referenced. The
+// signatures of the remaining revisions are marked.
+func (gc *garbageCollector) sweepRepository(ctx context.Context, name string) error {
+	repo, err := gc.registry.Repository(ctx, name)
+	if err != nil {
+		return fmt.Errorf("unable to access repository %s: %v", name, err)
+	}
+	revisions, err := gc.manifestRevisions(ctx, name)
+	if err != nil {
+		return fmt.Errorf("unable to list the manifests of repository %s: %v", name, err)
+	}
+
+	for _, revision := range revisions {
+		signatures, err := repo.Signatures().Enumerate(revision)
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("unable to list the signatures of manifest %s@%s: %v", name, revision, err)
+		}
+		keep := gc.referenced[revision]
+		for _, signature := range signatures {
+			if gc.recentlyModified(ctx, signatureLinkPath(name, revision, signature)) {
+				keep = true
+			}
+		}
+		if keep {
+			for _, signature := range signatures {
+				gc.referenced[signature] = true
+			}
+			continue
+		}
+
+		gc.report.Manifests = append(gc.report.Manifests, name+"@"+revision.String())
+		if gc.report.DryRun {
+			continue
+		}
+		context.GetLogger(ctx).Infof("Removing manifest %s@%s", name, revision)
+		// the revision is removed with its signatures
+		if err := gc.driver.Delete(ctx, manifestRevisionPath(name, revision)); err != nil && !isUnknownBlob(err) {
+			gc.addError("unable to remove manifest %s@%s: %v", name, revision, err)
+		}
+	}
+
+	layers := []digest.Digest{}
+	err = repo.Blobs(ctx).Enumerate(ctx, func(dgst digest.Digest) error {
+		layers = append(layers, dgst)
+		return nil
+	})
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("unable to list the layers of repository %s: %v", name, err)
+	}
+	for _, layer := range layers {
+		if gc.referenced[layer] {
+			continue
+		}
+		if gc.recentlyModified(ctx, layerLinkPath(name, layer)) {
+			// the blob may belong to an image being pushed to the repository
+			gc.referenced[layer] = true
+			continue
+		}
+
+		gc.report.LayerLinks = append(gc.report.LayerLinks, name+"@"+layer.String())
+		if gc.report.DryRun {
+			continue
+		}
+		context.GetLogger(ctx).Infof("Removing layer link %s@%s", name, layer)
+		if err := repo.Blobs(ctx).Delete(ctx, layer); err != nil && !isUnknownBlob(err) {
+			gc.addError("unable to remove layer link %s@%s: %v", name, layer, err)
+		}
+	}
+	return nil
+}
+
+// manifestRevisions returns the digests of the manifest revisions stored in the
+// repository name. Revisions are listed from the storage, since the manifests
+// of images are stored by the master rather than as revisions.
+func (gc *garbageCollector) manifestRevisions(ctx context.Context, name string) ([]digest.Digest, error) {
+	revisionsPath := path.Join(storagePathRoot, "repositories", name, "_manifests", "revisions")
+	algorithms, err := gc.driver.List(ctx, revisionsPath)
+	if err != nil {
+		if _, ok := err.(storagedriver.PathNotFoundError); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	revisions := []digest.Digest{}
+	for _, algorithmPath := range algorithms {
+		hexPaths, err := gc.driver.List(ctx, algorithmPath)
+		if err != nil {
+			return nil, err
+		}
+		for _, hexPath := range hexPaths {
+			dgst := digest.NewDigestFromHex(path.Base(algorithmPath), path.Base(hexPath))
+			if err := dgst.Validate(); err == nil {
+				revisions = append(revisions, dgst)
+			}
+		}
+	}
+	return revisions, nil
+}
+
+// sweepBlobs removes the blobs which are not referenced from the storage. A
+// push may link a blob into a repository after the repositories were swept, so
+// the links to each blob are checked again right before it is removed.
+func (gc *garbageCollector) sweepBlobs(ctx context.Context) error {
+	enumerator, err := storage.RegistryBlobEnumerator(gc.registry)
+	if err != nil {
+		return err
+	}
+	deleter, err := storage.RegistryBlobDeleter(gc.registry)
+	if err != nil {
+		return err
+	}
+
+	blobs := []digest.Digest{}
+	err = enumerator.Enumerate(ctx, func(dgst digest.Digest) error {
+		if !gc.referenced[dgst] {
+			blobs = append(blobs, dgst)
+		}
+		return nil
+	})
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("unable to list blobs: %v", err)
+	}
+	// repositories created by pushes since the sweep of the repositories are
+	// checked too
+	names, err := gc.repositories(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, dgst := range blobs {
+		if gc.recentlyModified(ctx, blobDataPath(dgst)) {
+			continue
+		}
+		desc, err := gc.registry.Blobs().Stat(ctx, dgst)
+		if err != nil {
+			if !isUnknownBlob(err) {
+				gc.addError("unable to retrieve the size of blob %s: %v", dgst, err)
+			}
+			continue
+		}
+		if gc.recentlyLinked(ctx, names, dgst) {
+			continue
+		}
+
+		gc.report.Blobs = append(gc.report.Blobs, dgst.String())
+		gc.report.Size += desc.Size
+		if gc.report.DryRun {
+			continue
+		}
+		context.GetLogger(ctx).Infof("Removing blob %s", dgst)
+		if err := deleter.Delete(ctx, dgst); err != nil && !isUnknownBlob(err) {
+			gc.addError("unable to remove blob %s: %v", dgst, err)
+		}
+	}
+	sort.Strings(gc.report.Blobs)
+	return nil
+}
+
+// recentlyLinked returns true if any of the repositories names has a recently
+// modified link to the layer dgst.
+func (gc *garbageCollector) recentlyLinked(ctx context.Context, names []string, dgst digest.Digest) bool {
+	for _, name := range names {
+		if gc.recentlyModified(ctx, layerLinkPath(name, dgst)) {
+			context.GetLogger(ctx).Infof("Keeping blob %s, which was linked into repository %s", dgst, name)
+			return true
+		}
+	}
+	return false
+}
+
+// recentlyModified returns true if the content at storagePath was modified
+// within the grace period or since the collection started. Content whose
+// modification time cannot be retrieved is considered recent, so that it is
+// kept.
+func (gc *garbageCollector) recentlyModified(ctx context.Context, storagePath string) bool {
+	info, err := gc.driver.Stat(ctx, storagePath)
+	if err != nil {
+		if _, ok := err.(storagedriver.PathNotFoundError); ok {
+			return false
+		}
+		context.GetLogger(ctx).Errorf("Error retrieving the modification time of %s: %v", storagePath, err)
+		return true
+	}
+	return time.Since(info.ModTime()) < gc.gracePeriod || !info.ModTime().Before(gc.started)
+}
+
+func (gc *garbageCollector) addError(format string, args ...interface{}) {
+	gc.report.Errors = append(gc.report.Errors, fmt.Sprintf(format, args...))
+}
+
+// isUnknownBlob returns true if err reports that a blob or its link does not
+// exist, which happens if it was removed concurrently.
+func isUnknownBlob(err error) bool {
+	if _, ok := err.(storagedriver.PathNotFoundError); ok {
+		return true
+	}
+	return err == distribution.ErrBlobUnknown
+}
+
+// blobDataPath returns the storage path of the data of the blob dgst.
+func blobDataPath(dgst digest.Digest) string {
+	hex := dgst.Hex()
+	if len(hex) < 2 {
+		return path.Join(storagePathRoot, "blobs", string(dgst.Algorithm()), hex, "data")
+	}
+	return path.Join(storagePathRoot, "blobs", string(dgst.Algorithm()), hex[:2], hex, "data")
+}
+
+// layerLinkPath returns the storage path of the link to the layer dgst in the
+// repository name.
+func layerLinkPath(name string, dgst digest.Digest) string {
+	return path.Join(storagePathRoot, "repositories", name, "_layers", string(dgst.Algorithm()), dgst.Hex(), "link")
+}
+
+// manifestRevisionPath returns the storage path of the manifest revision in the
+// repository name.
+func manifestRevisionPath(name string, revision digest.Digest) string {
+	return path.Join(storagePathRoot, "repositories", name, "_manifests", "revisions", string(revision.Algorithm()), revision.Hex())
+}
+
+// signatureLinkPath returns the storage path of the link to the signature
+// signature of the manifest revision in the repository name.
+func signatureLinkPath(name string, revision, signature digest.Digest) string {
+	return path.Join(manifestRevisionPath(name, revision), "signatures", string(signature.Algorithm()), signature.Hex(), "link")
+}
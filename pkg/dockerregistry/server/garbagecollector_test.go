@@ -0,0 +1,194 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/digest"
+	"github.com/docker/distribution/registry/handlers"
+	"github.com/docker/distribution/registry/storage"
+	"github.com/docker/distribution/registry/storage/driver/inmemory"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	"github.com/openshift/origin/pkg/dockerregistry"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func TestCollectGarbage(t *testing.T) {
+	ctx := context.Background()
+	driver := inmemory.New()
+	registry, err := storage.NewRegistry(ctx, driver, storage.EnableDelete)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := registry.Repository(ctx, "user/ruby")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	put := func(content string) digest.Digest {
+		desc, err := repo.Blobs(ctx).Put(ctx, "application/octet-stream", []byte(content))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return desc.Digest
+	}
+	kept := put("referenced layer")
+	orphan := put("orphaned layer")
+	liveManifest, _ := digest.FromBytes([]byte("live manifest"))
+	deadManifest, _ := digest.FromBytes([]byte("dead manifest"))
+	if err := repo.Signatures().Put(liveManifest, []byte("live signature")); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Signatures().Put(deadManifest, []byte("dead signature")); err != nil {
+		t.Fatal(err)
+	}
+	liveSignature, _ := digest.FromBytes([]byte("live signature"))
+	deadSignature, _ := digest.FromBytes([]byte("dead signature"))
+
+	fake := &testclient.Fake{}
+	fake.AddReactor("list", "images", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &imageapi.ImageList{Items: []imageapi.Image{{
+			ObjectMeta:        kapi.ObjectMeta{Name: liveManifest.String()},
+			DockerImageLayers: []imageapi.ImageLayer{{Name: kept.String()}},
+		}}}, nil
+	})
+
+	// recent content is kept
+	report, err := CollectGarbage(ctx, registry, driver, fake, time.Hour, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.Blobs) != 0 || len(report.LayerLinks) != 0 || len(report.Manifests) != 0 {
+		t.Errorf("expected recent content to be kept, got %#v", report)
+	}
+
+	// a dry run reports the unreferenced content
+	report, err = CollectGarbage(ctx, registry, driver, fake, 0, true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expectedBlobs := []string{orphan.String(), deadSignature.String()}
+	sort.Strings(expectedBlobs)
+	expected := &dockerregistry.GarbageCollectionReport{
+		DryRun:     true,
+		Blobs:      expectedBlobs,
+		Size:       int64(len("orphaned layer") + len("dead signature")),
+		LayerLinks: []string{"user/ruby@" + orphan.String()},
+		Manifests:  []string{"user/ruby@" + deadManifest.String()},
+	}
+	if !reflect.DeepEqual(expected, report) {
+		t.Errorf("unexpected report:\n%#v\nexpected:\n%#v", report, expected)
+	}
+	if _, err := registry.Blobs().Stat(ctx, orphan); err != nil {
+		t.Errorf("expected a dry run to keep the blob: %v", err)
+	}
+
+	report, err = CollectGarbage(ctx, registry, driver, fake, 0, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected.DryRun = false
+	if !reflect.DeepEqual(expected, report) {
+		t.Errorf("unexpected report:\n%#v\nexpected:\n%#v", report, expected)
+	}
+	for _, dgst := range []digest.Digest{orphan, deadSignature} {
+		if _, err := registry.Blobs().Stat(ctx, dgst); err != distribution.ErrBlobUnknown {
+			t.Errorf("expected blob %s to be removed, got %v", dgst, err)
+		}
+	}
+	for _, dgst := range []digest.Digest{kept, liveSignature} {
+		if _, err := registry.Blobs().Stat(ctx, dgst); err != nil {
+			t.Errorf("expected blob %s to be kept: %v", dgst, err)
+		}
+	}
+	if _, err := repo.Blobs(ctx).Stat(ctx, orphan); err != distribution.ErrBlobUnknown {
+		t.Errorf("expected the layer link to be removed, got %v", err)
+	}
+	if signatures, err := repo.Signatures().Get(liveManifest); err != nil || len(signatures) != 1 {
+		t.Errorf("expected the signature of the live manifest to be kept, got %v %v", signatures, err)
+	}
+}
+
+func TestRecentlyLinked(t *testing.T) {
+	ctx := context.Background()
+	driver := inmemory.New()
+	registry, err := storage.NewRegistry(ctx, driver, storage.EnableDelete)
+	if err != nil {
+		t.Fatal(err)
+	}
+	put := func(name, content string) digest.Digest {
+		repo, err := registry.Repository(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		desc, err := repo.Blobs(ctx).Put(ctx, "application/octet-stream", []byte(content))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return desc.Digest
+	}
+	dgst := put("user/ruby", "layer")
+
+	gc := &garbageCollector{registry: registry, driver: driver, started: time.Now()}
+	names := []string{"user/ruby", "user/rails"}
+	if gc.recentlyLinked(ctx, names, dgst) {
+		t.Errorf("expected the links created before the collection to be old")
+	}
+
+	// a push during the collection links the blob into another repository
+	put("user/rails", "layer")
+	if !gc.recentlyLinked(ctx, names, dgst) {
+		t.Errorf("expected the link created during the collection to be recent")
+	}
+}
+
+func TestCollectConflict(t *testing.T) {
+	running := int32(1)
+	gh := &garbageCollectHandler{
+		Context: &handlers.Context{Context: context.Background()},
+		running: &running,
+	}
+	req, _ := http.NewRequest("POST", "/admin/garbagecollect", strings.NewReader(""))
+	gh.Collect(httptest.NewRecorder(), req)
+	if len(gh.Errors) != 1 || gh.Errors[0] != errorCodeGarbageCollectionRunning {
+		t.Fatalf("expected a conflict, got %v", gh.Errors)
+	}
+	if e, a := http.StatusConflict, errorCodeGarbageCollectionRunning.Descriptor().HTTPStatusCode; e != a {
+		t.Errorf("expected status %d, got %d", e, a)
+	}
+	if running != 1 {
+		t.Errorf("expected the running collection to be left alone")
+	}
+}
+
+func TestImageBlobs(t *testing.T) {
+	image := &imageapi.Image{
+		ObjectMeta:          kapi.ObjectMeta{Name: "sha256:manifest"},
+		DockerImageManifest: `{"schemaVersion":1,"fsLayers":[{"blobSum":"sha256:layer2"},{"blobSum":"sha256:layer1"}]}`,
+	}
+	blobs, err := imageBlobs(image)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []digest.Digest{"sha256:manifest", "sha256:layer2", "sha256:layer1"}
+	if !reflect.DeepEqual(expected, blobs) {
+		t.Errorf("unexpected blobs: %v", blobs)
+	}
+
+	image.DockerImageManifest = "{"
+	if _, err := imageBlobs(image); err == nil {
+		t.Errorf("expected an error for an unreadable manifest")
+	}
+}
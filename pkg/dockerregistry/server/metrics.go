@@ -0,0 +1,222 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/digest"
+	registryauth "github.com/docker/distribution/registry/auth"
+	"github.com/docker/distribution/registry/handlers"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	manifestLatency = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Name: "openshift_registry_manifest_duration_seconds",
+			Help: "Latency of the image pulls and pushes broken out for each repository and operation",
+		},
+		[]string{"repository", "operation"},
+	)
+
+	blobBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "openshift_registry_blob_bytes_total",
+			Help: "Counter of the bytes of blobs pulled and pushed broken out for each repository and operation",
+		},
+		[]string{"repository", "operation"},
+	)
+
+	apiLatency = prometheus.NewSummaryVec(
+		prometheus.SummaryOpts{
+			Name: "openshift_registry_api_request_duration_seconds",
+			Help: "Latency of the requests of the registry to the OpenShift API broken out for each verb and resource",
+		},
+		[]string{"verb", "resource"},
+	)
+
+	authFailureCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "openshift_registry_auth_failure_count",
+			Help: "Counter of the requests which failed authentication or authorization broken out for each reason",
+		},
+		[]string{"reason"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(manifestLatency)
+	prometheus.MustRegister(blobBytes)
+	prometheus.MustRegister(apiLatency)
+	prometheus.MustRegister(authFailureCounter)
+}
+
+// Operations reported by the metrics of repositories.
+const (
+	operationPull = "pull"
+	operationPush = "push"
+)
+
+// MetricsHandler serves the metrics of the registry. Requests are authorized by
+// the access controller with the access records of MetricsAccessRecords.
+func MetricsHandler(ctx *handlers.Context, r *http.Request) http.Handler {
+	return prometheus.UninstrumentedHandler()
+}
+
+// MetricsAccessRecords returns the access records required to read the metrics
+// of the registry.
+func MetricsAccessRecords(*http.Request) []registryauth.Access {
+	return []registryauth.Access{
+		{
+			Resource: registryauth.Resource{
+				Type: "metrics",
+			},
+			Action: "get",
+		},
+	}
+}
+
+// observeManifest records the latency of a pull or push of a manifest of the
+// repository since start.
+func observeManifest(repository, operation string, start time.Time) {
+	manifestLatency.WithLabelValues(repository, operation).Observe(time.Since(start).Seconds())
+}
+
+// recordAuthFailure counts a request which was denied by the access controller
+// because of err. Errors other than the errors of this package are counted
+// together, so that the number of reasons remains bounded. ErrTokenRequired is
+// not counted: clients first request /v2/ without credentials to receive the
+// authentication challenge, so it is part of every login.
+func recordAuthFailure(err error) {
+	if err == ErrTokenRequired {
+		return
+	}
+	reason := "other"
+	switch err {
+	case ErrTokenInvalid, ErrOpenShiftTokenRequired, ErrOpenShiftAccessDenied,
+		ErrNamespaceRequired, ErrUnsupportedAction, ErrUnsupportedResource:
+		reason = err.Error()
+	}
+	authFailureCounter.WithLabelValues(reason).Inc()
+}
+
+// metricsBlobStore wraps the blob store of a repository to count the bytes of
+// the blobs pulled from and pushed to the repository.
+type metricsBlobStore struct {
+	distribution.BlobStore
+
+	repository string
+}
+
+var _ distribution.BlobStore = &metricsBlobStore{}
+
+// ServeBlob counts the bytes of the blob with digest dgst written to w.
+func (bs *metricsBlobStore) ServeBlob(ctx context.Context, w http.ResponseWriter, req *http.Request, dgst digest.Digest) error {
+	cw := &countingResponseWriter{ResponseWriter: w}
+	err := bs.BlobStore.ServeBlob(ctx, cw, req, dgst)
+	blobBytes.WithLabelValues(bs.repository, operationPull).Add(float64(cw.written))
+	return err
+}
+
+// Put counts the bytes of p once they are stored.
+func (bs *metricsBlobStore) Put(ctx context.Context, mediaType string, p []byte) (distribution.Descriptor, error) {
+	desc, err := bs.BlobStore.Put(ctx, mediaType, p)
+	if err == nil {
+		blobBytes.WithLabelValues(bs.repository, operationPush).Add(float64(desc.Size))
+	}
+	return desc, err
+}
+
+// Create returns a writer which counts the bytes of the blob when it is committed.
+func (bs *metricsBlobStore) Create(ctx context.Context) (distribution.BlobWriter, error) {
+	bw, err := bs.BlobStore.Create(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &metricsBlobWriter{BlobWriter: bw, repository: bs.repository}, nil
+}
+
+// Resume returns a writer which counts the bytes of the blob when it is committed.
+func (bs *metricsBlobStore) Resume(ctx context.Context, id string) (distribution.BlobWriter, error) {
+	bw, err := bs.BlobStore.Resume(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &metricsBlobWriter{BlobWriter: bw, repository: bs.repository}, nil
+}
+
+// metricsBlobWriter counts the bytes of the blobs pushed to a repository.
+type metricsBlobWriter struct {
+	distribution.BlobWriter
+
+	repository string
+}
+
+// Commit counts the bytes of the blob once it is stored.
+func (bw *metricsBlobWriter) Commit(ctx context.Context, provisional distribution.Descriptor) (distribution.Descriptor, error) {
+	desc, err := bw.BlobWriter.Commit(ctx, provisional)
+	if err == nil {
+		blobBytes.WithLabelValues(bw.repository, operationPush).Add(float64(desc.Size))
+	}
+	return desc, err
+}
+
+// countingResponseWriter counts the bytes written to a response.
+type countingResponseWriter struct {
+	http.ResponseWriter
+
+	written int64
+}
+
+func (w *countingResponseWriter) Write(p []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(p)
+	w.written += int64(n)
+	return n, err
+}
+
+// apiMetricsRoundTripper records the latency of the requests to the OpenShift API.
+type apiMetricsRoundTripper struct {
+	rt http.RoundTripper
+}
+
+// newAPIMetricsRoundTripper wraps rt to record the latency of its requests.
+func newAPIMetricsRoundTripper(rt http.RoundTripper) http.RoundTripper {
+	return &apiMetricsRoundTripper{rt: rt}
+}
+
+func (rt *apiMetricsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := rt.rt.RoundTrip(req)
+	apiLatency.WithLabelValues(req.Method, apiResource(req.URL.Path)).Observe(time.Since(start).Seconds())
+	return resp, err
+}
+
+// apiResource returns the resource of an OpenShift or Kubernetes API path, e.g.
+// "imagestreams" for /oapi/v1/namespaces/ns/imagestreams/name, and the
+// subresource if there is one, e.g. "imagestreams/status". Other paths are
+// reported as "other".
+func apiResource(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) < 3 || (parts[0] != "oapi" && parts[0] != "api") {
+		return "other"
+	}
+	// skip the prefix and the version
+	parts = parts[2:]
+	if len(parts) >= 2 && parts[0] == "namespaces" {
+		parts = parts[2:]
+		if len(parts) == 0 {
+			return "namespaces"
+		}
+	}
+	switch len(parts) {
+	case 0:
+		return "other"
+	case 1, 2:
+		return parts[0]
+	default:
+		return parts[0] + "/" + parts[2]
+	}
+}
@@ -0,0 +1,93 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/registry/storage"
+	"github.com/docker/distribution/registry/storage/driver/inmemory"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestAPIResource(t *testing.T) {
+	tests := map[string]string{
+		"/oapi/v1/namespaces/user/imagestreams/ruby":           "imagestreams",
+		"/oapi/v1/namespaces/user/imagestreams":                "imagestreams",
+		"/oapi/v1/namespaces/user/imagestreamtags/ruby:latest": "imagestreamtags",
+		"/oapi/v1/namespaces/user/imagestreams/ruby/status":    "imagestreams/status",
+		"/oapi/v1/images/sha256:abc":                           "images",
+		"/oapi/v1/subjectaccessreviews":                        "subjectaccessreviews",
+		"/api/v1/namespaces/user/resourcequotas":               "resourcequotas",
+		"/api/v1/namespaces/user":                              "namespaces",
+		"/api/v1/namespaces":                                   "namespaces",
+		"/oapi/v1":                                             "other",
+		"/healthz":                                             "other",
+	}
+	for path, expected := range tests {
+		if resource := apiResource(path); resource != expected {
+			t.Errorf("%s: expected %q, got %q", path, expected, resource)
+		}
+	}
+}
+
+func TestMetricsBlobStore(t *testing.T) {
+	ctx := context.Background()
+	registry, err := storage.NewRegistry(ctx, inmemory.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := registry.Repository(ctx, "user/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs := &metricsBlobStore{BlobStore: repo.Blobs(ctx), repository: "user/metrics"}
+
+	content := []byte("layer content")
+	desc, err := bs.Put(ctx, "application/octet-stream", content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pushed := counterValue(t, blobBytes.WithLabelValues("user/metrics", operationPush)); pushed != float64(len(content)) {
+		t.Errorf("expected %d pushed bytes, got %v", len(content), pushed)
+	}
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v2/user/metrics/blobs/"+desc.Digest.String(), nil)
+	if err := bs.ServeBlob(ctx, w, req, desc.Digest); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pulled := counterValue(t, blobBytes.WithLabelValues("user/metrics", operationPull)); pulled != float64(len(content)) {
+		t.Errorf("expected %d pulled bytes, got %v", len(content), pulled)
+	}
+}
+
+func TestRecordAuthFailure(t *testing.T) {
+	before := counterValue(t, authFailureCounter.WithLabelValues(ErrOpenShiftAccessDenied.Error()))
+	recordAuthFailure(ErrOpenShiftAccessDenied)
+	if after := counterValue(t, authFailureCounter.WithLabelValues(ErrOpenShiftAccessDenied.Error())); after != before+1 {
+		t.Errorf("expected the failure to be counted, got %v after %v", after, before)
+	}
+
+	before = counterValue(t, authFailureCounter.WithLabelValues("other"))
+	recordAuthFailure(http.ErrNoCookie)
+	if after := counterValue(t, authFailureCounter.WithLabelValues("other")); after != before+1 {
+		t.Errorf("expected an unknown error to be counted as other, got %v after %v", after, before)
+	}
+
+	before = counterValue(t, authFailureCounter.WithLabelValues(ErrTokenRequired.Error()))
+	recordAuthFailure(ErrTokenRequired)
+	if after := counterValue(t, authFailureCounter.WithLabelValues(ErrTokenRequired.Error())); after != before {
+		t.Errorf("expected the authentication challenge not to be counted, got %v after %v", after, before)
+	}
+}
+
+func counterValue(t *testing.T, c prometheus.Counter) float64 {
+	m := &dto.Metric{}
+	if err := c.Write(m); err != nil {
+		t.Fatal(err)
+	}
+	return m.GetCounter().GetValue()
+}
@@ -23,6 +23,34 @@ func NewUserOpenShiftClient(bearerToken string) (*osclient.Client, error) {
 }
 
 func NewRegistryOpenShiftClient() (*osclient.Client, error) {
+	config, err := registryClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	client, err := osclient.New(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Origin client: %s", err)
+	}
+	return client, nil
+}
+
+// NewRegistryKubernetesClient returns a Kubernetes client which authenticates
+// as the registry.
+func NewRegistryKubernetesClient() (*kclient.Client, error) {
+	config, err := registryClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	client, err := kclient.New(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Kubernetes client: %s", err)
+	}
+	return client, nil
+}
+
+// registryClientConfig returns the configuration of the clients which
+// authenticate with the certificate of the registry.
+func registryClientConfig() (*kclient.Config, error) {
 	config, err := openShiftClientConfig()
 	if err != nil {
 		return nil, err
@@ -39,11 +67,7 @@ func NewRegistryOpenShiftClient() (*osclient.Client, error) {
 		config.TLSClientConfig.CertData = []byte(certData)
 		config.TLSClientConfig.KeyData = []byte(certKeyData)
 	}
-	client, err := osclient.New(config)
-	if err != nil {
-		return nil, fmt.Errorf("error creating Origin client: %s", err)
-	}
-	return client, nil
+	return config, nil
 }
 
 func openShiftClientConfig() (*kclient.Config, error) {
@@ -68,5 +92,6 @@ func openShiftClientConfig() (*kclient.Config, error) {
 		Host:            openshiftAddr,
 		TLSClientConfig: tlsClientConfig,
 		Insecure:        insecure,
+		WrapTransport:   newAPIMetricsRoundTripper,
 	}, nil
 }
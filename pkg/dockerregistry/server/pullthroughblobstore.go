@@ -0,0 +1,291 @@
+package server
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/digest"
+	"github.com/docker/distribution/registry/client"
+	"github.com/docker/distribution/registry/client/auth"
+	"github.com/docker/distribution/registry/client/transport"
+	"k8s.io/kubernetes/pkg/credentialprovider"
+	kutil "k8s.io/kubernetes/pkg/util"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// dockerHubV2Host is the host serving the v2 API of the Docker Hub registry.
+const dockerHubV2Host = "registry-1.docker.io"
+
+// remoteTimeout bounds the time to connect to a remote registry and to wait for
+// the headers of its responses. Blob bodies may take longer to stream.
+const remoteTimeout = 30 * time.Second
+
+var (
+	// secureTransport and insecureTransport are shared by all the remote
+	// repositories, so that their connections are reused across requests.
+	secureTransport   = remoteTransport(&tls.Config{})
+	insecureTransport = remoteTransport(&tls.Config{InsecureSkipVerify: true})
+)
+
+// remoteTransport returns a transport for remote registries which uses
+// tlsConfig and times out after remoteTimeout.
+func remoteTransport(tlsConfig *tls.Config) *http.Transport {
+	return kutil.SetTransportDefaults(&http.Transport{
+		Dial:                  (&net.Dialer{Timeout: remoteTimeout, KeepAlive: 30 * time.Second}).Dial,
+		TLSClientConfig:       tlsConfig,
+		ResponseHeaderTimeout: remoteTimeout,
+	})
+}
+
+// pullthroughBlobStore wraps the local blob store of a repository. Blobs which
+// are not stored locally are retrieved from the remote repositories that the
+// images of the image stream were imported from, and are stored locally while
+// they are served.
+type pullthroughBlobStore struct {
+	distribution.BlobStore
+
+	repo *repository
+	// remotes holds the remote blob store found for each digest. A blob store
+	// is only used while serving a single request, so this is not locked.
+	remotes map[digest.Digest]distribution.BlobStore
+}
+
+var _ distribution.BlobStore = &pullthroughBlobStore{}
+
+// newPullthroughBlobStore returns a blob store which pulls blobs missing from
+// local into repo through the remote repositories of its image stream.
+func newPullthroughBlobStore(local distribution.BlobStore, repo *repository) *pullthroughBlobStore {
+	return &pullthroughBlobStore{
+		BlobStore: local,
+		repo:      repo,
+		remotes:   make(map[digest.Digest]distribution.BlobStore),
+	}
+}
+
+// Stat returns the descriptor of the blob with digest dgst, looking for it in
+// the remote repositories of the image stream if it is not stored locally.
+func (bs *pullthroughBlobStore) Stat(ctx context.Context, dgst digest.Digest) (distribution.Descriptor, error) {
+	desc, err := bs.BlobStore.Stat(ctx, dgst)
+	if err != distribution.ErrBlobUnknown {
+		return desc, err
+	}
+	_, desc, err = bs.findRemoteBlob(ctx, dgst)
+	return desc, err
+}
+
+// ServeBlob serves the blob with digest dgst from local storage if it is
+// present. Otherwise the blob is streamed from a remote repository to the
+// client and written to local storage at the same time.
+func (bs *pullthroughBlobStore) ServeBlob(ctx context.Context, w http.ResponseWriter, req *http.Request, dgst digest.Digest) error {
+	err := bs.BlobStore.ServeBlob(ctx, w, req, dgst)
+	if err != distribution.ErrBlobUnknown {
+		return err
+	}
+	remote, desc, err := bs.findRemoteBlob(ctx, dgst)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Length", strconv.FormatInt(desc.Size, 10))
+	if len(desc.MediaType) > 0 {
+		w.Header().Set("Content-Type", desc.MediaType)
+	}
+	w.Header().Set("Docker-Content-Digest", dgst.String())
+	w.Header().Set("Etag", dgst.String())
+	if req.Method == "HEAD" {
+		return nil
+	}
+
+	reader, err := remote.Open(ctx, dgst)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	writer, err := bs.BlobStore.Create(ctx)
+	if err != nil {
+		context.GetLogger(ctx).Errorf("Error creating upload to store blob %s locally: %v", dgst, err)
+		_, err = io.CopyN(w, reader, desc.Size)
+		return err
+	}
+	if _, err := io.CopyN(io.MultiWriter(w, writer), reader, desc.Size); err != nil {
+		writer.Cancel(ctx)
+		return err
+	}
+	if _, err := writer.Commit(ctx, desc); err != nil {
+		context.GetLogger(ctx).Errorf("Error storing blob %s locally: %v", dgst, err)
+	}
+	return nil
+}
+
+// findRemoteBlob returns the blob store of the first remote repository of the
+// image stream which contains the blob with digest dgst, and the descriptor of
+// the blob.
+func (bs *pullthroughBlobStore) findRemoteBlob(ctx context.Context, dgst digest.Digest) (distribution.BlobStore, distribution.Descriptor, error) {
+	if remote, ok := bs.remotes[dgst]; ok {
+		desc, err := remote.Stat(ctx, dgst)
+		return remote, desc, err
+	}
+
+	stream, err := bs.repo.getImageStream()
+	if err != nil {
+		context.GetLogger(ctx).Errorf("Error retrieving image stream %s/%s: %v", bs.repo.namespace, bs.repo.name, err)
+		return nil, distribution.Descriptor{}, distribution.ErrBlobUnknown
+	}
+	upstreams := bs.repo.upstreamRepositories(stream)
+	if len(upstreams) == 0 {
+		return nil, distribution.Descriptor{}, distribution.ErrBlobUnknown
+	}
+	keyring := bs.repo.pullKeyring()
+
+	for _, upstream := range upstreams {
+		repo, err := remoteRepository(ctx, upstream, keyring)
+		if err != nil {
+			context.GetLogger(ctx).Errorf("Error connecting to remote repository %s: %v", upstream.ref.Exact(), err)
+			continue
+		}
+		remote := repo.Blobs(ctx)
+		desc, err := remote.Stat(ctx, dgst)
+		if err != nil {
+			if err != distribution.ErrBlobUnknown {
+				context.GetLogger(ctx).Errorf("Error looking up blob %s in remote repository %s: %v", dgst, upstream.ref.Exact(), err)
+			}
+			continue
+		}
+		context.GetLogger(ctx).Debugf("Pulling blob %s through from remote repository %s", dgst, upstream.ref.Exact())
+		bs.remotes[dgst] = remote
+		return remote, desc, nil
+	}
+	return nil, distribution.Descriptor{}, distribution.ErrBlobUnknown
+}
+
+// upstreamRepository is a repository in another registry that images of an
+// image stream were imported from.
+type upstreamRepository struct {
+	ref      imageapi.DockerImageReference
+	insecure bool
+}
+
+// upstreamRepositories returns the remote repositories that the images tagged
+// in stream were imported from. Images pushed to this registry are skipped.
+func (r *repository) upstreamRepositories(stream *imageapi.ImageStream) []upstreamRepository {
+	tags := make([]string, 0, len(stream.Status.Tags))
+	for tag := range stream.Status.Tags {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	streamInsecure := stream.Annotations[imageapi.InsecureRepositoryAnnotation] == "true"
+	seen := make(map[string]int)
+	upstreams := []upstreamRepository{}
+	for _, tag := range tags {
+		insecure := streamInsecure || stream.Spec.Tags[tag].ImportPolicy.Insecure
+		for _, event := range stream.Status.Tags[tag].Items {
+			ref, err := imageapi.ParseDockerImageReference(event.DockerImageReference)
+			if err != nil || ref.Registry == r.registryAddr {
+				continue
+			}
+			ref = ref.DockerClientDefaults().AsRepository()
+			key := ref.Exact()
+			if i, ok := seen[key]; ok {
+				upstreams[i].insecure = upstreams[i].insecure || insecure
+				continue
+			}
+			seen[key] = len(upstreams)
+			upstreams = append(upstreams, upstreamRepository{ref: ref, insecure: insecure})
+		}
+	}
+	return upstreams
+}
+
+// pullKeyring returns the credentials of the pull secrets of the image stream.
+// An empty keyring is returned if the secrets cannot be retrieved.
+func (r *repository) pullKeyring() credentialprovider.DockerKeyring {
+	empty := &credentialprovider.BasicDockerKeyring{}
+	secrets, err := r.registryClient.ImageStreams(r.namespace).Secrets(r.name)
+	if err != nil {
+		context.GetLogger(r.ctx).Errorf("Error retrieving pull secrets for %s/%s: %v", r.namespace, r.name, err)
+		return empty
+	}
+	keyring, err := credentialprovider.MakeDockerKeyring(secrets.Items, empty)
+	if err != nil {
+		context.GetLogger(r.ctx).Errorf("Error reading pull secrets for %s/%s: %v", r.namespace, r.name, err)
+		return empty
+	}
+	return keyring
+}
+
+// basicCredentials is an auth.CredentialStore which returns the same
+// credentials for every authentication server.
+type basicCredentials struct {
+	username string
+	password string
+}
+
+func (c basicCredentials) Basic(*url.URL) (string, string) {
+	return c.username, c.password
+}
+
+// credentialsFor returns the credentials in keyring for the registry of ref.
+func credentialsFor(keyring credentialprovider.DockerKeyring, ref imageapi.DockerImageReference) auth.CredentialStore {
+	configs, found := keyring.Lookup(ref.String())
+	if !found || len(configs) == 0 {
+		return basicCredentials{}
+	}
+	return basicCredentials{username: configs[0].Username, password: configs[0].Password}
+}
+
+// remoteRepository returns a client of the remote repository upstream, which
+// authenticates with the credentials in keyring. Insecure repositories are
+// contacted without verifying certificates, falling back to plain HTTP.
+func remoteRepository(ctx context.Context, upstream upstreamRepository, keyring credentialprovider.DockerKeyring) (distribution.Repository, error) {
+	ref := upstream.ref
+	host := ref.Registry
+	if host == imageapi.DockerDefaultRegistry {
+		host = dockerHubV2Host
+	}
+	name := ref.Namespace + "/" + ref.Name
+
+	base := http.RoundTripper(secureTransport)
+	schemes := []string{"https"}
+	if upstream.insecure {
+		base = insecureTransport
+		schemes = append(schemes, "http")
+	}
+	ping := &http.Client{Transport: base, Timeout: remoteTimeout}
+
+	// the challenges of the registry determine how requests are authorized
+	manager := auth.NewSimpleChallengeManager()
+	var baseURL string
+	var lastErr error
+	for _, scheme := range schemes {
+		endpoint := scheme + "://" + host
+		resp, err := ping.Get(endpoint + "/v2/")
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp.Body.Close()
+		if err := manager.AddResponse(resp); err != nil {
+			return nil, err
+		}
+		baseURL = endpoint
+		break
+	}
+	if len(baseURL) == 0 {
+		return nil, lastErr
+	}
+
+	creds := credentialsFor(keyring, ref)
+	rt := transport.NewTransport(base, auth.NewAuthorizer(manager, auth.NewTokenHandler(base, creds, name, "pull"), auth.NewBasicHandler(creds)))
+	return client.NewRepository(ctx, name, baseURL, rt)
+}
@@ -0,0 +1,191 @@
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/digest"
+	"github.com/docker/distribution/registry/storage"
+	"github.com/docker/distribution/registry/storage/driver/inmemory"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func TestPullthroughServeBlob(t *testing.T) {
+	ctx := context.Background()
+	content := []byte("layer content")
+	dgst, err := digest.FromBytes(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requests := 0
+	remote := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		switch req.URL.Path {
+		case "/v2/":
+			w.WriteHeader(http.StatusOK)
+		case "/v2/upstream/ruby/blobs/" + dgst.String():
+			w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+			w.Header().Set("Content-Type", "application/octet-stream")
+			if req.Method == "GET" {
+				w.Write(content)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer remote.Close()
+	remoteURL, err := url.Parse(remote.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stream := &imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        "ruby",
+			Namespace:   "user",
+			Annotations: map[string]string{imageapi.InsecureRepositoryAnnotation: "true"},
+		},
+		Status: imageapi.ImageStreamStatus{
+			Tags: map[string]imageapi.TagEventList{
+				"latest": {Items: []imageapi.TagEvent{{DockerImageReference: remoteURL.Host + "/upstream/ruby@" + dgst.String()}}},
+			},
+		},
+	}
+	repo := newTestRepository(t, ctx, stream)
+
+	bs := repo.Blobs(ctx)
+	desc, err := bs.Stat(ctx, dgst)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if desc.Size != int64(len(content)) {
+		t.Errorf("unexpected descriptor: %#v", desc)
+	}
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/v2/user/ruby/blobs/"+dgst.String(), nil)
+	if err := bs.ServeBlob(ctx, w, req, dgst); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(w.Body.Bytes(), content) {
+		t.Errorf("unexpected blob content: %q", w.Body.String())
+	}
+	if e, a := dgst.String(), w.Header().Get("Docker-Content-Digest"); e != a {
+		t.Errorf("expected digest header %s, got %s", e, a)
+	}
+
+	// the blob was stored locally, so it is served without contacting the remote registry
+	requests = 0
+	w = httptest.NewRecorder()
+	if err := repo.Blobs(ctx).ServeBlob(ctx, w, req, dgst); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(w.Body.Bytes(), content) {
+		t.Errorf("unexpected blob content: %q", w.Body.String())
+	}
+	if requests != 0 {
+		t.Errorf("expected the blob to be served locally, got %d remote requests", requests)
+	}
+
+	missing, _ := digest.FromBytes([]byte("missing"))
+	if _, err := repo.Blobs(ctx).Stat(ctx, missing); err != distribution.ErrBlobUnknown {
+		t.Errorf("expected an unknown blob, got %v", err)
+	}
+}
+
+func TestPullthroughDisabled(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, ctx, &imageapi.ImageStream{})
+	repo.pullthrough = false
+
+	missing, _ := digest.FromBytes([]byte("missing"))
+	if _, err := repo.Blobs(ctx).Stat(ctx, missing); err != distribution.ErrBlobUnknown {
+		t.Errorf("expected an unknown blob, got %v", err)
+	}
+	if actions := repo.registryClient.(*testclient.Fake).Actions(); len(actions) != 0 {
+		t.Errorf("unexpected actions: %#v", actions)
+	}
+}
+
+func TestUpstreamRepositories(t *testing.T) {
+	repo := &repository{registryAddr: "172.30.0.1:5000"}
+	stream := &imageapi.ImageStream{
+		Spec: imageapi.ImageStreamSpec{
+			Tags: map[string]imageapi.TagReference{
+				"insecure": {ImportPolicy: imageapi.TagImportPolicy{Insecure: true}},
+			},
+		},
+		Status: imageapi.ImageStreamStatus{
+			Tags: map[string]imageapi.TagEventList{
+				"latest": {Items: []imageapi.TagEvent{
+					{DockerImageReference: "openshift/ruby@sha256:8b7f0d8cbbc24bc4b44dd9d9b5a5a7e15eb1c74ac3ae2b3d4d53ec9bb0fa3f50"},
+					{DockerImageReference: "172.30.0.1:5000/user/ruby@sha256:8b7f0d8cbbc24bc4b44dd9d9b5a5a7e15eb1c74ac3ae2b3d4d53ec9bb0fa3f50"},
+				}},
+				"insecure": {Items: []imageapi.TagEvent{
+					{DockerImageReference: "myregistry:5000/test/ruby:v1"},
+				}},
+				"old": {Items: []imageapi.TagEvent{
+					{DockerImageReference: "docker.io/openshift/ruby:v2"},
+				}},
+			},
+		},
+	}
+
+	expected := []upstreamRepository{
+		{ref: imageapi.DockerImageReference{Registry: "myregistry:5000", Namespace: "test", Name: "ruby"}, insecure: true},
+		{ref: imageapi.DockerImageReference{Registry: "docker.io", Namespace: "openshift", Name: "ruby"}},
+	}
+	if upstreams := repo.upstreamRepositories(stream); !reflect.DeepEqual(expected, upstreams) {
+		t.Errorf("unexpected upstream repositories: %#v", upstreams)
+	}
+}
+
+// newTestRepository returns a repository with in-memory storage whose image
+// stream is stream. The project of the repository has no quotas.
+func newTestRepository(t *testing.T, ctx context.Context, stream *imageapi.ImageStream) *repository {
+	registry, err := storage.NewRegistry(ctx, inmemory.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	local, err := registry.Repository(ctx, "user/ruby")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fake := &testclient.Fake{}
+	fake.AddReactor("get", "imagestreams", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		if action.GetSubresource() == "secrets" {
+			return true, &kapi.SecretList{}, nil
+		}
+		return true, stream, nil
+	})
+	kFake := &ktestclient.Fake{}
+	kFake.AddReactor("list", "resourcequotas", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &kapi.ResourceQuotaList{}, nil
+	})
+
+	return &repository{
+		Repository:     local,
+		ctx:            ctx,
+		registryClient: fake,
+		quotaClient:    kFake,
+		registryAddr:   "172.30.0.1:5000",
+		namespace:      "user",
+		name:           "ruby",
+		pullthrough:    true,
+	}
+}
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/registry/api/errcode"
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+	imagequota "github.com/openshift/origin/pkg/image/quota"
+)
+
+// imageLayerCacheSize is the number of images whose layers are remembered by
+// the registry to compute the image storage of projects.
+const imageLayerCacheSize = 10000
+
+// imageLayers remembers the layers of images across pushes, so that each push
+// only retrieves the images of the project it has not seen yet.
+var imageLayers = imagequota.NewLayerCache(imageLayerCacheSize)
+
+// projectStorageLocks serializes the storage quota checks of each project,
+// see lockProjectStorage.
+var (
+	projectStorageLocks     = map[string]*sync.Mutex{}
+	projectStorageLocksLock sync.Mutex
+)
+
+// lockProjectStorage locks the storage of the images of namespace and returns
+// the function which unlocks it. Pushes hold the lock from the quota check
+// until the image is stored, so that concurrent pushes to the project don't
+// both fit in the quota. Only the pushes handled by the same registry process
+// are serialized; pushes to several replicas of the registry may still exceed
+// the quota together.
+func lockProjectStorage(namespace string) func() {
+	projectStorageLocksLock.Lock()
+	lock, ok := projectStorageLocks[namespace]
+	if !ok {
+		lock = &sync.Mutex{}
+		projectStorageLocks[namespace] = lock
+	}
+	projectStorageLocksLock.Unlock()
+
+	lock.Lock()
+	return lock.Unlock
+}
+
+// checkStorageQuota returns an error if pushing image to the repository would
+// increase the storage used by the images of the project beyond the smallest
+// quota on it. Layers already stored for other images of the project are not
+// counted again. Pushes are denied whenever the storage can't be checked,
+// including when the quotas can't be retrieved, so that failures to reach the
+// API don't let projects exceed their quota. Pushes beyond the quota are denied
+// with errcode.ErrorCodeDenied, wrapped in distribution.ErrManifestVerification
+// since that is the only error the manifest handler reports with its own code.
+func (r *repository) checkStorageQuota(image *imageapi.Image) error {
+	quotas, err := r.quotaClient.ResourceQuotas(r.namespace).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return fmt.Errorf("unable to retrieve the quotas of project %s: %v", r.namespace, err)
+	}
+
+	var limit *resource.Quantity
+	quotaName := ""
+	for i := range quotas.Items {
+		hard, ok := quotas.Items[i].Spec.Hard[imageapi.ResourceImageStreamsStorage]
+		if ok && (limit == nil || hard.Cmp(*limit) < 0) {
+			limit = hard.Copy()
+			quotaName = quotas.Items[i].Name
+		}
+	}
+	if limit == nil {
+		return nil
+	}
+
+	streams, err := r.registryClient.ImageStreams(r.namespace).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return fmt.Errorf("unable to compute the image storage of project %s: %v", r.namespace, err)
+	}
+	layers, err := imagequota.NewAccountant(r.registryClient.Images(), imageLayers).ProjectLayers(streams.Items)
+	if err != nil {
+		return fmt.Errorf("unable to compute the image storage of project %s: %v", r.namespace, err)
+	}
+	used := layers.Size()
+	layers.AddImage(image)
+	if requested := layers.Size(); requested > used && requested > limit.Value() {
+		return distribution.ErrManifestVerification{errcode.Error{
+			Code:    errcode.ErrorCodeDenied,
+			Message: fmt.Sprintf("pushing image %s would increase the image storage of project %s to %d bytes, exceeding the limit of %s set by quota %q", image.Name, r.namespace, requested, limit.String(), quotaName),
+		}}
+	}
+	return nil
+}
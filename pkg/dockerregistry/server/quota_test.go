@@ -0,0 +1,150 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/registry/api/errcode"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func TestCheckStorageQuota(t *testing.T) {
+	stored := &imageapi.Image{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        "sha256:stored",
+			Annotations: map[string]string{imageapi.ManagedByOpenShiftAnnotation: "true"},
+		},
+		DockerImageLayers: []imageapi.ImageLayer{{Name: "sha256:base", Size: 600}},
+	}
+	pushed := func(layers ...imageapi.ImageLayer) *imageapi.Image {
+		return &imageapi.Image{ObjectMeta: kapi.ObjectMeta{Name: "sha256:pushed"}, DockerImageLayers: layers}
+	}
+
+	tests := []struct {
+		name   string
+		quotas []string
+		// quotaErr is returned when the quotas are listed
+		quotaErr error
+		image    *imageapi.Image
+		err      string
+		denied   bool
+	}{
+		{
+			name:  "no quota",
+			image: pushed(imageapi.ImageLayer{Name: "sha256:big", Size: 5000}),
+		},
+		{
+			name:   "within quota",
+			quotas: []string{"1000"},
+			image:  pushed(imageapi.ImageLayer{Name: "sha256:base", Size: 600}, imageapi.ImageLayer{Name: "sha256:top", Size: 300}),
+		},
+		{
+			name:   "exceeds quota",
+			quotas: []string{"1000"},
+			image:  pushed(imageapi.ImageLayer{Name: "sha256:base", Size: 600}, imageapi.ImageLayer{Name: "sha256:top", Size: 500}),
+			err:    "1100 bytes",
+			denied: true,
+		},
+		{
+			name:   "exceeds the smallest quota",
+			quotas: []string{"1000", "800"},
+			image:  pushed(imageapi.ImageLayer{Name: "sha256:base", Size: 600}, imageapi.ImageLayer{Name: "sha256:top", Size: 300}),
+			err:    `quota "quota-1"`,
+			denied: true,
+		},
+		{
+			name:     "quotas unavailable",
+			quotaErr: fmt.Errorf("unavailable"),
+			image:    pushed(imageapi.ImageLayer{Name: "sha256:top", Size: 1}),
+			err:      "unable to retrieve the quotas",
+		},
+		{
+			name:   "already stored layers over quota",
+			quotas: []string{"500"},
+			image:  pushed(imageapi.ImageLayer{Name: "sha256:base", Size: 600}),
+		},
+	}
+
+	for _, test := range tests {
+		ctx := context.Background()
+		repo := newTestRepository(t, ctx, &imageapi.ImageStream{})
+
+		quotas := &kapi.ResourceQuotaList{}
+		for i, hard := range test.quotas {
+			quotas.Items = append(quotas.Items, kapi.ResourceQuota{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "user", Name: fmt.Sprintf("quota-%d", i)},
+				Spec:       kapi.ResourceQuotaSpec{Hard: kapi.ResourceList{imageapi.ResourceImageStreamsStorage: resource.MustParse(hard)}},
+			})
+		}
+		kFake := &ktestclient.Fake{}
+		kFake.AddReactor("list", "resourcequotas", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			return true, quotas, test.quotaErr
+		})
+		repo.quotaClient = kFake
+
+		fake := repo.registryClient.(*testclient.Fake)
+		fake.AddReactor("list", "imagestreams", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			return true, &imageapi.ImageStreamList{Items: []imageapi.ImageStream{{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "user", Name: "ruby"},
+				Status: imageapi.ImageStreamStatus{Tags: map[string]imageapi.TagEventList{
+					"latest": {Items: []imageapi.TagEvent{{Image: stored.Name}}},
+				}},
+			}}}, nil
+		})
+		fake.AddReactor("get", "images", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+			return true, stored, nil
+		})
+
+		err := repo.checkStorageQuota(test.image)
+		switch {
+		case len(test.err) == 0 && err != nil:
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		case len(test.err) > 0 && (err == nil || !strings.Contains(err.Error(), test.err)):
+			t.Errorf("%s: expected an error containing %q, got %v", test.name, test.err, err)
+		case test.denied:
+			// the push is denied rather than failing with an internal error
+			if errs, ok := err.(distribution.ErrManifestVerification); !ok || len(errs) != 1 {
+				t.Errorf("%s: expected a manifest verification error, got %#v", test.name, err)
+			} else if e, ok := errs[0].(errcode.Error); !ok || e.Code != errcode.ErrorCodeDenied {
+				t.Errorf("%s: expected a denied error, got %#v", test.name, errs[0])
+			}
+		}
+	}
+}
+
+func TestLockProjectStorage(t *testing.T) {
+	unlock := lockProjectStorage("user")
+
+	locked := make(chan struct{})
+	go func() {
+		unlockOther := lockProjectStorage("other")
+		unlockOther()
+		unlockUser := lockProjectStorage("user")
+		close(locked)
+		unlockUser()
+	}()
+
+	select {
+	case <-locked:
+		t.Fatalf("expected the storage of the project to stay locked")
+	case <-time.After(50 * time.Millisecond):
+	}
+	unlock()
+	select {
+	case <-locked:
+	case <-time.After(util.ForeverTestTimeout):
+		t.Fatalf("expected the storage of the project to be unlocked")
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/docker/distribution"
 	"github.com/docker/distribution/context"
@@ -16,6 +18,7 @@ import (
 	"github.com/docker/libtrust"
 	kapi "k8s.io/kubernetes/pkg/api"
 	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
 
 	"github.com/openshift/origin/pkg/client"
 	imageapi "github.com/openshift/origin/pkg/image/api"
@@ -30,9 +33,13 @@ type repository struct {
 
 	ctx            context.Context
 	registryClient client.Interface
+	quotaClient    kclient.ResourceQuotasNamespacer
 	registryAddr   string
 	namespace      string
 	name           string
+	// pullthrough is true if blobs and manifests of images imported from
+	// other registries are retrieved from those registries.
+	pullthrough bool
 }
 
 var _ distribution.ManifestService = &repository{}
@@ -48,6 +55,15 @@ func newRepository(ctx context.Context, repo distribution.Repository, options ma
 	if err != nil {
 		return nil, err
 	}
+	quotaClient, err := NewRegistryKubernetesClient()
+	if err != nil {
+		return nil, err
+	}
+
+	pullthrough, err := getBoolOption("pullthrough", false, options)
+	if err != nil {
+		return nil, err
+	}
 
 	nameParts := strings.SplitN(repo.Name(), "/", 2)
 	if len(nameParts) != 2 {
@@ -59,12 +75,48 @@ func newRepository(ctx context.Context, repo distribution.Repository, options ma
 
 		ctx:            ctx,
 		registryClient: registryClient,
+		quotaClient:    quotaClient,
 		registryAddr:   registryAddr,
 		namespace:      nameParts[0],
 		name:           nameParts[1],
+		pullthrough:    pullthrough,
 	}, nil
 }
 
+// getBoolOption returns the boolean value of the middleware option name, or
+// defval if the option is not set.
+func getBoolOption(name string, defval bool, options map[string]interface{}) (bool, error) {
+	value, ok := options[name]
+	if !ok {
+		return defval, nil
+	}
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, fmt.Errorf("invalid value %q for option %q: %v", v, name, err)
+		}
+		return b, nil
+	default:
+		return false, fmt.Errorf("invalid value %v for option %q: a boolean is required", value, name)
+	}
+}
+
+// Blobs returns the blob store of the repository. If pull-through is enabled,
+// blobs which are not stored locally are retrieved from the registries that
+// the images of the image stream were imported from.
+func (r *repository) Blobs(ctx context.Context) distribution.BlobStore {
+	bs := r.Repository.Blobs(ctx)
+	if r.pullthrough {
+		repo := repository(*r)
+		repo.ctx = ctx
+		bs = newPullthroughBlobStore(bs, &repo)
+	}
+	return &metricsBlobStore{BlobStore: bs, repository: r.Name()}
+}
+
 // Manifests returns r, which implements distribution.ManifestService.
 func (r *repository) Manifests(ctx context.Context, options ...distribution.ManifestServiceOption) (distribution.ManifestService, error) {
 	if r.ctx == ctx {
@@ -110,6 +162,8 @@ func (r *repository) ExistsByTag(tag string) (bool, error) {
 
 // Get retrieves the manifest with digest `dgst`.
 func (r *repository) Get(dgst digest.Digest) (*schema1.SignedManifest, error) {
+	defer observeManifest(r.Name(), operationPull, time.Now())
+
 	if _, err := r.getImageStreamImage(dgst); err != nil {
 		context.GetLogger(r.ctx).Errorf("Error retrieving ImageStreamImage %s/%s@%s: %v", r.namespace, r.name, dgst.String(), err)
 		return nil, err
@@ -131,6 +185,8 @@ func (r *repository) Enumerate() ([]digest.Digest, error) {
 
 // GetByTag retrieves the named manifest with the provided tag
 func (r *repository) GetByTag(tag string, options ...distribution.ManifestServiceOption) (*schema1.SignedManifest, error) {
+	defer observeManifest(r.Name(), operationPull, time.Now())
+
 	for _, opt := range options {
 		if err := opt(r); err != nil {
 			return nil, err
@@ -160,6 +216,8 @@ func (r *repository) GetByTag(tag string, options ...distribution.ManifestServic
 
 // Put creates or updates the named manifest.
 func (r *repository) Put(manifest *schema1.SignedManifest) error {
+	defer observeManifest(r.Name(), operationPush, time.Now())
+
 	// Resolve the payload in the manifest.
 	payload, err := manifest.Payload()
 	if err != nil {
@@ -188,9 +246,17 @@ func (r *repository) Put(manifest *schema1.SignedManifest) error {
 			},
 			DockerImageReference: fmt.Sprintf("%s/%s/%s@%s", r.registryAddr, r.namespace, r.name, dgst.String()),
 			DockerImageManifest:  string(payload),
+			DockerImageLayers:    r.imageLayers(manifest),
 		},
 	}
 
+	unlock := lockProjectStorage(r.namespace)
+	defer unlock()
+	if err := r.checkStorageQuota(&ism.Image); err != nil {
+		context.GetLogger(r.ctx).Errorf("Rejecting image %s: %v", dgst.String(), err)
+		return err
+	}
+
 	if err := r.registryClient.ImageStreamMappings(r.namespace).Create(&ism); err != nil {
 		// if the error was that the image stream wasn't found, try to auto provision it
 		statusErr, ok := err.(*kerrors.StatusError)
@@ -278,6 +344,24 @@ func (r *repository) getImageStreamImage(dgst digest.Digest) (*imageapi.ImageStr
 	return r.registryClient.ImageStreamImages(r.namespace).Get(r.name, dgst.String())
 }
 
+// imageLayers returns the layers of manifest from the base layer to the top
+// layer, with the sizes of the blobs stored for them. Schema 1 manifests do not
+// record the sizes of their layers. Nil is returned if a blob is not found.
+func (r *repository) imageLayers(manifest *schema1.SignedManifest) []imageapi.ImageLayer {
+	blobs := r.Repository.Blobs(r.ctx)
+	layers := make([]imageapi.ImageLayer, 0, len(manifest.FSLayers))
+	for i := len(manifest.FSLayers) - 1; i >= 0; i-- {
+		dgst := manifest.FSLayers[i].BlobSum
+		desc, err := blobs.Stat(r.ctx, dgst)
+		if err != nil {
+			context.GetLogger(r.ctx).Errorf("Error retrieving the size of layer %s: %v", dgst.String(), err)
+			return nil
+		}
+		layers = append(layers, imageapi.ImageLayer{Name: dgst.String(), Size: desc.Size})
+	}
+	return layers
+}
+
 // manifestFromImage converts an Image to a SignedManifest.
 func (r *repository) manifestFromImage(image *imageapi.Image) (*schema1.SignedManifest, error) {
 	dgst, err := digest.ParseDigest(image.Name)
@@ -285,6 +369,11 @@ func (r *repository) manifestFromImage(image *imageapi.Image) (*schema1.SignedMa
 		return nil, err
 	}
 
+	// Images imported from other registries have no manifest stored locally
+	if len(image.DockerImageManifest) == 0 && r.pullthrough {
+		return r.pullthroughManifest(image, dgst)
+	}
+
 	// Fetch the signatures for the manifest
 	signatures, err := r.Signatures().Get(dgst)
 	if err != nil {
@@ -308,3 +397,31 @@ func (r *repository) manifestFromImage(image *imageapi.Image) (*schema1.SignedMa
 	}
 	return &sm, err
 }
+
+// pullthroughManifest retrieves the manifest with digest dgst of an image which
+// was imported from another registry from the repository it was imported from.
+func (r *repository) pullthroughManifest(image *imageapi.Image, dgst digest.Digest) (*schema1.SignedManifest, error) {
+	ref, err := imageapi.ParseDockerImageReference(image.DockerImageReference)
+	if err != nil {
+		return nil, err
+	}
+	upstream := upstreamRepository{ref: ref.DockerClientDefaults().AsRepository()}
+	if stream, err := r.getImageStream(); err == nil {
+		for _, candidate := range r.upstreamRepositories(stream) {
+			if candidate.ref.Equal(upstream.ref) {
+				upstream.insecure = candidate.insecure
+			}
+		}
+	}
+
+	repo, err := remoteRepository(r.ctx, upstream, r.pullKeyring())
+	if err != nil {
+		context.GetLogger(r.ctx).Errorf("Error connecting to remote repository %s: %v", upstream.ref.Exact(), err)
+		return nil, err
+	}
+	ms, err := repo.Manifests(r.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ms.Get(dgst)
+}
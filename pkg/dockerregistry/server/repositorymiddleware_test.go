@@ -0,0 +1,66 @@
+package server
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/docker/distribution/context"
+	"github.com/docker/distribution/manifest/schema1"
+	"github.com/docker/libtrust"
+
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func TestPutRecordsLayerSizes(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, ctx, &imageapi.ImageStream{})
+
+	base, err := repo.Repository.Blobs(ctx).Put(ctx, "application/octet-stream", []byte("base layer"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	top, err := repo.Repository.Blobs(ctx).Put(ctx, "application/octet-stream", []byte("top"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := libtrust.GenerateECP256PrivateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sm, err := schema1.Sign(&schema1.Manifest{
+		Versioned: schema1.SchemaVersion,
+		Name:      "user/ruby",
+		Tag:       "latest",
+		FSLayers:  []schema1.FSLayer{{BlobSum: top.Digest}, {BlobSum: base.Digest}},
+		History:   []schema1.History{{V1Compatibility: `{"id":"2"}`}, {V1Compatibility: `{"id":"1"}`}},
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var mapping *imageapi.ImageStreamMapping
+	fake := repo.registryClient.(*testclient.Fake)
+	fake.AddReactor("create", "imagestreammappings", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		mapping = action.(ktestclient.CreateAction).GetObject().(*imageapi.ImageStreamMapping)
+		return true, mapping, nil
+	})
+
+	if err := repo.Put(sm); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mapping == nil {
+		t.Fatalf("expected an image stream mapping to be created")
+	}
+	expected := []imageapi.ImageLayer{
+		{Name: base.Digest.String(), Size: int64(len("base layer"))},
+		{Name: top.Digest.String(), Size: int64(len("top"))},
+	}
+	if !reflect.DeepEqual(expected, mapping.Image.DockerImageLayers) {
+		t.Errorf("unexpected layers: %#v", mapping.Image.DockerImageLayers)
+	}
+}
@@ -171,10 +171,18 @@ func (c *AppConfig) SetClientMapper(clientMapper resource.ClientMapper) {
 }
 
 func (c *AppConfig) dockerRegistrySearcher() app.Searcher {
-	return app.DockerRegistrySearcher{
+	searcher := app.DockerRegistrySearcher{
 		Client:        dockerregistry.NewClient(30 * time.Second),
 		AllowInsecure: c.InsecureRegistry,
 	}
+	if c.osclient == nil {
+		return searcher
+	}
+	return app.ImageImportSearcher{
+		Client:        c.osclient.ImageStreamImports(c.originNamespace),
+		AllowInsecure: c.InsecureRegistry,
+		Fallback:      searcher,
+	}
 }
 
 func (c *AppConfig) ensureDockerSearcher() {
@@ -197,6 +205,10 @@ func (c *AppConfig) SetDockerClient(dockerclient *docker.Client) {
 func (c *AppConfig) SetOpenShiftClient(osclient client.Interface, originNamespace string) {
 	c.osclient = osclient
 	c.originNamespace = originNamespace
+	if searcher, ok := c.dockerSearcher.(app.DockerClientSearcher); ok {
+		searcher.RegistrySearcher = c.dockerRegistrySearcher()
+		c.dockerSearcher = searcher
+	}
 	namespaces := []string{originNamespace}
 	if openshiftNamespace := "openshift"; originNamespace != openshiftNamespace {
 		namespaces = append(namespaces, openshiftNamespace)
@@ -9,8 +9,11 @@ import (
 
 	"github.com/golang/glog"
 	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
 	utilerrors "k8s.io/kubernetes/pkg/util/errors"
 
+	"github.com/openshift/origin/pkg/client"
 	"github.com/openshift/origin/pkg/dockerregistry"
 	imageapi "github.com/openshift/origin/pkg/image/api"
 )
@@ -221,6 +224,78 @@ func (r DockerRegistrySearcher) Search(terms ...string) (ComponentMatches, error
 	return componentMatches, nil
 }
 
+// ImageImportSearcher uses the server to look up images in Docker registries,
+// without importing them.
+type ImageImportSearcher struct {
+	Client        client.ImageStreamImportInterface
+	AllowInsecure bool
+	// Optional, will be used to look up the images if the server can't be
+	// used to import images.
+	Fallback Searcher
+}
+
+// Search asks the server for the metadata of the images that match terms
+func (s ImageImportSearcher) Search(terms ...string) (ComponentMatches, error) {
+	isi := &imageapi.ImageStreamImport{}
+	isi.Name = "newapp"
+	refs := make([]imageapi.DockerImageReference, 0, len(terms))
+	for _, term := range terms {
+		ref, err := imageapi.ParseDockerImageReference(term)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+		isi.Spec.Images = append(isi.Spec.Images, imageapi.ImageImportSpec{
+			From:         kapi.ObjectReference{Kind: "DockerImage", Name: term},
+			ImportPolicy: imageapi.TagImportPolicy{Insecure: s.AllowInsecure},
+		})
+	}
+
+	glog.V(4).Infof("checking Docker registries for %v using the server, allow-insecure=%v", terms, s.AllowInsecure)
+	result, err := s.Client.Create(isi)
+	if err != nil || result == nil {
+		if s.Fallback != nil && (err == nil || kapierrors.IsNotFound(err) || kapierrors.IsForbidden(err)) {
+			glog.V(4).Infof("the server can't import images, checking the Docker registries directly: %v", err)
+			return s.Fallback.Search(terms...)
+		}
+		return nil, fmt.Errorf("can't look up images on the server: %v", err)
+	}
+
+	componentMatches := ComponentMatches{}
+	for i, status := range result.Status.Images {
+		term, ref := terms[i], refs[i]
+		if status.Status.Status != unversioned.StatusSuccess || status.Image == nil {
+			if status.Status.Reason == unversioned.StatusReasonNotFound {
+				glog.V(4).Infof("image not found: %s", status.Status.Message)
+				continue
+			}
+			return nil, fmt.Errorf("can't look up Docker image %q: %s", term, status.Status.Message)
+		}
+
+		if len(ref.Tag) == 0 {
+			ref.Tag = imageapi.DefaultImageTag
+		}
+		if len(ref.Registry) == 0 {
+			ref.Registry = "Docker Hub"
+		}
+		dockerImage := &status.Image.DockerImageMetadata
+		glog.V(4).Infof("found image: %#v", dockerImage)
+
+		componentMatches = append(componentMatches, &ComponentMatch{
+			Value:       term,
+			Argument:    fmt.Sprintf("--docker-image=%q", term),
+			Name:        term,
+			Description: descriptionFor(dockerImage, term, ref.Registry, ref.Tag),
+			Score:       0,
+			Image:       dockerImage,
+			ImageTag:    ref.Tag,
+			Insecure:    s.AllowInsecure,
+			Meta:        map[string]string{"registry": ref.Registry},
+		})
+	}
+	return componentMatches, nil
+}
+
 func descriptionFor(image *imageapi.DockerImage, value, from string, tag string) string {
 	shortID := imageapi.ShortDockerImageID(image, 7)
 	tagPart := ""
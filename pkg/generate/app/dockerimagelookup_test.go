@@ -0,0 +1,79 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kapierrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func TestImageImportSearcher(t *testing.T) {
+	fake := &testclient.Fake{}
+	fake.AddReactor("create", "imagestreamimports", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		isi := action.(ktestclient.CreateAction).GetObject().(*imageapi.ImageStreamImport)
+		if isi.Spec.Import {
+			t.Errorf("unexpected import: %#v", isi)
+		}
+		isi.Status.Images = []imageapi.ImageImportStatus{
+			{
+				Status: unversioned.Status{Status: unversioned.StatusSuccess},
+				Image: &imageapi.Image{
+					ObjectMeta:          kapi.ObjectMeta{Name: "id1"},
+					DockerImageMetadata: imageapi.DockerImage{ID: "id1"},
+				},
+			},
+			{
+				Status: unversioned.Status{Status: unversioned.StatusFailure, Code: http.StatusNotFound, Reason: unversioned.StatusReasonNotFound},
+			},
+		}
+		return true, isi, nil
+	})
+
+	searcher := ImageImportSearcher{Client: fake.ImageStreamImports("test"), AllowInsecure: true}
+	matches, err := searcher.Search("myregistry:5000/test/ruby", "test/missing")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matches) != 1 {
+		t.Fatalf("expected a single match, got %#v", matches)
+	}
+	match := matches[0]
+	if match.Value != "myregistry:5000/test/ruby" || match.ImageTag != imageapi.DefaultImageTag || !match.Insecure || match.Image == nil || match.Image.ID != "id1" {
+		t.Errorf("unexpected match: %#v", match)
+	}
+	if e, a := "myregistry:5000", match.Meta["registry"]; e != a {
+		t.Errorf("expected registry %s, got %s", e, a)
+	}
+}
+
+func TestImageImportSearcherFallback(t *testing.T) {
+	fake := &testclient.Fake{}
+	fake.AddReactor("create", "imagestreamimports", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, nil, kapierrors.NewNotFound("imageStreamImports", "newapp")
+	})
+	fallback := &fakeSearcher{}
+
+	searcher := ImageImportSearcher{Client: fake.ImageStreamImports("test"), Fallback: fallback}
+	if _, err := searcher.Search("test/ruby"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fallback.terms) != 1 || fallback.terms[0] != "test/ruby" {
+		t.Errorf("expected the fallback searcher to be used, got %v", fallback.terms)
+	}
+}
+
+type fakeSearcher struct {
+	terms []string
+}
+
+func (s *fakeSearcher) Search(terms ...string) (ComponentMatches, error) {
+	s.terms = append(s.terms, terms...)
+	return ComponentMatches{}, nil
+}
@@ -237,7 +237,10 @@ func NormalizeImageStreamTag(name string) string {
 }
 
 // ImageWithMetadata returns a copy of image with the DockerImageMetadata filled in
-// from the raw DockerImageManifest data stored in the image.
+// from the raw DockerImageManifest data stored in the image. The layers of the
+// image are filled in from the manifest unless they are already set, and the size
+// of the image is the sum of the sizes of its layers. Only schema 1 manifests are
+// supported.
 func ImageWithMetadata(image Image) (*Image, error) {
 	if len(image.DockerImageManifest) == 0 {
 		return &image, nil
@@ -252,6 +255,10 @@ func ImageWithMetadata(image Image) (*Image, error) {
 		return nil, err
 	}
 
+	if manifest.SchemaVersion > 1 {
+		return nil, fmt.Errorf("unsupported Docker image manifest schema %d", manifest.SchemaVersion)
+	}
+
 	if len(manifest.History) == 0 {
 		// should never have an empty history, but just in case...
 		return &image, nil
@@ -262,6 +269,19 @@ func ImageWithMetadata(image Image) (*Image, error) {
 		return nil, err
 	}
 
+	if len(image.DockerImageLayers) == 0 && len(manifest.FSLayers) == len(manifest.History) {
+		// schema 1 manifests list the top layer first
+		layers := make([]ImageLayer, 0, len(manifest.FSLayers))
+		for i := len(manifest.FSLayers) - 1; i >= 0; i-- {
+			layer := DockerV1CompatibilityImage{}
+			if err := json.Unmarshal([]byte(manifest.History[i].DockerV1Compatibility), &layer); err != nil {
+				return nil, err
+			}
+			layers = append(layers, ImageLayer{Name: manifest.FSLayers[i].DockerBlobSum, Size: layer.Size})
+		}
+		image.DockerImageLayers = layers
+	}
+
 	image.DockerImageMetadata.ID = v1Metadata.ID
 	image.DockerImageMetadata.Parent = v1Metadata.Parent
 	image.DockerImageMetadata.Comment = v1Metadata.Comment
@@ -272,7 +292,10 @@ func ImageWithMetadata(image Image) (*Image, error) {
 	image.DockerImageMetadata.Author = v1Metadata.Author
 	image.DockerImageMetadata.Config = v1Metadata.Config
 	image.DockerImageMetadata.Architecture = v1Metadata.Architecture
-	image.DockerImageMetadata.Size = v1Metadata.Size
+	image.DockerImageMetadata.Size = 0
+	for _, layer := range image.DockerImageLayers {
+		image.DockerImageMetadata.Size += layer.Size
+	}
 
 	return &image, nil
 }
@@ -320,7 +343,8 @@ func AddTagEventToImageStream(stream *ImageStream, tag string, next TagEvent) bo
 
 	tags, ok := stream.Status.Tags[tag]
 	if !ok || len(tags.Items) == 0 {
-		stream.Status.Tags[tag] = TagEventList{Items: []TagEvent{next}}
+		tags.Items = []TagEvent{next}
+		stream.Status.Tags[tag] = tags
 		return true
 	}
 
@@ -348,6 +372,36 @@ func AddTagEventToImageStream(stream *ImageStream, tag string, next TagEvent) bo
 	return true
 }
 
+// GetTagCondition returns the condition of the given type on tag in the status of stream,
+// or nil if the tag has no such condition.
+func GetTagCondition(stream *ImageStream, tag string, conditionType TagEventConditionType) *TagEventCondition {
+	tags, ok := stream.Status.Tags[tag]
+	if !ok {
+		return nil
+	}
+	for i := range tags.Conditions {
+		if tags.Conditions[i].Type == conditionType {
+			return &tags.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetTagConditions replaces the conditions of tag in the status of stream. A tag with
+// neither history nor conditions is removed from the status.
+func SetTagConditions(stream *ImageStream, tag string, conditions ...TagEventCondition) {
+	tags := stream.Status.Tags[tag]
+	tags.Conditions = conditions
+	if len(tags.Items) == 0 && len(tags.Conditions) == 0 {
+		delete(stream.Status.Tags, tag)
+		return
+	}
+	if stream.Status.Tags == nil {
+		stream.Status.Tags = make(map[string]TagEventList)
+	}
+	stream.Status.Tags[tag] = tags
+}
+
 // UpdateChangedTrackingTags identifies any tags in the status that have changed and
 // ensures any referenced tracking tags are also updated. It returns the number of
 // updates applied.
@@ -489,9 +489,22 @@ func TestImageWithMetadata(t *testing.T) {
 						OnBuild:         []string{},
 					},
 					Architecture: "amd64",
-					Size:         0,
+					Size:         188294133,
 				},
+				DockerImageLayers: []ImageLayer{
+					{Name: "tarsum.dev+sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Size: 0},
+					{Name: "tarsum.dev+sha256:2aaacc362ac6be2b9e9ae8c6029f6f616bb50aec63746521858e47841b90fabd", Size: 188097705},
+					{Name: "tarsum.dev+sha256:c937c4bb1c1a21cc6d94340812262c6472092028972ae69b551b1a70d4276171", Size: 194533},
+					{Name: "tarsum.dev+sha256:b194de3772ebbcdc8f244f663669799ac1cb141834b7cb8b69100285d357a2b0", Size: 1895},
+					{Name: "tarsum.dev+sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Size: 0},
+				},
+			},
+		},
+		"schema 2": {
+			image: Image{
+				DockerImageManifest: `{"schemaVersion": 2, "mediaType": "application/vnd.docker.distribution.manifest.v2+json"}`,
 			},
+			expectError: true,
 		},
 	}
 
@@ -748,6 +761,48 @@ func TestAddTagEventToImageStream(t *testing.T) {
 	}
 }
 
+func TestSetTagConditions(t *testing.T) {
+	failed := TagEventCondition{Type: ImportSuccess, Status: kapi.ConditionFalse, Reason: "NotFound"}
+	stream := &ImageStream{
+		Status: ImageStreamStatus{
+			Tags: map[string]TagEventList{
+				"latest": {Items: []TagEvent{{DockerImageReference: "foo/bar:latest", Image: "1"}}},
+			},
+		},
+	}
+
+	SetTagConditions(stream, "latest", failed)
+	SetTagConditions(stream, "missing", failed)
+	if condition := GetTagCondition(stream, "latest", ImportSuccess); condition == nil || condition.Reason != "NotFound" {
+		t.Errorf("expected a condition on latest, got %#v", condition)
+	}
+	if len(stream.Status.Tags["latest"].Items) != 1 {
+		t.Errorf("expected the history of latest to be preserved: %#v", stream.Status.Tags["latest"])
+	}
+	if GetTagCondition(stream, "missing", ImportSuccess) == nil {
+		t.Errorf("expected a condition on missing")
+	}
+
+	AddTagEventToImageStream(stream, "missing", TagEvent{DockerImageReference: "foo/bar:missing", Image: "2"})
+	if GetTagCondition(stream, "missing", ImportSuccess) == nil {
+		t.Errorf("expected adding a tag event to preserve the conditions of the tag")
+	}
+
+	SetTagConditions(stream, "latest")
+	if GetTagCondition(stream, "latest", ImportSuccess) != nil {
+		t.Errorf("expected the condition on latest to be cleared")
+	}
+	if _, ok := stream.Status.Tags["latest"]; !ok {
+		t.Errorf("expected latest to remain in the status")
+	}
+
+	stream.Status.Tags["empty"] = TagEventList{Conditions: []TagEventCondition{failed}}
+	SetTagConditions(stream, "empty")
+	if _, ok := stream.Status.Tags["empty"]; ok {
+		t.Errorf("expected a tag with neither history nor conditions to be removed")
+	}
+}
+
 func TestUpdateTrackingTags(t *testing.T) {
 	tests := map[string]struct {
 		fromNil               bool
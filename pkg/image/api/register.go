@@ -14,6 +14,7 @@ func init() {
 		&ImageStreamTag{},
 		&ImageStreamTagList{},
 		&ImageStreamImage{},
+		&ImageStreamImport{},
 		&DockerImage{},
 	)
 }
@@ -27,3 +28,4 @@ func (*ImageStreamMapping) IsAnAPIObject() {}
 func (*ImageStreamTag) IsAnAPIObject()     {}
 func (*ImageStreamTagList) IsAnAPIObject() {}
 func (*ImageStreamImage) IsAnAPIObject()   {}
+func (*ImageStreamImport) IsAnAPIObject()  {}
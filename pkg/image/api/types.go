@@ -29,6 +29,10 @@ const (
 	DefaultImageTag = "latest"
 )
 
+// ResourceImageStreamsStorage is the quota resource which limits the size of the layers of the images
+// pushed to the image streams of a project. Layers shared by several images are counted once.
+const ResourceImageStreamsStorage kapi.ResourceName = "openshift.io/imagestreams.storage"
+
 // Image is an immutable representation of a Docker image and metadata at a point in time.
 type Image struct {
 	unversioned.TypeMeta
@@ -42,6 +46,16 @@ type Image struct {
 	DockerImageMetadataVersion string
 	// The raw JSON of the manifest
 	DockerImageManifest string
+	// The layers of the image, from the base layer to the top layer
+	DockerImageLayers []ImageLayer
+}
+
+// ImageLayer is a layer of an image.
+type ImageLayer struct {
+	// The digest of the layer
+	Name string
+	// The size of the layer in bytes
+	Size int64
 }
 
 // ImageStreamList is a list of ImageStream objects.
@@ -81,6 +95,16 @@ type TagReference struct {
 	From *kapi.ObjectReference
 	// Reference states if the tag will be imported. Default value is false, which means the tag will be imported.
 	Reference bool
+	// ImportPolicy is information that controls how images may be imported by the server.
+	ImportPolicy TagImportPolicy
+}
+
+// TagImportPolicy describes how images referenced by a tag are imported.
+type TagImportPolicy struct {
+	// Insecure is true if the server may bypass certificate verification or connect directly over HTTP during image import.
+	Insecure bool
+	// Scheduled indicates to the server that this tag should be periodically checked to ensure it is up to date, and imported
+	Scheduled bool
 }
 
 // ImageStreamStatus contains information about the state of this image stream.
@@ -96,6 +120,8 @@ type ImageStreamStatus struct {
 // TagEventList contains a historical record of images associated with a tag.
 type TagEventList struct {
 	Items []TagEvent
+	// Conditions is an array of conditions that apply to the tag event list.
+	Conditions []TagEventCondition
 }
 
 // TagEvent is used by ImageRepositoryStatus to keep a historical record of images associated with a tag.
@@ -108,6 +134,29 @@ type TagEvent struct {
 	Image string
 }
 
+// TagEventConditionType is a valid value for TagEventCondition.Type
+type TagEventConditionType string
+
+// These are valid conditions of TagEvents.
+const (
+	// ImportSuccess with status False means the import of the specific tag failed
+	ImportSuccess TagEventConditionType = "ImportSuccess"
+)
+
+// TagEventCondition contains condition information for a tag event.
+type TagEventCondition struct {
+	// Type of tag event condition, currently only ImportSuccess
+	Type TagEventConditionType
+	// Status of the condition, one of True, False, Unknown.
+	Status kapi.ConditionStatus
+	// LastTransitionTime is the time the condition transitioned from one status to another.
+	LastTransitionTime unversioned.Time
+	// Reason is a brief machine readable explanation for the condition's last transition.
+	Reason string
+	// Message is a human readable description of the details about last transition, complementing reason.
+	Message string
+}
+
 // ImageStreamMapping represents a mapping from a single tag to a Docker image as
 // well as the reference to the Docker image repository the image came from.
 type ImageStreamMapping struct {
@@ -149,6 +198,85 @@ type ImageStreamImage struct {
 	Image Image
 }
 
+// ImageStreamImport allows a caller to request information about a set of images for possible
+// import into an image stream, or import a set of images into an image stream. Images are
+// identified by their pull spec on a Docker registry. The status of each requested image is
+// returned, and the result of the import is returned in status.import if spec.import is true.
+// Creating an ImageStreamImport does not store the object; it is only used to make the request.
+type ImageStreamImport struct {
+	unversioned.TypeMeta
+	kapi.ObjectMeta
+
+	// Spec is a description of the images that the user wishes to import
+	Spec ImageStreamImportSpec
+	// Status is the result of the import
+	Status ImageStreamImportStatus
+}
+
+// ImageStreamImportSpec defines what images should be imported.
+type ImageStreamImportSpec struct {
+	// Import indicates whether to perform an import - if so, the specified tags are set on the spec
+	// and status of the image stream defined by the type meta.
+	Import bool
+	// Repository is an optional import of an entire Docker image repository. A maximum limit on the
+	// number of tags imported this way is imposed by the server.
+	Repository *RepositoryImportSpec
+	// Images are a list of individual images to import.
+	Images []ImageImportSpec
+}
+
+// ImageStreamImportStatus contains information about the status of an image stream import.
+type ImageStreamImportStatus struct {
+	// Import is the image stream that was successfully updated or created when 'to' was set.
+	Import *ImageStream
+	// Repository is set if spec.repository was set to the outcome of the import
+	Repository *RepositoryImportStatus
+	// Images is set with the result of importing spec.images
+	Images []ImageImportStatus
+}
+
+// RepositoryImportSpec describes a request to import images from a Docker image repository.
+type RepositoryImportSpec struct {
+	// From is the source of the repository to import, only kind DockerImage is supported
+	From kapi.ObjectReference
+
+	// ImportPolicy controls how the images are imported
+	ImportPolicy TagImportPolicy
+}
+
+// RepositoryImportStatus describes the result of an image repository import
+type RepositoryImportStatus struct {
+	// Status reflects whether any failure occurred during import
+	Status unversioned.Status
+	// Images is the list of images successfully retrieved by the import of the repository.
+	Images []ImageImportStatus
+	// AdditionalTags are tags that exist in the repository but were not imported because
+	// a maximum limit of automatic imports was applied.
+	AdditionalTags []string
+}
+
+// ImageImportSpec defines how an image is imported.
+type ImageImportSpec struct {
+	// From is the source of the image to import, only kind DockerImage is supported
+	From kapi.ObjectReference
+	// To is the tag in the image stream the image is imported to. If not set, the image is
+	// not recorded on the stream.
+	To *kapi.LocalObjectReference
+
+	// ImportPolicy controls how the image is imported
+	ImportPolicy TagImportPolicy
+}
+
+// ImageImportStatus describes the result of an image import.
+type ImageImportStatus struct {
+	// Status is the status of the image import, including errors encountered while retrieving the image
+	Status unversioned.Status
+	// Image is the metadata of that image, if the image was located
+	Image *Image
+	// Tag is the tag this image was located under, if any
+	Tag string
+}
+
 // DockerImageReference points to a Docker image.
 type DockerImageReference struct {
 	Registry  string
@@ -18,6 +18,9 @@ func convert_api_Image_To_v1_Image(in *newer.Image, out *Image, s conversion.Sco
 
 	out.DockerImageReference = in.DockerImageReference
 	out.DockerImageManifest = in.DockerImageManifest
+	if err := s.Convert(&in.DockerImageLayers, &out.DockerImageLayers, 0); err != nil {
+		return err
+	}
 
 	version := in.DockerImageMetadataVersion
 	if len(version) == 0 {
@@ -40,6 +43,9 @@ func convert_v1_Image_To_api_Image(in *Image, out *newer.Image, s conversion.Sco
 
 	out.DockerImageReference = in.DockerImageReference
 	out.DockerImageManifest = in.DockerImageManifest
+	if err := s.Convert(&in.DockerImageLayers, &out.DockerImageLayers, 0); err != nil {
+		return err
+	}
 
 	version := in.DockerImageMetadataVersion
 	if len(version) == 0 {
@@ -121,6 +127,9 @@ func init() {
 				if err := s.Convert(&curr.Items, &newTagEventList.Items, 0); err != nil {
 					return err
 				}
+				if err := s.Convert(&curr.Conditions, &newTagEventList.Conditions, 0); err != nil {
+					return err
+				}
 				(*out)[curr.Tag] = newTagEventList
 			}
 
@@ -139,6 +148,9 @@ func init() {
 				if err := s.Convert(&newTagEventList.Items, &oldTagEventList.Items, 0); err != nil {
 					return err
 				}
+				if err := s.Convert(&newTagEventList.Conditions, &oldTagEventList.Conditions, 0); err != nil {
+					return err
+				}
 
 				*out = append(*out, *oldTagEventList)
 			}
@@ -154,6 +166,9 @@ func init() {
 				if err := s.Convert(&curr.From, &r.From, 0); err != nil {
 					return err
 				}
+				if err := s.Convert(&curr.ImportPolicy, &r.ImportPolicy, 0); err != nil {
+					return err
+				}
 				(*out)[curr.Name] = r
 			}
 			return nil
@@ -175,6 +190,9 @@ func init() {
 				if err := s.Convert(&newTagReference.From, &oldTagReference.From, 0); err != nil {
 					return err
 				}
+				if err := s.Convert(&newTagReference.ImportPolicy, &oldTagReference.ImportPolicy, 0); err != nil {
+					return err
+				}
 				*out = append(*out, oldTagReference)
 			}
 			return nil
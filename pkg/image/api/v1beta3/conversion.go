@@ -18,6 +18,9 @@ func convert_api_Image_To_v1beta3_Image(in *newer.Image, out *Image, s conversio
 
 	out.DockerImageReference = in.DockerImageReference
 	out.DockerImageManifest = in.DockerImageManifest
+	if err := s.Convert(&in.DockerImageLayers, &out.DockerImageLayers, 0); err != nil {
+		return err
+	}
 
 	version := in.DockerImageMetadataVersion
 	if len(version) == 0 {
@@ -40,6 +43,9 @@ func convert_v1beta3_Image_To_api_Image(in *Image, out *newer.Image, s conversio
 
 	out.DockerImageReference = in.DockerImageReference
 	out.DockerImageManifest = in.DockerImageManifest
+	if err := s.Convert(&in.DockerImageLayers, &out.DockerImageLayers, 0); err != nil {
+		return err
+	}
 
 	version := in.DockerImageMetadataVersion
 	if len(version) == 0 {
@@ -206,6 +212,9 @@ func init() {
 				if err := s.Convert(&curr.Items, &newTagEventList.Items, 0); err != nil {
 					return err
 				}
+				if err := s.Convert(&curr.Conditions, &newTagEventList.Conditions, 0); err != nil {
+					return err
+				}
 				(*out)[curr.Tag] = newTagEventList
 			}
 
@@ -224,6 +233,9 @@ func init() {
 				if err := s.Convert(&newTagEventList.Items, &oldTagEventList.Items, 0); err != nil {
 					return err
 				}
+				if err := s.Convert(&newTagEventList.Conditions, &oldTagEventList.Conditions, 0); err != nil {
+					return err
+				}
 
 				*out = append(*out, *oldTagEventList)
 			}
@@ -239,6 +251,9 @@ func init() {
 				if err := s.Convert(&curr.From, &r.From, 0); err != nil {
 					return err
 				}
+				if err := s.Convert(&curr.ImportPolicy, &r.ImportPolicy, 0); err != nil {
+					return err
+				}
 				(*out)[curr.Name] = r
 			}
 			return nil
@@ -260,6 +275,9 @@ func init() {
 				if err := s.Convert(&newTagReference.From, &oldTagReference.From, 0); err != nil {
 					return err
 				}
+				if err := s.Convert(&newTagReference.ImportPolicy, &oldTagReference.ImportPolicy, 0); err != nil {
+					return err
+				}
 				*out = append(*out, oldTagReference)
 			}
 			return nil
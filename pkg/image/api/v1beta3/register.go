@@ -17,6 +17,7 @@ func init() {
 		&ImageStreamTag{},
 		&ImageStreamTagList{},
 		&ImageStreamImage{},
+		&ImageStreamImport{},
 	)
 }
 
@@ -27,3 +28,4 @@ func (*ImageStreamList) IsAnAPIObject()    {}
 func (*ImageStreamMapping) IsAnAPIObject() {}
 func (*ImageStreamTag) IsAnAPIObject()     {}
 func (*ImageStreamTagList) IsAnAPIObject() {}
+func (*ImageStreamImport) IsAnAPIObject()  {}
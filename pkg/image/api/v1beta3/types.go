@@ -27,6 +27,16 @@ type Image struct {
 	DockerImageMetadataVersion string `json:"dockerImageMetadataVersion,omitempty"`
 	// The raw JSON of the manifest
 	DockerImageManifest string `json:"dockerImageManifest,omitempty"`
+	// The layers of the image, from the base layer to the top layer
+	DockerImageLayers []ImageLayer `json:"dockerImageLayers,omitempty"`
+}
+
+// ImageLayer is a layer of an image.
+type ImageLayer struct {
+	// The digest of the layer
+	Name string `json:"name"`
+	// The size of the layer in bytes
+	Size int64 `json:"size"`
 }
 
 // ImageStreamList is a list of ImageStream objects.
@@ -65,6 +75,16 @@ type NamedTagReference struct {
 	From        *kapi.ObjectReference `json:"from,omitempty"`
 	// Reference states if the tag will be imported. Default value is false, which means the tag will be imported.
 	Reference bool `json:"reference,omitempty" description:"if true consider this tag a reference only and do not attempt to import metadata about the image"`
+	// ImportPolicy is information that controls how images may be imported by the server.
+	ImportPolicy TagImportPolicy `json:"importPolicy,omitempty" description:"attributes controlling how this reference is imported"`
+}
+
+// TagImportPolicy describes how images referenced by a tag are imported.
+type TagImportPolicy struct {
+	// Insecure is true if the server may bypass certificate verification or connect directly over HTTP during image import.
+	Insecure bool `json:"insecure,omitempty" description:"if true, the server may bypass certificate verification or connect directly over HTTP during image import"`
+	// Scheduled indicates to the server that this tag should be periodically checked to ensure it is up to date, and imported
+	Scheduled bool `json:"scheduled,omitempty" description:"if true, the server will periodically check that this tag is up to date and import any changes"`
 }
 
 // ImageStreamStatus contains information about the state of this image stream.
@@ -81,6 +101,8 @@ type ImageStreamStatus struct {
 type NamedTagEventList struct {
 	Tag   string     `json:"tag"`
 	Items []TagEvent `json:"items"`
+	// Conditions is an array of conditions that apply to the tag event list.
+	Conditions []TagEventCondition `json:"conditions,omitempty" description:"the set of conditions that apply to this tag"`
 }
 
 // TagEvent is used by ImageRepositoryStatus to keep a historical record of images associated with a tag.
@@ -93,6 +115,29 @@ type TagEvent struct {
 	Image string `json:"image"`
 }
 
+// TagEventConditionType is a valid value for TagEventCondition.Type
+type TagEventConditionType string
+
+// These are valid conditions of TagEvents.
+const (
+	// ImportSuccess with status False means the import of the specific tag failed
+	ImportSuccess TagEventConditionType = "ImportSuccess"
+)
+
+// TagEventCondition contains condition information for a tag event.
+type TagEventCondition struct {
+	// Type of tag event condition, currently only ImportSuccess
+	Type TagEventConditionType `json:"type" description:"type of tag event condition, currently only ImportSuccess"`
+	// Status of the condition, one of True, False, Unknown.
+	Status kapi.ConditionStatus `json:"status" description:"status of the condition, one of True, False or Unknown"`
+	// LastTransitionTime is the time the condition transitioned from one status to another.
+	LastTransitionTime unversioned.Time `json:"lastTransitionTime,omitempty" description:"the last time the condition changed from one status to another"`
+	// Reason is a brief machine readable explanation for the condition's last transition.
+	Reason string `json:"reason,omitempty" description:"a brief machine readable reason for the last transition of the condition"`
+	// Message is a human readable description of the details about last transition, complementing reason.
+	Message string `json:"message,omitempty" description:"a human readable description of the last transition of the condition"`
+}
+
 // ImageStreamMapping represents a mapping from a single tag to a Docker image as
 // well as the reference to the Docker image repository the image came from.
 type ImageStreamMapping struct {
@@ -125,6 +170,80 @@ type ImageStreamImage struct {
 	ImageName string `json:"imageName"`
 }
 
+// ImageStreamImport imports an image from remote repositories into OpenShift.
+type ImageStreamImport struct {
+	unversioned.TypeMeta `json:",inline"`
+	kapi.ObjectMeta      `json:"metadata,omitempty"`
+
+	// Spec is a description of the images that the user wishes to import
+	Spec ImageStreamImportSpec `json:"spec"`
+	// Status is the the result of importing the image
+	Status ImageStreamImportStatus `json:"status"`
+}
+
+// ImageStreamImportSpec defines what images should be imported.
+type ImageStreamImportSpec struct {
+	// Import indicates whether to perform an import - if so, the specified tags are set on the spec
+	// and status of the image stream defined by the type meta.
+	Import bool `json:"import"`
+	// Repository is an optional import of an entire Docker image repository. A maximum limit on the
+	// number of tags imported this way is imposed by the server.
+	Repository *RepositoryImportSpec `json:"repository,omitempty"`
+	// Images are a list of individual images to import.
+	Images []ImageImportSpec `json:"images,omitempty"`
+}
+
+// ImageStreamImportStatus contains information about the status of an image stream import.
+type ImageStreamImportStatus struct {
+	// Import is the image stream that was successfully updated or created when 'to' was set.
+	Import *ImageStream `json:"import,omitempty"`
+	// Repository is set if spec.repository was set to the outcome of the import
+	Repository *RepositoryImportStatus `json:"repository,omitempty"`
+	// Images is set with the result of importing spec.images
+	Images []ImageImportStatus `json:"images,omitempty"`
+}
+
+// RepositoryImportSpec describes a request to import images from a Docker image repository.
+type RepositoryImportSpec struct {
+	// From is the source for the image repository to import; only kind DockerImage and a name of a container image repository is allowed
+	From kapi.ObjectReference `json:"from"`
+
+	// ImportPolicy is the policy controlling how the images are imported
+	ImportPolicy TagImportPolicy `json:"importPolicy,omitempty"`
+}
+
+// RepositoryImportStatus describes the result of an image repository import
+type RepositoryImportStatus struct {
+	// Status reflects whether any failure occurred during import
+	Status unversioned.Status `json:"status,omitempty"`
+	// Images is a list of images successfully retrieved by the import of the repository.
+	Images []ImageImportStatus `json:"images,omitempty"`
+	// AdditionalTags are tags that exist in the repository but were not imported because
+	// a maximum limit of automatic imports was applied.
+	AdditionalTags []string `json:"additionalTags,omitempty"`
+}
+
+// ImageImportSpec defines how an image is imported.
+type ImageImportSpec struct {
+	// From is the source of an image to import; only kind DockerImage is allowed
+	From kapi.ObjectReference `json:"from"`
+	// To is a tag in the current image stream to assign the imported image to, if name is not specified the default tag from from.name will be used
+	To *kapi.LocalObjectReference `json:"to,omitempty"`
+
+	// ImportPolicy is the policy controlling how the image is imported
+	ImportPolicy TagImportPolicy `json:"importPolicy,omitempty"`
+}
+
+// ImageImportStatus describes the result of an image import.
+type ImageImportStatus struct {
+	// Status is the status of the image import, including errors encountered while retrieving the image
+	Status unversioned.Status `json:"status"`
+	// Image is the metadata of that image, if the image was located
+	Image *Image `json:"image,omitempty"`
+	// Tag is the tag this image was located under, if any
+	Tag string `json:"tag,omitempty"`
+}
+
 // DockerImageReference points to a Docker image.
 type DockerImageReference struct {
 	Registry  string
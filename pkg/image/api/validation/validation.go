@@ -54,6 +54,12 @@ func ValidateImage(image *api.Image) fielderrors.ValidationErrorList {
 		}
 	}
 
+	if len(image.DockerImageManifest) != 0 {
+		if _, err := api.ImageWithMetadata(*image); err != nil {
+			result = append(result, fielderrors.NewFieldInvalid("dockerImageManifest", "", err.Error()))
+		}
+	}
+
 	return result
 }
 
@@ -100,6 +106,9 @@ func ValidateImageStream(stream *api.ImageStream) fielderrors.ValidationErrorLis
 				result = append(result, fielderrors.NewFieldInvalid(fmt.Sprintf("spec.tags[%s].from.kind", tag), tagRef.From.Kind, "valid values are 'DockerImage', 'ImageStreamImage', 'ImageStreamTag'"))
 			}
 		}
+		if tagRef.ImportPolicy.Scheduled && (tagRef.From == nil || tagRef.From.Kind != "DockerImage" || tagRef.Reference) {
+			result = append(result, fielderrors.NewFieldInvalid(fmt.Sprintf("spec.tags[%s].importPolicy.scheduled", tag), tagRef.ImportPolicy.Scheduled, "only tags which import from a DockerImage may be scheduled"))
+		}
 	}
 	for tag, history := range stream.Status.Tags {
 		for i, tagEvent := range history.Items {
@@ -160,6 +169,53 @@ func ValidateImageStreamMapping(mapping *api.ImageStreamMapping) fielderrors.Val
 	return result
 }
 
+// ValidateImageStreamImport ensures that an import request names a valid image stream and that
+// every image or repository to import is a valid Docker pull spec.
+func ValidateImageStreamImport(isi *api.ImageStreamImport) fielderrors.ValidationErrorList {
+	result := fielderrors.ValidationErrorList{}
+	result = append(result, validation.ValidateObjectMeta(&isi.ObjectMeta, true, ValidateImageStreamName).Prefix("metadata")...)
+
+	if len(isi.Namespace+"/"+isi.Name) > reference.NameTotalLengthMax {
+		result = append(result, fielderrors.NewFieldInvalid("metadata.name", isi.Name, fmt.Sprintf("'namespace/name' cannot be longer than %d characters", reference.NameTotalLengthMax)))
+	}
+
+	if spec := isi.Spec.Repository; spec != nil {
+		if spec.From.Kind != "DockerImage" {
+			result = append(result, fielderrors.NewFieldInvalid("spec.repository.from.kind", spec.From.Kind, "only DockerImage is supported"))
+		} else if ref, err := api.ParseDockerImageReference(spec.From.Name); err != nil {
+			result = append(result, fielderrors.NewFieldInvalid("spec.repository.from.name", spec.From.Name, err.Error()))
+		} else if len(ref.Tag) > 0 || len(ref.ID) > 0 {
+			result = append(result, fielderrors.NewFieldInvalid("spec.repository.from.name", spec.From.Name, "you must specify an image repository, not a tag or ID"))
+		}
+	}
+
+	tags := make(map[string]int)
+	for i, spec := range isi.Spec.Images {
+		if spec.From.Kind != "DockerImage" {
+			result = append(result, fielderrors.NewFieldInvalid(fmt.Sprintf("spec.images[%d].from.kind", i), spec.From.Kind, "only DockerImage is supported"))
+		} else if _, err := api.ParseDockerImageReference(spec.From.Name); err != nil {
+			result = append(result, fielderrors.NewFieldInvalid(fmt.Sprintf("spec.images[%d].from.name", i), spec.From.Name, err.Error()))
+		}
+		if spec.To == nil {
+			continue
+		}
+		if len(spec.To.Name) == 0 {
+			result = append(result, fielderrors.NewFieldRequired(fmt.Sprintf("spec.images[%d].to.name", i)))
+			continue
+		}
+		if existing, ok := tags[spec.To.Name]; ok {
+			result = append(result, fielderrors.NewFieldInvalid(fmt.Sprintf("spec.images[%d].to.name", i), spec.To.Name, fmt.Sprintf("the tag is already imported by spec.images[%d]", existing)))
+			continue
+		}
+		tags[spec.To.Name] = i
+	}
+
+	if isi.Spec.Repository == nil && len(isi.Spec.Images) == 0 {
+		result = append(result, fielderrors.NewFieldRequired("spec.images"))
+	}
+	return result
+}
+
 // ValidateImageStreamTag is essentially a no-op.  We don't allow direct creation of istags
 func ValidateImageStreamTag(ist *api.ImageStreamTag) fielderrors.ValidationErrorList {
 	result := fielderrors.ValidationErrorList{}
@@ -276,6 +276,22 @@ func TestValidateImageStream(t *testing.T) {
 				fielderrors.NewFieldRequired("status.tags[tag].items[2].dockerImageReference"),
 			},
 		},
+		"scheduled import of an image stream tag": {
+			namespace: "namespace",
+			name:      "foo",
+			specTags: map[string]api.TagReference{
+				"other": {
+					From: &kapi.ObjectReference{
+						Kind: "ImageStreamTag",
+						Name: "other:latest",
+					},
+					ImportPolicy: api.TagImportPolicy{Scheduled: true},
+				},
+			},
+			expected: fielderrors.ValidationErrorList{
+				fielderrors.NewFieldInvalid("spec.tags[other].importPolicy.scheduled", true, "only tags which import from a DockerImage may be scheduled"),
+			},
+		},
 		"valid": {
 			namespace: "namespace",
 			name:      "foo",
@@ -285,6 +301,7 @@ func TestValidateImageStream(t *testing.T) {
 						Kind: "DockerImage",
 						Name: "abc",
 					},
+					ImportPolicy: api.TagImportPolicy{Scheduled: true, Insecure: true},
 				},
 				"other": {
 					From: &kapi.ObjectReference{
@@ -348,6 +365,124 @@ func TestValidateImageStream(t *testing.T) {
 	}
 }
 
+func TestValidateImageStreamImport(t *testing.T) {
+	meta := kapi.ObjectMeta{Name: "foo", Namespace: "default"}
+	valid := api.ImageStreamImport{
+		ObjectMeta: meta,
+		Spec: api.ImageStreamImportSpec{
+			Repository: &api.RepositoryImportSpec{From: kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/origin"}},
+			Images: []api.ImageImportSpec{
+				{From: kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/origin:v1.0.0"}, To: &kapi.LocalObjectReference{Name: "v1"}},
+				{From: kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/origin:v1.1.0"}},
+			},
+		},
+	}
+	if errs := ValidateImageStreamImport(&valid); len(errs) != 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+
+	errorCases := map[string]struct {
+		I api.ImageStreamImport
+		T fielderrors.ValidationErrorType
+		F string
+	}{
+		"missing name": {
+			api.ImageStreamImport{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "default"},
+				Spec:       valid.Spec,
+			},
+			fielderrors.ValidationErrorTypeRequired,
+			"metadata.name",
+		},
+		"nothing to import": {
+			api.ImageStreamImport{ObjectMeta: meta},
+			fielderrors.ValidationErrorTypeRequired,
+			"spec.images",
+		},
+		"repository with a tag": {
+			api.ImageStreamImport{
+				ObjectMeta: meta,
+				Spec: api.ImageStreamImportSpec{
+					Repository: &api.RepositoryImportSpec{From: kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/origin:latest"}},
+				},
+			},
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.repository.from.name",
+		},
+		"repository of the wrong kind": {
+			api.ImageStreamImport{
+				ObjectMeta: meta,
+				Spec: api.ImageStreamImportSpec{
+					Repository: &api.RepositoryImportSpec{From: kapi.ObjectReference{Kind: "ImageStreamTag", Name: "origin:latest"}},
+				},
+			},
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.repository.from.kind",
+		},
+		"image of the wrong kind": {
+			api.ImageStreamImport{
+				ObjectMeta: meta,
+				Spec: api.ImageStreamImportSpec{
+					Images: []api.ImageImportSpec{{From: kapi.ObjectReference{Kind: "ImageStreamImage", Name: "origin@id"}}},
+				},
+			},
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.images[0].from.kind",
+		},
+		"invalid image": {
+			api.ImageStreamImport{
+				ObjectMeta: meta,
+				Spec: api.ImageStreamImportSpec{
+					Images: []api.ImageImportSpec{{From: kapi.ObjectReference{Kind: "DockerImage", Name: "a/b/c/d"}}},
+				},
+			},
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.images[0].from.name",
+		},
+		"empty tag": {
+			api.ImageStreamImport{
+				ObjectMeta: meta,
+				Spec: api.ImageStreamImportSpec{
+					Images: []api.ImageImportSpec{{From: kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/origin"}, To: &kapi.LocalObjectReference{}}},
+				},
+			},
+			fielderrors.ValidationErrorTypeRequired,
+			"spec.images[0].to.name",
+		},
+		"duplicate tag": {
+			api.ImageStreamImport{
+				ObjectMeta: meta,
+				Spec: api.ImageStreamImportSpec{
+					Images: []api.ImageImportSpec{
+						{From: kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/origin:v1.0.0"}, To: &kapi.LocalObjectReference{Name: "v1"}},
+						{From: kapi.ObjectReference{Kind: "DockerImage", Name: "openshift/origin:v1.1.0"}, To: &kapi.LocalObjectReference{Name: "v1"}},
+					},
+				},
+			},
+			fielderrors.ValidationErrorTypeInvalid,
+			"spec.images[1].to.name",
+		},
+	}
+
+	for k, v := range errorCases {
+		errs := ValidateImageStreamImport(&v.I)
+		if len(errs) == 0 {
+			t.Errorf("Expected failure for %s", k)
+			continue
+		}
+		match := false
+		for i := range errs {
+			if errs[i].(*fielderrors.ValidationError).Type == v.T && errs[i].(*fielderrors.ValidationError).Field == v.F {
+				match = true
+				break
+			}
+		}
+		if !match {
+			t.Errorf("%s: expected errors to have field %s and type %s: %v", k, v.F, v.T, errs)
+		}
+	}
+}
+
 func TestValidateISTUpdate(t *testing.T) {
 	old := &api.ImageStreamTag{
 		ObjectMeta: kapi.ObjectMeta{Namespace: kapi.NamespaceDefault, Name: "foo:bar", ResourceVersion: "1", Annotations: map[string]string{"one": "two"}},
@@ -61,10 +61,7 @@ func (c *ImportController) Next(stream *api.ImageStream) error {
 	glog.V(4).Infof("Importing stream %s/%s...", stream.Namespace, stream.Name)
 
 	insecure := stream.Annotations[api.InsecureRepositoryAnnotation] == "true"
-	client := c.client
-	if client == nil {
-		client = dockerregistry.NewClient(5 * time.Second)
-	}
+	client := c.registryClient()
 
 	var errlist []error
 	toImport, retry, err := getTags(stream, client, insecure)
@@ -73,13 +70,13 @@ func (c *ImportController) Next(stream *api.ImageStream) error {
 		if retry {
 			return err
 		}
-		return c.done(stream, err.Error(), retryCount)
+		return c.done(stream, err.Error(), nil, retryCount)
 	}
 	if err != nil {
 		errlist = append(errlist, err)
 	}
 
-	retry, err = c.importTags(stream, toImport, client, insecure)
+	results, retry, err := c.importTags(stream, toImport, client, insecure)
 	if err != nil {
 		if retry {
 			return err
@@ -88,10 +85,57 @@ func (c *ImportController) Next(stream *api.ImageStream) error {
 	}
 
 	if len(errlist) > 0 {
-		return c.done(stream, kerrors.NewAggregate(errlist).Error(), retryCount)
+		return c.done(stream, kerrors.NewAggregate(errlist).Error(), results, retryCount)
+	}
+
+	return c.done(stream, "", results, retryCount)
+}
+
+// NextScheduled re-imports the tags of the given image stream which have a scheduled
+// import policy, so that changes to the upstream images are picked up. Unlike Next,
+// streams which were already imported are processed, and errors are never retried:
+// every failure is recorded on the status of the tag, and the tag is tried again the
+// next time scheduled imports run.
+func (c *ImportController) NextScheduled(stream *api.ImageStream) error {
+	toImport := scheduledTags(stream)
+	if len(toImport) == 0 {
+		return nil
+	}
+	glog.V(4).Infof("Importing scheduled tags of stream %s/%s...", stream.Namespace, stream.Name)
+
+	insecure := stream.Annotations[api.InsecureRepositoryAnnotation] == "true"
+	results, _, err := c.importTags(stream, toImport, c.registryClient(), insecure)
+	reason := ""
+	if err != nil {
+		reason = err.Error()
 	}
+	return c.done(stream, reason, results, retryCount)
+}
 
-	return c.done(stream, "", retryCount)
+// registryClient returns the client used to connect to Docker registries.
+func (c *ImportController) registryClient() dockerregistry.Client {
+	if c.client == nil {
+		return dockerregistry.NewClient(5 * time.Second)
+	}
+	return c.client
+}
+
+// scheduledTags returns a map of the tags of stream with a scheduled import policy
+// to the images they should be imported from.
+func scheduledTags(stream *api.ImageStream) map[string]api.DockerImageReference {
+	imports := make(map[string]api.DockerImageReference)
+	for tagName, specTag := range stream.Spec.Tags {
+		if !specTag.ImportPolicy.Scheduled || specTag.From == nil || specTag.From.Kind != "DockerImage" || specTag.Reference {
+			continue
+		}
+		ref, err := api.ParseDockerImageReference(specTag.From.Name)
+		if err != nil {
+			glog.V(2).Infof("error parsing DockerImage %s: %v", specTag.From.Name, err)
+			continue
+		}
+		imports[tagName] = ref.DockerClientDefaults()
+	}
+	return imports
 }
 
 // getTags returns a map of tags to be imported, a flag saying if we should retry
@@ -172,15 +216,19 @@ func getTags(stream *api.ImageStream, client dockerregistry.Client, insecure boo
 	return imports, false, nil
 }
 
-// importTags imports tags specified in a map from given ImageStream. Returns flag
-// saying if we should retry imports, meaning not setting the import annotation
-// and an error if one occurs.
-func (c *ImportController) importTags(stream *api.ImageStream, imports map[string]api.DockerImageReference, client dockerregistry.Client, insecure bool) (bool, error) {
+// importTags imports tags specified in a map from given ImageStream. Returns the
+// result of importing each tag, a flag saying if we should retry imports, meaning
+// not setting the import annotation and an error if one occurs. A tag is imported
+// insecurely if insecure is true or the import policy of the tag allows it.
+func (c *ImportController) importTags(stream *api.ImageStream, imports map[string]api.DockerImageReference, client dockerregistry.Client, insecure bool) (map[string]error, bool, error) {
 	retrieved := make(map[string]*dockerregistry.Image)
+	results := make(map[string]error)
 	var errlist []error
 	shouldRetry := false
 	for tag, ref := range imports {
-		image, retry, err := c.importTag(stream, tag, ref, retrieved[ref.ID], client, insecure)
+		tagInsecure := insecure || stream.Spec.Tags[tag].ImportPolicy.Insecure
+		image, retry, err := c.importTag(stream, tag, ref, retrieved[ref.ID], client, tagInsecure)
+		results[tag] = err
 		if err != nil {
 			if retry {
 				shouldRetry = retry
@@ -193,7 +241,7 @@ func (c *ImportController) importTags(stream *api.ImageStream, imports map[strin
 			retrieved[ref.ID] = image
 		}
 	}
-	return shouldRetry, kerrors.NewAggregate(errlist)
+	return results, shouldRetry, kerrors.NewAggregate(errlist)
 }
 
 // importTag import single tag from given ImageStream. Returns retrieved image (for later reuse),
@@ -201,7 +249,6 @@ func (c *ImportController) importTags(stream *api.ImageStream, imports map[strin
 func (c *ImportController) importTag(stream *api.ImageStream, tag string, ref api.DockerImageReference, dockerImage *dockerregistry.Image, client dockerregistry.Client, insecure bool) (*dockerregistry.Image, bool, error) {
 	glog.V(5).Infof("Importing tag %s from %s/%s...", tag, stream.Namespace, stream.Name)
 	if dockerImage == nil {
-		// TODO insecure applies to the stream's spec.dockerImageRepository, not necessarily to an external one!
 		conn, err := client.Connect(ref.Registry, insecure)
 		if err != nil {
 			// retry-able error no. 3
@@ -220,6 +267,12 @@ func (c *ImportController) importTag(stream *api.ImageStream, tag string, ref ap
 			return nil, true, err
 		}
 	}
+	// the tag already points to the upstream image, so there is nothing to import
+	if latest := api.LatestTaggedImage(stream, tag); latest != nil && latest.Image == dockerImage.ID {
+		glog.V(5).Infof("Tag %s of %s/%s is up to date", tag, stream.Namespace, stream.Name)
+		return dockerImage, false, nil
+	}
+
 	var image api.DockerImage
 	if err := kapi.Scheme.Convert(&dockerImage.Image, &image); err != nil {
 		return nil, false, fmt.Errorf("could not convert image: %#v", err)
@@ -253,8 +306,9 @@ func (c *ImportController) importTag(stream *api.ImageStream, tag string, ref ap
 	return dockerImage, false, nil
 }
 
-// done marks the stream as being processed due to an error or failure condition.
-func (c *ImportController) done(stream *api.ImageStream, reason string, retry int) error {
+// done marks the stream as being processed due to an error or failure condition, and
+// records the result of importing each tag in results on the status of the stream.
+func (c *ImportController) done(stream *api.ImageStream, reason string, results map[string]error, retry int) error {
 	if len(reason) == 0 {
 		reason = unversioned.Now().UTC().Format(time.RFC3339)
 	} else if len(reason) > 300 {
@@ -265,13 +319,50 @@ func (c *ImportController) done(stream *api.ImageStream, reason string, retry in
 		stream.Annotations = make(map[string]string)
 	}
 	stream.Annotations[api.DockerImageRepositoryCheckAnnotation] = reason
-	if _, err := c.streams.ImageStreams(stream.Namespace).Update(stream); err != nil && !errors.IsNotFound(err) {
+	updateImportConditions(stream, results)
+	if _, err := c.streams.ImageStreams(stream.Namespace).UpdateStatus(stream); err != nil && !errors.IsNotFound(err) {
 		if errors.IsConflict(err) && retry > 0 {
 			if stream, err := c.streams.ImageStreams(stream.Namespace).Get(stream.Name); err == nil {
-				return c.done(stream, reason, retry-1)
+				return c.done(stream, reason, results, retry-1)
 			}
 		}
 		return err
 	}
 	return nil
 }
+
+// updateImportConditions records the result of importing each tag on the status of
+// stream. A failed import sets an ImportSuccess condition with status False on the
+// tag, and a successful import clears it.
+func updateImportConditions(stream *api.ImageStream, results map[string]error) {
+	now := unversioned.Now()
+	for tag, err := range results {
+		if err == nil {
+			if api.GetTagCondition(stream, tag, api.ImportSuccess) != nil {
+				api.SetTagConditions(stream, tag)
+			}
+			continue
+		}
+		condition := api.TagEventCondition{
+			Type:               api.ImportSuccess,
+			Status:             kapi.ConditionFalse,
+			LastTransitionTime: now,
+			Reason:             importFailureReason(err),
+			Message:            err.Error(),
+		}
+		if previous := api.GetTagCondition(stream, tag, api.ImportSuccess); previous != nil && previous.Status == condition.Status {
+			condition.LastTransitionTime = previous.LastTransitionTime
+		}
+		api.SetTagConditions(stream, tag, condition)
+	}
+}
+
+// importFailureReason returns a brief machine readable reason for a failed import.
+func importFailureReason(err error) string {
+	switch {
+	case dockerregistry.IsRepositoryNotFound(err), dockerregistry.IsRegistryNotFound(err), dockerregistry.IsImageNotFound(err), dockerregistry.IsTagNotFound(err):
+		return "NotFound"
+	default:
+		return "InternalError"
+	}
+}
@@ -810,6 +810,131 @@ func TestControllerReturnsErrForRetries(t *testing.T) {
 	}
 }
 
+func TestControllerRecordsImportConditions(t *testing.T) {
+	cli, fake := &fakeDockerRegistryClient{
+		Images: []expectedImage{
+			{
+				Tag:   "found",
+				Image: &dockerregistry.Image{Image: docker.Image{ID: "found", Config: &docker.Config{}}},
+			},
+		},
+	}, &client.Fake{}
+	c := ImportController{client: cli, streams: fake, mappings: fake}
+
+	stream := api.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Name: "test", Namespace: "other"},
+		Spec: api.ImageStreamSpec{
+			Tags: map[string]api.TagReference{
+				"missing": {From: &kapi.ObjectReference{Kind: "DockerImage", Name: "some/repo:missing"}},
+				"found":   {From: &kapi.ObjectReference{Kind: "DockerImage", Name: "some/repo:found"}},
+			},
+		},
+		Status: api.ImageStreamStatus{
+			Tags: map[string]api.TagEventList{
+				"found": {
+					Conditions: []api.TagEventCondition{{Type: api.ImportSuccess, Status: kapi.ConditionFalse, Reason: "NotFound"}},
+				},
+			},
+		},
+	}
+	if err := c.Next(&stream); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	condition := api.GetTagCondition(&stream, "missing", api.ImportSuccess)
+	if condition == nil {
+		t.Fatalf("expected an import condition on the missing tag: %#v", stream.Status)
+	}
+	if condition.Status != kapi.ConditionFalse || condition.Reason != "NotFound" || len(condition.Message) == 0 {
+		t.Errorf("unexpected import condition: %#v", condition)
+	}
+	if condition := api.GetTagCondition(&stream, "found", api.ImportSuccess); condition != nil {
+		t.Errorf("expected the import condition of the found tag to be cleared: %#v", condition)
+	}
+
+	actions := fake.Actions()
+	last := actions[len(actions)-1]
+	if !last.Matches("update", "imagestreams") || last.GetSubresource() != "status" {
+		t.Errorf("expected a status update, got %#v", last)
+	}
+}
+
+func TestControllerNextScheduled(t *testing.T) {
+	tests := map[string]struct {
+		current        string
+		expectMappings int
+	}{
+		"upstream changed": {
+			current:        "old",
+			expectMappings: 1,
+		},
+		"upstream unchanged": {
+			current:        "new",
+			expectMappings: 0,
+		},
+	}
+
+	for name, test := range tests {
+		cli, fake := &fakeDockerRegistryClient{
+			Images: []expectedImage{
+				{
+					Tag:   "scheduled",
+					Image: &dockerregistry.Image{Image: docker.Image{ID: "new", Config: &docker.Config{}}},
+				},
+				{
+					Tag:   "manual",
+					Image: &dockerregistry.Image{Image: docker.Image{ID: "new", Config: &docker.Config{}}},
+				},
+			},
+		}, &client.Fake{}
+		c := ImportController{client: cli, streams: fake, mappings: fake}
+
+		stream := api.ImageStream{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:        "test",
+				Namespace:   "other",
+				Annotations: map[string]string{api.DockerImageRepositoryCheckAnnotation: unversioned.Now().UTC().Format(time.RFC3339)},
+			},
+			Spec: api.ImageStreamSpec{
+				Tags: map[string]api.TagReference{
+					"scheduled": {
+						From:         &kapi.ObjectReference{Kind: "DockerImage", Name: "some/repo:scheduled"},
+						ImportPolicy: api.TagImportPolicy{Scheduled: true, Insecure: true},
+					},
+					"manual": {
+						From: &kapi.ObjectReference{Kind: "DockerImage", Name: "some/repo:manual"},
+					},
+				},
+			},
+			Status: api.ImageStreamStatus{
+				Tags: map[string]api.TagEventList{
+					"scheduled": {Items: []api.TagEvent{{DockerImageReference: "some/repo:scheduled", Image: test.current}}},
+					"manual":    {Items: []api.TagEvent{{DockerImageReference: "some/repo:manual", Image: "old"}}},
+				},
+			},
+		}
+		if err := c.NextScheduled(&stream); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if cli.Tag != "scheduled" {
+			t.Errorf("%s: expected only the scheduled tag to be imported, got %q", name, cli.Tag)
+		}
+		if !cli.Insecure {
+			t.Errorf("%s: expected the import policy of the tag to allow an insecure import", name)
+		}
+
+		mappings := 0
+		for _, action := range fake.Actions() {
+			if action.Matches("create", "imagestreammappings") {
+				mappings++
+			}
+		}
+		if mappings != test.expectMappings {
+			t.Errorf("%s: expected %d mappings, got %d", name, test.expectMappings, mappings)
+		}
+	}
+}
+
 func isRFC3339(s string) bool {
 	_, err := time.Parse(time.RFC3339, s)
 	return err == nil
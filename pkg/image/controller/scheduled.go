@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/util"
+
+	"github.com/openshift/origin/pkg/client"
+)
+
+// ScheduledImportController is a controller loop that periodically re-imports the
+// image stream tags which have a scheduled import policy, so that the tags follow
+// changes to their upstream images.
+type ScheduledImportController struct {
+	interval time.Duration
+	streams  client.ImageStreamsNamespacer
+	importer *ImportController
+}
+
+// NewScheduledImportController creates a controller that re-imports scheduled tags
+// every interval.
+func NewScheduledImportController(interval time.Duration, client client.Interface) *ScheduledImportController {
+	return &ScheduledImportController{
+		interval: interval,
+		streams:  client,
+		importer: &ImportController{
+			streams:  client,
+			mappings: client,
+		},
+	}
+}
+
+// RunUntil starts the controller until the provided ch is closed.
+func (c *ScheduledImportController) RunUntil(ch <-chan struct{}) {
+	util.Until(func() {
+		if err := c.RunOnce(); err != nil {
+			util.HandleError(err)
+		}
+	}, c.interval, ch)
+}
+
+// RunOnce re-imports the scheduled tags of every image stream. Failures to import
+// a tag are recorded on the status of the tag rather than returned.
+func (c *ScheduledImportController) RunOnce() error {
+	list, err := c.streams.ImageStreams(kapi.NamespaceAll).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return fmt.Errorf("unable to list image streams for scheduled import: %v", err)
+	}
+	for i := range list.Items {
+		stream := &list.Items[i]
+		if err := c.importer.NextScheduled(stream); err != nil {
+			util.HandleError(fmt.Errorf("unable to record scheduled import of %s/%s: %v", stream.Namespace, stream.Name, err))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package quota
+
+import (
+	"fmt"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/util"
+
+	"github.com/openshift/origin/pkg/client"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// StorageQuotaController is a controller loop that periodically records the
+// storage used by the images of each project on the quotas of the project which
+// limit it. The Kubernetes quota controller leaves resources it does not know
+// about to other controllers.
+type StorageQuotaController struct {
+	interval time.Duration
+	quotas   kclient.ResourceQuotasNamespacer
+	streams  client.ImageStreamsNamespacer
+	images   client.ImagesInterfacer
+	// layers remembers the layers of the images across runs.
+	layers *LayerCache
+}
+
+// layerCacheSize is the number of images whose layers are remembered by the
+// controller.
+const layerCacheSize = 10000
+
+// NewStorageQuotaController creates a controller that updates the image storage
+// usage of quotas every interval.
+func NewStorageQuotaController(interval time.Duration, osClient client.Interface, kClient kclient.ResourceQuotasNamespacer) *StorageQuotaController {
+	return &StorageQuotaController{
+		interval: interval,
+		quotas:   kClient,
+		streams:  osClient,
+		images:   osClient,
+		layers:   NewLayerCache(layerCacheSize),
+	}
+}
+
+// RunUntil starts the controller until the provided ch is closed.
+func (c *StorageQuotaController) RunUntil(ch <-chan struct{}) {
+	util.Until(func() {
+		if err := c.RunOnce(); err != nil {
+			util.HandleError(err)
+		}
+	}, c.interval, ch)
+}
+
+// RunOnce updates the image storage usage of every quota which limits it.
+// Failures to update a quota are handled rather than returned.
+func (c *StorageQuotaController) RunOnce() error {
+	list, err := c.quotas.ResourceQuotas(kapi.NamespaceAll).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return fmt.Errorf("unable to list quotas for image storage usage: %v", err)
+	}
+
+	accountant := NewAccountant(c.images.Images(), c.layers)
+	usage := make(map[string]int64)
+	for i := range list.Items {
+		quota := &list.Items[i]
+		if _, ok := quota.Spec.Hard[imageapi.ResourceImageStreamsStorage]; !ok {
+			continue
+		}
+		size, ok := usage[quota.Namespace]
+		if !ok {
+			size, err = c.projectSize(accountant, quota.Namespace)
+			if err != nil {
+				util.HandleError(fmt.Errorf("unable to compute the image storage usage of project %s: %v", quota.Namespace, err))
+				continue
+			}
+			usage[quota.Namespace] = size
+		}
+		if err := c.updateUsage(quota, size); err != nil {
+			util.HandleError(fmt.Errorf("unable to record the image storage usage of quota %s/%s: %v", quota.Namespace, quota.Name, err))
+		}
+	}
+	return nil
+}
+
+// projectSize returns the total size of the layers of the images pushed to the
+// image streams of namespace.
+func (c *StorageQuotaController) projectSize(accountant *Accountant, namespace string) (int64, error) {
+	streams, err := c.streams.ImageStreams(namespace).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return 0, err
+	}
+	layers, err := accountant.ProjectLayers(streams.Items)
+	if err != nil {
+		return 0, err
+	}
+	return layers.Size(), nil
+}
+
+// updateUsage records size as the image storage used in the status of quota,
+// unless it is already recorded.
+func (c *StorageQuotaController) updateUsage(quota *kapi.ResourceQuota, size int64) error {
+	if used, ok := quota.Status.Used[imageapi.ResourceImageStreamsStorage]; ok && used.Value() == size {
+		return nil
+	}
+	if quota.Status.Used == nil {
+		quota.Status.Used = kapi.ResourceList{}
+	}
+	quota.Status.Used[imageapi.ResourceImageStreamsStorage] = *resource.NewQuantity(size, resource.BinarySI)
+	_, err := c.quotas.ResourceQuotas(quota.Namespace).UpdateStatus(quota)
+	return err
+}
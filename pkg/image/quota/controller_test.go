@@ -0,0 +1,85 @@
+package quota
+
+import (
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func TestStorageQuotaController(t *testing.T) {
+	gets := map[string]int{}
+	fake := fakeImages(gets,
+		managedImage("image1", imageapi.ImageLayer{Name: "layer1", Size: 100}, imageapi.ImageLayer{Name: "layer2", Size: 20}),
+		managedImage("image2", imageapi.ImageLayer{Name: "layer1", Size: 100}, imageapi.ImageLayer{Name: "layer3", Size: 3}),
+	)
+	fake.AddReactor("list", "imagestreams", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &imageapi.ImageStreamList{Items: []imageapi.ImageStream{
+			imageStream("user", "ruby", "image1"),
+			imageStream("user", "rails", "image2"),
+		}}, nil
+	})
+
+	storage := imageapi.ResourceImageStreamsStorage
+	kFake := &ktestclient.Fake{}
+	kFake.AddReactor("list", "resourcequotas", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, &kapi.ResourceQuotaList{Items: []kapi.ResourceQuota{
+			{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "user", Name: "storage"},
+				Spec:       kapi.ResourceQuotaSpec{Hard: kapi.ResourceList{storage: resource.MustParse("1Gi")}},
+			},
+			{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "user", Name: "current"},
+				Spec:       kapi.ResourceQuotaSpec{Hard: kapi.ResourceList{storage: resource.MustParse("1Gi")}},
+				Status:     kapi.ResourceQuotaStatus{Used: kapi.ResourceList{storage: resource.MustParse("123")}},
+			},
+			{
+				ObjectMeta: kapi.ObjectMeta{Namespace: "other", Name: "pods"},
+				Spec:       kapi.ResourceQuotaSpec{Hard: kapi.ResourceList{kapi.ResourcePods: resource.MustParse("10")}},
+			},
+		}}, nil
+	})
+	updated := []*kapi.ResourceQuota{}
+	kFake.AddReactor("update", "resourcequotas", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		quota := action.(ktestclient.UpdateAction).GetObject().(*kapi.ResourceQuota)
+		updated = append(updated, quota)
+		return true, quota, nil
+	})
+
+	controller := NewStorageQuotaController(time.Minute, fake, kFake)
+	if err := controller.RunOnce(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(updated) != 1 || updated[0].Name != "storage" {
+		t.Fatalf("expected only the outdated storage quota to be updated, got %#v", updated)
+	}
+	used := updated[0].Status.Used[storage]
+	if used.Value() != 123 {
+		t.Errorf("unexpected usage: %s", used.String())
+	}
+	listed := 0
+	for _, action := range fake.Actions() {
+		if action.Matches("list", "imagestreams") {
+			listed++
+		}
+	}
+	if listed != 1 {
+		t.Errorf("expected the image streams of the project to be listed once, got %d", listed)
+	}
+
+	// the layers of the images are not retrieved again by later runs
+	if err := controller.RunOnce(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, count := range gets {
+		if count != 1 {
+			t.Errorf("expected image %s to be retrieved once, got %d", name, count)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+// Package quota computes the storage used by the images pushed to the image
+// streams of projects, and keeps the usage recorded on the quotas which limit it.
+package quota
@@ -0,0 +1,149 @@
+package quota
+
+import (
+	"github.com/golang/glog"
+	"github.com/hashicorp/golang-lru"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+// LayerSet holds the sizes of a set of image layers, keyed by the digests of
+// the layers. A layer shared by several images is only counted once.
+type LayerSet map[string]int64
+
+// AddImage adds the layers of image to s.
+func (s LayerSet) AddImage(image *imageapi.Image) {
+	s.addLayers(ImageLayers(image))
+}
+
+// Size returns the total size of the layers in s.
+func (s LayerSet) Size() int64 {
+	size := int64(0)
+	for _, layerSize := range s {
+		size += layerSize
+	}
+	return size
+}
+
+func (s LayerSet) addLayers(layers []imageapi.ImageLayer) {
+	for _, layer := range layers {
+		s[layer.Name] = layer.Size
+	}
+}
+
+// ImageLayers returns the layers of image with their sizes. The layers of
+// images created before their sizes were recorded are read from the manifest.
+func ImageLayers(image *imageapi.Image) []imageapi.ImageLayer {
+	if len(image.DockerImageLayers) > 0 || len(image.DockerImageManifest) == 0 {
+		return image.DockerImageLayers
+	}
+	withMetadata, err := imageapi.ImageWithMetadata(*image)
+	if err != nil {
+		glog.V(4).Infof("Unable to read the layers of image %s: %v", image.Name, err)
+		return nil
+	}
+	return withMetadata.DockerImageLayers
+}
+
+// ImageGetter retrieves images by name.
+type ImageGetter interface {
+	Get(name string) (*imageapi.Image, error)
+}
+
+// LayerCache remembers the layers of images by the names of the images. Images
+// are immutable, so a cache can be shared by the accountants of a process. It
+// is safe for concurrent use.
+type LayerCache struct {
+	cache *lru.Cache
+}
+
+// NewLayerCache returns a cache of the layers of at most size images.
+func NewLayerCache(size int) *LayerCache {
+	cache, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+	return &LayerCache{cache: cache}
+}
+
+func (c *LayerCache) get(name string) ([]imageapi.ImageLayer, bool) {
+	layers, ok := c.cache.Get(name)
+	if !ok {
+		return nil, false
+	}
+	return layers.([]imageapi.ImageLayer), true
+}
+
+func (c *LayerCache) add(name string, layers []imageapi.ImageLayer) {
+	c.cache.Add(name, layers)
+}
+
+// Accountant computes the storage used by the images pushed to image streams.
+// Only images managed by the integrated registry are counted, since the layers
+// of other images are not stored by it. The layers of each image are retrieved
+// once, unless they are evicted from the cache.
+type Accountant struct {
+	images ImageGetter
+	// layers holds the layers of the images retrieved so far, nil for images
+	// which are missing or not managed by the integrated registry.
+	layers *LayerCache
+}
+
+// NewAccountant returns an Accountant which retrieves images from images and
+// remembers their layers in layers.
+func NewAccountant(images ImageGetter, layers *LayerCache) *Accountant {
+	return &Accountant{
+		images: images,
+		layers: layers,
+	}
+}
+
+// AddImageStream adds the layers of the images pushed to stream to layers.
+func (a *Accountant) AddImageStream(layers LayerSet, stream *imageapi.ImageStream) error {
+	for _, history := range stream.Status.Tags {
+		for _, event := range history.Items {
+			if len(event.Image) == 0 {
+				continue
+			}
+			imageLayers, err := a.imageLayers(event.Image)
+			if err != nil {
+				return err
+			}
+			layers.addLayers(imageLayers)
+		}
+	}
+	return nil
+}
+
+// ProjectLayers returns the layers of the images pushed to streams, which are
+// the image streams of a project.
+func (a *Accountant) ProjectLayers(streams []imageapi.ImageStream) (LayerSet, error) {
+	layers := LayerSet{}
+	for i := range streams {
+		if err := a.AddImageStream(layers, &streams[i]); err != nil {
+			return nil, err
+		}
+	}
+	return layers, nil
+}
+
+func (a *Accountant) imageLayers(name string) ([]imageapi.ImageLayer, error) {
+	if layers, ok := a.layers.get(name); ok {
+		return layers, nil
+	}
+	image, err := a.images.Get(name)
+	switch {
+	case kerrors.IsNotFound(err):
+		a.layers.add(name, nil)
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	var layers []imageapi.ImageLayer
+	if image.Annotations[imageapi.ManagedByOpenShiftAnnotation] == "true" {
+		layers = ImageLayers(image)
+	}
+	a.layers.add(name, layers)
+	return layers, nil
+}
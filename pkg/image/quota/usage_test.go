@@ -0,0 +1,124 @@
+package quota
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	imageapi "github.com/openshift/origin/pkg/image/api"
+)
+
+func managedImage(name string, layers ...imageapi.ImageLayer) *imageapi.Image {
+	return &imageapi.Image{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{imageapi.ManagedByOpenShiftAnnotation: "true"},
+		},
+		DockerImageLayers: layers,
+	}
+}
+
+func imageStream(namespace, name string, images ...string) imageapi.ImageStream {
+	stream := imageapi.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{Namespace: namespace, Name: name},
+		Status:     imageapi.ImageStreamStatus{Tags: map[string]imageapi.TagEventList{}},
+	}
+	for i, image := range images {
+		tag := "latest"
+		if i > 0 {
+			tag = "v" + image
+		}
+		history := stream.Status.Tags[tag]
+		history.Items = append(history.Items, imageapi.TagEvent{Image: image})
+		stream.Status.Tags[tag] = history
+	}
+	return stream
+}
+
+// fakeImages returns a client which returns images, and counts the images it
+// retrieves in gets.
+func fakeImages(gets map[string]int, images ...*imageapi.Image) *testclient.Fake {
+	fake := &testclient.Fake{}
+	fake.AddReactor("get", "images", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		name := action.(ktestclient.GetAction).GetName()
+		gets[name]++
+		for _, image := range images {
+			if image.Name == name {
+				return true, image, nil
+			}
+		}
+		return true, nil, kerrors.NewNotFound("image", name)
+	})
+	return fake
+}
+
+func TestAddImageStream(t *testing.T) {
+	gets := map[string]int{}
+	fake := fakeImages(gets,
+		managedImage("image1", imageapi.ImageLayer{Name: "layer1", Size: 100}, imageapi.ImageLayer{Name: "layer2", Size: 20}),
+		managedImage("image2", imageapi.ImageLayer{Name: "layer1", Size: 100}, imageapi.ImageLayer{Name: "layer3", Size: 3}),
+		&imageapi.Image{ObjectMeta: kapi.ObjectMeta{Name: "external"}, DockerImageLayers: []imageapi.ImageLayer{{Name: "layer4", Size: 1000}}},
+	)
+	cache := NewLayerCache(10)
+
+	stream := imageStream("user", "ruby", "image1", "image2", "external", "missing", "image1")
+	layers := LayerSet{}
+	if err := NewAccountant(fake.Images(), cache).AddImageStream(layers, &stream); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if layers.Size() != 123 {
+		t.Errorf("expected the shared layer to be counted once and external images to be skipped, got %d", layers.Size())
+	}
+
+	// the layers of the images are remembered by the cache shared by accountants
+	layers = LayerSet{}
+	if err := NewAccountant(fake.Images(), cache).AddImageStream(layers, &stream); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if layers.Size() != 123 {
+		t.Errorf("unexpected size with cached layers: %d", layers.Size())
+	}
+	for name, count := range gets {
+		if count != 1 {
+			t.Errorf("expected image %s to be retrieved once, got %d", name, count)
+		}
+	}
+}
+
+func TestProjectLayers(t *testing.T) {
+	fake := fakeImages(map[string]int{},
+		managedImage("image1", imageapi.ImageLayer{Name: "layer1", Size: 100}, imageapi.ImageLayer{Name: "layer2", Size: 20}),
+		managedImage("image2", imageapi.ImageLayer{Name: "layer1", Size: 100}, imageapi.ImageLayer{Name: "layer3", Size: 3}),
+	)
+	streams := []imageapi.ImageStream{
+		imageStream("user", "ruby", "image1"),
+		imageStream("user", "rails", "image2"),
+	}
+
+	layers, err := NewAccountant(fake.Images(), NewLayerCache(10)).ProjectLayers(streams)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if layers.Size() != 123 {
+		t.Errorf("expected the layers shared by image streams to be counted once, got %d", layers.Size())
+	}
+
+	layers.AddImage(managedImage("image3", imageapi.ImageLayer{Name: "layer2", Size: 20}, imageapi.ImageLayer{Name: "layer5", Size: 5000}))
+	if layers.Size() != 5123 {
+		t.Errorf("unexpected size after adding an image: %d", layers.Size())
+	}
+}
+
+func TestImageLayersFromManifest(t *testing.T) {
+	image := &imageapi.Image{
+		DockerImageManifest: `{"schemaVersion":1,"fsLayers":[{"blobSum":"sha256:top"},{"blobSum":"sha256:base"}],"history":[{"v1Compatibility":"{\"id\":\"2\",\"Size\":20}"},{"v1Compatibility":"{\"id\":\"1\",\"Size\":10}"}]}`,
+	}
+	layers := ImageLayers(image)
+	if len(layers) != 2 || layers[0].Size != 10 || layers[1].Size != 20 {
+		t.Errorf("unexpected layers: %#v", layers)
+	}
+}
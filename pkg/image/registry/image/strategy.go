@@ -31,7 +31,16 @@ func (imageStrategy) NamespaceScoped() bool {
 }
 
 // PrepareForCreate clears fields that are not allowed to be set by end users on creation.
+// The metadata and layers of images with a manifest are computed from the manifest.
 func (imageStrategy) PrepareForCreate(obj runtime.Object) {
+	image := obj.(*api.Image)
+	withMetadata, err := api.ImageWithMetadata(*image)
+	if err != nil {
+		// invalid manifests are reported by validation
+		return
+	}
+	image.DockerImageMetadata = withMetadata.DockerImageMetadata
+	image.DockerImageLayers = withMetadata.DockerImageLayers
 }
 
 // Validate validates a new image.
@@ -57,6 +66,7 @@ func (imageStrategy) PrepareForUpdate(obj, old runtime.Object) {
 	newImage.DockerImageMetadata = oldImage.DockerImageMetadata
 	newImage.DockerImageManifest = oldImage.DockerImageManifest
 	newImage.DockerImageMetadataVersion = oldImage.DockerImageMetadataVersion
+	newImage.DockerImageLayers = oldImage.DockerImageLayers
 }
 
 // ValidateUpdate is the default update validation for an end user.
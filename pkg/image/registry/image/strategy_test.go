@@ -0,0 +1,49 @@
+package image
+
+import (
+	"reflect"
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+
+	"github.com/openshift/origin/pkg/image/api"
+)
+
+func TestImageStrategyPrepareForCreate(t *testing.T) {
+	image := &api.Image{
+		ObjectMeta:           kapi.ObjectMeta{Name: "sha256:58ad1a2ba8aa7d0ff8a3fef3bd8e5bc7b1bb2d0c6e4a3d52ab3acaf6ad1b5c35"},
+		DockerImageReference: "registry:5000/test/image@sha256:58ad1a2ba8aa7d0ff8a3fef3bd8e5bc7b1bb2d0c6e4a3d52ab3acaf6ad1b5c35",
+		DockerImageManifest:  `{"schemaVersion":1,"name":"test/image","tag":"latest","architecture":"amd64","fsLayers":[{"blobSum":"sha256:b13e5a3c8f6c38f1d4ff98b5c63b6a5a1c6e1b7b2a7f9d3d2c0d8e0b9a8f7e6d"},{"blobSum":"sha256:e2c2a0a1b0ef1ec2c2c8b5b8b9e0f5a1ce4f69a7c0d9cba0a58e3cb7a0b3e5f0"}],"history":[{"v1Compatibility":"{\"id\":\"2\",\"parent\":\"1\",\"architecture\":\"amd64\",\"Size\":1024}"},{"v1Compatibility":"{\"id\":\"1\",\"Size\":2291}"}]}`,
+	}
+	Strategy.PrepareForCreate(image)
+
+	if len(image.DockerImageManifest) == 0 {
+		t.Errorf("the manifest should be kept: %#v", image)
+	}
+	expectedLayers := []api.ImageLayer{
+		{Name: "sha256:e2c2a0a1b0ef1ec2c2c8b5b8b9e0f5a1ce4f69a7c0d9cba0a58e3cb7a0b3e5f0", Size: 2291},
+		{Name: "sha256:b13e5a3c8f6c38f1d4ff98b5c63b6a5a1c6e1b7b2a7f9d3d2c0d8e0b9a8f7e6d", Size: 1024},
+	}
+	if !reflect.DeepEqual(expectedLayers, image.DockerImageLayers) {
+		t.Errorf("unexpected layers: %#v", image.DockerImageLayers)
+	}
+	if image.DockerImageMetadata.Size != 3315 || image.DockerImageMetadata.Architecture != "amd64" {
+		t.Errorf("unexpected metadata: %#v", image.DockerImageMetadata)
+	}
+	if errs := Strategy.Validate(kapi.NewContext(), image); len(errs) != 0 {
+		t.Errorf("unexpected validation errors: %v", errs)
+	}
+}
+
+func TestImageStrategyValidateInvalidManifest(t *testing.T) {
+	image := &api.Image{
+		ObjectMeta:           kapi.ObjectMeta{Name: "sha256:58ad1a2ba8aa7d0ff8a3fef3bd8e5bc7b1bb2d0c6e4a3d52ab3acaf6ad1b5c35"},
+		DockerImageReference: "registry:5000/test/image@sha256:58ad1a2ba8aa7d0ff8a3fef3bd8e5bc7b1bb2d0c6e4a3d52ab3acaf6ad1b5c35",
+		// schema 2 manifests are not supported
+		DockerImageManifest: `{"schemaVersion":2,"mediaType":"application/vnd.docker.distribution.manifest.v2+json"}`,
+	}
+	Strategy.PrepareForCreate(image)
+	if errs := Strategy.Validate(kapi.NewContext(), image); len(errs) != 1 {
+		t.Errorf("expected a single validation error, got %v", errs)
+	}
+}
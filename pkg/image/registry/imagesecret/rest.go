@@ -0,0 +1,53 @@
+package imagesecret
+
+import (
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+)
+
+// REST implements the RESTStorage interface for the secrets of an image stream.
+// It returns the secrets in the namespace of the image stream which may be used
+// to pull its images from other Docker registries.
+type REST struct {
+	secrets kclient.SecretsNamespacer
+}
+
+// NewREST returns a new REST which retrieves secrets with the given client.
+func NewREST(secrets kclient.SecretsNamespacer) *REST {
+	return &REST{secrets: secrets}
+}
+
+var _ = rest.Getter(&REST{})
+
+// New returns a new SecretList.
+func (r *REST) New() runtime.Object {
+	return &kapi.SecretList{}
+}
+
+// Get returns the Docker configuration secrets in the namespace of the image
+// stream named name.
+func (r *REST) Get(ctx kapi.Context, name string) (runtime.Object, error) {
+	ns, ok := kapi.NamespaceFrom(ctx)
+	if !ok {
+		return nil, errors.NewBadRequest("a namespace must be specified to retrieve secrets")
+	}
+	secrets, err := r.secrets.Secrets(ns).List(labels.Everything(), fields.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]kapi.Secret, 0, len(secrets.Items))
+	for _, secret := range secrets.Items {
+		switch secret.Type {
+		case kapi.SecretTypeDockercfg, kapi.SecretTypeDockerConfigJson:
+			filtered = append(filtered, secret)
+		}
+	}
+	secrets.Items = filtered
+	return secrets, nil
+}
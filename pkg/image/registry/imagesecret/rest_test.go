@@ -0,0 +1,38 @@
+package imagesecret
+
+import (
+	"testing"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/unversioned/testclient"
+)
+
+func TestGetSecrets(t *testing.T) {
+	fake := testclient.NewSimpleFake(&kapi.SecretList{
+		Items: []kapi.Secret{
+			{ObjectMeta: kapi.ObjectMeta{Name: "secret-1", Namespace: "default"}, Type: kapi.SecretTypeDockercfg},
+			{ObjectMeta: kapi.ObjectMeta{Name: "secret-2", Namespace: "default"}, Type: kapi.SecretTypeOpaque},
+			{ObjectMeta: kapi.ObjectMeta{Name: "secret-3", Namespace: "default"}, Type: kapi.SecretTypeDockerConfigJson},
+		},
+	})
+	rest := NewREST(fake)
+	obj, err := rest.Get(kapi.WithNamespace(kapi.NewContext(), "default"), "stream")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	list := obj.(*kapi.SecretList)
+	if len(list.Items) != 2 || list.Items[0].Name != "secret-1" || list.Items[1].Name != "secret-3" {
+		t.Errorf("unexpected secrets: %#v", list.Items)
+	}
+	actions := fake.Actions()
+	if len(actions) != 1 || !actions[0].Matches("list", "secrets") || actions[0].GetNamespace() != "default" {
+		t.Errorf("unexpected actions: %#v", actions)
+	}
+}
+
+func TestGetSecretsRequiresNamespace(t *testing.T) {
+	rest := NewREST(testclient.NewSimpleFake())
+	if _, err := rest.Get(kapi.NewContext(), "stream"); err == nil {
+		t.Fatalf("expected an error")
+	}
+}
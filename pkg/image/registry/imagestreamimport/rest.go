@@ -0,0 +1,328 @@
+package imagestreamimport
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/rest"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/fielderrors"
+
+	"github.com/openshift/origin/pkg/dockerregistry"
+	"github.com/openshift/origin/pkg/image/api"
+	"github.com/openshift/origin/pkg/image/api/validation"
+	"github.com/openshift/origin/pkg/image/registry/imagestream"
+)
+
+// REST implements the RESTStorage interface for ImageStreamImport. It only
+// supports the Create method, which retrieves the requested images from their
+// Docker registries and optionally records them on an image stream.
+type REST struct {
+	streams  imagestream.Registry
+	mappings rest.Creater
+	client   dockerregistry.Client
+	// maximumTags is the maximum number of tags imported from a repository.
+	maximumTags int
+}
+
+// NewREST returns a new REST. Images are recorded on image streams by creating
+// image stream mappings with mappings, and at most maximumTags tags of a
+// repository are imported by one request.
+func NewREST(streams imagestream.Registry, mappings rest.Creater, client dockerregistry.Client, maximumTags int) *REST {
+	return &REST{
+		streams:     streams,
+		mappings:    mappings,
+		client:      client,
+		maximumTags: maximumTags,
+	}
+}
+
+// imageStreamImportStrategy implements behavior for image stream imports.
+type imageStreamImportStrategy struct {
+	runtime.ObjectTyper
+	kapi.NameGenerator
+}
+
+// Strategy is the default logic that applies when creating ImageStreamImport
+// objects via the REST API.
+var Strategy = imageStreamImportStrategy{kapi.Scheme, kapi.SimpleNameGenerator}
+
+// NamespaceScoped is true for image stream imports.
+func (s imageStreamImportStrategy) NamespaceScoped() bool {
+	return true
+}
+
+// PrepareForCreate clears the status, which is only set by the server.
+func (s imageStreamImportStrategy) PrepareForCreate(obj runtime.Object) {
+	isi := obj.(*api.ImageStreamImport)
+	isi.Status = api.ImageStreamImportStatus{}
+}
+
+// Validate validates a new ImageStreamImport.
+func (s imageStreamImportStrategy) Validate(ctx kapi.Context, obj runtime.Object) fielderrors.ValidationErrorList {
+	isi := obj.(*api.ImageStreamImport)
+	return validation.ValidateImageStreamImport(isi)
+}
+
+// New returns a new ImageStreamImport for use with Create.
+func (r *REST) New() runtime.Object {
+	return &api.ImageStreamImport{}
+}
+
+// Create retrieves the requested images and returns the result of each
+// retrieval in the status of the import. If spec.import is set, the image
+// stream is created or updated to track the images which were found, and the
+// resulting stream is returned in status.import.
+func (r *REST) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	if err := rest.BeforeCreate(Strategy, ctx, obj); err != nil {
+		return nil, err
+	}
+	isi := obj.(*api.ImageStreamImport)
+
+	retrieved := make(map[string]*api.Image)
+	if spec := isi.Spec.Repository; spec != nil {
+		isi.Status.Repository = r.importRepository(spec, retrieved)
+	}
+	for _, spec := range isi.Spec.Images {
+		isi.Status.Images = append(isi.Status.Images, r.importImage(spec, retrieved))
+	}
+
+	if !isi.Spec.Import {
+		return isi, nil
+	}
+	stream, err := r.importIntoStream(ctx, isi)
+	if err != nil {
+		return nil, err
+	}
+	isi.Status.Import = stream
+	return isi, nil
+}
+
+// importRepository retrieves the tags of the repository described by spec, up
+// to the maximum number of tags allowed. Images retrieved are added to retrieved
+// by their ID.
+func (r *REST) importRepository(spec *api.RepositoryImportSpec, retrieved map[string]*api.Image) *api.RepositoryImportStatus {
+	status := &api.RepositoryImportStatus{}
+	ref, err := api.ParseDockerImageReference(spec.From.Name)
+	if err != nil {
+		status.Status = statusForError(err)
+		return status
+	}
+	conn, err := r.client.Connect(ref.Registry, spec.ImportPolicy.Insecure)
+	if err != nil {
+		status.Status = statusForError(err)
+		return status
+	}
+	tags, err := conn.ImageTags(ref.Namespace, ref.Name)
+	if err != nil {
+		status.Status = statusForError(err)
+		return status
+	}
+
+	names := make([]string, 0, len(tags))
+	for tag := range tags {
+		names = append(names, tag)
+	}
+	sort.Strings(names)
+	if len(names) > r.maximumTags {
+		status.AdditionalTags = names[r.maximumTags:]
+		names = names[:r.maximumTags]
+	}
+
+	for _, tag := range names {
+		tagRef := ref
+		tagRef.Tag = tag
+		image, err := retrieveImage(conn, tagRef, tags[tag], retrieved)
+		imageStatus := api.ImageImportStatus{Status: statusForError(err), Image: image, Tag: tag}
+		status.Images = append(status.Images, imageStatus)
+	}
+	status.Status = unversioned.Status{Status: unversioned.StatusSuccess}
+	return status
+}
+
+// importImage retrieves the image described by spec.
+func (r *REST) importImage(spec api.ImageImportSpec, retrieved map[string]*api.Image) api.ImageImportStatus {
+	status := api.ImageImportStatus{}
+	ref, err := api.ParseDockerImageReference(spec.From.Name)
+	if err != nil {
+		status.Status = statusForError(err)
+		return status
+	}
+	if len(ref.Tag) == 0 && len(ref.ID) == 0 {
+		ref.Tag = api.DefaultImageTag
+	}
+	status.Tag = ref.Tag
+
+	conn, err := r.client.Connect(ref.Registry, spec.ImportPolicy.Insecure)
+	if err != nil {
+		status.Status = statusForError(err)
+		return status
+	}
+	image, err := retrieveImage(conn, ref, ref.ID, retrieved)
+	status.Status = statusForError(err)
+	status.Image = image
+	return status
+}
+
+// retrieveImage returns the image referenced by ref, which has the given ID
+// if known. Images are fetched from conn unless they are already in retrieved.
+func retrieveImage(conn dockerregistry.Connection, ref api.DockerImageReference, id string, retrieved map[string]*api.Image) (*api.Image, error) {
+	if image, ok := retrieved[id]; ok && len(id) > 0 {
+		return image, nil
+	}
+
+	var dockerImage *dockerregistry.Image
+	var err error
+	if len(ref.ID) > 0 {
+		dockerImage, err = conn.ImageByID(ref.Namespace, ref.Name, ref.ID)
+	} else {
+		dockerImage, err = conn.ImageByTag(ref.Namespace, ref.Name, ref.Tag)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var metadata api.DockerImage
+	if err := kapi.Scheme.Convert(&dockerImage.Image, &metadata); err != nil {
+		return nil, fmt.Errorf("could not convert image: %v", err)
+	}
+	// prefer to pull by ID always
+	if dockerImage.PullByID {
+		ref.Tag = ""
+		ref.ID = dockerImage.ID
+	}
+	image := &api.Image{
+		ObjectMeta: kapi.ObjectMeta{
+			Name: dockerImage.ID,
+		},
+		DockerImageReference: ref.String(),
+		DockerImageMetadata:  metadata,
+	}
+	retrieved[dockerImage.ID] = image
+	return image, nil
+}
+
+// statusForError returns the status of an image retrieval which failed with
+// err, or a successful status if err is nil.
+func statusForError(err error) unversioned.Status {
+	switch {
+	case err == nil:
+		return unversioned.Status{Status: unversioned.StatusSuccess}
+	case dockerregistry.IsNotFound(err):
+		return unversioned.Status{
+			Status:  unversioned.StatusFailure,
+			Code:    http.StatusNotFound,
+			Reason:  unversioned.StatusReasonNotFound,
+			Message: err.Error(),
+		}
+	default:
+		return unversioned.Status{
+			Status:  unversioned.StatusFailure,
+			Code:    http.StatusInternalServerError,
+			Reason:  unversioned.StatusReasonInternalError,
+			Message: err.Error(),
+		}
+	}
+}
+
+// importIntoStream creates or updates the image stream named by isi so that
+// the images in spec.images with a destination tag are tracked by the stream,
+// and tags the images which were retrieved into the stream.
+func (r *REST) importIntoStream(ctx kapi.Context, isi *api.ImageStreamImport) (*api.ImageStream, error) {
+	stream, err := r.streams.GetImageStream(ctx, isi.Name)
+	create := false
+	switch {
+	case errors.IsNotFound(err):
+		create = true
+		stream = &api.ImageStream{
+			ObjectMeta: kapi.ObjectMeta{
+				Name:      isi.Name,
+				Namespace: isi.Namespace,
+			},
+		}
+	case err != nil:
+		return nil, err
+	}
+
+	if stream.Spec.Tags == nil {
+		stream.Spec.Tags = make(map[string]api.TagReference)
+	}
+	for i, spec := range isi.Spec.Images {
+		if spec.To == nil || isi.Status.Images[i].Image == nil {
+			continue
+		}
+		tagRef := stream.Spec.Tags[spec.To.Name]
+		tagRef.From = &kapi.ObjectReference{Kind: "DockerImage", Name: spec.From.Name}
+		tagRef.Reference = false
+		tagRef.ImportPolicy = spec.ImportPolicy
+		stream.Spec.Tags[spec.To.Name] = tagRef
+	}
+	// the images are imported below, so the import controller does not need to
+	// import the stream again, unless tags of the repository were skipped: the
+	// controller then imports the whole repository in the background, which
+	// it finds through the docker image repository of the stream
+	if status := isi.Status.Repository; status != nil && len(status.AdditionalTags) > 0 {
+		if len(stream.Spec.DockerImageRepository) == 0 {
+			stream.Spec.DockerImageRepository = isi.Spec.Repository.From.Name
+		}
+		delete(stream.Annotations, api.DockerImageRepositoryCheckAnnotation)
+	} else {
+		if stream.Annotations == nil {
+			stream.Annotations = make(map[string]string)
+		}
+		stream.Annotations[api.DockerImageRepositoryCheckAnnotation] = unversioned.Now().UTC().Format(time.RFC3339)
+	}
+
+	if create {
+		stream, err = r.streams.CreateImageStream(ctx, stream)
+	} else {
+		stream, err = r.streams.UpdateImageStream(ctx, stream)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if status := isi.Status.Repository; status != nil {
+		for _, imageStatus := range status.Images {
+			if err := r.tagImage(ctx, stream, imageStatus.Tag, imageStatus.Image); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for i, spec := range isi.Spec.Images {
+		if spec.To == nil {
+			continue
+		}
+		if err := r.tagImage(ctx, stream, spec.To.Name, isi.Status.Images[i].Image); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.streams.GetImageStream(ctx, stream.Name)
+}
+
+// tagImage records image as the latest image of tag in stream. Nothing is
+// recorded if image is nil.
+func (r *REST) tagImage(ctx kapi.Context, stream *api.ImageStream, tag string, image *api.Image) error {
+	if image == nil {
+		return nil
+	}
+	glog.V(5).Infof("Importing image %s into %s/%s:%s", image.DockerImageReference, stream.Namespace, stream.Name, tag)
+	mapping := &api.ImageStreamMapping{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:      stream.Name,
+			Namespace: stream.Namespace,
+		},
+		Tag:   tag,
+		Image: *image,
+	}
+	_, err := r.mappings.Create(ctx, mapping)
+	return err
+}
@@ -0,0 +1,324 @@
+package imagestreamimport
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/fsouza/go-dockerclient"
+	kapi "k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/fields"
+	"k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/openshift/origin/pkg/dockerregistry"
+	"github.com/openshift/origin/pkg/image/api"
+)
+
+func TestCreatePreview(t *testing.T) {
+	client := &fakeDockerRegistryClient{
+		Tags: map[string]string{"v1": "id1", "v2": "sha256:958608f8ecc1dc62c93b6c610f3a834dae4220c9642e6e8b4e0f2b3ad7cbd238", "v3": "id3"},
+		Images: map[string]*dockerregistry.Image{
+			"v1": {Image: docker.Image{ID: "id1"}},
+			"v2": {Image: docker.Image{ID: "sha256:958608f8ecc1dc62c93b6c610f3a834dae4220c9642e6e8b4e0f2b3ad7cbd238"}, PullByID: true},
+			"v3": {Image: docker.Image{ID: "id3"}},
+		},
+	}
+	storage := NewREST(&fakeImageStreamRegistry{}, nil, client, 2)
+
+	isi := &api.ImageStreamImport{
+		ObjectMeta: kapi.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: api.ImageStreamImportSpec{
+			Repository: &api.RepositoryImportSpec{From: kapi.ObjectReference{Kind: "DockerImage", Name: "registry:5000/foo/bar"}},
+			Images: []api.ImageImportSpec{
+				{From: kapi.ObjectReference{Kind: "DockerImage", Name: "registry:5000/foo/bar:v1"}},
+				{From: kapi.ObjectReference{Kind: "DockerImage", Name: "registry:5000/foo/bar:missing"}},
+			},
+		},
+	}
+	obj, err := storage.Create(kapi.NewDefaultContext(), isi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	status := obj.(*api.ImageStreamImport).Status
+
+	if status.Import != nil {
+		t.Errorf("unexpected import: %#v", status.Import)
+	}
+	repository := status.Repository
+	if repository == nil || repository.Status.Status != unversioned.StatusSuccess {
+		t.Fatalf("unexpected repository status: %#v", repository)
+	}
+	if !reflect.DeepEqual(repository.AdditionalTags, []string{"v3"}) {
+		t.Errorf("unexpected additional tags: %v", repository.AdditionalTags)
+	}
+	if len(repository.Images) != 2 {
+		t.Fatalf("unexpected repository images: %#v", repository.Images)
+	}
+	if e, a := "registry:5000/foo/bar:v1", repository.Images[0].Image.DockerImageReference; e != a {
+		t.Errorf("expected %s, got %s", e, a)
+	}
+	if e, a := "registry:5000/foo/bar@sha256:958608f8ecc1dc62c93b6c610f3a834dae4220c9642e6e8b4e0f2b3ad7cbd238", repository.Images[1].Image.DockerImageReference; e != a {
+		t.Errorf("expected %s, got %s", e, a)
+	}
+
+	if len(status.Images) != 2 {
+		t.Fatalf("unexpected images: %#v", status.Images)
+	}
+	if status.Images[0].Status.Status != unversioned.StatusSuccess || status.Images[0].Image == nil || status.Images[0].Image.Name != "id1" {
+		t.Errorf("unexpected image status: %#v", status.Images[0])
+	}
+	if status.Images[1].Status.Code != http.StatusNotFound || status.Images[1].Image != nil {
+		t.Errorf("unexpected image status: %#v", status.Images[1])
+	}
+}
+
+func TestCreateImport(t *testing.T) {
+	client := &fakeDockerRegistryClient{
+		Images: map[string]*dockerregistry.Image{
+			"v1": {Image: docker.Image{ID: "id1"}},
+		},
+	}
+	var created *api.ImageStream
+	streams := &fakeImageStreamRegistry{
+		getImageStream: func(ctx kapi.Context, id string) (*api.ImageStream, error) {
+			if created == nil {
+				return nil, errors.NewNotFound("imageStream", id)
+			}
+			return created, nil
+		},
+		createImageStream: func(ctx kapi.Context, stream *api.ImageStream) (*api.ImageStream, error) {
+			created = stream
+			return stream, nil
+		},
+	}
+	mappings := &fakeMappings{}
+	storage := NewREST(streams, mappings, client, 5)
+
+	isi := &api.ImageStreamImport{
+		ObjectMeta: kapi.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: api.ImageStreamImportSpec{
+			Import: true,
+			Images: []api.ImageImportSpec{
+				{From: kapi.ObjectReference{Kind: "DockerImage", Name: "foo/bar:v1"}, To: &kapi.LocalObjectReference{Name: "latest"}, ImportPolicy: api.TagImportPolicy{Scheduled: true}},
+				{From: kapi.ObjectReference{Kind: "DockerImage", Name: "foo/bar:missing"}, To: &kapi.LocalObjectReference{Name: "missing"}},
+			},
+		},
+	}
+	obj, err := storage.Create(kapi.NewDefaultContext(), isi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	status := obj.(*api.ImageStreamImport).Status
+
+	if created == nil || status.Import != created {
+		t.Fatalf("expected the created stream to be returned, got %#v", status.Import)
+	}
+	if len(created.Annotations[api.DockerImageRepositoryCheckAnnotation]) == 0 {
+		t.Errorf("expected the stream to be marked as imported: %#v", created.Annotations)
+	}
+	expected := map[string]api.TagReference{
+		"latest": {
+			From:         &kapi.ObjectReference{Kind: "DockerImage", Name: "foo/bar:v1"},
+			ImportPolicy: api.TagImportPolicy{Scheduled: true},
+		},
+	}
+	if !reflect.DeepEqual(expected, created.Spec.Tags) {
+		t.Errorf("unexpected spec tags: %#v", created.Spec.Tags)
+	}
+	if len(mappings.created) != 1 {
+		t.Fatalf("expected a single mapping, got %#v", mappings.created)
+	}
+	if mapping := mappings.created[0]; mapping.Name != "test" || mapping.Tag != "latest" || mapping.Image.Name != "id1" {
+		t.Errorf("unexpected mapping: %#v", mapping)
+	}
+}
+
+func TestCreateImportSkippedTags(t *testing.T) {
+	client := &fakeDockerRegistryClient{
+		Tags: map[string]string{"v1": "id1", "v2": "id2"},
+		Images: map[string]*dockerregistry.Image{
+			"v1": {Image: docker.Image{ID: "id1"}},
+			"v2": {Image: docker.Image{ID: "id2"}},
+		},
+	}
+	existing := &api.ImageStream{
+		ObjectMeta: kapi.ObjectMeta{
+			Name:        "test",
+			Namespace:   "default",
+			Annotations: map[string]string{api.DockerImageRepositoryCheckAnnotation: "2015-01-01T00:00:00Z"},
+		},
+		Spec: api.ImageStreamSpec{DockerImageRepository: "foo/bar"},
+	}
+	var updated *api.ImageStream
+	streams := &fakeImageStreamRegistry{
+		getImageStream: func(ctx kapi.Context, id string) (*api.ImageStream, error) {
+			if updated != nil {
+				return updated, nil
+			}
+			return existing, nil
+		},
+		updateImageStream: func(ctx kapi.Context, stream *api.ImageStream) (*api.ImageStream, error) {
+			updated = stream
+			return stream, nil
+		},
+	}
+	mappings := &fakeMappings{}
+	storage := NewREST(streams, mappings, client, 1)
+
+	isi := &api.ImageStreamImport{
+		ObjectMeta: kapi.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: api.ImageStreamImportSpec{
+			Import:     true,
+			Repository: &api.RepositoryImportSpec{From: kapi.ObjectReference{Kind: "DockerImage", Name: "foo/bar"}},
+		},
+	}
+	obj, err := storage.Create(kapi.NewDefaultContext(), isi)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if repository := obj.(*api.ImageStreamImport).Status.Repository; repository == nil || !reflect.DeepEqual(repository.AdditionalTags, []string{"v2"}) {
+		t.Fatalf("unexpected repository status: %#v", repository)
+	}
+	if len(mappings.created) != 1 || mappings.created[0].Tag != "v1" {
+		t.Errorf("expected only the first tag to be imported, got %#v", mappings.created)
+	}
+	// the import controller imports the skipped tags
+	if updated == nil {
+		t.Fatalf("expected the stream to be updated")
+	}
+	if _, ok := updated.Annotations[api.DockerImageRepositoryCheckAnnotation]; ok {
+		t.Errorf("expected the stream to be left for the import controller: %#v", updated.Annotations)
+	}
+}
+
+func TestCreateImportSkippedTagsNewStream(t *testing.T) {
+	client := &fakeDockerRegistryClient{
+		Tags: map[string]string{"v1": "id1", "v2": "id2"},
+		Images: map[string]*dockerregistry.Image{
+			"v1": {Image: docker.Image{ID: "id1"}},
+			"v2": {Image: docker.Image{ID: "id2"}},
+		},
+	}
+	var created *api.ImageStream
+	streams := &fakeImageStreamRegistry{
+		getImageStream: func(ctx kapi.Context, id string) (*api.ImageStream, error) {
+			if created == nil {
+				return nil, errors.NewNotFound("imageStream", id)
+			}
+			return created, nil
+		},
+		createImageStream: func(ctx kapi.Context, stream *api.ImageStream) (*api.ImageStream, error) {
+			created = stream
+			return stream, nil
+		},
+	}
+	mappings := &fakeMappings{}
+	storage := NewREST(streams, mappings, client, 1)
+
+	isi := &api.ImageStreamImport{
+		ObjectMeta: kapi.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: api.ImageStreamImportSpec{
+			Import:     true,
+			Repository: &api.RepositoryImportSpec{From: kapi.ObjectReference{Kind: "DockerImage", Name: "foo/bar"}},
+		},
+	}
+	if _, err := storage.Create(kapi.NewDefaultContext(), isi); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if created == nil {
+		t.Fatalf("expected the stream to be created")
+	}
+	// the import controller needs the repository to import the skipped tags
+	if created.Spec.DockerImageRepository != "foo/bar" {
+		t.Errorf("expected the stream to track the imported repository, got %q", created.Spec.DockerImageRepository)
+	}
+	if _, ok := created.Annotations[api.DockerImageRepositoryCheckAnnotation]; ok {
+		t.Errorf("expected the stream to be left for the import controller: %#v", created.Annotations)
+	}
+}
+
+func TestCreateInvalid(t *testing.T) {
+	storage := NewREST(&fakeImageStreamRegistry{}, nil, &fakeDockerRegistryClient{}, 5)
+	isi := &api.ImageStreamImport{ObjectMeta: kapi.ObjectMeta{Name: "test", Namespace: "default"}}
+	if _, err := storage.Create(kapi.NewDefaultContext(), isi); !errors.IsInvalid(err) {
+		t.Errorf("expected an invalid error, got %v", err)
+	}
+}
+
+type fakeDockerRegistryClient struct {
+	Tags   map[string]string
+	Images map[string]*dockerregistry.Image
+}
+
+func (f *fakeDockerRegistryClient) Connect(registry string, insecure bool) (dockerregistry.Connection, error) {
+	return f, nil
+}
+
+func (f *fakeDockerRegistryClient) ImageTags(namespace, name string) (map[string]string, error) {
+	return f.Tags, nil
+}
+
+func (f *fakeDockerRegistryClient) ImageByTag(namespace, name, tag string) (*dockerregistry.Image, error) {
+	if image, ok := f.Images[tag]; ok {
+		return image, nil
+	}
+	return nil, dockerregistry.NewImageNotFoundError(fmt.Sprintf("%s/%s", namespace, name), tag, tag)
+}
+
+func (f *fakeDockerRegistryClient) ImageByID(namespace, name, id string) (*dockerregistry.Image, error) {
+	for _, image := range f.Images {
+		if image.ID == id {
+			return image, nil
+		}
+	}
+	return nil, dockerregistry.NewImageNotFoundError(fmt.Sprintf("%s/%s", namespace, name), id, "")
+}
+
+type fakeMappings struct {
+	created []*api.ImageStreamMapping
+}
+
+func (f *fakeMappings) New() runtime.Object {
+	return &api.ImageStreamMapping{}
+}
+
+func (f *fakeMappings) Create(ctx kapi.Context, obj runtime.Object) (runtime.Object, error) {
+	f.created = append(f.created, obj.(*api.ImageStreamMapping))
+	return &unversioned.Status{Status: unversioned.StatusSuccess}, nil
+}
+
+type fakeImageStreamRegistry struct {
+	getImageStream    func(ctx kapi.Context, id string) (*api.ImageStream, error)
+	createImageStream func(ctx kapi.Context, repo *api.ImageStream) (*api.ImageStream, error)
+	updateImageStream func(ctx kapi.Context, repo *api.ImageStream) (*api.ImageStream, error)
+}
+
+func (f *fakeImageStreamRegistry) ListImageStreams(ctx kapi.Context, selector labels.Selector) (*api.ImageStreamList, error) {
+	return nil, fmt.Errorf("unexpected call")
+}
+func (f *fakeImageStreamRegistry) GetImageStream(ctx kapi.Context, id string) (*api.ImageStream, error) {
+	return f.getImageStream(ctx, id)
+}
+func (f *fakeImageStreamRegistry) CreateImageStream(ctx kapi.Context, repo *api.ImageStream) (*api.ImageStream, error) {
+	return f.createImageStream(ctx, repo)
+}
+func (f *fakeImageStreamRegistry) UpdateImageStream(ctx kapi.Context, repo *api.ImageStream) (*api.ImageStream, error) {
+	return f.updateImageStream(ctx, repo)
+}
+func (f *fakeImageStreamRegistry) UpdateImageStreamSpec(ctx kapi.Context, repo *api.ImageStream) (*api.ImageStream, error) {
+	return nil, fmt.Errorf("unexpected call")
+}
+func (f *fakeImageStreamRegistry) UpdateImageStreamStatus(ctx kapi.Context, repo *api.ImageStream) (*api.ImageStream, error) {
+	return nil, fmt.Errorf("unexpected call")
+}
+func (f *fakeImageStreamRegistry) DeleteImageStream(ctx kapi.Context, id string) (*unversioned.Status, error) {
+	return nil, fmt.Errorf("unexpected call")
+}
+func (f *fakeImageStreamRegistry) WatchImageStreams(ctx kapi.Context, label labels.Selector, field fields.Selector, resourceVersion string) (watch.Interface, error) {
+	return nil, fmt.Errorf("unexpected call")
+}
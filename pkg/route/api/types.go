@@ -49,6 +49,43 @@ type RoutePort struct {
 // RouteStatus provides relevant info about the status of a route, including which routers
 // acknowledge it.
 type RouteStatus struct {
+	// Ingress describes the places where the route may be exposed. Each router which has
+	// handled the route records whether it admitted the route.
+	Ingress []RouteIngress
+}
+
+// RouteIngress holds information about the places where a route is exposed
+type RouteIngress struct {
+	// Host is the host string under which the route is exposed; this value is required
+	Host string
+	// RouterName is the name of the router that has handled the route
+	RouterName string
+	// Conditions is the state of the route, may be empty.
+	Conditions []RouteIngressCondition
+}
+
+// RouteIngressConditionType is a valid value for RouteIngressCondition.Type
+type RouteIngressConditionType string
+
+// These are valid conditions of route ingresses.
+const (
+	// RouteAdmitted means the route is able to service requests for the provided Host
+	RouteAdmitted RouteIngressConditionType = "Admitted"
+)
+
+// RouteIngressCondition contains details for the current condition of this route on a particular
+// router.
+type RouteIngressCondition struct {
+	// Type of route ingress condition, currently only Admitted
+	Type RouteIngressConditionType
+	// Status of the condition, one of True, False, Unknown.
+	Status kapi.ConditionStatus
+	// Reason is a brief machine readable explanation for the condition's last transition.
+	Reason string
+	// Message is a human readable description of the details about last transition, complementing reason.
+	Message string
+	// LastTransitionTime is the time the condition transitioned from one status to another.
+	LastTransitionTime unversioned.Time
 }
 
 // RouteList is a collection of Routes.
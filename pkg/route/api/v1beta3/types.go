@@ -57,6 +57,43 @@ type RoutePort struct {
 // RouteStatus provides relevant info about the status of a route, including which routers
 // acknowledge it.
 type RouteStatus struct {
+	// Ingress describes the places where the route may be exposed. Each router which has
+	// handled the route records whether it admitted the route.
+	Ingress []RouteIngress `json:"ingress,omitempty" description:"the places where the route may be exposed, as reported by the routers which handled the route"`
+}
+
+// RouteIngress holds information about the places where a route is exposed
+type RouteIngress struct {
+	// Host is the host string under which the route is exposed; this value is required
+	Host string `json:"host,omitempty" description:"the host string under which the route is exposed"`
+	// RouterName is the name of the router that has handled the route
+	RouterName string `json:"routerName,omitempty" description:"the name of the router that has handled the route"`
+	// Conditions is the state of the route, may be empty.
+	Conditions []RouteIngressCondition `json:"conditions,omitempty" description:"the state of the route on this router"`
+}
+
+// RouteIngressConditionType is a valid value for RouteIngressCondition.Type
+type RouteIngressConditionType string
+
+// These are valid conditions of route ingresses.
+const (
+	// RouteAdmitted means the route is able to service requests for the provided Host
+	RouteAdmitted RouteIngressConditionType = "Admitted"
+)
+
+// RouteIngressCondition contains details for the current condition of this route on a particular
+// router.
+type RouteIngressCondition struct {
+	// Type of route ingress condition, currently only Admitted
+	Type RouteIngressConditionType `json:"type" description:"type of route ingress condition, currently only Admitted"`
+	// Status of the condition, one of True, False, Unknown.
+	Status kapi.ConditionStatus `json:"status" description:"status of the condition, one of True, False or Unknown"`
+	// Reason is a brief machine readable explanation for the condition's last transition.
+	Reason string `json:"reason,omitempty" description:"a brief machine readable reason for the last transition of the condition"`
+	// Message is a human readable description of the details about last transition, complementing reason.
+	Message string `json:"message,omitempty" description:"a human readable description of the last transition of the condition"`
+	// LastTransitionTime is the time the condition transitioned from one status to another.
+	LastTransitionTime unversioned.Time `json:"lastTransitionTime,omitempty" description:"the last time the condition changed from one status to another"`
 }
 
 // RouterShard has information of a routing shard and is used to
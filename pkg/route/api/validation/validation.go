@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	kapi "k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/validation"
 	kval "k8s.io/kubernetes/pkg/api/validation"
 	"k8s.io/kubernetes/pkg/util"
@@ -62,11 +63,37 @@ func ValidateRouteUpdate(route *routeapi.Route, older *routeapi.Route) fielderro
 func ValidateRouteStatusUpdate(route *routeapi.Route, older *routeapi.Route) fielderrors.ValidationErrorList {
 	allErrs := fielderrors.ValidationErrorList{}
 	allErrs = append(allErrs, validation.ValidateObjectMetaUpdate(&route.ObjectMeta, &older.ObjectMeta).Prefix("metadata")...)
-
-	// TODO: validate route status
+	allErrs = append(allErrs, validateRouteStatus(&route.Status).Prefix("status")...)
 	return allErrs
 }
 
+// validateRouteStatus ensures that every ingress reported by a router names the router
+// and the host it exposes the route under.
+func validateRouteStatus(status *routeapi.RouteStatus) fielderrors.ValidationErrorList {
+	result := fielderrors.ValidationErrorList{}
+	for i, ingress := range status.Ingress {
+		ingressErrs := fielderrors.ValidationErrorList{}
+		if len(ingress.RouterName) == 0 {
+			ingressErrs = append(ingressErrs, fielderrors.NewFieldRequired("routerName"))
+		}
+		if len(ingress.Host) == 0 {
+			ingressErrs = append(ingressErrs, fielderrors.NewFieldRequired("host"))
+		}
+		for j, condition := range ingress.Conditions {
+			if len(condition.Type) == 0 {
+				ingressErrs = append(ingressErrs, fielderrors.NewFieldRequired(fmt.Sprintf("conditions[%d].type", j)))
+			}
+			switch condition.Status {
+			case kapi.ConditionTrue, kapi.ConditionFalse, kapi.ConditionUnknown:
+			default:
+				ingressErrs = append(ingressErrs, fielderrors.NewFieldValueNotSupported(fmt.Sprintf("conditions[%d].status", j), condition.Status, []string{string(kapi.ConditionTrue), string(kapi.ConditionFalse), string(kapi.ConditionUnknown)}))
+			}
+		}
+		result = append(result, ingressErrs.PrefixIndex(i).Prefix("ingress")...)
+	}
+	return result
+}
+
 // ValidateTLS tests fields for different types of TLS combinations are set.  Called
 // by ValidateRoute.
 func validateTLS(route *routeapi.Route) fielderrors.ValidationErrorList {
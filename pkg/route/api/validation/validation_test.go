@@ -506,3 +506,46 @@ func TestValidateNoTLSInsecureEdgeTerminationPolicy(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateRouteStatusUpdate(t *testing.T) {
+	admitted := []api.RouteIngressCondition{{Type: api.RouteAdmitted, Status: kapi.ConditionTrue}}
+	tests := []struct {
+		name           string
+		ingress        []api.RouteIngress
+		expectedErrors int
+	}{
+		{
+			name: "no ingress",
+		},
+		{
+			name:    "admitted",
+			ingress: []api.RouteIngress{{Host: "www.example.com", RouterName: "public", Conditions: admitted}},
+		},
+		{
+			name:           "missing router name and host",
+			ingress:        []api.RouteIngress{{Conditions: admitted}},
+			expectedErrors: 2,
+		},
+		{
+			name: "invalid condition",
+			ingress: []api.RouteIngress{{
+				Host:       "www.example.com",
+				RouterName: "public",
+				Conditions: []api.RouteIngressCondition{{Status: "Maybe"}},
+			}},
+			expectedErrors: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		old := &api.Route{ObjectMeta: kapi.ObjectMeta{Name: "route", Namespace: "default", ResourceVersion: "1"}}
+		route := &api.Route{
+			ObjectMeta: kapi.ObjectMeta{Name: "route", Namespace: "default", ResourceVersion: "1"},
+			Status:     api.RouteStatus{Ingress: tc.ingress},
+		}
+		errs := ValidateRouteStatusUpdate(route, old)
+		if len(errs) != tc.expectedErrors {
+			t.Errorf("Test case %s expected %d error(s), got %d. %v", tc.name, tc.expectedErrors, len(errs), errs)
+		}
+	}
+}
@@ -127,3 +127,48 @@ func TestDelete(t *testing.T) {
 	test := registrytest.New(t, fakeClient, storage.Etcd)
 	test.TestDelete(validNewRoute("foo"))
 }
+
+func TestUpdateStatus(t *testing.T) {
+	etcdStorage, _ := registrytest.NewEtcdStorage(t, "")
+	storage := NewREST(etcdStorage, nil)
+	ctx := kapi.NewDefaultContext()
+
+	obj, err := storage.Route.Create(ctx, validNewRoute("foo"))
+	if err != nil {
+		t.Fatalf("unable to create object: %v", err)
+	}
+	created := obj.(*api.Route)
+
+	ingress := []api.RouteIngress{{
+		Host:       "www.example.com",
+		RouterName: "public",
+		Conditions: []api.RouteIngressCondition{{Type: api.RouteAdmitted, Status: kapi.ConditionTrue}},
+	}}
+
+	// the status of a route is ignored when the route is updated
+	update := *created
+	update.Status.Ingress = ingress
+	obj, _, err = storage.Route.Update(ctx, &update)
+	if err != nil {
+		t.Fatalf("unable to update object: %v", err)
+	}
+	if updated := obj.(*api.Route); len(updated.Status.Ingress) != 0 {
+		t.Errorf("expected the status to be ignored, got %#v", updated.Status)
+	}
+
+	// the spec of a route is ignored when its status is updated
+	update = *obj.(*api.Route)
+	update.Spec.Host = "other.example.com"
+	update.Status.Ingress = ingress
+	obj, _, err = storage.Status.Update(ctx, &update)
+	if err != nil {
+		t.Fatalf("unable to update status: %v", err)
+	}
+	updated := obj.(*api.Route)
+	if len(updated.Status.Ingress) != 1 || updated.Status.Ingress[0].RouterName != "public" {
+		t.Errorf("expected the status to be updated, got %#v", updated.Status)
+	}
+	if updated.Spec.Host != created.Spec.Host {
+		t.Errorf("expected the spec to be ignored, got %#v", updated.Spec)
+	}
+}
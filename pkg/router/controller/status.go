@@ -0,0 +1,216 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kclient "k8s.io/kubernetes/pkg/client/unversioned"
+	kutil "k8s.io/kubernetes/pkg/util"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/openshift/origin/pkg/client"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+	"github.com/openshift/origin/pkg/router"
+)
+
+// RejectionRecorder is an object capable of recording why a route was rejected
+type RejectionRecorder interface {
+	RecordRouteRejection(route *routeapi.Route, reason, message string)
+}
+
+// LogRejections writes rejection messages to the log.
+var LogRejections = logRecorder{}
+
+type logRecorder struct{}
+
+func (logRecorder) RecordRouteRejection(route *routeapi.Route, reason, message string) {
+	glog.V(4).Infof("Rejected route %s: %s: %s", routeNameKey(route), reason, message)
+}
+
+// StatusAdmitter ensures routes added to the plugin have status set.
+//
+// A route rejected because an older route holds its host remains rejected until
+// it is handled again after the older route is removed, at the latest when the
+// routes are resynced. The ingress of routes outside the namespaces of the
+// router is removed when the namespaces change, unless the routes were
+// rejected.
+type StatusAdmitter struct {
+	plugin     router.Plugin
+	client     client.RoutesNamespacer
+	routerName string
+
+	// recorded holds the routes whose ingress was recorded by the router, by
+	// key.
+	recorded map[string]*routeapi.Route
+}
+
+// NewStatusAdmitter creates a plugin wrapper that records in the status of the routes
+// handled by plugin that they were admitted by the router named routerName. Routes
+// rejected by another plugin are recorded through RecordRouteRejection.
+func NewStatusAdmitter(plugin router.Plugin, client client.RoutesNamespacer, routerName string) *StatusAdmitter {
+	return &StatusAdmitter{
+		plugin:     plugin,
+		client:     client,
+		routerName: routerName,
+		recorded:   make(map[string]*routeapi.Route),
+	}
+}
+
+// nowFn returns the time of condition transitions; it is replaced in tests.
+var nowFn = unversioned.Now
+
+// HandleRoute records that the route was admitted once the underlying plugin has
+// handled it.
+func (a *StatusAdmitter) HandleRoute(eventType watch.EventType, route *routeapi.Route) error {
+	if err := a.plugin.HandleRoute(eventType, route); err != nil {
+		return err
+	}
+	switch eventType {
+	case watch.Added, watch.Modified:
+		a.recordIngressCondition(route, kapi.ConditionTrue, "", "")
+	case watch.Deleted:
+		delete(a.recorded, routeNameKey(route))
+	}
+	return nil
+}
+
+// HandleEndpoints processes watch events on the Endpoints resource.
+func (a *StatusAdmitter) HandleEndpoints(eventType watch.EventType, endpoints *kapi.Endpoints) error {
+	return a.plugin.HandleEndpoints(eventType, endpoints)
+}
+
+// HandleNamespaces limits the scope of valid routes to only those that match
+// the provided namespace list. The ingress of the router is removed from the
+// routes of other namespaces.
+func (a *StatusAdmitter) HandleNamespaces(namespaces sets.String) error {
+	for key, route := range a.recorded {
+		if namespaces.Has(route.Namespace) {
+			continue
+		}
+		a.removeIngress(route)
+		delete(a.recorded, key)
+	}
+	return a.plugin.HandleNamespaces(namespaces)
+}
+
+// RecordRouteRejection records in the status of the route that it was rejected by
+// the router.
+func (a *StatusAdmitter) RecordRouteRejection(route *routeapi.Route, reason, message string) {
+	a.recordIngressCondition(route, kapi.ConditionFalse, reason, message)
+}
+
+// recordIngressCondition updates the Admitted condition of the ingress of the router
+// in the status of route. The status is only written to the server if it changed,
+// so that routers do not react to their own updates. route is shared with the other
+// plugins, so it is left unchanged; if it is outdated, the condition is recorded on
+// the latest route.
+func (a *StatusAdmitter) recordIngressCondition(route *routeapi.Route, status kapi.ConditionStatus, reason, message string) {
+	host := route.Spec.Host
+	current := route
+	err := kclient.RetryOnConflict(kclient.DefaultRetry, func() error {
+		updated, changed := withIngressCondition(current, a.routerName, host, status, reason, message)
+		if !changed {
+			return nil
+		}
+		glog.V(4).Infof("Updating the status of route %s: admitted=%s", routeNameKey(route), status)
+		_, err := a.client.Routes(route.Namespace).UpdateStatus(updated)
+		if kerrors.IsConflict(err) {
+			latest, getErr := a.client.Routes(route.Namespace).Get(route.Name)
+			if getErr != nil {
+				return getErr
+			}
+			current = latest
+		}
+		return err
+	})
+	if err != nil {
+		kutil.HandleError(fmt.Errorf("unable to write the status of route %s: %v", routeNameKey(route), err))
+		return
+	}
+	a.recorded[routeNameKey(route)] = route
+}
+
+// removeIngress removes the ingress of the router from the status of the latest
+// copy of route.
+func (a *StatusAdmitter) removeIngress(route *routeapi.Route) {
+	err := kclient.RetryOnConflict(kclient.DefaultRetry, func() error {
+		latest, err := a.client.Routes(route.Namespace).Get(route.Name)
+		if err != nil {
+			return err
+		}
+		ingress := []routeapi.RouteIngress{}
+		for _, existing := range latest.Status.Ingress {
+			if existing.RouterName != a.routerName {
+				ingress = append(ingress, existing)
+			}
+		}
+		if len(ingress) == len(latest.Status.Ingress) {
+			return nil
+		}
+		latest.Status.Ingress = ingress
+		glog.V(4).Infof("Removing the ingress of route %s, which is not served by the router", routeNameKey(route))
+		_, err = a.client.Routes(route.Namespace).UpdateStatus(latest)
+		return err
+	})
+	if err != nil && !kerrors.IsNotFound(err) {
+		kutil.HandleError(fmt.Errorf("unable to remove the ingress of route %s: %v", routeNameKey(route), err))
+	}
+}
+
+// withIngressCondition returns a copy of route whose ingress for routerName has
+// host and the Admitted condition with status, reason and message, and whether
+// the copy differs from route.
+func withIngressCondition(route *routeapi.Route, routerName, host string, status kapi.ConditionStatus, reason, message string) (*routeapi.Route, bool) {
+	updated := *route
+	updated.Status.Ingress = make([]routeapi.RouteIngress, len(route.Status.Ingress))
+	for i := range route.Status.Ingress {
+		updated.Status.Ingress[i] = route.Status.Ingress[i]
+		updated.Status.Ingress[i].Conditions = append([]routeapi.RouteIngressCondition(nil), route.Status.Ingress[i].Conditions...)
+	}
+
+	ingress := findOrCreateIngress(&updated, routerName)
+	condition := findCondition(ingress, routeapi.RouteAdmitted)
+	if condition != nil && ingress.Host == host &&
+		condition.Status == status && condition.Reason == reason && condition.Message == message {
+		return route, false
+	}
+
+	ingress.Host = host
+	if condition == nil {
+		ingress.Conditions = append(ingress.Conditions, routeapi.RouteIngressCondition{Type: routeapi.RouteAdmitted})
+		condition = &ingress.Conditions[len(ingress.Conditions)-1]
+	}
+	if condition.Status != status {
+		condition.LastTransitionTime = nowFn()
+	}
+	condition.Status = status
+	condition.Reason = reason
+	condition.Message = message
+	return &updated, true
+}
+
+// findOrCreateIngress returns the ingress of the router named routerName in the
+// status of route, adding it if it is missing.
+func findOrCreateIngress(route *routeapi.Route, routerName string) *routeapi.RouteIngress {
+	for i := range route.Status.Ingress {
+		if route.Status.Ingress[i].RouterName == routerName {
+			return &route.Status.Ingress[i]
+		}
+	}
+	route.Status.Ingress = append(route.Status.Ingress, routeapi.RouteIngress{RouterName: routerName})
+	return &route.Status.Ingress[len(route.Status.Ingress)-1]
+}
+
+// findCondition returns the condition of type t of ingress, or nil.
+func findCondition(ingress *routeapi.RouteIngress, t routeapi.RouteIngressConditionType) *routeapi.RouteIngressCondition {
+	for i := range ingress.Conditions {
+		if ingress.Conditions[i].Type == t {
+			return &ingress.Conditions[i]
+		}
+	}
+	return nil
+}
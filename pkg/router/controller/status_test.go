@@ -0,0 +1,224 @@
+package controller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	kapi "k8s.io/kubernetes/pkg/api"
+	kerrors "k8s.io/kubernetes/pkg/api/errors"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	ktestclient "k8s.io/kubernetes/pkg/client/unversioned/testclient"
+	"k8s.io/kubernetes/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/sets"
+	"k8s.io/kubernetes/pkg/watch"
+
+	"github.com/openshift/origin/pkg/client/testclient"
+	routeapi "github.com/openshift/origin/pkg/route/api"
+)
+
+type fakePlugin struct {
+	t     watch.EventType
+	route *routeapi.Route
+	err   error
+}
+
+func (p *fakePlugin) HandleRoute(t watch.EventType, route *routeapi.Route) error {
+	p.t, p.route = t, route
+	return p.err
+}
+
+func (p *fakePlugin) HandleEndpoints(watch.EventType, *kapi.Endpoints) error {
+	return nil
+}
+
+func (p *fakePlugin) HandleNamespaces(namespaces sets.String) error {
+	return nil
+}
+
+func statusUpdates(fake *testclient.Fake) []*routeapi.Route {
+	updates := []*routeapi.Route{}
+	for _, action := range fake.Actions() {
+		if action.Matches("update", "routes") && action.GetSubresource() == "status" {
+			updates = append(updates, action.(ktestclient.UpdateAction).GetObject().(*routeapi.Route))
+		}
+	}
+	return updates
+}
+
+func TestStatusAdmitterAdmits(t *testing.T) {
+	now := unversioned.Now()
+	nowFn = func() unversioned.Time { return now }
+	defer func() { nowFn = unversioned.Now }()
+
+	fake := &testclient.Fake{}
+	plugin := &fakePlugin{}
+	admitter := NewStatusAdmitter(plugin, fake, "test")
+
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "route1", Namespace: "default"},
+		Spec:       routeapi.RouteSpec{Host: "route1.test.local"},
+		Status: routeapi.RouteStatus{Ingress: []routeapi.RouteIngress{
+			{Host: "route1.test.local", RouterName: "other"},
+		}},
+	}
+	if err := admitter.HandleRoute(watch.Added, route); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if plugin.route != route {
+		t.Fatalf("expected the route to be passed to the plugin")
+	}
+
+	updates := statusUpdates(fake)
+	if len(updates) != 1 {
+		t.Fatalf("expected one status update, got %#v", fake.Actions())
+	}
+	ingress := updates[0].Status.Ingress
+	if len(ingress) != 2 || ingress[0].RouterName != "other" {
+		t.Fatalf("expected the ingress of other routers to be kept, got %#v", ingress)
+	}
+	expected := routeapi.RouteIngress{
+		Host:       "route1.test.local",
+		RouterName: "test",
+		Conditions: []routeapi.RouteIngressCondition{{Type: routeapi.RouteAdmitted, Status: kapi.ConditionTrue, LastTransitionTime: now}},
+	}
+	if !kapi.Semantic.DeepEqual(expected, ingress[1]) {
+		t.Errorf("unexpected ingress: %#v", ingress[1])
+	}
+	if len(route.Status.Ingress) != 1 {
+		t.Errorf("expected the route shared with other plugins to be left unchanged, got %#v", route.Status.Ingress)
+	}
+
+	// the status is not written again when it did not change
+	if err := admitter.HandleRoute(watch.Modified, updates[0]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(statusUpdates(fake)) != 1 {
+		t.Errorf("expected no further status updates, got %#v", fake.Actions())
+	}
+
+	// deleted routes are not admitted
+	deleted := &routeapi.Route{ObjectMeta: kapi.ObjectMeta{Name: "route2", Namespace: "default"}}
+	if err := admitter.HandleRoute(watch.Deleted, deleted); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(statusUpdates(fake)) != 1 {
+		t.Errorf("expected no status update for a deleted route, got %#v", fake.Actions())
+	}
+}
+
+func TestStatusAdmitterRecordsRejections(t *testing.T) {
+	fake := &testclient.Fake{}
+	admitter := NewStatusAdmitter(&fakePlugin{}, fake, "test")
+	plugin := NewUniqueHost(admitter, HostForRoute, admitter)
+
+	older := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "old", Namespace: "ns1", CreationTimestamp: unversioned.Time{Time: time.Now().Add(-time.Hour)}},
+		Spec:       routeapi.RouteSpec{Host: "www.example.com"},
+	}
+	newer := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "new", Namespace: "ns2", CreationTimestamp: unversioned.Time{Time: time.Now()}},
+		Spec:       routeapi.RouteSpec{Host: "www.example.com"},
+	}
+	if err := plugin.HandleRoute(watch.Added, older); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := plugin.HandleRoute(watch.Added, newer); err == nil {
+		t.Fatalf("expected the newer route to be rejected")
+	}
+
+	updates := statusUpdates(fake)
+	if len(updates) != 2 {
+		t.Fatalf("expected two status updates, got %#v", fake.Actions())
+	}
+	condition := updates[1].Status.Ingress[0].Conditions[0]
+	if updates[1].Name != "new" || condition.Status != kapi.ConditionFalse || condition.Reason != "HostAlreadyClaimed" {
+		t.Errorf("expected the newer route to be rejected, got %#v", updates[1].Status)
+	}
+	if condition.Message != "route ns1/old already exposes www.example.com and is older" {
+		t.Errorf("unexpected message: %s", condition.Message)
+	}
+}
+
+func TestStatusAdmitterRetriesConflicts(t *testing.T) {
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "route1", Namespace: "default", ResourceVersion: "1"},
+		Spec:       routeapi.RouteSpec{Host: "route1.test.local"},
+	}
+	// another router recorded its ingress since the route was handled
+	latest := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "route1", Namespace: "default", ResourceVersion: "2"},
+		Spec:       routeapi.RouteSpec{Host: "route1.test.local"},
+		Status: routeapi.RouteStatus{Ingress: []routeapi.RouteIngress{
+			{Host: "route1.test.local", RouterName: "other"},
+		}},
+	}
+
+	fake := &testclient.Fake{}
+	fake.AddReactor("get", "routes", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, latest, nil
+	})
+	fake.AddReactor("update", "routes", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		updated := action.(ktestclient.UpdateAction).GetObject().(*routeapi.Route)
+		if updated.ResourceVersion != latest.ResourceVersion {
+			return true, nil, kerrors.NewConflict("route", updated.Name, errors.New("outdated"))
+		}
+		return true, updated, nil
+	})
+
+	admitter := NewStatusAdmitter(&fakePlugin{}, fake, "test")
+	if err := admitter.HandleRoute(watch.Modified, route); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	updates := statusUpdates(fake)
+	if len(updates) != 2 {
+		t.Fatalf("expected the update to be retried, got %#v", fake.Actions())
+	}
+	ingress := updates[1].Status.Ingress
+	if updates[1].ResourceVersion != "2" || len(ingress) != 2 || ingress[0].RouterName != "other" || ingress[1].RouterName != "test" {
+		t.Errorf("expected the condition to be recorded on the latest route, got %#v", updates[1])
+	}
+}
+
+func TestStatusAdmitterRemovesIngressOutsideNamespaces(t *testing.T) {
+	route := &routeapi.Route{
+		ObjectMeta: kapi.ObjectMeta{Name: "route1", Namespace: "ns1"},
+		Spec:       routeapi.RouteSpec{Host: "route1.test.local"},
+		Status: routeapi.RouteStatus{Ingress: []routeapi.RouteIngress{
+			{Host: "route1.test.local", RouterName: "other"},
+		}},
+	}
+	var stored *routeapi.Route
+	fake := &testclient.Fake{}
+	fake.AddReactor("get", "routes", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		return true, stored, nil
+	})
+	fake.AddReactor("update", "routes", func(action ktestclient.Action) (handled bool, ret runtime.Object, err error) {
+		stored = action.(ktestclient.UpdateAction).GetObject().(*routeapi.Route)
+		return true, stored, nil
+	})
+
+	admitter := NewStatusAdmitter(&fakePlugin{}, fake, "test")
+	if err := admitter.HandleRoute(watch.Added, route); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stored == nil || len(stored.Status.Ingress) != 2 {
+		t.Fatalf("expected the route to be admitted, got %#v", stored)
+	}
+
+	// the route is still served
+	if err := admitter.HandleNamespaces(sets.NewString("ns1", "ns2")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stored.Status.Ingress) != 2 {
+		t.Errorf("expected the ingress to be kept, got %#v", stored.Status.Ingress)
+	}
+
+	if err := admitter.HandleNamespaces(sets.NewString("ns2")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stored.Status.Ingress) != 1 || stored.Status.Ingress[0].RouterName != "other" {
+		t.Errorf("expected only the ingress of the router to be removed, got %#v", stored.Status.Ingress)
+	}
+}
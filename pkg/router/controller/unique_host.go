@@ -28,6 +28,7 @@ type RouteToHostMap map[string]string
 type UniqueHost struct {
 	plugin       router.Plugin
 	hostForRoute RouteHostFunc
+	recorder     RejectionRecorder
 
 	hostToRoute HostToRouteMap
 	routeToHost RouteToHostMap
@@ -36,11 +37,13 @@ type UniqueHost struct {
 }
 
 // NewUniqueHost creates a plugin wrapper that ensures only unique routes are passed into
-// the underlying plugin.
-func NewUniqueHost(plugin router.Plugin, fn RouteHostFunc) *UniqueHost {
+// the underlying plugin. Routes which are rejected because another route holds their
+// host are reported to recorder.
+func NewUniqueHost(plugin router.Plugin, fn RouteHostFunc, recorder RejectionRecorder) *UniqueHost {
 	return &UniqueHost{
 		plugin:       plugin,
 		hostForRoute: fn,
+		recorder:     recorder,
 
 		hostToRoute: make(HostToRouteMap),
 		routeToHost: make(RouteToHostMap),
@@ -97,10 +100,12 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 				if old[i].Spec.Path == route.Spec.Path {
 					if old[i].CreationTimestamp.Before(route.CreationTimestamp) {
 						glog.V(4).Infof("Route %s cannot take %s from %s", routeName, host, routeNameKey(oldest))
+						p.recordHostClaimed(route, old[i])
 						return fmt.Errorf("route %s holds %s and is older than %s", routeNameKey(oldest), host, key)
 					}
 					glog.V(4).Infof("Route %s will replace path %s from %s because it is older", routeName, route.Spec.Path, routeNameKey(old[i]))
 					p.plugin.HandleRoute(watch.Deleted, old[i])
+					p.recordHostClaimed(old[i], route)
 					old[i] = route
 					added = true
 				}
@@ -115,12 +120,14 @@ func (p *UniqueHost) HandleRoute(eventType watch.EventType, route *routeapi.Rout
 		} else {
 			if oldest.CreationTimestamp.Before(route.CreationTimestamp) {
 				glog.V(4).Infof("Route %s cannot take %s from %s", routeName, host, routeNameKey(oldest))
+				p.recordHostClaimed(route, oldest)
 				return fmt.Errorf("route %s holds %s and is older than %s", routeNameKey(oldest), host, key)
 			}
 
 			glog.V(4).Infof("Route %s is reclaiming %s from namespace %s", routeName, host, oldest.Namespace)
 			for i := range old {
 				p.plugin.HandleRoute(watch.Deleted, old[i])
+				p.recordHostClaimed(old[i], route)
 			}
 			p.hostToRoute[host] = []*routeapi.Route{route}
 		}
@@ -183,6 +190,11 @@ func (p *UniqueHost) HandleNamespaces(namespaces sets.String) error {
 	return p.plugin.HandleNamespaces(namespaces)
 }
 
+// recordHostClaimed reports that route was rejected because owner holds its host.
+func (p *UniqueHost) recordHostClaimed(route, owner *routeapi.Route) {
+	p.recorder.RecordRouteRejection(route, "HostAlreadyClaimed", fmt.Sprintf("route %s already exposes %s and is older", routeNameKey(owner), route.Spec.Host))
+}
+
 // routeKey returns the internal router key to use for the given Route.
 func routeKey(route *routeapi.Route) string {
 	return fmt.Sprintf("%s/%s", route.Namespace, route.Spec.To.Name)
@@ -21,6 +21,13 @@ func Env(m []KeyValue) (string, error) {
 	return keyValueInstruction(command.Env, m)
 }
 
+// Add builds an ADD Dockerfile instruction adding src from the build context
+// to dest in the image. The arguments are serialized as a JSON array to
+// ensure compatibility with the Dockerfile parser.
+func Add(src, dest string) (string, error) {
+	return jsonArgsInstruction(command.Add, src, dest)
+}
+
 // From builds a FROM Dockerfile instruction referring the base image image.
 func From(image string) (string, error) {
 	return unquotedArgsInstruction(command.From, image)
@@ -55,6 +62,17 @@ func keyValueInstruction(cmd string, m []KeyValue) (string, error) {
 	return strings.Join(s, " "), nil
 }
 
+// jsonArgsInstruction builds a Dockerfile instruction with its arguments
+// serialized as a JSON array. Syntax:
+//   COMMAND ["value1","may contain spaces"]
+func jsonArgsInstruction(cmd string, args ...string) (string, error) {
+	a, err := json.Marshal(args)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s", strings.ToUpper(cmd), a), nil
+}
+
 // unquotedArgsInstruction builds a Dockerfile instruction that takes unquoted
 // string arguments. Syntax:
 //   COMMAND single unquoted argument
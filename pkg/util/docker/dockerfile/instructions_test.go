@@ -115,3 +115,30 @@ func TestFrom(t *testing.T) {
 		}
 	}
 }
+
+func TestAdd(t *testing.T) {
+	testCases := []struct {
+		src, dest string
+		want      string
+	}{
+		{
+			src:  "cache.tar",
+			dest: "/root/",
+			want: `ADD ["cache.tar","/root/"]`,
+		},
+		{
+			src:  "my cache\n.tar",
+			dest: "/opt/app root/",
+			want: `ADD ["my cache\n.tar","/opt/app root/"]`,
+		},
+	}
+	for _, tc := range testCases {
+		got, err := Add(tc.src, tc.dest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.want {
+			t.Errorf("Add(%q, %q) = %q; want %q", tc.src, tc.dest, got, tc.want)
+		}
+	}
+}